@@ -58,6 +58,8 @@ const (
 	ClusterInstallationNotStartedMsg    string = "The installation has not yet started"
 	ClusterInstallationOnHoldReason     string = "InstallationOnHold"
 	ClusterInstallationOnHoldMsg        string = "The installation is on hold. To unhold set holdInstallation to false"
+	ClusterInstallationScheduledReason  string = "InstallationScheduled"
+	ClusterInstallationScheduledMsg     string = "The installation is waiting for its scheduled time or maintenance window:"
 	ClusterInstallationInProgressReason string = "InstallationInProgress"
 	ClusterInstallationInProgressMsg    string = "The installation is in progress:"
 	ClusterUnknownStatusReason          string = "UnknownStatus"
@@ -179,6 +181,22 @@ type AgentClusterInstallSpec struct {
 	// +optional
 	HoldInstallation bool `json:"holdInstallation,omitempty"`
 
+	// ScheduledInstallTime is the earliest time at which the installation will begin.
+	// Inspection and validation will proceed as usual, but once the RequirementsMet condition is true,
+	// installation will not begin before this time.
+	// +optional
+	ScheduledInstallTime *metav1.Time `json:"scheduledInstallTime,omitempty"`
+
+	// MaintenanceWindow is the recurring time window in which the installation is allowed to begin.
+	// An installation that did not start writing images to the disks of the hosts when the window
+	// closes is aborted and begins again in the next window.
+	// +optional
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// BareMetalProvisioning is the configuration of the provisioning network of the baremetal platform.
+	// +optional
+	BareMetalProvisioning *BareMetalProvisioning `json:"bareMetalProvisioning,omitempty"`
+
 	// IgnitionEndpoint stores the data of the custom ignition endpoint.
 	// +optional
 	IgnitionEndpoint *IgnitionEndpoint `json:"ignitionEndpoint,omitempty"`
@@ -386,11 +404,11 @@ const (
 )
 
 // PlatformType is a specific supported infrastructure provider.
-// +kubebuilder:validation:Enum="";BareMetal;None;VSphere;Nutanix;External
+// +kubebuilder:validation:Enum="";BareMetal;None;VSphere;Nutanix;OpenStack;External
 type PlatformType string
 
 // CloudControllerManager describes the type of cloud controller manager to be enabled.
-// +kubebuilder:validation:Enum="";BareMetal;None;VSphere;Nutanix;External
+// +kubebuilder:validation:Enum="";BareMetal;None;VSphere;Nutanix;OpenStack;External
 type CloudControllerManager string
 
 const (
@@ -432,6 +450,9 @@ const (
 	// NutanixPlatformType represents Nutanix infrastructure.
 	NutanixPlatformType PlatformType = "Nutanix"
 
+	// OpenStackPlatformType represents OpenStack infrastructure.
+	OpenStackPlatformType PlatformType = "OpenStack"
+
 	// ExternalPlatformType represents external cloud provider infrastructure.
 	ExternalPlatformType PlatformType = "External"
 )
@@ -467,6 +488,60 @@ type DiskEncryption struct {
 	TangServers string `json:"tangServers,omitempty" gorm:"type:text"`
 }
 
+// MaintenanceWindow defines a recurring time window
+type MaintenanceWindow struct {
+	// StartTime is the time of day at which the window opens, in HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// DurationMinutes is the length of the window in minutes.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1440
+	DurationMinutes int64 `json:"durationMinutes"`
+
+	// Days are the weekdays on which the window opens. The window opens every day when empty.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// TimeZone is the IANA time zone in which StartTime is interpreted.
+	// +kubebuilder:default=UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// BareMetalProvisioning defines the provisioning network of the baremetal platform
+type BareMetalProvisioning struct {
+	// ProvisioningNetwork is the mode of the provisioning network.
+	// +kubebuilder:validation:Enum=Managed;Unmanaged;Disabled
+	ProvisioningNetwork string `json:"provisioningNetwork"`
+
+	// ProvisioningNetworkCIDR is the CIDR of the provisioning network.
+	// +optional
+	ProvisioningNetworkCIDR string `json:"provisioningNetworkCIDR,omitempty"`
+
+	// ProvisioningNetworkInterface is the name of the network interface of the control plane hosts that
+	// is connected to the provisioning network.
+	// +optional
+	ProvisioningNetworkInterface string `json:"provisioningNetworkInterface,omitempty"`
+
+	// ClusterProvisioningIP is the IP address of the provisioning services of the installed cluster.
+	// +optional
+	ClusterProvisioningIP string `json:"clusterProvisioningIP,omitempty"`
+
+	// BootstrapProvisioningIP is the IP address of the provisioning services of the bootstrap host.
+	// +optional
+	BootstrapProvisioningIP string `json:"bootstrapProvisioningIP,omitempty"`
+
+	// ProvisioningDHCPRange is the range of the addresses leased by the DHCP server of the Managed
+	// provisioning network, as a comma-separated start and end addresses.
+	// +optional
+	ProvisioningDHCPRange string `json:"provisioningDHCPRange,omitempty"`
+}
+
+// Weekday is the abbreviated name of a day of the week.
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 // AgentClusterInstallList contains a list of AgentClusterInstalls
@@ -132,6 +132,20 @@ func (in *AgentClusterInstallSpec) DeepCopyInto(out *AgentClusterInstallSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ScheduledInstallTime != nil {
+		in, out := &in.ScheduledInstallTime, &out.ScheduledInstallTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BareMetalProvisioning != nil {
+		in, out := &in.BareMetalProvisioning, &out.BareMetalProvisioning
+		*out = new(BareMetalProvisioning)
+		**out = **in
+	}
 	if in.IgnitionEndpoint != nil {
 		in, out := &in.IgnitionEndpoint, &out.IgnitionEndpoint
 		*out = new(IgnitionEndpoint)
@@ -244,6 +258,21 @@ func (in *AgentMachinePool) DeepCopy() *AgentMachinePool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BareMetalProvisioning) DeepCopyInto(out *BareMetalProvisioning) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BareMetalProvisioning.
+func (in *BareMetalProvisioning) DeepCopy() *BareMetalProvisioning {
+	if in == nil {
+		return nil
+	}
+	out := new(BareMetalProvisioning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CaCertificateReference) DeepCopyInto(out *CaCertificateReference) {
 	*out = *in
@@ -379,6 +408,26 @@ func (in *MachineNetworkEntry) DeepCopy() *MachineNetworkEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManifestsConfigMapReference) DeepCopyInto(out *ManifestsConfigMapReference) {
 	*out = *in
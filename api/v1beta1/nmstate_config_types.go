@@ -53,6 +53,39 @@ type NMStateConfigSpec struct {
 	// yaml that can be processed by nmstate, using custom marshaling/unmarshaling that will allow to populate nmstate config as plain yaml.
 	// +kubebuilder:validation:XPreserveUnknownFields
 	NetConfig NetConfig `json:"config,omitempty"`
+	// Template makes the config a template that is expanded once per host in
+	// its list, instead of the config of a single host. The string values of the
+	// config can reference the index of the host with {{.HostIndex}}, the address
+	// picked for the host from the IP range with {{.IP}}, the MAC addresses of
+	// the host by interface name with {{index .MacAddresses "eth0"}} and the
+	// variables of the host with {{.Variables.name}}.
+	// +optional
+	Template *NMStateConfigTemplate `json:"template,omitempty"`
+}
+
+type NMStateConfigTemplate struct {
+	// Hosts is the list of hosts that the config is expanded for.
+	// +kubebuilder:validation:MinItems=1
+	Hosts []NMStateConfigTemplateHost `json:"hosts"`
+	// IPRangeStart is the first address of the range from which consecutive
+	// addresses are picked for the hosts, in the order of the list.
+	// +optional
+	IPRangeStart string `json:"ipRangeStart,omitempty"`
+	// IPRangeEnd is the last address of the range from which addresses are
+	// picked for the hosts.
+	// +optional
+	IPRangeEnd string `json:"ipRangeEnd,omitempty"`
+}
+
+type NMStateConfigTemplateHost struct {
+	// Interfaces is an array of interface objects containing the name and MAC
+	// address of the interfaces of the host, as in the interfaces of the spec.
+	// +kubebuilder:validation:MinItems=1
+	Interfaces []*Interface `json:"interfaces"`
+	// Variables are the values of the variables of the host referenced by the
+	// config.
+	// +optional
+	Variables map[string]string `json:"variables,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1106,6 +1106,11 @@ func (in *NMStateConfigSpec) DeepCopyInto(out *NMStateConfigSpec) {
 		}
 	}
 	in.NetConfig.DeepCopyInto(&out.NetConfig)
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(NMStateConfigTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NMStateConfigSpec.
@@ -1118,6 +1123,61 @@ func (in *NMStateConfigSpec) DeepCopy() *NMStateConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NMStateConfigTemplate) DeepCopyInto(out *NMStateConfigTemplate) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]NMStateConfigTemplateHost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NMStateConfigTemplate.
+func (in *NMStateConfigTemplate) DeepCopy() *NMStateConfigTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NMStateConfigTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NMStateConfigTemplateHost) DeepCopyInto(out *NMStateConfigTemplateHost) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]*Interface, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Interface)
+				**out = **in
+			}
+		}
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NMStateConfigTemplateHost.
+func (in *NMStateConfigTemplateHost) DeepCopy() *NMStateConfigTemplateHost {
+	if in == nil {
+		return nil
+	}
+	out := new(NMStateConfigTemplateHost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetConfig) DeepCopyInto(out *NetConfig) {
 	*out = *in
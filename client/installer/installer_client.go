@@ -106,27 +106,52 @@ type API interface {
 	/*
 	   V2UploadLogs Agent API to upload logs.*/
 	V2UploadLogs(ctx context.Context, params *V2UploadLogsParams) (*V2UploadLogsNoContent, error)
+	/*
+	   V2CancelClusterBatch Cancels the ongoing installations of the clusters of the batch.*/
+	V2CancelClusterBatch(ctx context.Context, params *V2CancelClusterBatchParams) (*V2CancelClusterBatchAccepted, error)
 	/*
 	   V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.*/
 	V2CompleteInstallation(ctx context.Context, params *V2CompleteInstallationParams) (*V2CompleteInstallationAccepted, error)
+	/*
+	   V2CreateClusterBatch Creates a batch of OpenShift cluster definitions and their infra-envs from a template and a list of per-site overrides.*/
+	V2CreateClusterBatch(ctx context.Context, params *V2CreateClusterBatchParams) (*V2CreateClusterBatchCreated, error)
 	/*
 	   V2DeregisterCluster Deletes an OpenShift cluster definition.*/
 	V2DeregisterCluster(ctx context.Context, params *V2DeregisterClusterParams) (*V2DeregisterClusterNoContent, error)
 	/*
 	   V2DeregisterHost Deregisters an OpenShift host.*/
 	V2DeregisterHost(ctx context.Context, params *V2DeregisterHostParams) (*V2DeregisterHostNoContent, error)
+	/*
+	   V2DownloadClusterNetworkTopology Downloads the network topology of the hosts of the cluster as a Graphviz DOT graph.*/
+	V2DownloadClusterNetworkTopology(ctx context.Context, params *V2DownloadClusterNetworkTopologyParams, writer io.Writer) (*V2DownloadClusterNetworkTopologyOK, error)
 	/*
 	   V2DownloadHostIgnition Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned*/
 	V2DownloadHostIgnition(ctx context.Context, params *V2DownloadHostIgnitionParams, writer io.Writer) (*V2DownloadHostIgnitionOK, error)
 	/*
 	   V2DownloadInfraEnvFiles Downloads the customized ignition file for this host*/
 	V2DownloadInfraEnvFiles(ctx context.Context, params *V2DownloadInfraEnvFilesParams, writer io.Writer) (*V2DownloadInfraEnvFilesOK, error)
+	/*
+	   V2DownloadInfraEnvNetworkTopology Downloads the network topology of the hosts of the infra-env as a Graphviz DOT graph.*/
+	V2DownloadInfraEnvNetworkTopology(ctx context.Context, params *V2DownloadInfraEnvNetworkTopologyParams, writer io.Writer) (*V2DownloadInfraEnvNetworkTopologyOK, error)
+	/*
+	   V2EraseHostDisks Securely erases the given disks of an unbound host. The result of the erasure is
+	   recorded in a signed disk erasure report.*/
+	V2EraseHostDisks(ctx context.Context, params *V2EraseHostDisksParams) (*V2EraseHostDisksAccepted, error)
 	/*
 	   V2GetCluster Retrieves the details of the OpenShift cluster.*/
 	V2GetCluster(ctx context.Context, params *V2GetClusterParams) (*V2GetClusterOK, error)
+	/*
+	   V2GetClusterBatch Retrieves the details of the cluster batch, including the aggregated status of its clusters.*/
+	V2GetClusterBatch(ctx context.Context, params *V2GetClusterBatchParams) (*V2GetClusterBatchOK, error)
 	/*
 	   V2GetClusterInstallConfig Get the cluster's install config YAML.*/
 	V2GetClusterInstallConfig(ctx context.Context, params *V2GetClusterInstallConfigParams) (*V2GetClusterInstallConfigOK, error)
+	/*
+	   V2GetClusterNetworkTopology Retrieves the network topology of the hosts of the cluster, built from their inventories and connectivity reports.*/
+	V2GetClusterNetworkTopology(ctx context.Context, params *V2GetClusterNetworkTopologyParams) (*V2GetClusterNetworkTopologyOK, error)
+	/*
+	   V2GetClusterSecondaryNetworks Retrieves the secondary network policies of the cluster.*/
+	V2GetClusterSecondaryNetworks(ctx context.Context, params *V2GetClusterSecondaryNetworksParams) (*V2GetClusterSecondaryNetworksOK, error)
 	/*
 	   V2GetHost Retrieves the details of the OpenShift host.*/
 	V2GetHost(ctx context.Context, params *V2GetHostParams) (*V2GetHostOK, error)
@@ -136,6 +161,9 @@ type API interface {
 	/*
 	   V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster.*/
 	V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnoredValidationsParams) (*V2GetIgnoredValidationsOK, error)
+	/*
+	   V2GetInfraEnvNetworkTopology Retrieves the network topology of the hosts of the infra-env, built from their inventories and connectivity reports.*/
+	V2GetInfraEnvNetworkTopology(ctx context.Context, params *V2GetInfraEnvNetworkTopologyParams) (*V2GetInfraEnvNetworkTopologyOK, error)
 	/*
 	   V2GetNextSteps Retrieves the next operations that the host agent needs to perform.*/
 	V2GetNextSteps(ctx context.Context, params *V2GetNextStepsParams) (*V2GetNextStepsOK, error)
@@ -148,12 +176,21 @@ type API interface {
 	/*
 	   V2InstallCluster Installs the OpenShift cluster.*/
 	V2InstallCluster(ctx context.Context, params *V2InstallClusterParams) (*V2InstallClusterAccepted, error)
+	/*
+	   V2InstallClusterBatch Installs all the clusters of the batch.*/
+	V2InstallClusterBatch(ctx context.Context, params *V2InstallClusterBatchParams) (*V2InstallClusterBatchAccepted, error)
 	/*
 	   V2InstallHost install specific host for day2 cluster.*/
 	V2InstallHost(ctx context.Context, params *V2InstallHostParams) (*V2InstallHostAccepted, error)
+	/*
+	   V2ListClusterBatches Retrieves the list of cluster batches.*/
+	V2ListClusterBatches(ctx context.Context, params *V2ListClusterBatchesParams) (*V2ListClusterBatchesOK, error)
 	/*
 	   V2ListClusters Retrieves the list of OpenShift clusters.*/
 	V2ListClusters(ctx context.Context, params *V2ListClustersParams) (*V2ListClustersOK, error)
+	/*
+	   V2ListHostDiskErasureReports Retrieves the signed disk erasure reports of the host.*/
+	V2ListHostDiskErasureReports(ctx context.Context, params *V2ListHostDiskErasureReportsParams) (*V2ListHostDiskErasureReportsOK, error)
 	/*
 	   V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.*/
 	V2ListHosts(ctx context.Context, params *V2ListHostsParams) (*V2ListHostsOK, error)
@@ -180,6 +217,9 @@ type API interface {
 	/*
 	   V2SetIgnoredValidations Register the validations which are to be ignored for this cluster.*/
 	V2SetIgnoredValidations(ctx context.Context, params *V2SetIgnoredValidationsParams) (*V2SetIgnoredValidationsCreated, error)
+	/*
+	   V2SuggestNetworks Suggests cluster and service networks that don't overlap the networks of the hosts of the cluster, the reserved networks of the service and the networks of the other clusters of the organization.*/
+	V2SuggestNetworks(ctx context.Context, params *V2SuggestNetworksParams) (*V2SuggestNetworksOK, error)
 	/*
 	   V2UpdateClusterFinalizingProgress Update installation finalizing progress.*/
 	V2UpdateClusterFinalizingProgress(ctx context.Context, params *V2UpdateClusterFinalizingProgressParams) (*V2UpdateClusterFinalizingProgressOK, error)
@@ -189,6 +229,9 @@ type API interface {
 	/*
 	   V2UpdateClusterLogsProgress Update log collection state and progress.*/
 	V2UpdateClusterLogsProgress(ctx context.Context, params *V2UpdateClusterLogsProgressParams) (*V2UpdateClusterLogsProgressNoContent, error)
+	/*
+	   V2UpdateClusterSecondaryNetworks Sets the secondary network policies of the cluster, the NMState configurations applied to the selected hosts of the cluster at installation time.*/
+	V2UpdateClusterSecondaryNetworks(ctx context.Context, params *V2UpdateClusterSecondaryNetworksParams) (*V2UpdateClusterSecondaryNetworksOK, error)
 	/*
 	   V2UpdateHost Update an Openshift host*/
 	V2UpdateHost(ctx context.Context, params *V2UpdateHostParams) (*V2UpdateHostCreated, error)
@@ -958,6 +1001,31 @@ func (a *Client) V2UploadLogs(ctx context.Context, params *V2UploadLogsParams) (
 
 }
 
+/*
+V2CancelClusterBatch Cancels the ongoing installations of the clusters of the batch.
+*/
+func (a *Client) V2CancelClusterBatch(ctx context.Context, params *V2CancelClusterBatchParams) (*V2CancelClusterBatchAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2CancelClusterBatch",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-batches/{cluster_batch_id}/actions/cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CancelClusterBatchReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CancelClusterBatchAccepted), nil
+
+}
+
 /*
 V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%.
 */
@@ -983,6 +1051,31 @@ func (a *Client) V2CompleteInstallation(ctx context.Context, params *V2CompleteI
 
 }
 
+/*
+V2CreateClusterBatch Creates a batch of OpenShift cluster definitions and their infra-envs from a template and a list of per-site overrides.
+*/
+func (a *Client) V2CreateClusterBatch(ctx context.Context, params *V2CreateClusterBatchParams) (*V2CreateClusterBatchCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2CreateClusterBatch",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-batches",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2CreateClusterBatchReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2CreateClusterBatchCreated), nil
+
+}
+
 /*
 V2DeregisterCluster Deletes an OpenShift cluster definition.
 */
@@ -1033,6 +1126,31 @@ func (a *Client) V2DeregisterHost(ctx context.Context, params *V2DeregisterHostP
 
 }
 
+/*
+V2DownloadClusterNetworkTopology Downloads the network topology of the hosts of the cluster as a Graphviz DOT graph.
+*/
+func (a *Client) V2DownloadClusterNetworkTopology(ctx context.Context, params *V2DownloadClusterNetworkTopologyParams, writer io.Writer) (*V2DownloadClusterNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2DownloadClusterNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/downloads/network-topology",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DownloadClusterNetworkTopologyReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DownloadClusterNetworkTopologyOK), nil
+
+}
+
 /*
 V2DownloadHostIgnition Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned
 */
@@ -1083,6 +1201,57 @@ func (a *Client) V2DownloadInfraEnvFiles(ctx context.Context, params *V2Download
 
 }
 
+/*
+V2DownloadInfraEnvNetworkTopology Downloads the network topology of the hosts of the infra-env as a Graphviz DOT graph.
+*/
+func (a *Client) V2DownloadInfraEnvNetworkTopology(ctx context.Context, params *V2DownloadInfraEnvNetworkTopologyParams, writer io.Writer) (*V2DownloadInfraEnvNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2DownloadInfraEnvNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/downloads/network-topology",
+		ProducesMediaTypes: []string{"application/octet-stream"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2DownloadInfraEnvNetworkTopologyReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2DownloadInfraEnvNetworkTopologyOK), nil
+
+}
+
+/*
+V2EraseHostDisks Securely erases the given disks of an unbound host. The result of the erasure is
+recorded in a signed disk erasure report.
+*/
+func (a *Client) V2EraseHostDisks(ctx context.Context, params *V2EraseHostDisksParams) (*V2EraseHostDisksAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2EraseHostDisks",
+		Method:             "POST",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2EraseHostDisksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2EraseHostDisksAccepted), nil
+
+}
+
 /*
 V2GetCluster Retrieves the details of the OpenShift cluster.
 */
@@ -1108,6 +1277,31 @@ func (a *Client) V2GetCluster(ctx context.Context, params *V2GetClusterParams) (
 
 }
 
+/*
+V2GetClusterBatch Retrieves the details of the cluster batch, including the aggregated status of its clusters.
+*/
+func (a *Client) V2GetClusterBatch(ctx context.Context, params *V2GetClusterBatchParams) (*V2GetClusterBatchOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetClusterBatch",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-batches/{cluster_batch_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterBatchReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterBatchOK), nil
+
+}
+
 /*
 V2GetClusterInstallConfig Get the cluster's install config YAML.
 */
@@ -1133,6 +1327,56 @@ func (a *Client) V2GetClusterInstallConfig(ctx context.Context, params *V2GetClu
 
 }
 
+/*
+V2GetClusterNetworkTopology Retrieves the network topology of the hosts of the cluster, built from their inventories and connectivity reports.
+*/
+func (a *Client) V2GetClusterNetworkTopology(ctx context.Context, params *V2GetClusterNetworkTopologyParams) (*V2GetClusterNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetClusterNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/network-topology",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterNetworkTopologyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterNetworkTopologyOK), nil
+
+}
+
+/*
+V2GetClusterSecondaryNetworks Retrieves the secondary network policies of the cluster.
+*/
+func (a *Client) V2GetClusterSecondaryNetworks(ctx context.Context, params *V2GetClusterSecondaryNetworksParams) (*V2GetClusterSecondaryNetworksOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetClusterSecondaryNetworks",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/secondary-networks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetClusterSecondaryNetworksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetClusterSecondaryNetworksOK), nil
+
+}
+
 /*
 V2GetHost Retrieves the details of the OpenShift host.
 */
@@ -1208,6 +1452,31 @@ func (a *Client) V2GetIgnoredValidations(ctx context.Context, params *V2GetIgnor
 
 }
 
+/*
+V2GetInfraEnvNetworkTopology Retrieves the network topology of the hosts of the infra-env, built from their inventories and connectivity reports.
+*/
+func (a *Client) V2GetInfraEnvNetworkTopology(ctx context.Context, params *V2GetInfraEnvNetworkTopologyParams) (*V2GetInfraEnvNetworkTopologyOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetInfraEnvNetworkTopology",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/network-topology",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetInfraEnvNetworkTopologyReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetInfraEnvNetworkTopologyOK), nil
+
+}
+
 /*
 V2GetNextSteps Retrieves the next operations that the host agent needs to perform.
 */
@@ -1308,6 +1577,31 @@ func (a *Client) V2InstallCluster(ctx context.Context, params *V2InstallClusterP
 
 }
 
+/*
+V2InstallClusterBatch Installs all the clusters of the batch.
+*/
+func (a *Client) V2InstallClusterBatch(ctx context.Context, params *V2InstallClusterBatchParams) (*V2InstallClusterBatchAccepted, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2InstallClusterBatch",
+		Method:             "POST",
+		PathPattern:        "/v2/cluster-batches/{cluster_batch_id}/actions/install",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2InstallClusterBatchReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2InstallClusterBatchAccepted), nil
+
+}
+
 /*
 V2InstallHost install specific host for day2 cluster.
 */
@@ -1333,6 +1627,31 @@ func (a *Client) V2InstallHost(ctx context.Context, params *V2InstallHostParams)
 
 }
 
+/*
+V2ListClusterBatches Retrieves the list of cluster batches.
+*/
+func (a *Client) V2ListClusterBatches(ctx context.Context, params *V2ListClusterBatchesParams) (*V2ListClusterBatchesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ListClusterBatches",
+		Method:             "GET",
+		PathPattern:        "/v2/cluster-batches",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListClusterBatchesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListClusterBatchesOK), nil
+
+}
+
 /*
 V2ListClusters Retrieves the list of OpenShift clusters.
 */
@@ -1358,6 +1677,31 @@ func (a *Client) V2ListClusters(ctx context.Context, params *V2ListClustersParam
 
 }
 
+/*
+V2ListHostDiskErasureReports Retrieves the signed disk erasure reports of the host.
+*/
+func (a *Client) V2ListHostDiskErasureReports(ctx context.Context, params *V2ListHostDiskErasureReportsParams) (*V2ListHostDiskErasureReportsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ListHostDiskErasureReports",
+		Method:             "GET",
+		PathPattern:        "/v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListHostDiskErasureReportsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListHostDiskErasureReportsOK), nil
+
+}
+
 /*
 V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env.
 */
@@ -1560,6 +1904,31 @@ func (a *Client) V2SetIgnoredValidations(ctx context.Context, params *V2SetIgnor
 
 }
 
+/*
+V2SuggestNetworks Suggests cluster and service networks that don't overlap the networks of the hosts of the cluster, the reserved networks of the service and the networks of the other clusters of the organization.
+*/
+func (a *Client) V2SuggestNetworks(ctx context.Context, params *V2SuggestNetworksParams) (*V2SuggestNetworksOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2SuggestNetworks",
+		Method:             "GET",
+		PathPattern:        "/v2/clusters/{cluster_id}/suggest-networks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2SuggestNetworksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2SuggestNetworksOK), nil
+
+}
+
 /*
 V2UpdateClusterFinalizingProgress Update installation finalizing progress.
 */
@@ -1635,6 +2004,31 @@ func (a *Client) V2UpdateClusterLogsProgress(ctx context.Context, params *V2Upda
 
 }
 
+/*
+V2UpdateClusterSecondaryNetworks Sets the secondary network policies of the cluster, the NMState configurations applied to the selected hosts of the cluster at installation time.
+*/
+func (a *Client) V2UpdateClusterSecondaryNetworks(ctx context.Context, params *V2UpdateClusterSecondaryNetworksParams) (*V2UpdateClusterSecondaryNetworksOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2UpdateClusterSecondaryNetworks",
+		Method:             "PUT",
+		PathPattern:        "/v2/clusters/{cluster_id}/secondary-networks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2UpdateClusterSecondaryNetworksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2UpdateClusterSecondaryNetworksOK), nil
+
+}
+
 /*
 V2UpdateHost Update an Openshift host
 */
@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CancelClusterBatchReader is a Reader for the V2CancelClusterBatch structure.
+type V2CancelClusterBatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2CancelClusterBatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2CancelClusterBatchAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2CancelClusterBatchUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2CancelClusterBatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2CancelClusterBatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2CancelClusterBatchMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2CancelClusterBatchConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2CancelClusterBatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2CancelClusterBatchAccepted creates a V2CancelClusterBatchAccepted with default headers values
+func NewV2CancelClusterBatchAccepted() *V2CancelClusterBatchAccepted {
+	return &V2CancelClusterBatchAccepted{}
+}
+
+/*
+V2CancelClusterBatchAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2CancelClusterBatchAccepted struct {
+	Payload *models.ClusterBatch
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch accepted response has a 2xx status code
+func (o *V2CancelClusterBatchAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch accepted response has a 3xx status code
+func (o *V2CancelClusterBatchAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch accepted response has a 4xx status code
+func (o *V2CancelClusterBatchAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 cancel cluster batch accepted response has a 5xx status code
+func (o *V2CancelClusterBatchAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 cancel cluster batch accepted response a status code equal to that given
+func (o *V2CancelClusterBatchAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2CancelClusterBatchAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2CancelClusterBatchAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2CancelClusterBatchAccepted) GetPayload() *models.ClusterBatch {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterBatch)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelClusterBatchUnauthorized creates a V2CancelClusterBatchUnauthorized with default headers values
+func NewV2CancelClusterBatchUnauthorized() *V2CancelClusterBatchUnauthorized {
+	return &V2CancelClusterBatchUnauthorized{}
+}
+
+/*
+V2CancelClusterBatchUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2CancelClusterBatchUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch unauthorized response has a 2xx status code
+func (o *V2CancelClusterBatchUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch unauthorized response has a 3xx status code
+func (o *V2CancelClusterBatchUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch unauthorized response has a 4xx status code
+func (o *V2CancelClusterBatchUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 cancel cluster batch unauthorized response has a 5xx status code
+func (o *V2CancelClusterBatchUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 cancel cluster batch unauthorized response a status code equal to that given
+func (o *V2CancelClusterBatchUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2CancelClusterBatchUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CancelClusterBatchUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CancelClusterBatchUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelClusterBatchForbidden creates a V2CancelClusterBatchForbidden with default headers values
+func NewV2CancelClusterBatchForbidden() *V2CancelClusterBatchForbidden {
+	return &V2CancelClusterBatchForbidden{}
+}
+
+/*
+V2CancelClusterBatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2CancelClusterBatchForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch forbidden response has a 2xx status code
+func (o *V2CancelClusterBatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch forbidden response has a 3xx status code
+func (o *V2CancelClusterBatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch forbidden response has a 4xx status code
+func (o *V2CancelClusterBatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 cancel cluster batch forbidden response has a 5xx status code
+func (o *V2CancelClusterBatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 cancel cluster batch forbidden response a status code equal to that given
+func (o *V2CancelClusterBatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2CancelClusterBatchForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CancelClusterBatchForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CancelClusterBatchForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelClusterBatchNotFound creates a V2CancelClusterBatchNotFound with default headers values
+func NewV2CancelClusterBatchNotFound() *V2CancelClusterBatchNotFound {
+	return &V2CancelClusterBatchNotFound{}
+}
+
+/*
+V2CancelClusterBatchNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2CancelClusterBatchNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch not found response has a 2xx status code
+func (o *V2CancelClusterBatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch not found response has a 3xx status code
+func (o *V2CancelClusterBatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch not found response has a 4xx status code
+func (o *V2CancelClusterBatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 cancel cluster batch not found response has a 5xx status code
+func (o *V2CancelClusterBatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 cancel cluster batch not found response a status code equal to that given
+func (o *V2CancelClusterBatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2CancelClusterBatchNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2CancelClusterBatchNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2CancelClusterBatchNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelClusterBatchMethodNotAllowed creates a V2CancelClusterBatchMethodNotAllowed with default headers values
+func NewV2CancelClusterBatchMethodNotAllowed() *V2CancelClusterBatchMethodNotAllowed {
+	return &V2CancelClusterBatchMethodNotAllowed{}
+}
+
+/*
+V2CancelClusterBatchMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2CancelClusterBatchMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch method not allowed response has a 2xx status code
+func (o *V2CancelClusterBatchMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch method not allowed response has a 3xx status code
+func (o *V2CancelClusterBatchMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch method not allowed response has a 4xx status code
+func (o *V2CancelClusterBatchMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 cancel cluster batch method not allowed response has a 5xx status code
+func (o *V2CancelClusterBatchMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 cancel cluster batch method not allowed response a status code equal to that given
+func (o *V2CancelClusterBatchMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2CancelClusterBatchMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2CancelClusterBatchMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2CancelClusterBatchMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelClusterBatchConflict creates a V2CancelClusterBatchConflict with default headers values
+func NewV2CancelClusterBatchConflict() *V2CancelClusterBatchConflict {
+	return &V2CancelClusterBatchConflict{}
+}
+
+/*
+V2CancelClusterBatchConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2CancelClusterBatchConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch conflict response has a 2xx status code
+func (o *V2CancelClusterBatchConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch conflict response has a 3xx status code
+func (o *V2CancelClusterBatchConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch conflict response has a 4xx status code
+func (o *V2CancelClusterBatchConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 cancel cluster batch conflict response has a 5xx status code
+func (o *V2CancelClusterBatchConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 cancel cluster batch conflict response a status code equal to that given
+func (o *V2CancelClusterBatchConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2CancelClusterBatchConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2CancelClusterBatchConflict) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2CancelClusterBatchConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CancelClusterBatchInternalServerError creates a V2CancelClusterBatchInternalServerError with default headers values
+func NewV2CancelClusterBatchInternalServerError() *V2CancelClusterBatchInternalServerError {
+	return &V2CancelClusterBatchInternalServerError{}
+}
+
+/*
+V2CancelClusterBatchInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2CancelClusterBatchInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 cancel cluster batch internal server error response has a 2xx status code
+func (o *V2CancelClusterBatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 cancel cluster batch internal server error response has a 3xx status code
+func (o *V2CancelClusterBatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 cancel cluster batch internal server error response has a 4xx status code
+func (o *V2CancelClusterBatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 cancel cluster batch internal server error response has a 5xx status code
+func (o *V2CancelClusterBatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 cancel cluster batch internal server error response a status code equal to that given
+func (o *V2CancelClusterBatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2CancelClusterBatchInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CancelClusterBatchInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel][%d] v2CancelClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CancelClusterBatchInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CancelClusterBatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
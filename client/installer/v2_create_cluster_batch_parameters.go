@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2CreateClusterBatchParams creates a new V2CreateClusterBatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2CreateClusterBatchParams() *V2CreateClusterBatchParams {
+	return &V2CreateClusterBatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2CreateClusterBatchParamsWithTimeout creates a new V2CreateClusterBatchParams object
+// with the ability to set a timeout on a request.
+func NewV2CreateClusterBatchParamsWithTimeout(timeout time.Duration) *V2CreateClusterBatchParams {
+	return &V2CreateClusterBatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2CreateClusterBatchParamsWithContext creates a new V2CreateClusterBatchParams object
+// with the ability to set a context for a request.
+func NewV2CreateClusterBatchParamsWithContext(ctx context.Context) *V2CreateClusterBatchParams {
+	return &V2CreateClusterBatchParams{
+		Context: ctx,
+	}
+}
+
+// NewV2CreateClusterBatchParamsWithHTTPClient creates a new V2CreateClusterBatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2CreateClusterBatchParamsWithHTTPClient(client *http.Client) *V2CreateClusterBatchParams {
+	return &V2CreateClusterBatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2CreateClusterBatchParams contains all the parameters to send to the API endpoint
+
+	for the v2 create cluster batch operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2CreateClusterBatchParams struct {
+
+	/* NewClusterBatchParams.
+
+	   The template and the sites of the new clusters.
+	*/
+	NewClusterBatchParams *models.ClusterBatchCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 create cluster batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateClusterBatchParams) WithDefaults() *V2CreateClusterBatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 create cluster batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2CreateClusterBatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) WithTimeout(timeout time.Duration) *V2CreateClusterBatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) WithContext(ctx context.Context) *V2CreateClusterBatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) WithHTTPClient(client *http.Client) *V2CreateClusterBatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithNewClusterBatchParams adds the newClusterBatchParams to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) WithNewClusterBatchParams(newClusterBatchParams *models.ClusterBatchCreateParams) *V2CreateClusterBatchParams {
+	o.SetNewClusterBatchParams(newClusterBatchParams)
+	return o
+}
+
+// SetNewClusterBatchParams adds the newClusterBatchParams to the v2 create cluster batch params
+func (o *V2CreateClusterBatchParams) SetNewClusterBatchParams(newClusterBatchParams *models.ClusterBatchCreateParams) {
+	o.NewClusterBatchParams = newClusterBatchParams
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2CreateClusterBatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.NewClusterBatchParams != nil {
+		if err := r.SetBodyParam(o.NewClusterBatchParams); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateClusterBatchReader is a Reader for the V2CreateClusterBatch structure.
+type V2CreateClusterBatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2CreateClusterBatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2CreateClusterBatchCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2CreateClusterBatchBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2CreateClusterBatchUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2CreateClusterBatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2CreateClusterBatchMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2CreateClusterBatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2CreateClusterBatchCreated creates a V2CreateClusterBatchCreated with default headers values
+func NewV2CreateClusterBatchCreated() *V2CreateClusterBatchCreated {
+	return &V2CreateClusterBatchCreated{}
+}
+
+/*
+V2CreateClusterBatchCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2CreateClusterBatchCreated struct {
+	Payload *models.ClusterBatch
+}
+
+// IsSuccess returns true when this v2 create cluster batch created response has a 2xx status code
+func (o *V2CreateClusterBatchCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 create cluster batch created response has a 3xx status code
+func (o *V2CreateClusterBatchCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster batch created response has a 4xx status code
+func (o *V2CreateClusterBatchCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 create cluster batch created response has a 5xx status code
+func (o *V2CreateClusterBatchCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster batch created response a status code equal to that given
+func (o *V2CreateClusterBatchCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2CreateClusterBatchCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2CreateClusterBatchCreated) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2CreateClusterBatchCreated) GetPayload() *models.ClusterBatch {
+	return o.Payload
+}
+
+func (o *V2CreateClusterBatchCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterBatch)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterBatchBadRequest creates a V2CreateClusterBatchBadRequest with default headers values
+func NewV2CreateClusterBatchBadRequest() *V2CreateClusterBatchBadRequest {
+	return &V2CreateClusterBatchBadRequest{}
+}
+
+/*
+V2CreateClusterBatchBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2CreateClusterBatchBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create cluster batch bad request response has a 2xx status code
+func (o *V2CreateClusterBatchBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster batch bad request response has a 3xx status code
+func (o *V2CreateClusterBatchBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster batch bad request response has a 4xx status code
+func (o *V2CreateClusterBatchBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster batch bad request response has a 5xx status code
+func (o *V2CreateClusterBatchBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster batch bad request response a status code equal to that given
+func (o *V2CreateClusterBatchBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2CreateClusterBatchBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2CreateClusterBatchBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2CreateClusterBatchBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateClusterBatchBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterBatchUnauthorized creates a V2CreateClusterBatchUnauthorized with default headers values
+func NewV2CreateClusterBatchUnauthorized() *V2CreateClusterBatchUnauthorized {
+	return &V2CreateClusterBatchUnauthorized{}
+}
+
+/*
+V2CreateClusterBatchUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2CreateClusterBatchUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 create cluster batch unauthorized response has a 2xx status code
+func (o *V2CreateClusterBatchUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster batch unauthorized response has a 3xx status code
+func (o *V2CreateClusterBatchUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster batch unauthorized response has a 4xx status code
+func (o *V2CreateClusterBatchUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster batch unauthorized response has a 5xx status code
+func (o *V2CreateClusterBatchUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster batch unauthorized response a status code equal to that given
+func (o *V2CreateClusterBatchUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2CreateClusterBatchUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CreateClusterBatchUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2CreateClusterBatchUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateClusterBatchUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterBatchForbidden creates a V2CreateClusterBatchForbidden with default headers values
+func NewV2CreateClusterBatchForbidden() *V2CreateClusterBatchForbidden {
+	return &V2CreateClusterBatchForbidden{}
+}
+
+/*
+V2CreateClusterBatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2CreateClusterBatchForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 create cluster batch forbidden response has a 2xx status code
+func (o *V2CreateClusterBatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster batch forbidden response has a 3xx status code
+func (o *V2CreateClusterBatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster batch forbidden response has a 4xx status code
+func (o *V2CreateClusterBatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster batch forbidden response has a 5xx status code
+func (o *V2CreateClusterBatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster batch forbidden response a status code equal to that given
+func (o *V2CreateClusterBatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2CreateClusterBatchForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CreateClusterBatchForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2CreateClusterBatchForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2CreateClusterBatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterBatchMethodNotAllowed creates a V2CreateClusterBatchMethodNotAllowed with default headers values
+func NewV2CreateClusterBatchMethodNotAllowed() *V2CreateClusterBatchMethodNotAllowed {
+	return &V2CreateClusterBatchMethodNotAllowed{}
+}
+
+/*
+V2CreateClusterBatchMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2CreateClusterBatchMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create cluster batch method not allowed response has a 2xx status code
+func (o *V2CreateClusterBatchMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster batch method not allowed response has a 3xx status code
+func (o *V2CreateClusterBatchMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster batch method not allowed response has a 4xx status code
+func (o *V2CreateClusterBatchMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 create cluster batch method not allowed response has a 5xx status code
+func (o *V2CreateClusterBatchMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 create cluster batch method not allowed response a status code equal to that given
+func (o *V2CreateClusterBatchMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2CreateClusterBatchMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2CreateClusterBatchMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2CreateClusterBatchMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateClusterBatchMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2CreateClusterBatchInternalServerError creates a V2CreateClusterBatchInternalServerError with default headers values
+func NewV2CreateClusterBatchInternalServerError() *V2CreateClusterBatchInternalServerError {
+	return &V2CreateClusterBatchInternalServerError{}
+}
+
+/*
+V2CreateClusterBatchInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2CreateClusterBatchInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 create cluster batch internal server error response has a 2xx status code
+func (o *V2CreateClusterBatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 create cluster batch internal server error response has a 3xx status code
+func (o *V2CreateClusterBatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 create cluster batch internal server error response has a 4xx status code
+func (o *V2CreateClusterBatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 create cluster batch internal server error response has a 5xx status code
+func (o *V2CreateClusterBatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 create cluster batch internal server error response a status code equal to that given
+func (o *V2CreateClusterBatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2CreateClusterBatchInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CreateClusterBatchInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches][%d] v2CreateClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2CreateClusterBatchInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2CreateClusterBatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
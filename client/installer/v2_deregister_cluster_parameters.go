@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewV2DeregisterClusterParams creates a new V2DeregisterClusterParams object,
@@ -69,6 +70,13 @@ type V2DeregisterClusterParams struct {
 	*/
 	ClusterID strfmt.UUID
 
+	/* DecommissionHosts.
+
+	     Wipe the disks of the installed hosts that are returned to their infra-env before they
+	reboot into discovery. Hosts whose infra-env is bound to the cluster are deleted with it.
+	*/
+	DecommissionHosts *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -86,7 +94,18 @@ func (o *V2DeregisterClusterParams) WithDefaults() *V2DeregisterClusterParams {
 //
 // All values with no default are reset to their zero value.
 func (o *V2DeregisterClusterParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		decommissionHostsDefault = bool(false)
+	)
+
+	val := V2DeregisterClusterParams{
+		DecommissionHosts: &decommissionHostsDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the v2 deregister cluster params
@@ -133,6 +152,17 @@ func (o *V2DeregisterClusterParams) SetClusterID(clusterID strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithDecommissionHosts adds the decommissionHosts to the v2 deregister cluster params
+func (o *V2DeregisterClusterParams) WithDecommissionHosts(decommissionHosts *bool) *V2DeregisterClusterParams {
+	o.SetDecommissionHosts(decommissionHosts)
+	return o
+}
+
+// SetDecommissionHosts adds the decommissionHosts to the v2 deregister cluster params
+func (o *V2DeregisterClusterParams) SetDecommissionHosts(decommissionHosts *bool) {
+	o.DecommissionHosts = decommissionHosts
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *V2DeregisterClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -146,6 +176,23 @@ func (o *V2DeregisterClusterParams) WriteToRequest(r runtime.ClientRequest, reg
 		return err
 	}
 
+	if o.DecommissionHosts != nil {
+
+		// query param decommission_hosts
+		var qrDecommissionHosts bool
+
+		if o.DecommissionHosts != nil {
+			qrDecommissionHosts = *o.DecommissionHosts
+		}
+		qDecommissionHosts := swag.FormatBool(qrDecommissionHosts)
+		if qDecommissionHosts != "" {
+
+			if err := r.SetQueryParam("decommission_hosts", qDecommissionHosts); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DownloadClusterNetworkTopologyParams creates a new V2DownloadClusterNetworkTopologyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DownloadClusterNetworkTopologyParams() *V2DownloadClusterNetworkTopologyParams {
+	return &V2DownloadClusterNetworkTopologyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DownloadClusterNetworkTopologyParamsWithTimeout creates a new V2DownloadClusterNetworkTopologyParams object
+// with the ability to set a timeout on a request.
+func NewV2DownloadClusterNetworkTopologyParamsWithTimeout(timeout time.Duration) *V2DownloadClusterNetworkTopologyParams {
+	return &V2DownloadClusterNetworkTopologyParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DownloadClusterNetworkTopologyParamsWithContext creates a new V2DownloadClusterNetworkTopologyParams object
+// with the ability to set a context for a request.
+func NewV2DownloadClusterNetworkTopologyParamsWithContext(ctx context.Context) *V2DownloadClusterNetworkTopologyParams {
+	return &V2DownloadClusterNetworkTopologyParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DownloadClusterNetworkTopologyParamsWithHTTPClient creates a new V2DownloadClusterNetworkTopologyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DownloadClusterNetworkTopologyParamsWithHTTPClient(client *http.Client) *V2DownloadClusterNetworkTopologyParams {
+	return &V2DownloadClusterNetworkTopologyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DownloadClusterNetworkTopologyParams contains all the parameters to send to the API endpoint
+
+	for the v2 download cluster network topology operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DownloadClusterNetworkTopologyParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose network topology should be downloaded.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 download cluster network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadClusterNetworkTopologyParams) WithDefaults() *V2DownloadClusterNetworkTopologyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 download cluster network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadClusterNetworkTopologyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) WithTimeout(timeout time.Duration) *V2DownloadClusterNetworkTopologyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) WithContext(ctx context.Context) *V2DownloadClusterNetworkTopologyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) WithHTTPClient(client *http.Client) *V2DownloadClusterNetworkTopologyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) WithClusterID(clusterID strfmt.UUID) *V2DownloadClusterNetworkTopologyParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 download cluster network topology params
+func (o *V2DownloadClusterNetworkTopologyParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DownloadClusterNetworkTopologyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,445 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadClusterNetworkTopologyReader is a Reader for the V2DownloadClusterNetworkTopology structure.
+type V2DownloadClusterNetworkTopologyReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DownloadClusterNetworkTopologyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2DownloadClusterNetworkTopologyOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DownloadClusterNetworkTopologyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DownloadClusterNetworkTopologyForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DownloadClusterNetworkTopologyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2DownloadClusterNetworkTopologyMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DownloadClusterNetworkTopologyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DownloadClusterNetworkTopologyOK creates a V2DownloadClusterNetworkTopologyOK with default headers values
+func NewV2DownloadClusterNetworkTopologyOK(writer io.Writer) *V2DownloadClusterNetworkTopologyOK {
+	return &V2DownloadClusterNetworkTopologyOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2DownloadClusterNetworkTopologyOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2DownloadClusterNetworkTopologyOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 download cluster network topology o k response has a 2xx status code
+func (o *V2DownloadClusterNetworkTopologyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 download cluster network topology o k response has a 3xx status code
+func (o *V2DownloadClusterNetworkTopologyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download cluster network topology o k response has a 4xx status code
+func (o *V2DownloadClusterNetworkTopologyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download cluster network topology o k response has a 5xx status code
+func (o *V2DownloadClusterNetworkTopologyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download cluster network topology o k response a status code equal to that given
+func (o *V2DownloadClusterNetworkTopologyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2DownloadClusterNetworkTopologyOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2DownloadClusterNetworkTopologyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadClusterNetworkTopologyUnauthorized creates a V2DownloadClusterNetworkTopologyUnauthorized with default headers values
+func NewV2DownloadClusterNetworkTopologyUnauthorized() *V2DownloadClusterNetworkTopologyUnauthorized {
+	return &V2DownloadClusterNetworkTopologyUnauthorized{}
+}
+
+/*
+V2DownloadClusterNetworkTopologyUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DownloadClusterNetworkTopologyUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download cluster network topology unauthorized response has a 2xx status code
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download cluster network topology unauthorized response has a 3xx status code
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download cluster network topology unauthorized response has a 4xx status code
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download cluster network topology unauthorized response has a 5xx status code
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download cluster network topology unauthorized response a status code equal to that given
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadClusterNetworkTopologyForbidden creates a V2DownloadClusterNetworkTopologyForbidden with default headers values
+func NewV2DownloadClusterNetworkTopologyForbidden() *V2DownloadClusterNetworkTopologyForbidden {
+	return &V2DownloadClusterNetworkTopologyForbidden{}
+}
+
+/*
+V2DownloadClusterNetworkTopologyForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DownloadClusterNetworkTopologyForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download cluster network topology forbidden response has a 2xx status code
+func (o *V2DownloadClusterNetworkTopologyForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download cluster network topology forbidden response has a 3xx status code
+func (o *V2DownloadClusterNetworkTopologyForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download cluster network topology forbidden response has a 4xx status code
+func (o *V2DownloadClusterNetworkTopologyForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download cluster network topology forbidden response has a 5xx status code
+func (o *V2DownloadClusterNetworkTopologyForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download cluster network topology forbidden response a status code equal to that given
+func (o *V2DownloadClusterNetworkTopologyForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DownloadClusterNetworkTopologyForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadClusterNetworkTopologyForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadClusterNetworkTopologyNotFound creates a V2DownloadClusterNetworkTopologyNotFound with default headers values
+func NewV2DownloadClusterNetworkTopologyNotFound() *V2DownloadClusterNetworkTopologyNotFound {
+	return &V2DownloadClusterNetworkTopologyNotFound{}
+}
+
+/*
+V2DownloadClusterNetworkTopologyNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DownloadClusterNetworkTopologyNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download cluster network topology not found response has a 2xx status code
+func (o *V2DownloadClusterNetworkTopologyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download cluster network topology not found response has a 3xx status code
+func (o *V2DownloadClusterNetworkTopologyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download cluster network topology not found response has a 4xx status code
+func (o *V2DownloadClusterNetworkTopologyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download cluster network topology not found response has a 5xx status code
+func (o *V2DownloadClusterNetworkTopologyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download cluster network topology not found response a status code equal to that given
+func (o *V2DownloadClusterNetworkTopologyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DownloadClusterNetworkTopologyNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadClusterNetworkTopologyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadClusterNetworkTopologyMethodNotAllowed creates a V2DownloadClusterNetworkTopologyMethodNotAllowed with default headers values
+func NewV2DownloadClusterNetworkTopologyMethodNotAllowed() *V2DownloadClusterNetworkTopologyMethodNotAllowed {
+	return &V2DownloadClusterNetworkTopologyMethodNotAllowed{}
+}
+
+/*
+V2DownloadClusterNetworkTopologyMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2DownloadClusterNetworkTopologyMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download cluster network topology method not allowed response has a 2xx status code
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download cluster network topology method not allowed response has a 3xx status code
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download cluster network topology method not allowed response has a 4xx status code
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download cluster network topology method not allowed response has a 5xx status code
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download cluster network topology method not allowed response a status code equal to that given
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadClusterNetworkTopologyInternalServerError creates a V2DownloadClusterNetworkTopologyInternalServerError with default headers values
+func NewV2DownloadClusterNetworkTopologyInternalServerError() *V2DownloadClusterNetworkTopologyInternalServerError {
+	return &V2DownloadClusterNetworkTopologyInternalServerError{}
+}
+
+/*
+V2DownloadClusterNetworkTopologyInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DownloadClusterNetworkTopologyInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download cluster network topology internal server error response has a 2xx status code
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download cluster network topology internal server error response has a 3xx status code
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download cluster network topology internal server error response has a 4xx status code
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download cluster network topology internal server error response has a 5xx status code
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 download cluster network topology internal server error response a status code equal to that given
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/downloads/network-topology][%d] v2DownloadClusterNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2DownloadInfraEnvNetworkTopologyParams creates a new V2DownloadInfraEnvNetworkTopologyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2DownloadInfraEnvNetworkTopologyParams() *V2DownloadInfraEnvNetworkTopologyParams {
+	return &V2DownloadInfraEnvNetworkTopologyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyParamsWithTimeout creates a new V2DownloadInfraEnvNetworkTopologyParams object
+// with the ability to set a timeout on a request.
+func NewV2DownloadInfraEnvNetworkTopologyParamsWithTimeout(timeout time.Duration) *V2DownloadInfraEnvNetworkTopologyParams {
+	return &V2DownloadInfraEnvNetworkTopologyParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyParamsWithContext creates a new V2DownloadInfraEnvNetworkTopologyParams object
+// with the ability to set a context for a request.
+func NewV2DownloadInfraEnvNetworkTopologyParamsWithContext(ctx context.Context) *V2DownloadInfraEnvNetworkTopologyParams {
+	return &V2DownloadInfraEnvNetworkTopologyParams{
+		Context: ctx,
+	}
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyParamsWithHTTPClient creates a new V2DownloadInfraEnvNetworkTopologyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2DownloadInfraEnvNetworkTopologyParamsWithHTTPClient(client *http.Client) *V2DownloadInfraEnvNetworkTopologyParams {
+	return &V2DownloadInfraEnvNetworkTopologyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyParams contains all the parameters to send to the API endpoint
+
+	for the v2 download infra env network topology operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2DownloadInfraEnvNetworkTopologyParams struct {
+
+	/* InfraEnvID.
+
+	   The infra-env whose network topology should be downloaded.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 download infra env network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadInfraEnvNetworkTopologyParams) WithDefaults() *V2DownloadInfraEnvNetworkTopologyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 download infra env network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2DownloadInfraEnvNetworkTopologyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) WithTimeout(timeout time.Duration) *V2DownloadInfraEnvNetworkTopologyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) WithContext(ctx context.Context) *V2DownloadInfraEnvNetworkTopologyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) WithHTTPClient(client *http.Client) *V2DownloadInfraEnvNetworkTopologyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2DownloadInfraEnvNetworkTopologyParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 download infra env network topology params
+func (o *V2DownloadInfraEnvNetworkTopologyParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2DownloadInfraEnvNetworkTopologyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,445 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadInfraEnvNetworkTopologyReader is a Reader for the V2DownloadInfraEnvNetworkTopology structure.
+type V2DownloadInfraEnvNetworkTopologyReader struct {
+	formats strfmt.Registry
+	writer  io.Writer
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2DownloadInfraEnvNetworkTopologyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2DownloadInfraEnvNetworkTopologyOK(o.writer)
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2DownloadInfraEnvNetworkTopologyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2DownloadInfraEnvNetworkTopologyForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2DownloadInfraEnvNetworkTopologyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2DownloadInfraEnvNetworkTopologyMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2DownloadInfraEnvNetworkTopologyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyOK creates a V2DownloadInfraEnvNetworkTopologyOK with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyOK(writer io.Writer) *V2DownloadInfraEnvNetworkTopologyOK {
+	return &V2DownloadInfraEnvNetworkTopologyOK{
+
+		Payload: writer,
+	}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2DownloadInfraEnvNetworkTopologyOK struct {
+	Payload io.Writer
+}
+
+// IsSuccess returns true when this v2 download infra env network topology o k response has a 2xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 download infra env network topology o k response has a 3xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download infra env network topology o k response has a 4xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download infra env network topology o k response has a 5xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download infra env network topology o k response a status code equal to that given
+func (o *V2DownloadInfraEnvNetworkTopologyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyOK) GetPayload() io.Writer {
+	return o.Payload
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyUnauthorized creates a V2DownloadInfraEnvNetworkTopologyUnauthorized with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyUnauthorized() *V2DownloadInfraEnvNetworkTopologyUnauthorized {
+	return &V2DownloadInfraEnvNetworkTopologyUnauthorized{}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2DownloadInfraEnvNetworkTopologyUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download infra env network topology unauthorized response has a 2xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download infra env network topology unauthorized response has a 3xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download infra env network topology unauthorized response has a 4xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download infra env network topology unauthorized response has a 5xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download infra env network topology unauthorized response a status code equal to that given
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyForbidden creates a V2DownloadInfraEnvNetworkTopologyForbidden with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyForbidden() *V2DownloadInfraEnvNetworkTopologyForbidden {
+	return &V2DownloadInfraEnvNetworkTopologyForbidden{}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2DownloadInfraEnvNetworkTopologyForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 download infra env network topology forbidden response has a 2xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download infra env network topology forbidden response has a 3xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download infra env network topology forbidden response has a 4xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download infra env network topology forbidden response has a 5xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download infra env network topology forbidden response a status code equal to that given
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyNotFound creates a V2DownloadInfraEnvNetworkTopologyNotFound with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyNotFound() *V2DownloadInfraEnvNetworkTopologyNotFound {
+	return &V2DownloadInfraEnvNetworkTopologyNotFound{}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2DownloadInfraEnvNetworkTopologyNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download infra env network topology not found response has a 2xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download infra env network topology not found response has a 3xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download infra env network topology not found response has a 4xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download infra env network topology not found response has a 5xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download infra env network topology not found response a status code equal to that given
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyMethodNotAllowed creates a V2DownloadInfraEnvNetworkTopologyMethodNotAllowed with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyMethodNotAllowed() *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed {
+	return &V2DownloadInfraEnvNetworkTopologyMethodNotAllowed{}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2DownloadInfraEnvNetworkTopologyMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download infra env network topology method not allowed response has a 2xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download infra env network topology method not allowed response has a 3xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download infra env network topology method not allowed response has a 4xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 download infra env network topology method not allowed response has a 5xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 download infra env network topology method not allowed response a status code equal to that given
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyInternalServerError creates a V2DownloadInfraEnvNetworkTopologyInternalServerError with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyInternalServerError() *V2DownloadInfraEnvNetworkTopologyInternalServerError {
+	return &V2DownloadInfraEnvNetworkTopologyInternalServerError{}
+}
+
+/*
+V2DownloadInfraEnvNetworkTopologyInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2DownloadInfraEnvNetworkTopologyInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 download infra env network topology internal server error response has a 2xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 download infra env network topology internal server error response has a 3xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 download infra env network topology internal server error response has a 4xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 download infra env network topology internal server error response has a 5xx status code
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 download infra env network topology internal server error response a status code equal to that given
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/downloads/network-topology][%d] v2DownloadInfraEnvNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
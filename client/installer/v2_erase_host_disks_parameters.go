@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2EraseHostDisksParams creates a new V2EraseHostDisksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2EraseHostDisksParams() *V2EraseHostDisksParams {
+	return &V2EraseHostDisksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2EraseHostDisksParamsWithTimeout creates a new V2EraseHostDisksParams object
+// with the ability to set a timeout on a request.
+func NewV2EraseHostDisksParamsWithTimeout(timeout time.Duration) *V2EraseHostDisksParams {
+	return &V2EraseHostDisksParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2EraseHostDisksParamsWithContext creates a new V2EraseHostDisksParams object
+// with the ability to set a context for a request.
+func NewV2EraseHostDisksParamsWithContext(ctx context.Context) *V2EraseHostDisksParams {
+	return &V2EraseHostDisksParams{
+		Context: ctx,
+	}
+}
+
+// NewV2EraseHostDisksParamsWithHTTPClient creates a new V2EraseHostDisksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2EraseHostDisksParamsWithHTTPClient(client *http.Client) *V2EraseHostDisksParams {
+	return &V2EraseHostDisksParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2EraseHostDisksParams contains all the parameters to send to the API endpoint
+
+	for the v2 erase host disks operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2EraseHostDisksParams struct {
+
+	/* DiskEraseParams.
+
+	   The disks to erase.
+	*/
+	DiskEraseParams *models.DiskEraseParams
+
+	/* HostID.
+
+	   The host whose disks are being erased.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host whose disks are being erased.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 erase host disks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2EraseHostDisksParams) WithDefaults() *V2EraseHostDisksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 erase host disks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2EraseHostDisksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) WithTimeout(timeout time.Duration) *V2EraseHostDisksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) WithContext(ctx context.Context) *V2EraseHostDisksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) WithHTTPClient(client *http.Client) *V2EraseHostDisksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDiskEraseParams adds the diskEraseParams to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) WithDiskEraseParams(diskEraseParams *models.DiskEraseParams) *V2EraseHostDisksParams {
+	o.SetDiskEraseParams(diskEraseParams)
+	return o
+}
+
+// SetDiskEraseParams adds the diskEraseParams to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) SetDiskEraseParams(diskEraseParams *models.DiskEraseParams) {
+	o.DiskEraseParams = diskEraseParams
+}
+
+// WithHostID adds the hostID to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) WithHostID(hostID strfmt.UUID) *V2EraseHostDisksParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2EraseHostDisksParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 erase host disks params
+func (o *V2EraseHostDisksParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2EraseHostDisksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.DiskEraseParams != nil {
+		if err := r.SetBodyParam(o.DiskEraseParams); err != nil {
+			return err
+		}
+	}
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
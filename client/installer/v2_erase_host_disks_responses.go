@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2EraseHostDisksReader is a Reader for the V2EraseHostDisks structure.
+type V2EraseHostDisksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2EraseHostDisksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2EraseHostDisksAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2EraseHostDisksBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2EraseHostDisksUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2EraseHostDisksForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2EraseHostDisksNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2EraseHostDisksConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2EraseHostDisksInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2EraseHostDisksAccepted creates a V2EraseHostDisksAccepted with default headers values
+func NewV2EraseHostDisksAccepted() *V2EraseHostDisksAccepted {
+	return &V2EraseHostDisksAccepted{}
+}
+
+/*
+V2EraseHostDisksAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2EraseHostDisksAccepted struct {
+	Payload *models.Host
+}
+
+// IsSuccess returns true when this v2 erase host disks accepted response has a 2xx status code
+func (o *V2EraseHostDisksAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 erase host disks accepted response has a 3xx status code
+func (o *V2EraseHostDisksAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks accepted response has a 4xx status code
+func (o *V2EraseHostDisksAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 erase host disks accepted response has a 5xx status code
+func (o *V2EraseHostDisksAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 erase host disks accepted response a status code equal to that given
+func (o *V2EraseHostDisksAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2EraseHostDisksAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2EraseHostDisksAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2EraseHostDisksAccepted) GetPayload() *models.Host {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Host)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EraseHostDisksBadRequest creates a V2EraseHostDisksBadRequest with default headers values
+func NewV2EraseHostDisksBadRequest() *V2EraseHostDisksBadRequest {
+	return &V2EraseHostDisksBadRequest{}
+}
+
+/*
+V2EraseHostDisksBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2EraseHostDisksBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 erase host disks bad request response has a 2xx status code
+func (o *V2EraseHostDisksBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 erase host disks bad request response has a 3xx status code
+func (o *V2EraseHostDisksBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks bad request response has a 4xx status code
+func (o *V2EraseHostDisksBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 erase host disks bad request response has a 5xx status code
+func (o *V2EraseHostDisksBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 erase host disks bad request response a status code equal to that given
+func (o *V2EraseHostDisksBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2EraseHostDisksBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2EraseHostDisksBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2EraseHostDisksBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EraseHostDisksUnauthorized creates a V2EraseHostDisksUnauthorized with default headers values
+func NewV2EraseHostDisksUnauthorized() *V2EraseHostDisksUnauthorized {
+	return &V2EraseHostDisksUnauthorized{}
+}
+
+/*
+V2EraseHostDisksUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2EraseHostDisksUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 erase host disks unauthorized response has a 2xx status code
+func (o *V2EraseHostDisksUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 erase host disks unauthorized response has a 3xx status code
+func (o *V2EraseHostDisksUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks unauthorized response has a 4xx status code
+func (o *V2EraseHostDisksUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 erase host disks unauthorized response has a 5xx status code
+func (o *V2EraseHostDisksUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 erase host disks unauthorized response a status code equal to that given
+func (o *V2EraseHostDisksUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2EraseHostDisksUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2EraseHostDisksUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2EraseHostDisksUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EraseHostDisksForbidden creates a V2EraseHostDisksForbidden with default headers values
+func NewV2EraseHostDisksForbidden() *V2EraseHostDisksForbidden {
+	return &V2EraseHostDisksForbidden{}
+}
+
+/*
+V2EraseHostDisksForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2EraseHostDisksForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 erase host disks forbidden response has a 2xx status code
+func (o *V2EraseHostDisksForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 erase host disks forbidden response has a 3xx status code
+func (o *V2EraseHostDisksForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks forbidden response has a 4xx status code
+func (o *V2EraseHostDisksForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 erase host disks forbidden response has a 5xx status code
+func (o *V2EraseHostDisksForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 erase host disks forbidden response a status code equal to that given
+func (o *V2EraseHostDisksForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2EraseHostDisksForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2EraseHostDisksForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2EraseHostDisksForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EraseHostDisksNotFound creates a V2EraseHostDisksNotFound with default headers values
+func NewV2EraseHostDisksNotFound() *V2EraseHostDisksNotFound {
+	return &V2EraseHostDisksNotFound{}
+}
+
+/*
+V2EraseHostDisksNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2EraseHostDisksNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 erase host disks not found response has a 2xx status code
+func (o *V2EraseHostDisksNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 erase host disks not found response has a 3xx status code
+func (o *V2EraseHostDisksNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks not found response has a 4xx status code
+func (o *V2EraseHostDisksNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 erase host disks not found response has a 5xx status code
+func (o *V2EraseHostDisksNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 erase host disks not found response a status code equal to that given
+func (o *V2EraseHostDisksNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2EraseHostDisksNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2EraseHostDisksNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2EraseHostDisksNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EraseHostDisksConflict creates a V2EraseHostDisksConflict with default headers values
+func NewV2EraseHostDisksConflict() *V2EraseHostDisksConflict {
+	return &V2EraseHostDisksConflict{}
+}
+
+/*
+V2EraseHostDisksConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2EraseHostDisksConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 erase host disks conflict response has a 2xx status code
+func (o *V2EraseHostDisksConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 erase host disks conflict response has a 3xx status code
+func (o *V2EraseHostDisksConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks conflict response has a 4xx status code
+func (o *V2EraseHostDisksConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 erase host disks conflict response has a 5xx status code
+func (o *V2EraseHostDisksConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 erase host disks conflict response a status code equal to that given
+func (o *V2EraseHostDisksConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2EraseHostDisksConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2EraseHostDisksConflict) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2EraseHostDisksConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EraseHostDisksInternalServerError creates a V2EraseHostDisksInternalServerError with default headers values
+func NewV2EraseHostDisksInternalServerError() *V2EraseHostDisksInternalServerError {
+	return &V2EraseHostDisksInternalServerError{}
+}
+
+/*
+V2EraseHostDisksInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2EraseHostDisksInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 erase host disks internal server error response has a 2xx status code
+func (o *V2EraseHostDisksInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 erase host disks internal server error response has a 3xx status code
+func (o *V2EraseHostDisksInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 erase host disks internal server error response has a 4xx status code
+func (o *V2EraseHostDisksInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 erase host disks internal server error response has a 5xx status code
+func (o *V2EraseHostDisksInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 erase host disks internal server error response a status code equal to that given
+func (o *V2EraseHostDisksInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2EraseHostDisksInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2EraseHostDisksInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks][%d] v2EraseHostDisksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2EraseHostDisksInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EraseHostDisksInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetClusterBatchParams creates a new V2GetClusterBatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterBatchParams() *V2GetClusterBatchParams {
+	return &V2GetClusterBatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterBatchParamsWithTimeout creates a new V2GetClusterBatchParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterBatchParamsWithTimeout(timeout time.Duration) *V2GetClusterBatchParams {
+	return &V2GetClusterBatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterBatchParamsWithContext creates a new V2GetClusterBatchParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterBatchParamsWithContext(ctx context.Context) *V2GetClusterBatchParams {
+	return &V2GetClusterBatchParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterBatchParamsWithHTTPClient creates a new V2GetClusterBatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterBatchParamsWithHTTPClient(client *http.Client) *V2GetClusterBatchParams {
+	return &V2GetClusterBatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetClusterBatchParams contains all the parameters to send to the API endpoint
+
+	for the v2 get cluster batch operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetClusterBatchParams struct {
+
+	/* ClusterBatchID.
+
+	   The cluster batch to be retrieved.
+
+	   Format: uuid
+	*/
+	ClusterBatchID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterBatchParams) WithDefaults() *V2GetClusterBatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterBatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) WithTimeout(timeout time.Duration) *V2GetClusterBatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) WithContext(ctx context.Context) *V2GetClusterBatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) WithHTTPClient(client *http.Client) *V2GetClusterBatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterBatchID adds the clusterBatchID to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) WithClusterBatchID(clusterBatchID strfmt.UUID) *V2GetClusterBatchParams {
+	o.SetClusterBatchID(clusterBatchID)
+	return o
+}
+
+// SetClusterBatchID adds the clusterBatchId to the v2 get cluster batch params
+func (o *V2GetClusterBatchParams) SetClusterBatchID(clusterBatchID strfmt.UUID) {
+	o.ClusterBatchID = clusterBatchID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterBatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_batch_id
+	if err := r.SetPathParam("cluster_batch_id", o.ClusterBatchID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterBatchReader is a Reader for the V2GetClusterBatch structure.
+type V2GetClusterBatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterBatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterBatchOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterBatchUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterBatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterBatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetClusterBatchMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterBatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterBatchOK creates a V2GetClusterBatchOK with default headers values
+func NewV2GetClusterBatchOK() *V2GetClusterBatchOK {
+	return &V2GetClusterBatchOK{}
+}
+
+/*
+V2GetClusterBatchOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterBatchOK struct {
+	Payload *models.ClusterBatch
+}
+
+// IsSuccess returns true when this v2 get cluster batch o k response has a 2xx status code
+func (o *V2GetClusterBatchOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get cluster batch o k response has a 3xx status code
+func (o *V2GetClusterBatchOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster batch o k response has a 4xx status code
+func (o *V2GetClusterBatchOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster batch o k response has a 5xx status code
+func (o *V2GetClusterBatchOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster batch o k response a status code equal to that given
+func (o *V2GetClusterBatchOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetClusterBatchOK) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterBatchOK) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterBatchOK) GetPayload() *models.ClusterBatch {
+	return o.Payload
+}
+
+func (o *V2GetClusterBatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterBatch)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterBatchUnauthorized creates a V2GetClusterBatchUnauthorized with default headers values
+func NewV2GetClusterBatchUnauthorized() *V2GetClusterBatchUnauthorized {
+	return &V2GetClusterBatchUnauthorized{}
+}
+
+/*
+V2GetClusterBatchUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterBatchUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster batch unauthorized response has a 2xx status code
+func (o *V2GetClusterBatchUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster batch unauthorized response has a 3xx status code
+func (o *V2GetClusterBatchUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster batch unauthorized response has a 4xx status code
+func (o *V2GetClusterBatchUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster batch unauthorized response has a 5xx status code
+func (o *V2GetClusterBatchUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster batch unauthorized response a status code equal to that given
+func (o *V2GetClusterBatchUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetClusterBatchUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterBatchUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterBatchUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterBatchUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterBatchForbidden creates a V2GetClusterBatchForbidden with default headers values
+func NewV2GetClusterBatchForbidden() *V2GetClusterBatchForbidden {
+	return &V2GetClusterBatchForbidden{}
+}
+
+/*
+V2GetClusterBatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterBatchForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster batch forbidden response has a 2xx status code
+func (o *V2GetClusterBatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster batch forbidden response has a 3xx status code
+func (o *V2GetClusterBatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster batch forbidden response has a 4xx status code
+func (o *V2GetClusterBatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster batch forbidden response has a 5xx status code
+func (o *V2GetClusterBatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster batch forbidden response a status code equal to that given
+func (o *V2GetClusterBatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetClusterBatchForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterBatchForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterBatchForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterBatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterBatchNotFound creates a V2GetClusterBatchNotFound with default headers values
+func NewV2GetClusterBatchNotFound() *V2GetClusterBatchNotFound {
+	return &V2GetClusterBatchNotFound{}
+}
+
+/*
+V2GetClusterBatchNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterBatchNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster batch not found response has a 2xx status code
+func (o *V2GetClusterBatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster batch not found response has a 3xx status code
+func (o *V2GetClusterBatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster batch not found response has a 4xx status code
+func (o *V2GetClusterBatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster batch not found response has a 5xx status code
+func (o *V2GetClusterBatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster batch not found response a status code equal to that given
+func (o *V2GetClusterBatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetClusterBatchNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterBatchNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterBatchNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterBatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterBatchMethodNotAllowed creates a V2GetClusterBatchMethodNotAllowed with default headers values
+func NewV2GetClusterBatchMethodNotAllowed() *V2GetClusterBatchMethodNotAllowed {
+	return &V2GetClusterBatchMethodNotAllowed{}
+}
+
+/*
+V2GetClusterBatchMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetClusterBatchMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster batch method not allowed response has a 2xx status code
+func (o *V2GetClusterBatchMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster batch method not allowed response has a 3xx status code
+func (o *V2GetClusterBatchMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster batch method not allowed response has a 4xx status code
+func (o *V2GetClusterBatchMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster batch method not allowed response has a 5xx status code
+func (o *V2GetClusterBatchMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster batch method not allowed response a status code equal to that given
+func (o *V2GetClusterBatchMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GetClusterBatchMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetClusterBatchMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetClusterBatchMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterBatchMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterBatchInternalServerError creates a V2GetClusterBatchInternalServerError with default headers values
+func NewV2GetClusterBatchInternalServerError() *V2GetClusterBatchInternalServerError {
+	return &V2GetClusterBatchInternalServerError{}
+}
+
+/*
+V2GetClusterBatchInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterBatchInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster batch internal server error response has a 2xx status code
+func (o *V2GetClusterBatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster batch internal server error response has a 3xx status code
+func (o *V2GetClusterBatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster batch internal server error response has a 4xx status code
+func (o *V2GetClusterBatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster batch internal server error response has a 5xx status code
+func (o *V2GetClusterBatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster batch internal server error response a status code equal to that given
+func (o *V2GetClusterBatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetClusterBatchInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterBatchInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches/{cluster_batch_id}][%d] v2GetClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterBatchInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterBatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetClusterNetworkTopologyParams creates a new V2GetClusterNetworkTopologyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterNetworkTopologyParams() *V2GetClusterNetworkTopologyParams {
+	return &V2GetClusterNetworkTopologyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterNetworkTopologyParamsWithTimeout creates a new V2GetClusterNetworkTopologyParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterNetworkTopologyParamsWithTimeout(timeout time.Duration) *V2GetClusterNetworkTopologyParams {
+	return &V2GetClusterNetworkTopologyParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterNetworkTopologyParamsWithContext creates a new V2GetClusterNetworkTopologyParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterNetworkTopologyParamsWithContext(ctx context.Context) *V2GetClusterNetworkTopologyParams {
+	return &V2GetClusterNetworkTopologyParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterNetworkTopologyParamsWithHTTPClient creates a new V2GetClusterNetworkTopologyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterNetworkTopologyParamsWithHTTPClient(client *http.Client) *V2GetClusterNetworkTopologyParams {
+	return &V2GetClusterNetworkTopologyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetClusterNetworkTopologyParams contains all the parameters to send to the API endpoint
+
+	for the v2 get cluster network topology operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetClusterNetworkTopologyParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose network topology should be retrieved.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterNetworkTopologyParams) WithDefaults() *V2GetClusterNetworkTopologyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterNetworkTopologyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) WithTimeout(timeout time.Duration) *V2GetClusterNetworkTopologyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) WithContext(ctx context.Context) *V2GetClusterNetworkTopologyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) WithHTTPClient(client *http.Client) *V2GetClusterNetworkTopologyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) WithClusterID(clusterID strfmt.UUID) *V2GetClusterNetworkTopologyParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get cluster network topology params
+func (o *V2GetClusterNetworkTopologyParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterNetworkTopologyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetClusterSecondaryNetworksParams creates a new V2GetClusterSecondaryNetworksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetClusterSecondaryNetworksParams() *V2GetClusterSecondaryNetworksParams {
+	return &V2GetClusterSecondaryNetworksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetClusterSecondaryNetworksParamsWithTimeout creates a new V2GetClusterSecondaryNetworksParams object
+// with the ability to set a timeout on a request.
+func NewV2GetClusterSecondaryNetworksParamsWithTimeout(timeout time.Duration) *V2GetClusterSecondaryNetworksParams {
+	return &V2GetClusterSecondaryNetworksParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetClusterSecondaryNetworksParamsWithContext creates a new V2GetClusterSecondaryNetworksParams object
+// with the ability to set a context for a request.
+func NewV2GetClusterSecondaryNetworksParamsWithContext(ctx context.Context) *V2GetClusterSecondaryNetworksParams {
+	return &V2GetClusterSecondaryNetworksParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetClusterSecondaryNetworksParamsWithHTTPClient creates a new V2GetClusterSecondaryNetworksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetClusterSecondaryNetworksParamsWithHTTPClient(client *http.Client) *V2GetClusterSecondaryNetworksParams {
+	return &V2GetClusterSecondaryNetworksParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetClusterSecondaryNetworksParams contains all the parameters to send to the API endpoint
+
+	for the v2 get cluster secondary networks operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetClusterSecondaryNetworksParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose secondary network policies should be retrieved.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get cluster secondary networks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterSecondaryNetworksParams) WithDefaults() *V2GetClusterSecondaryNetworksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get cluster secondary networks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetClusterSecondaryNetworksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) WithTimeout(timeout time.Duration) *V2GetClusterSecondaryNetworksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) WithContext(ctx context.Context) *V2GetClusterSecondaryNetworksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) WithHTTPClient(client *http.Client) *V2GetClusterSecondaryNetworksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) WithClusterID(clusterID strfmt.UUID) *V2GetClusterSecondaryNetworksParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 get cluster secondary networks params
+func (o *V2GetClusterSecondaryNetworksParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetClusterSecondaryNetworksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
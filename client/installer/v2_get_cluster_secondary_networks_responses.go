@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterSecondaryNetworksReader is a Reader for the V2GetClusterSecondaryNetworks structure.
+type V2GetClusterSecondaryNetworksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetClusterSecondaryNetworksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetClusterSecondaryNetworksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetClusterSecondaryNetworksUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetClusterSecondaryNetworksForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetClusterSecondaryNetworksNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetClusterSecondaryNetworksMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetClusterSecondaryNetworksInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetClusterSecondaryNetworksOK creates a V2GetClusterSecondaryNetworksOK with default headers values
+func NewV2GetClusterSecondaryNetworksOK() *V2GetClusterSecondaryNetworksOK {
+	return &V2GetClusterSecondaryNetworksOK{}
+}
+
+/*
+V2GetClusterSecondaryNetworksOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetClusterSecondaryNetworksOK struct {
+	Payload models.SecondaryNetworkPolicies
+}
+
+// IsSuccess returns true when this v2 get cluster secondary networks o k response has a 2xx status code
+func (o *V2GetClusterSecondaryNetworksOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get cluster secondary networks o k response has a 3xx status code
+func (o *V2GetClusterSecondaryNetworksOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster secondary networks o k response has a 4xx status code
+func (o *V2GetClusterSecondaryNetworksOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster secondary networks o k response has a 5xx status code
+func (o *V2GetClusterSecondaryNetworksOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster secondary networks o k response a status code equal to that given
+func (o *V2GetClusterSecondaryNetworksOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetClusterSecondaryNetworksOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksOK) GetPayload() models.SecondaryNetworkPolicies {
+	return o.Payload
+}
+
+func (o *V2GetClusterSecondaryNetworksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterSecondaryNetworksUnauthorized creates a V2GetClusterSecondaryNetworksUnauthorized with default headers values
+func NewV2GetClusterSecondaryNetworksUnauthorized() *V2GetClusterSecondaryNetworksUnauthorized {
+	return &V2GetClusterSecondaryNetworksUnauthorized{}
+}
+
+/*
+V2GetClusterSecondaryNetworksUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetClusterSecondaryNetworksUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster secondary networks unauthorized response has a 2xx status code
+func (o *V2GetClusterSecondaryNetworksUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster secondary networks unauthorized response has a 3xx status code
+func (o *V2GetClusterSecondaryNetworksUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster secondary networks unauthorized response has a 4xx status code
+func (o *V2GetClusterSecondaryNetworksUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster secondary networks unauthorized response has a 5xx status code
+func (o *V2GetClusterSecondaryNetworksUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster secondary networks unauthorized response a status code equal to that given
+func (o *V2GetClusterSecondaryNetworksUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetClusterSecondaryNetworksUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterSecondaryNetworksUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterSecondaryNetworksForbidden creates a V2GetClusterSecondaryNetworksForbidden with default headers values
+func NewV2GetClusterSecondaryNetworksForbidden() *V2GetClusterSecondaryNetworksForbidden {
+	return &V2GetClusterSecondaryNetworksForbidden{}
+}
+
+/*
+V2GetClusterSecondaryNetworksForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetClusterSecondaryNetworksForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get cluster secondary networks forbidden response has a 2xx status code
+func (o *V2GetClusterSecondaryNetworksForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster secondary networks forbidden response has a 3xx status code
+func (o *V2GetClusterSecondaryNetworksForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster secondary networks forbidden response has a 4xx status code
+func (o *V2GetClusterSecondaryNetworksForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster secondary networks forbidden response has a 5xx status code
+func (o *V2GetClusterSecondaryNetworksForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster secondary networks forbidden response a status code equal to that given
+func (o *V2GetClusterSecondaryNetworksForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetClusterSecondaryNetworksForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetClusterSecondaryNetworksForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterSecondaryNetworksNotFound creates a V2GetClusterSecondaryNetworksNotFound with default headers values
+func NewV2GetClusterSecondaryNetworksNotFound() *V2GetClusterSecondaryNetworksNotFound {
+	return &V2GetClusterSecondaryNetworksNotFound{}
+}
+
+/*
+V2GetClusterSecondaryNetworksNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetClusterSecondaryNetworksNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster secondary networks not found response has a 2xx status code
+func (o *V2GetClusterSecondaryNetworksNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster secondary networks not found response has a 3xx status code
+func (o *V2GetClusterSecondaryNetworksNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster secondary networks not found response has a 4xx status code
+func (o *V2GetClusterSecondaryNetworksNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster secondary networks not found response has a 5xx status code
+func (o *V2GetClusterSecondaryNetworksNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster secondary networks not found response a status code equal to that given
+func (o *V2GetClusterSecondaryNetworksNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetClusterSecondaryNetworksNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterSecondaryNetworksNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterSecondaryNetworksMethodNotAllowed creates a V2GetClusterSecondaryNetworksMethodNotAllowed with default headers values
+func NewV2GetClusterSecondaryNetworksMethodNotAllowed() *V2GetClusterSecondaryNetworksMethodNotAllowed {
+	return &V2GetClusterSecondaryNetworksMethodNotAllowed{}
+}
+
+/*
+V2GetClusterSecondaryNetworksMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetClusterSecondaryNetworksMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster secondary networks method not allowed response has a 2xx status code
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster secondary networks method not allowed response has a 3xx status code
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster secondary networks method not allowed response has a 4xx status code
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get cluster secondary networks method not allowed response has a 5xx status code
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get cluster secondary networks method not allowed response a status code equal to that given
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetClusterSecondaryNetworksInternalServerError creates a V2GetClusterSecondaryNetworksInternalServerError with default headers values
+func NewV2GetClusterSecondaryNetworksInternalServerError() *V2GetClusterSecondaryNetworksInternalServerError {
+	return &V2GetClusterSecondaryNetworksInternalServerError{}
+}
+
+/*
+V2GetClusterSecondaryNetworksInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetClusterSecondaryNetworksInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get cluster secondary networks internal server error response has a 2xx status code
+func (o *V2GetClusterSecondaryNetworksInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get cluster secondary networks internal server error response has a 3xx status code
+func (o *V2GetClusterSecondaryNetworksInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get cluster secondary networks internal server error response has a 4xx status code
+func (o *V2GetClusterSecondaryNetworksInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get cluster secondary networks internal server error response has a 5xx status code
+func (o *V2GetClusterSecondaryNetworksInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get cluster secondary networks internal server error response a status code equal to that given
+func (o *V2GetClusterSecondaryNetworksInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetClusterSecondaryNetworksInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/secondary-networks][%d] v2GetClusterSecondaryNetworksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetClusterSecondaryNetworksInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetClusterSecondaryNetworksInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetInfraEnvNetworkTopologyParams creates a new V2GetInfraEnvNetworkTopologyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetInfraEnvNetworkTopologyParams() *V2GetInfraEnvNetworkTopologyParams {
+	return &V2GetInfraEnvNetworkTopologyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetInfraEnvNetworkTopologyParamsWithTimeout creates a new V2GetInfraEnvNetworkTopologyParams object
+// with the ability to set a timeout on a request.
+func NewV2GetInfraEnvNetworkTopologyParamsWithTimeout(timeout time.Duration) *V2GetInfraEnvNetworkTopologyParams {
+	return &V2GetInfraEnvNetworkTopologyParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetInfraEnvNetworkTopologyParamsWithContext creates a new V2GetInfraEnvNetworkTopologyParams object
+// with the ability to set a context for a request.
+func NewV2GetInfraEnvNetworkTopologyParamsWithContext(ctx context.Context) *V2GetInfraEnvNetworkTopologyParams {
+	return &V2GetInfraEnvNetworkTopologyParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetInfraEnvNetworkTopologyParamsWithHTTPClient creates a new V2GetInfraEnvNetworkTopologyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetInfraEnvNetworkTopologyParamsWithHTTPClient(client *http.Client) *V2GetInfraEnvNetworkTopologyParams {
+	return &V2GetInfraEnvNetworkTopologyParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyParams contains all the parameters to send to the API endpoint
+
+	for the v2 get infra env network topology operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetInfraEnvNetworkTopologyParams struct {
+
+	/* InfraEnvID.
+
+	   The infra-env whose network topology should be retrieved.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get infra env network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetInfraEnvNetworkTopologyParams) WithDefaults() *V2GetInfraEnvNetworkTopologyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get infra env network topology params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetInfraEnvNetworkTopologyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) WithTimeout(timeout time.Duration) *V2GetInfraEnvNetworkTopologyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) WithContext(ctx context.Context) *V2GetInfraEnvNetworkTopologyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) WithHTTPClient(client *http.Client) *V2GetInfraEnvNetworkTopologyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2GetInfraEnvNetworkTopologyParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 get infra env network topology params
+func (o *V2GetInfraEnvNetworkTopologyParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetInfraEnvNetworkTopologyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
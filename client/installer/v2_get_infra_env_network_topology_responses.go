@@ -0,0 +1,443 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetInfraEnvNetworkTopologyReader is a Reader for the V2GetInfraEnvNetworkTopology structure.
+type V2GetInfraEnvNetworkTopologyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2GetInfraEnvNetworkTopologyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2GetInfraEnvNetworkTopologyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2GetInfraEnvNetworkTopologyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2GetInfraEnvNetworkTopologyForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2GetInfraEnvNetworkTopologyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2GetInfraEnvNetworkTopologyMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2GetInfraEnvNetworkTopologyInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2GetInfraEnvNetworkTopologyOK creates a V2GetInfraEnvNetworkTopologyOK with default headers values
+func NewV2GetInfraEnvNetworkTopologyOK() *V2GetInfraEnvNetworkTopologyOK {
+	return &V2GetInfraEnvNetworkTopologyOK{}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2GetInfraEnvNetworkTopologyOK struct {
+	Payload *models.NetworkTopology
+}
+
+// IsSuccess returns true when this v2 get infra env network topology o k response has a 2xx status code
+func (o *V2GetInfraEnvNetworkTopologyOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 get infra env network topology o k response has a 3xx status code
+func (o *V2GetInfraEnvNetworkTopologyOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env network topology o k response has a 4xx status code
+func (o *V2GetInfraEnvNetworkTopologyOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get infra env network topology o k response has a 5xx status code
+func (o *V2GetInfraEnvNetworkTopologyOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env network topology o k response a status code equal to that given
+func (o *V2GetInfraEnvNetworkTopologyOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2GetInfraEnvNetworkTopologyOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyOK  %+v", 200, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyOK) GetPayload() *models.NetworkTopology {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvNetworkTopologyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworkTopology)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvNetworkTopologyUnauthorized creates a V2GetInfraEnvNetworkTopologyUnauthorized with default headers values
+func NewV2GetInfraEnvNetworkTopologyUnauthorized() *V2GetInfraEnvNetworkTopologyUnauthorized {
+	return &V2GetInfraEnvNetworkTopologyUnauthorized{}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2GetInfraEnvNetworkTopologyUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get infra env network topology unauthorized response has a 2xx status code
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env network topology unauthorized response has a 3xx status code
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env network topology unauthorized response has a 4xx status code
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env network topology unauthorized response has a 5xx status code
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env network topology unauthorized response a status code equal to that given
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvNetworkTopologyForbidden creates a V2GetInfraEnvNetworkTopologyForbidden with default headers values
+func NewV2GetInfraEnvNetworkTopologyForbidden() *V2GetInfraEnvNetworkTopologyForbidden {
+	return &V2GetInfraEnvNetworkTopologyForbidden{}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2GetInfraEnvNetworkTopologyForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 get infra env network topology forbidden response has a 2xx status code
+func (o *V2GetInfraEnvNetworkTopologyForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env network topology forbidden response has a 3xx status code
+func (o *V2GetInfraEnvNetworkTopologyForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env network topology forbidden response has a 4xx status code
+func (o *V2GetInfraEnvNetworkTopologyForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env network topology forbidden response has a 5xx status code
+func (o *V2GetInfraEnvNetworkTopologyForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env network topology forbidden response a status code equal to that given
+func (o *V2GetInfraEnvNetworkTopologyForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2GetInfraEnvNetworkTopologyForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvNetworkTopologyForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvNetworkTopologyNotFound creates a V2GetInfraEnvNetworkTopologyNotFound with default headers values
+func NewV2GetInfraEnvNetworkTopologyNotFound() *V2GetInfraEnvNetworkTopologyNotFound {
+	return &V2GetInfraEnvNetworkTopologyNotFound{}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2GetInfraEnvNetworkTopologyNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env network topology not found response has a 2xx status code
+func (o *V2GetInfraEnvNetworkTopologyNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env network topology not found response has a 3xx status code
+func (o *V2GetInfraEnvNetworkTopologyNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env network topology not found response has a 4xx status code
+func (o *V2GetInfraEnvNetworkTopologyNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env network topology not found response has a 5xx status code
+func (o *V2GetInfraEnvNetworkTopologyNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env network topology not found response a status code equal to that given
+func (o *V2GetInfraEnvNetworkTopologyNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2GetInfraEnvNetworkTopologyNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvNetworkTopologyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvNetworkTopologyMethodNotAllowed creates a V2GetInfraEnvNetworkTopologyMethodNotAllowed with default headers values
+func NewV2GetInfraEnvNetworkTopologyMethodNotAllowed() *V2GetInfraEnvNetworkTopologyMethodNotAllowed {
+	return &V2GetInfraEnvNetworkTopologyMethodNotAllowed{}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2GetInfraEnvNetworkTopologyMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env network topology method not allowed response has a 2xx status code
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env network topology method not allowed response has a 3xx status code
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env network topology method not allowed response has a 4xx status code
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 get infra env network topology method not allowed response has a 5xx status code
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 get infra env network topology method not allowed response a status code equal to that given
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2GetInfraEnvNetworkTopologyInternalServerError creates a V2GetInfraEnvNetworkTopologyInternalServerError with default headers values
+func NewV2GetInfraEnvNetworkTopologyInternalServerError() *V2GetInfraEnvNetworkTopologyInternalServerError {
+	return &V2GetInfraEnvNetworkTopologyInternalServerError{}
+}
+
+/*
+V2GetInfraEnvNetworkTopologyInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2GetInfraEnvNetworkTopologyInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 get infra env network topology internal server error response has a 2xx status code
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 get infra env network topology internal server error response has a 3xx status code
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 get infra env network topology internal server error response has a 4xx status code
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 get infra env network topology internal server error response has a 5xx status code
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 get infra env network topology internal server error response a status code equal to that given
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/network-topology][%d] v2GetInfraEnvNetworkTopologyInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2InstallClusterBatchParams creates a new V2InstallClusterBatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2InstallClusterBatchParams() *V2InstallClusterBatchParams {
+	return &V2InstallClusterBatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2InstallClusterBatchParamsWithTimeout creates a new V2InstallClusterBatchParams object
+// with the ability to set a timeout on a request.
+func NewV2InstallClusterBatchParamsWithTimeout(timeout time.Duration) *V2InstallClusterBatchParams {
+	return &V2InstallClusterBatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2InstallClusterBatchParamsWithContext creates a new V2InstallClusterBatchParams object
+// with the ability to set a context for a request.
+func NewV2InstallClusterBatchParamsWithContext(ctx context.Context) *V2InstallClusterBatchParams {
+	return &V2InstallClusterBatchParams{
+		Context: ctx,
+	}
+}
+
+// NewV2InstallClusterBatchParamsWithHTTPClient creates a new V2InstallClusterBatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2InstallClusterBatchParamsWithHTTPClient(client *http.Client) *V2InstallClusterBatchParams {
+	return &V2InstallClusterBatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2InstallClusterBatchParams contains all the parameters to send to the API endpoint
+
+	for the v2 install cluster batch operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2InstallClusterBatchParams struct {
+
+	/* ClusterBatchID.
+
+	   The cluster batch to be installed.
+
+	   Format: uuid
+	*/
+	ClusterBatchID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 install cluster batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2InstallClusterBatchParams) WithDefaults() *V2InstallClusterBatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 install cluster batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2InstallClusterBatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) WithTimeout(timeout time.Duration) *V2InstallClusterBatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) WithContext(ctx context.Context) *V2InstallClusterBatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) WithHTTPClient(client *http.Client) *V2InstallClusterBatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterBatchID adds the clusterBatchID to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) WithClusterBatchID(clusterBatchID strfmt.UUID) *V2InstallClusterBatchParams {
+	o.SetClusterBatchID(clusterBatchID)
+	return o
+}
+
+// SetClusterBatchID adds the clusterBatchId to the v2 install cluster batch params
+func (o *V2InstallClusterBatchParams) SetClusterBatchID(clusterBatchID strfmt.UUID) {
+	o.ClusterBatchID = clusterBatchID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2InstallClusterBatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_batch_id
+	if err := r.SetPathParam("cluster_batch_id", o.ClusterBatchID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2InstallClusterBatchReader is a Reader for the V2InstallClusterBatch structure.
+type V2InstallClusterBatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2InstallClusterBatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewV2InstallClusterBatchAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2InstallClusterBatchUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2InstallClusterBatchForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2InstallClusterBatchNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2InstallClusterBatchMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2InstallClusterBatchConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2InstallClusterBatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2InstallClusterBatchAccepted creates a V2InstallClusterBatchAccepted with default headers values
+func NewV2InstallClusterBatchAccepted() *V2InstallClusterBatchAccepted {
+	return &V2InstallClusterBatchAccepted{}
+}
+
+/*
+V2InstallClusterBatchAccepted describes a response with status code 202, with default header values.
+
+Success.
+*/
+type V2InstallClusterBatchAccepted struct {
+	Payload *models.ClusterBatch
+}
+
+// IsSuccess returns true when this v2 install cluster batch accepted response has a 2xx status code
+func (o *V2InstallClusterBatchAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 install cluster batch accepted response has a 3xx status code
+func (o *V2InstallClusterBatchAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch accepted response has a 4xx status code
+func (o *V2InstallClusterBatchAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 install cluster batch accepted response has a 5xx status code
+func (o *V2InstallClusterBatchAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 install cluster batch accepted response a status code equal to that given
+func (o *V2InstallClusterBatchAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+func (o *V2InstallClusterBatchAccepted) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2InstallClusterBatchAccepted) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchAccepted  %+v", 202, o.Payload)
+}
+
+func (o *V2InstallClusterBatchAccepted) GetPayload() *models.ClusterBatch {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ClusterBatch)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstallClusterBatchUnauthorized creates a V2InstallClusterBatchUnauthorized with default headers values
+func NewV2InstallClusterBatchUnauthorized() *V2InstallClusterBatchUnauthorized {
+	return &V2InstallClusterBatchUnauthorized{}
+}
+
+/*
+V2InstallClusterBatchUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2InstallClusterBatchUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 install cluster batch unauthorized response has a 2xx status code
+func (o *V2InstallClusterBatchUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 install cluster batch unauthorized response has a 3xx status code
+func (o *V2InstallClusterBatchUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch unauthorized response has a 4xx status code
+func (o *V2InstallClusterBatchUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 install cluster batch unauthorized response has a 5xx status code
+func (o *V2InstallClusterBatchUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 install cluster batch unauthorized response a status code equal to that given
+func (o *V2InstallClusterBatchUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2InstallClusterBatchUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2InstallClusterBatchUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2InstallClusterBatchUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstallClusterBatchForbidden creates a V2InstallClusterBatchForbidden with default headers values
+func NewV2InstallClusterBatchForbidden() *V2InstallClusterBatchForbidden {
+	return &V2InstallClusterBatchForbidden{}
+}
+
+/*
+V2InstallClusterBatchForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2InstallClusterBatchForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 install cluster batch forbidden response has a 2xx status code
+func (o *V2InstallClusterBatchForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 install cluster batch forbidden response has a 3xx status code
+func (o *V2InstallClusterBatchForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch forbidden response has a 4xx status code
+func (o *V2InstallClusterBatchForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 install cluster batch forbidden response has a 5xx status code
+func (o *V2InstallClusterBatchForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 install cluster batch forbidden response a status code equal to that given
+func (o *V2InstallClusterBatchForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2InstallClusterBatchForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2InstallClusterBatchForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2InstallClusterBatchForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstallClusterBatchNotFound creates a V2InstallClusterBatchNotFound with default headers values
+func NewV2InstallClusterBatchNotFound() *V2InstallClusterBatchNotFound {
+	return &V2InstallClusterBatchNotFound{}
+}
+
+/*
+V2InstallClusterBatchNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2InstallClusterBatchNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 install cluster batch not found response has a 2xx status code
+func (o *V2InstallClusterBatchNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 install cluster batch not found response has a 3xx status code
+func (o *V2InstallClusterBatchNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch not found response has a 4xx status code
+func (o *V2InstallClusterBatchNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 install cluster batch not found response has a 5xx status code
+func (o *V2InstallClusterBatchNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 install cluster batch not found response a status code equal to that given
+func (o *V2InstallClusterBatchNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2InstallClusterBatchNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2InstallClusterBatchNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2InstallClusterBatchNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstallClusterBatchMethodNotAllowed creates a V2InstallClusterBatchMethodNotAllowed with default headers values
+func NewV2InstallClusterBatchMethodNotAllowed() *V2InstallClusterBatchMethodNotAllowed {
+	return &V2InstallClusterBatchMethodNotAllowed{}
+}
+
+/*
+V2InstallClusterBatchMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2InstallClusterBatchMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 install cluster batch method not allowed response has a 2xx status code
+func (o *V2InstallClusterBatchMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 install cluster batch method not allowed response has a 3xx status code
+func (o *V2InstallClusterBatchMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch method not allowed response has a 4xx status code
+func (o *V2InstallClusterBatchMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 install cluster batch method not allowed response has a 5xx status code
+func (o *V2InstallClusterBatchMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 install cluster batch method not allowed response a status code equal to that given
+func (o *V2InstallClusterBatchMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2InstallClusterBatchMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2InstallClusterBatchMethodNotAllowed) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2InstallClusterBatchMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstallClusterBatchConflict creates a V2InstallClusterBatchConflict with default headers values
+func NewV2InstallClusterBatchConflict() *V2InstallClusterBatchConflict {
+	return &V2InstallClusterBatchConflict{}
+}
+
+/*
+V2InstallClusterBatchConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2InstallClusterBatchConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 install cluster batch conflict response has a 2xx status code
+func (o *V2InstallClusterBatchConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 install cluster batch conflict response has a 3xx status code
+func (o *V2InstallClusterBatchConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch conflict response has a 4xx status code
+func (o *V2InstallClusterBatchConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 install cluster batch conflict response has a 5xx status code
+func (o *V2InstallClusterBatchConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 install cluster batch conflict response a status code equal to that given
+func (o *V2InstallClusterBatchConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2InstallClusterBatchConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2InstallClusterBatchConflict) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2InstallClusterBatchConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2InstallClusterBatchInternalServerError creates a V2InstallClusterBatchInternalServerError with default headers values
+func NewV2InstallClusterBatchInternalServerError() *V2InstallClusterBatchInternalServerError {
+	return &V2InstallClusterBatchInternalServerError{}
+}
+
+/*
+V2InstallClusterBatchInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2InstallClusterBatchInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 install cluster batch internal server error response has a 2xx status code
+func (o *V2InstallClusterBatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 install cluster batch internal server error response has a 3xx status code
+func (o *V2InstallClusterBatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 install cluster batch internal server error response has a 4xx status code
+func (o *V2InstallClusterBatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 install cluster batch internal server error response has a 5xx status code
+func (o *V2InstallClusterBatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 install cluster batch internal server error response a status code equal to that given
+func (o *V2InstallClusterBatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2InstallClusterBatchInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2InstallClusterBatchInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/cluster-batches/{cluster_batch_id}/actions/install][%d] v2InstallClusterBatchInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2InstallClusterBatchInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2InstallClusterBatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListClusterBatchesParams creates a new V2ListClusterBatchesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListClusterBatchesParams() *V2ListClusterBatchesParams {
+	return &V2ListClusterBatchesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListClusterBatchesParamsWithTimeout creates a new V2ListClusterBatchesParams object
+// with the ability to set a timeout on a request.
+func NewV2ListClusterBatchesParamsWithTimeout(timeout time.Duration) *V2ListClusterBatchesParams {
+	return &V2ListClusterBatchesParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListClusterBatchesParamsWithContext creates a new V2ListClusterBatchesParams object
+// with the ability to set a context for a request.
+func NewV2ListClusterBatchesParamsWithContext(ctx context.Context) *V2ListClusterBatchesParams {
+	return &V2ListClusterBatchesParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListClusterBatchesParamsWithHTTPClient creates a new V2ListClusterBatchesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListClusterBatchesParamsWithHTTPClient(client *http.Client) *V2ListClusterBatchesParams {
+	return &V2ListClusterBatchesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListClusterBatchesParams contains all the parameters to send to the API endpoint
+
+	for the v2 list cluster batches operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListClusterBatchesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list cluster batches params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterBatchesParams) WithDefaults() *V2ListClusterBatchesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list cluster batches params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListClusterBatchesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list cluster batches params
+func (o *V2ListClusterBatchesParams) WithTimeout(timeout time.Duration) *V2ListClusterBatchesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list cluster batches params
+func (o *V2ListClusterBatchesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list cluster batches params
+func (o *V2ListClusterBatchesParams) WithContext(ctx context.Context) *V2ListClusterBatchesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list cluster batches params
+func (o *V2ListClusterBatchesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list cluster batches params
+func (o *V2ListClusterBatchesParams) WithHTTPClient(client *http.Client) *V2ListClusterBatchesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list cluster batches params
+func (o *V2ListClusterBatchesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListClusterBatchesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterBatchesReader is a Reader for the V2ListClusterBatches structure.
+type V2ListClusterBatchesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListClusterBatchesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListClusterBatchesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListClusterBatchesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListClusterBatchesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2ListClusterBatchesMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListClusterBatchesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListClusterBatchesOK creates a V2ListClusterBatchesOK with default headers values
+func NewV2ListClusterBatchesOK() *V2ListClusterBatchesOK {
+	return &V2ListClusterBatchesOK{}
+}
+
+/*
+V2ListClusterBatchesOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListClusterBatchesOK struct {
+	Payload models.ClusterBatchList
+}
+
+// IsSuccess returns true when this v2 list cluster batches o k response has a 2xx status code
+func (o *V2ListClusterBatchesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list cluster batches o k response has a 3xx status code
+func (o *V2ListClusterBatchesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster batches o k response has a 4xx status code
+func (o *V2ListClusterBatchesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster batches o k response has a 5xx status code
+func (o *V2ListClusterBatchesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster batches o k response a status code equal to that given
+func (o *V2ListClusterBatchesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListClusterBatchesOK) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterBatchesOK) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListClusterBatchesOK) GetPayload() models.ClusterBatchList {
+	return o.Payload
+}
+
+func (o *V2ListClusterBatchesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterBatchesUnauthorized creates a V2ListClusterBatchesUnauthorized with default headers values
+func NewV2ListClusterBatchesUnauthorized() *V2ListClusterBatchesUnauthorized {
+	return &V2ListClusterBatchesUnauthorized{}
+}
+
+/*
+V2ListClusterBatchesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListClusterBatchesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster batches unauthorized response has a 2xx status code
+func (o *V2ListClusterBatchesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster batches unauthorized response has a 3xx status code
+func (o *V2ListClusterBatchesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster batches unauthorized response has a 4xx status code
+func (o *V2ListClusterBatchesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster batches unauthorized response has a 5xx status code
+func (o *V2ListClusterBatchesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster batches unauthorized response a status code equal to that given
+func (o *V2ListClusterBatchesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListClusterBatchesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterBatchesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListClusterBatchesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterBatchesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterBatchesForbidden creates a V2ListClusterBatchesForbidden with default headers values
+func NewV2ListClusterBatchesForbidden() *V2ListClusterBatchesForbidden {
+	return &V2ListClusterBatchesForbidden{}
+}
+
+/*
+V2ListClusterBatchesForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListClusterBatchesForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list cluster batches forbidden response has a 2xx status code
+func (o *V2ListClusterBatchesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster batches forbidden response has a 3xx status code
+func (o *V2ListClusterBatchesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster batches forbidden response has a 4xx status code
+func (o *V2ListClusterBatchesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster batches forbidden response has a 5xx status code
+func (o *V2ListClusterBatchesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster batches forbidden response a status code equal to that given
+func (o *V2ListClusterBatchesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListClusterBatchesForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterBatchesForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListClusterBatchesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListClusterBatchesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterBatchesMethodNotAllowed creates a V2ListClusterBatchesMethodNotAllowed with default headers values
+func NewV2ListClusterBatchesMethodNotAllowed() *V2ListClusterBatchesMethodNotAllowed {
+	return &V2ListClusterBatchesMethodNotAllowed{}
+}
+
+/*
+V2ListClusterBatchesMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2ListClusterBatchesMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster batches method not allowed response has a 2xx status code
+func (o *V2ListClusterBatchesMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster batches method not allowed response has a 3xx status code
+func (o *V2ListClusterBatchesMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster batches method not allowed response has a 4xx status code
+func (o *V2ListClusterBatchesMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list cluster batches method not allowed response has a 5xx status code
+func (o *V2ListClusterBatchesMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list cluster batches method not allowed response a status code equal to that given
+func (o *V2ListClusterBatchesMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2ListClusterBatchesMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ListClusterBatchesMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2ListClusterBatchesMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterBatchesMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListClusterBatchesInternalServerError creates a V2ListClusterBatchesInternalServerError with default headers values
+func NewV2ListClusterBatchesInternalServerError() *V2ListClusterBatchesInternalServerError {
+	return &V2ListClusterBatchesInternalServerError{}
+}
+
+/*
+V2ListClusterBatchesInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListClusterBatchesInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list cluster batches internal server error response has a 2xx status code
+func (o *V2ListClusterBatchesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list cluster batches internal server error response has a 3xx status code
+func (o *V2ListClusterBatchesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list cluster batches internal server error response has a 4xx status code
+func (o *V2ListClusterBatchesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list cluster batches internal server error response has a 5xx status code
+func (o *V2ListClusterBatchesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list cluster batches internal server error response a status code equal to that given
+func (o *V2ListClusterBatchesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListClusterBatchesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterBatchesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/cluster-batches][%d] v2ListClusterBatchesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListClusterBatchesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListClusterBatchesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
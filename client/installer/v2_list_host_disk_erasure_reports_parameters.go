@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListHostDiskErasureReportsParams creates a new V2ListHostDiskErasureReportsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListHostDiskErasureReportsParams() *V2ListHostDiskErasureReportsParams {
+	return &V2ListHostDiskErasureReportsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListHostDiskErasureReportsParamsWithTimeout creates a new V2ListHostDiskErasureReportsParams object
+// with the ability to set a timeout on a request.
+func NewV2ListHostDiskErasureReportsParamsWithTimeout(timeout time.Duration) *V2ListHostDiskErasureReportsParams {
+	return &V2ListHostDiskErasureReportsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListHostDiskErasureReportsParamsWithContext creates a new V2ListHostDiskErasureReportsParams object
+// with the ability to set a context for a request.
+func NewV2ListHostDiskErasureReportsParamsWithContext(ctx context.Context) *V2ListHostDiskErasureReportsParams {
+	return &V2ListHostDiskErasureReportsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListHostDiskErasureReportsParamsWithHTTPClient creates a new V2ListHostDiskErasureReportsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListHostDiskErasureReportsParamsWithHTTPClient(client *http.Client) *V2ListHostDiskErasureReportsParams {
+	return &V2ListHostDiskErasureReportsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListHostDiskErasureReportsParams contains all the parameters to send to the API endpoint
+
+	for the v2 list host disk erasure reports operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListHostDiskErasureReportsParams struct {
+
+	/* HostID.
+
+	   The host whose disk erasure reports should be retrieved.
+
+	   Format: uuid
+	*/
+	HostID strfmt.UUID
+
+	/* InfraEnvID.
+
+	   The infra-env of the host.
+
+	   Format: uuid
+	*/
+	InfraEnvID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list host disk erasure reports params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostDiskErasureReportsParams) WithDefaults() *V2ListHostDiskErasureReportsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list host disk erasure reports params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListHostDiskErasureReportsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) WithTimeout(timeout time.Duration) *V2ListHostDiskErasureReportsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) WithContext(ctx context.Context) *V2ListHostDiskErasureReportsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) WithHTTPClient(client *http.Client) *V2ListHostDiskErasureReportsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithHostID adds the hostID to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) WithHostID(hostID strfmt.UUID) *V2ListHostDiskErasureReportsParams {
+	o.SetHostID(hostID)
+	return o
+}
+
+// SetHostID adds the hostId to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) SetHostID(hostID strfmt.UUID) {
+	o.HostID = hostID
+}
+
+// WithInfraEnvID adds the infraEnvID to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) WithInfraEnvID(infraEnvID strfmt.UUID) *V2ListHostDiskErasureReportsParams {
+	o.SetInfraEnvID(infraEnvID)
+	return o
+}
+
+// SetInfraEnvID adds the infraEnvId to the v2 list host disk erasure reports params
+func (o *V2ListHostDiskErasureReportsParams) SetInfraEnvID(infraEnvID strfmt.UUID) {
+	o.InfraEnvID = infraEnvID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListHostDiskErasureReportsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param host_id
+	if err := r.SetPathParam("host_id", o.HostID.String()); err != nil {
+		return err
+	}
+
+	// path param infra_env_id
+	if err := r.SetPathParam("infra_env_id", o.InfraEnvID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
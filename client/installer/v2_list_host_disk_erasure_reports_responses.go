@@ -0,0 +1,372 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostDiskErasureReportsReader is a Reader for the V2ListHostDiskErasureReports structure.
+type V2ListHostDiskErasureReportsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListHostDiskErasureReportsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListHostDiskErasureReportsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListHostDiskErasureReportsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListHostDiskErasureReportsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2ListHostDiskErasureReportsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListHostDiskErasureReportsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListHostDiskErasureReportsOK creates a V2ListHostDiskErasureReportsOK with default headers values
+func NewV2ListHostDiskErasureReportsOK() *V2ListHostDiskErasureReportsOK {
+	return &V2ListHostDiskErasureReportsOK{}
+}
+
+/*
+V2ListHostDiskErasureReportsOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListHostDiskErasureReportsOK struct {
+	Payload models.DiskErasureReportList
+}
+
+// IsSuccess returns true when this v2 list host disk erasure reports o k response has a 2xx status code
+func (o *V2ListHostDiskErasureReportsOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list host disk erasure reports o k response has a 3xx status code
+func (o *V2ListHostDiskErasureReportsOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host disk erasure reports o k response has a 4xx status code
+func (o *V2ListHostDiskErasureReportsOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host disk erasure reports o k response has a 5xx status code
+func (o *V2ListHostDiskErasureReportsOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host disk erasure reports o k response a status code equal to that given
+func (o *V2ListHostDiskErasureReportsOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListHostDiskErasureReportsOK) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsOK) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsOK) GetPayload() models.DiskErasureReportList {
+	return o.Payload
+}
+
+func (o *V2ListHostDiskErasureReportsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiskErasureReportsUnauthorized creates a V2ListHostDiskErasureReportsUnauthorized with default headers values
+func NewV2ListHostDiskErasureReportsUnauthorized() *V2ListHostDiskErasureReportsUnauthorized {
+	return &V2ListHostDiskErasureReportsUnauthorized{}
+}
+
+/*
+V2ListHostDiskErasureReportsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListHostDiskErasureReportsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host disk erasure reports unauthorized response has a 2xx status code
+func (o *V2ListHostDiskErasureReportsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host disk erasure reports unauthorized response has a 3xx status code
+func (o *V2ListHostDiskErasureReportsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host disk erasure reports unauthorized response has a 4xx status code
+func (o *V2ListHostDiskErasureReportsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host disk erasure reports unauthorized response has a 5xx status code
+func (o *V2ListHostDiskErasureReportsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host disk erasure reports unauthorized response a status code equal to that given
+func (o *V2ListHostDiskErasureReportsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListHostDiskErasureReportsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostDiskErasureReportsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiskErasureReportsForbidden creates a V2ListHostDiskErasureReportsForbidden with default headers values
+func NewV2ListHostDiskErasureReportsForbidden() *V2ListHostDiskErasureReportsForbidden {
+	return &V2ListHostDiskErasureReportsForbidden{}
+}
+
+/*
+V2ListHostDiskErasureReportsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListHostDiskErasureReportsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list host disk erasure reports forbidden response has a 2xx status code
+func (o *V2ListHostDiskErasureReportsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host disk erasure reports forbidden response has a 3xx status code
+func (o *V2ListHostDiskErasureReportsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host disk erasure reports forbidden response has a 4xx status code
+func (o *V2ListHostDiskErasureReportsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host disk erasure reports forbidden response has a 5xx status code
+func (o *V2ListHostDiskErasureReportsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host disk erasure reports forbidden response a status code equal to that given
+func (o *V2ListHostDiskErasureReportsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListHostDiskErasureReportsForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListHostDiskErasureReportsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiskErasureReportsNotFound creates a V2ListHostDiskErasureReportsNotFound with default headers values
+func NewV2ListHostDiskErasureReportsNotFound() *V2ListHostDiskErasureReportsNotFound {
+	return &V2ListHostDiskErasureReportsNotFound{}
+}
+
+/*
+V2ListHostDiskErasureReportsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2ListHostDiskErasureReportsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host disk erasure reports not found response has a 2xx status code
+func (o *V2ListHostDiskErasureReportsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host disk erasure reports not found response has a 3xx status code
+func (o *V2ListHostDiskErasureReportsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host disk erasure reports not found response has a 4xx status code
+func (o *V2ListHostDiskErasureReportsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list host disk erasure reports not found response has a 5xx status code
+func (o *V2ListHostDiskErasureReportsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list host disk erasure reports not found response a status code equal to that given
+func (o *V2ListHostDiskErasureReportsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2ListHostDiskErasureReportsNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostDiskErasureReportsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListHostDiskErasureReportsInternalServerError creates a V2ListHostDiskErasureReportsInternalServerError with default headers values
+func NewV2ListHostDiskErasureReportsInternalServerError() *V2ListHostDiskErasureReportsInternalServerError {
+	return &V2ListHostDiskErasureReportsInternalServerError{}
+}
+
+/*
+V2ListHostDiskErasureReportsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListHostDiskErasureReportsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list host disk erasure reports internal server error response has a 2xx status code
+func (o *V2ListHostDiskErasureReportsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list host disk erasure reports internal server error response has a 3xx status code
+func (o *V2ListHostDiskErasureReportsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list host disk erasure reports internal server error response has a 4xx status code
+func (o *V2ListHostDiskErasureReportsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list host disk erasure reports internal server error response has a 5xx status code
+func (o *V2ListHostDiskErasureReportsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list host disk erasure reports internal server error response a status code equal to that given
+func (o *V2ListHostDiskErasureReportsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListHostDiskErasureReportsInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports][%d] v2ListHostDiskErasureReportsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListHostDiskErasureReportsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListHostDiskErasureReportsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2SuggestNetworksParams creates a new V2SuggestNetworksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2SuggestNetworksParams() *V2SuggestNetworksParams {
+	return &V2SuggestNetworksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2SuggestNetworksParamsWithTimeout creates a new V2SuggestNetworksParams object
+// with the ability to set a timeout on a request.
+func NewV2SuggestNetworksParamsWithTimeout(timeout time.Duration) *V2SuggestNetworksParams {
+	return &V2SuggestNetworksParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2SuggestNetworksParamsWithContext creates a new V2SuggestNetworksParams object
+// with the ability to set a context for a request.
+func NewV2SuggestNetworksParamsWithContext(ctx context.Context) *V2SuggestNetworksParams {
+	return &V2SuggestNetworksParams{
+		Context: ctx,
+	}
+}
+
+// NewV2SuggestNetworksParamsWithHTTPClient creates a new V2SuggestNetworksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2SuggestNetworksParamsWithHTTPClient(client *http.Client) *V2SuggestNetworksParams {
+	return &V2SuggestNetworksParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2SuggestNetworksParams contains all the parameters to send to the API endpoint
+
+	for the v2 suggest networks operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2SuggestNetworksParams struct {
+
+	/* ClusterID.
+
+	   The cluster to suggest networks for.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* StackType.
+
+	   The address families of the suggested networks. By default, the address families of the hosts of the cluster.
+	*/
+	StackType *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 suggest networks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2SuggestNetworksParams) WithDefaults() *V2SuggestNetworksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 suggest networks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2SuggestNetworksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) WithTimeout(timeout time.Duration) *V2SuggestNetworksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) WithContext(ctx context.Context) *V2SuggestNetworksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) WithHTTPClient(client *http.Client) *V2SuggestNetworksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) WithClusterID(clusterID strfmt.UUID) *V2SuggestNetworksParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithStackType adds the stackType to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) WithStackType(stackType *string) *V2SuggestNetworksParams {
+	o.SetStackType(stackType)
+	return o
+}
+
+// SetStackType adds the stackType to the v2 suggest networks params
+func (o *V2SuggestNetworksParams) SetStackType(stackType *string) {
+	o.StackType = stackType
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2SuggestNetworksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+
+	if o.StackType != nil {
+
+		// query param stack_type
+		var qrStackType string
+
+		if o.StackType != nil {
+			qrStackType = *o.StackType
+		}
+		qStackType := qrStackType
+		if qStackType != "" {
+
+			if err := r.SetQueryParam("stack_type", qStackType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
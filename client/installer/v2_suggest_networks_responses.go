@@ -0,0 +1,512 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2SuggestNetworksReader is a Reader for the V2SuggestNetworks structure.
+type V2SuggestNetworksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2SuggestNetworksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2SuggestNetworksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2SuggestNetworksBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2SuggestNetworksUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2SuggestNetworksForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2SuggestNetworksNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2SuggestNetworksMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2SuggestNetworksInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2SuggestNetworksOK creates a V2SuggestNetworksOK with default headers values
+func NewV2SuggestNetworksOK() *V2SuggestNetworksOK {
+	return &V2SuggestNetworksOK{}
+}
+
+/*
+V2SuggestNetworksOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2SuggestNetworksOK struct {
+	Payload *models.NetworksSuggestion
+}
+
+// IsSuccess returns true when this v2 suggest networks o k response has a 2xx status code
+func (o *V2SuggestNetworksOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 suggest networks o k response has a 3xx status code
+func (o *V2SuggestNetworksOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks o k response has a 4xx status code
+func (o *V2SuggestNetworksOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 suggest networks o k response has a 5xx status code
+func (o *V2SuggestNetworksOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 suggest networks o k response a status code equal to that given
+func (o *V2SuggestNetworksOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2SuggestNetworksOK) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksOK  %+v", 200, o.Payload)
+}
+
+func (o *V2SuggestNetworksOK) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksOK  %+v", 200, o.Payload)
+}
+
+func (o *V2SuggestNetworksOK) GetPayload() *models.NetworksSuggestion {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NetworksSuggestion)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SuggestNetworksBadRequest creates a V2SuggestNetworksBadRequest with default headers values
+func NewV2SuggestNetworksBadRequest() *V2SuggestNetworksBadRequest {
+	return &V2SuggestNetworksBadRequest{}
+}
+
+/*
+V2SuggestNetworksBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2SuggestNetworksBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 suggest networks bad request response has a 2xx status code
+func (o *V2SuggestNetworksBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 suggest networks bad request response has a 3xx status code
+func (o *V2SuggestNetworksBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks bad request response has a 4xx status code
+func (o *V2SuggestNetworksBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 suggest networks bad request response has a 5xx status code
+func (o *V2SuggestNetworksBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 suggest networks bad request response a status code equal to that given
+func (o *V2SuggestNetworksBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2SuggestNetworksBadRequest) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2SuggestNetworksBadRequest) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2SuggestNetworksBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SuggestNetworksUnauthorized creates a V2SuggestNetworksUnauthorized with default headers values
+func NewV2SuggestNetworksUnauthorized() *V2SuggestNetworksUnauthorized {
+	return &V2SuggestNetworksUnauthorized{}
+}
+
+/*
+V2SuggestNetworksUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2SuggestNetworksUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 suggest networks unauthorized response has a 2xx status code
+func (o *V2SuggestNetworksUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 suggest networks unauthorized response has a 3xx status code
+func (o *V2SuggestNetworksUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks unauthorized response has a 4xx status code
+func (o *V2SuggestNetworksUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 suggest networks unauthorized response has a 5xx status code
+func (o *V2SuggestNetworksUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 suggest networks unauthorized response a status code equal to that given
+func (o *V2SuggestNetworksUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2SuggestNetworksUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2SuggestNetworksUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2SuggestNetworksUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SuggestNetworksForbidden creates a V2SuggestNetworksForbidden with default headers values
+func NewV2SuggestNetworksForbidden() *V2SuggestNetworksForbidden {
+	return &V2SuggestNetworksForbidden{}
+}
+
+/*
+V2SuggestNetworksForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2SuggestNetworksForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 suggest networks forbidden response has a 2xx status code
+func (o *V2SuggestNetworksForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 suggest networks forbidden response has a 3xx status code
+func (o *V2SuggestNetworksForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks forbidden response has a 4xx status code
+func (o *V2SuggestNetworksForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 suggest networks forbidden response has a 5xx status code
+func (o *V2SuggestNetworksForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 suggest networks forbidden response a status code equal to that given
+func (o *V2SuggestNetworksForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2SuggestNetworksForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2SuggestNetworksForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2SuggestNetworksForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SuggestNetworksNotFound creates a V2SuggestNetworksNotFound with default headers values
+func NewV2SuggestNetworksNotFound() *V2SuggestNetworksNotFound {
+	return &V2SuggestNetworksNotFound{}
+}
+
+/*
+V2SuggestNetworksNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2SuggestNetworksNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 suggest networks not found response has a 2xx status code
+func (o *V2SuggestNetworksNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 suggest networks not found response has a 3xx status code
+func (o *V2SuggestNetworksNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks not found response has a 4xx status code
+func (o *V2SuggestNetworksNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 suggest networks not found response has a 5xx status code
+func (o *V2SuggestNetworksNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 suggest networks not found response a status code equal to that given
+func (o *V2SuggestNetworksNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2SuggestNetworksNotFound) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2SuggestNetworksNotFound) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2SuggestNetworksNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SuggestNetworksMethodNotAllowed creates a V2SuggestNetworksMethodNotAllowed with default headers values
+func NewV2SuggestNetworksMethodNotAllowed() *V2SuggestNetworksMethodNotAllowed {
+	return &V2SuggestNetworksMethodNotAllowed{}
+}
+
+/*
+V2SuggestNetworksMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2SuggestNetworksMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 suggest networks method not allowed response has a 2xx status code
+func (o *V2SuggestNetworksMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 suggest networks method not allowed response has a 3xx status code
+func (o *V2SuggestNetworksMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks method not allowed response has a 4xx status code
+func (o *V2SuggestNetworksMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 suggest networks method not allowed response has a 5xx status code
+func (o *V2SuggestNetworksMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 suggest networks method not allowed response a status code equal to that given
+func (o *V2SuggestNetworksMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2SuggestNetworksMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2SuggestNetworksMethodNotAllowed) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2SuggestNetworksMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2SuggestNetworksInternalServerError creates a V2SuggestNetworksInternalServerError with default headers values
+func NewV2SuggestNetworksInternalServerError() *V2SuggestNetworksInternalServerError {
+	return &V2SuggestNetworksInternalServerError{}
+}
+
+/*
+V2SuggestNetworksInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2SuggestNetworksInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 suggest networks internal server error response has a 2xx status code
+func (o *V2SuggestNetworksInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 suggest networks internal server error response has a 3xx status code
+func (o *V2SuggestNetworksInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 suggest networks internal server error response has a 4xx status code
+func (o *V2SuggestNetworksInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 suggest networks internal server error response has a 5xx status code
+func (o *V2SuggestNetworksInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 suggest networks internal server error response a status code equal to that given
+func (o *V2SuggestNetworksInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2SuggestNetworksInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2SuggestNetworksInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/clusters/{cluster_id}/suggest-networks][%d] v2SuggestNetworksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2SuggestNetworksInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2SuggestNetworksInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
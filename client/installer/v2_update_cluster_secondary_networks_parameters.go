@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2UpdateClusterSecondaryNetworksParams creates a new V2UpdateClusterSecondaryNetworksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2UpdateClusterSecondaryNetworksParams() *V2UpdateClusterSecondaryNetworksParams {
+	return &V2UpdateClusterSecondaryNetworksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2UpdateClusterSecondaryNetworksParamsWithTimeout creates a new V2UpdateClusterSecondaryNetworksParams object
+// with the ability to set a timeout on a request.
+func NewV2UpdateClusterSecondaryNetworksParamsWithTimeout(timeout time.Duration) *V2UpdateClusterSecondaryNetworksParams {
+	return &V2UpdateClusterSecondaryNetworksParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2UpdateClusterSecondaryNetworksParamsWithContext creates a new V2UpdateClusterSecondaryNetworksParams object
+// with the ability to set a context for a request.
+func NewV2UpdateClusterSecondaryNetworksParamsWithContext(ctx context.Context) *V2UpdateClusterSecondaryNetworksParams {
+	return &V2UpdateClusterSecondaryNetworksParams{
+		Context: ctx,
+	}
+}
+
+// NewV2UpdateClusterSecondaryNetworksParamsWithHTTPClient creates a new V2UpdateClusterSecondaryNetworksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2UpdateClusterSecondaryNetworksParamsWithHTTPClient(client *http.Client) *V2UpdateClusterSecondaryNetworksParams {
+	return &V2UpdateClusterSecondaryNetworksParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksParams contains all the parameters to send to the API endpoint
+
+	for the v2 update cluster secondary networks operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2UpdateClusterSecondaryNetworksParams struct {
+
+	/* ClusterID.
+
+	   The cluster whose secondary network policies should be set.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* SecondaryNetworks.
+
+	   The secondary network policies of the cluster, replacing the current ones.
+	*/
+	SecondaryNetworks models.SecondaryNetworkPolicies
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 update cluster secondary networks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateClusterSecondaryNetworksParams) WithDefaults() *V2UpdateClusterSecondaryNetworksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 update cluster secondary networks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2UpdateClusterSecondaryNetworksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) WithTimeout(timeout time.Duration) *V2UpdateClusterSecondaryNetworksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) WithContext(ctx context.Context) *V2UpdateClusterSecondaryNetworksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) WithHTTPClient(client *http.Client) *V2UpdateClusterSecondaryNetworksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) WithClusterID(clusterID strfmt.UUID) *V2UpdateClusterSecondaryNetworksParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithSecondaryNetworks adds the secondaryNetworks to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) WithSecondaryNetworks(secondaryNetworks models.SecondaryNetworkPolicies) *V2UpdateClusterSecondaryNetworksParams {
+	o.SetSecondaryNetworks(secondaryNetworks)
+	return o
+}
+
+// SetSecondaryNetworks adds the secondaryNetworks to the v2 update cluster secondary networks params
+func (o *V2UpdateClusterSecondaryNetworksParams) SetSecondaryNetworks(secondaryNetworks models.SecondaryNetworkPolicies) {
+	o.SecondaryNetworks = secondaryNetworks
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2UpdateClusterSecondaryNetworksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+	if o.SecondaryNetworks != nil {
+		if err := r.SetBodyParam(o.SecondaryNetworks); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,579 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateClusterSecondaryNetworksReader is a Reader for the V2UpdateClusterSecondaryNetworks structure.
+type V2UpdateClusterSecondaryNetworksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2UpdateClusterSecondaryNetworksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2UpdateClusterSecondaryNetworksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2UpdateClusterSecondaryNetworksBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2UpdateClusterSecondaryNetworksUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2UpdateClusterSecondaryNetworksForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2UpdateClusterSecondaryNetworksNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 405:
+		result := NewV2UpdateClusterSecondaryNetworksMethodNotAllowed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2UpdateClusterSecondaryNetworksConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2UpdateClusterSecondaryNetworksInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2UpdateClusterSecondaryNetworksOK creates a V2UpdateClusterSecondaryNetworksOK with default headers values
+func NewV2UpdateClusterSecondaryNetworksOK() *V2UpdateClusterSecondaryNetworksOK {
+	return &V2UpdateClusterSecondaryNetworksOK{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2UpdateClusterSecondaryNetworksOK struct {
+	Payload models.SecondaryNetworkPolicies
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks o k response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks o k response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks o k response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks o k response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks o k response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2UpdateClusterSecondaryNetworksOK) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksOK) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksOK  %+v", 200, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksOK) GetPayload() models.SecondaryNetworkPolicies {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksBadRequest creates a V2UpdateClusterSecondaryNetworksBadRequest with default headers values
+func NewV2UpdateClusterSecondaryNetworksBadRequest() *V2UpdateClusterSecondaryNetworksBadRequest {
+	return &V2UpdateClusterSecondaryNetworksBadRequest{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2UpdateClusterSecondaryNetworksBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks bad request response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks bad request response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks bad request response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks bad request response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks bad request response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksUnauthorized creates a V2UpdateClusterSecondaryNetworksUnauthorized with default headers values
+func NewV2UpdateClusterSecondaryNetworksUnauthorized() *V2UpdateClusterSecondaryNetworksUnauthorized {
+	return &V2UpdateClusterSecondaryNetworksUnauthorized{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2UpdateClusterSecondaryNetworksUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks unauthorized response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks unauthorized response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks unauthorized response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks unauthorized response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks unauthorized response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksForbidden creates a V2UpdateClusterSecondaryNetworksForbidden with default headers values
+func NewV2UpdateClusterSecondaryNetworksForbidden() *V2UpdateClusterSecondaryNetworksForbidden {
+	return &V2UpdateClusterSecondaryNetworksForbidden{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2UpdateClusterSecondaryNetworksForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks forbidden response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks forbidden response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks forbidden response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks forbidden response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks forbidden response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2UpdateClusterSecondaryNetworksForbidden) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksForbidden) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksNotFound creates a V2UpdateClusterSecondaryNetworksNotFound with default headers values
+func NewV2UpdateClusterSecondaryNetworksNotFound() *V2UpdateClusterSecondaryNetworksNotFound {
+	return &V2UpdateClusterSecondaryNetworksNotFound{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2UpdateClusterSecondaryNetworksNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks not found response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks not found response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks not found response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks not found response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks not found response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2UpdateClusterSecondaryNetworksNotFound) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksNotFound) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksMethodNotAllowed creates a V2UpdateClusterSecondaryNetworksMethodNotAllowed with default headers values
+func NewV2UpdateClusterSecondaryNetworksMethodNotAllowed() *V2UpdateClusterSecondaryNetworksMethodNotAllowed {
+	return &V2UpdateClusterSecondaryNetworksMethodNotAllowed{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksMethodNotAllowed describes a response with status code 405, with default header values.
+
+Method Not Allowed.
+*/
+type V2UpdateClusterSecondaryNetworksMethodNotAllowed struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks method not allowed response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks method not allowed response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks method not allowed response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks method not allowed response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks method not allowed response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) IsCode(code int) bool {
+	return code == 405
+}
+
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksMethodNotAllowed  %+v", 405, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksConflict creates a V2UpdateClusterSecondaryNetworksConflict with default headers values
+func NewV2UpdateClusterSecondaryNetworksConflict() *V2UpdateClusterSecondaryNetworksConflict {
+	return &V2UpdateClusterSecondaryNetworksConflict{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2UpdateClusterSecondaryNetworksConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks conflict response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks conflict response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks conflict response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks conflict response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 update cluster secondary networks conflict response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2UpdateClusterSecondaryNetworksConflict) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksConflict) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2UpdateClusterSecondaryNetworksInternalServerError creates a V2UpdateClusterSecondaryNetworksInternalServerError with default headers values
+func NewV2UpdateClusterSecondaryNetworksInternalServerError() *V2UpdateClusterSecondaryNetworksInternalServerError {
+	return &V2UpdateClusterSecondaryNetworksInternalServerError{}
+}
+
+/*
+V2UpdateClusterSecondaryNetworksInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2UpdateClusterSecondaryNetworksInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 update cluster secondary networks internal server error response has a 2xx status code
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 update cluster secondary networks internal server error response has a 3xx status code
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 update cluster secondary networks internal server error response has a 4xx status code
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 update cluster secondary networks internal server error response has a 5xx status code
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 update cluster secondary networks internal server error response a status code equal to that given
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /v2/clusters/{cluster_id}/secondary-networks][%d] v2UpdateClusterSecondaryNetworksInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
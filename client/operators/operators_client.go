@@ -17,6 +17,15 @@ import (
 
 // API is the interface of the operators client
 type API interface {
+	/*
+	   V2EnableClusterOperators Enables additional OLM operators on an installed cluster. The hardware of the nodes of the cluster is validated against the requirements of the operators, the manifests of the operators are applied to the cluster and the operators are added to the monitored operators of the cluster, whose status tracks the rollout. Enabling an operator that is already enabled applies its manifests again.*/
+	V2EnableClusterOperators(ctx context.Context, params *V2EnableClusterOperatorsParams) (*V2EnableClusterOperatorsCreated, error)
+	/*
+	   V2GetBundle Retrieves a bundle of operators.*/
+	V2GetBundle(ctx context.Context, params *V2GetBundleParams) (*V2GetBundleOK, error)
+	/*
+	   V2ListBundles Lists the bundles of operators that can be installed together, such as virtualization. When an OpenShift version is given, the bundles that aren't supported on the version, CPU architecture and platform are omitted.*/
+	V2ListBundles(ctx context.Context, params *V2ListBundlesParams) (*V2ListBundlesOK, error)
 	/*
 	   V2ListOfClusterOperators Lists operators to be monitored for a cluster.*/
 	V2ListOfClusterOperators(ctx context.Context, params *V2ListOfClusterOperatorsParams) (*V2ListOfClusterOperatorsOK, error)
@@ -49,6 +58,81 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+V2EnableClusterOperators Enables additional OLM operators on an installed cluster. The hardware of the nodes of the cluster is validated against the requirements of the operators, the manifests of the operators are applied to the cluster and the operators are added to the monitored operators of the cluster, whose status tracks the rollout. Enabling an operator that is already enabled applies its manifests again.
+*/
+func (a *Client) V2EnableClusterOperators(ctx context.Context, params *V2EnableClusterOperatorsParams) (*V2EnableClusterOperatorsCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2EnableClusterOperators",
+		Method:             "POST",
+		PathPattern:        "/v2/clusters/{cluster_id}/monitored-operators",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2EnableClusterOperatorsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2EnableClusterOperatorsCreated), nil
+
+}
+
+/*
+V2GetBundle Retrieves a bundle of operators.
+*/
+func (a *Client) V2GetBundle(ctx context.Context, params *V2GetBundleParams) (*V2GetBundleOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2GetBundle",
+		Method:             "GET",
+		PathPattern:        "/v2/operators/bundles/{bundle_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2GetBundleReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2GetBundleOK), nil
+
+}
+
+/*
+V2ListBundles Lists the bundles of operators that can be installed together, such as virtualization. When an OpenShift version is given, the bundles that aren't supported on the version, CPU architecture and platform are omitted.
+*/
+func (a *Client) V2ListBundles(ctx context.Context, params *V2ListBundlesParams) (*V2ListBundlesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "V2ListBundles",
+		Method:             "GET",
+		PathPattern:        "/v2/operators/bundles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &V2ListBundlesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*V2ListBundlesOK), nil
+
+}
+
 /*
 V2ListOfClusterOperators Lists operators to be monitored for a cluster.
 */
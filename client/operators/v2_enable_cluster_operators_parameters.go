@@ -0,0 +1,177 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2EnableClusterOperatorsParams creates a new V2EnableClusterOperatorsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2EnableClusterOperatorsParams() *V2EnableClusterOperatorsParams {
+	return &V2EnableClusterOperatorsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2EnableClusterOperatorsParamsWithTimeout creates a new V2EnableClusterOperatorsParams object
+// with the ability to set a timeout on a request.
+func NewV2EnableClusterOperatorsParamsWithTimeout(timeout time.Duration) *V2EnableClusterOperatorsParams {
+	return &V2EnableClusterOperatorsParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2EnableClusterOperatorsParamsWithContext creates a new V2EnableClusterOperatorsParams object
+// with the ability to set a context for a request.
+func NewV2EnableClusterOperatorsParamsWithContext(ctx context.Context) *V2EnableClusterOperatorsParams {
+	return &V2EnableClusterOperatorsParams{
+		Context: ctx,
+	}
+}
+
+// NewV2EnableClusterOperatorsParamsWithHTTPClient creates a new V2EnableClusterOperatorsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2EnableClusterOperatorsParamsWithHTTPClient(client *http.Client) *V2EnableClusterOperatorsParams {
+	return &V2EnableClusterOperatorsParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2EnableClusterOperatorsParams contains all the parameters to send to the API endpoint
+
+	for the v2 enable cluster operators operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2EnableClusterOperatorsParams struct {
+
+	/* ClusterID.
+
+	   The installed cluster to enable the operators on.
+
+	   Format: uuid
+	*/
+	ClusterID strfmt.UUID
+
+	/* Operators.
+
+	   The operators to enable.
+	*/
+	Operators []*models.OperatorCreateParams
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 enable cluster operators params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2EnableClusterOperatorsParams) WithDefaults() *V2EnableClusterOperatorsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 enable cluster operators params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2EnableClusterOperatorsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) WithTimeout(timeout time.Duration) *V2EnableClusterOperatorsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) WithContext(ctx context.Context) *V2EnableClusterOperatorsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) WithHTTPClient(client *http.Client) *V2EnableClusterOperatorsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) WithClusterID(clusterID strfmt.UUID) *V2EnableClusterOperatorsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) SetClusterID(clusterID strfmt.UUID) {
+	o.ClusterID = clusterID
+}
+
+// WithOperators adds the operators to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) WithOperators(operators []*models.OperatorCreateParams) *V2EnableClusterOperatorsParams {
+	o.SetOperators(operators)
+	return o
+}
+
+// SetOperators adds the operators to the v2 enable cluster operators params
+func (o *V2EnableClusterOperatorsParams) SetOperators(operators []*models.OperatorCreateParams) {
+	o.Operators = operators
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2EnableClusterOperatorsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
+		return err
+	}
+	if o.Operators != nil {
+		if err := r.SetBodyParam(o.Operators); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,510 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2EnableClusterOperatorsReader is a Reader for the V2EnableClusterOperators structure.
+type V2EnableClusterOperatorsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2EnableClusterOperatorsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewV2EnableClusterOperatorsCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewV2EnableClusterOperatorsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewV2EnableClusterOperatorsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2EnableClusterOperatorsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewV2EnableClusterOperatorsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewV2EnableClusterOperatorsConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2EnableClusterOperatorsInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2EnableClusterOperatorsCreated creates a V2EnableClusterOperatorsCreated with default headers values
+func NewV2EnableClusterOperatorsCreated() *V2EnableClusterOperatorsCreated {
+	return &V2EnableClusterOperatorsCreated{}
+}
+
+/*
+V2EnableClusterOperatorsCreated describes a response with status code 201, with default header values.
+
+Success.
+*/
+type V2EnableClusterOperatorsCreated struct {
+	Payload models.MonitoredOperatorsList
+}
+
+// IsSuccess returns true when this v2 enable cluster operators created response has a 2xx status code
+func (o *V2EnableClusterOperatorsCreated) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 enable cluster operators created response has a 3xx status code
+func (o *V2EnableClusterOperatorsCreated) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators created response has a 4xx status code
+func (o *V2EnableClusterOperatorsCreated) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 enable cluster operators created response has a 5xx status code
+func (o *V2EnableClusterOperatorsCreated) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 enable cluster operators created response a status code equal to that given
+func (o *V2EnableClusterOperatorsCreated) IsCode(code int) bool {
+	return code == 201
+}
+
+func (o *V2EnableClusterOperatorsCreated) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsCreated) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsCreated  %+v", 201, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsCreated) GetPayload() models.MonitoredOperatorsList {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EnableClusterOperatorsBadRequest creates a V2EnableClusterOperatorsBadRequest with default headers values
+func NewV2EnableClusterOperatorsBadRequest() *V2EnableClusterOperatorsBadRequest {
+	return &V2EnableClusterOperatorsBadRequest{}
+}
+
+/*
+V2EnableClusterOperatorsBadRequest describes a response with status code 400, with default header values.
+
+Error.
+*/
+type V2EnableClusterOperatorsBadRequest struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 enable cluster operators bad request response has a 2xx status code
+func (o *V2EnableClusterOperatorsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 enable cluster operators bad request response has a 3xx status code
+func (o *V2EnableClusterOperatorsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators bad request response has a 4xx status code
+func (o *V2EnableClusterOperatorsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 enable cluster operators bad request response has a 5xx status code
+func (o *V2EnableClusterOperatorsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 enable cluster operators bad request response a status code equal to that given
+func (o *V2EnableClusterOperatorsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *V2EnableClusterOperatorsBadRequest) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsBadRequest) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsBadRequest) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EnableClusterOperatorsUnauthorized creates a V2EnableClusterOperatorsUnauthorized with default headers values
+func NewV2EnableClusterOperatorsUnauthorized() *V2EnableClusterOperatorsUnauthorized {
+	return &V2EnableClusterOperatorsUnauthorized{}
+}
+
+/*
+V2EnableClusterOperatorsUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2EnableClusterOperatorsUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 enable cluster operators unauthorized response has a 2xx status code
+func (o *V2EnableClusterOperatorsUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 enable cluster operators unauthorized response has a 3xx status code
+func (o *V2EnableClusterOperatorsUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators unauthorized response has a 4xx status code
+func (o *V2EnableClusterOperatorsUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 enable cluster operators unauthorized response has a 5xx status code
+func (o *V2EnableClusterOperatorsUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 enable cluster operators unauthorized response a status code equal to that given
+func (o *V2EnableClusterOperatorsUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2EnableClusterOperatorsUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsUnauthorized) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EnableClusterOperatorsForbidden creates a V2EnableClusterOperatorsForbidden with default headers values
+func NewV2EnableClusterOperatorsForbidden() *V2EnableClusterOperatorsForbidden {
+	return &V2EnableClusterOperatorsForbidden{}
+}
+
+/*
+V2EnableClusterOperatorsForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2EnableClusterOperatorsForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 enable cluster operators forbidden response has a 2xx status code
+func (o *V2EnableClusterOperatorsForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 enable cluster operators forbidden response has a 3xx status code
+func (o *V2EnableClusterOperatorsForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators forbidden response has a 4xx status code
+func (o *V2EnableClusterOperatorsForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 enable cluster operators forbidden response has a 5xx status code
+func (o *V2EnableClusterOperatorsForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 enable cluster operators forbidden response a status code equal to that given
+func (o *V2EnableClusterOperatorsForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2EnableClusterOperatorsForbidden) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsForbidden) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EnableClusterOperatorsNotFound creates a V2EnableClusterOperatorsNotFound with default headers values
+func NewV2EnableClusterOperatorsNotFound() *V2EnableClusterOperatorsNotFound {
+	return &V2EnableClusterOperatorsNotFound{}
+}
+
+/*
+V2EnableClusterOperatorsNotFound describes a response with status code 404, with default header values.
+
+Error.
+*/
+type V2EnableClusterOperatorsNotFound struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 enable cluster operators not found response has a 2xx status code
+func (o *V2EnableClusterOperatorsNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 enable cluster operators not found response has a 3xx status code
+func (o *V2EnableClusterOperatorsNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators not found response has a 4xx status code
+func (o *V2EnableClusterOperatorsNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 enable cluster operators not found response has a 5xx status code
+func (o *V2EnableClusterOperatorsNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 enable cluster operators not found response a status code equal to that given
+func (o *V2EnableClusterOperatorsNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+func (o *V2EnableClusterOperatorsNotFound) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsNotFound) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EnableClusterOperatorsConflict creates a V2EnableClusterOperatorsConflict with default headers values
+func NewV2EnableClusterOperatorsConflict() *V2EnableClusterOperatorsConflict {
+	return &V2EnableClusterOperatorsConflict{}
+}
+
+/*
+V2EnableClusterOperatorsConflict describes a response with status code 409, with default header values.
+
+Error.
+*/
+type V2EnableClusterOperatorsConflict struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 enable cluster operators conflict response has a 2xx status code
+func (o *V2EnableClusterOperatorsConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 enable cluster operators conflict response has a 3xx status code
+func (o *V2EnableClusterOperatorsConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators conflict response has a 4xx status code
+func (o *V2EnableClusterOperatorsConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 enable cluster operators conflict response has a 5xx status code
+func (o *V2EnableClusterOperatorsConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 enable cluster operators conflict response a status code equal to that given
+func (o *V2EnableClusterOperatorsConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+func (o *V2EnableClusterOperatorsConflict) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsConflict) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsConflict  %+v", 409, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2EnableClusterOperatorsInternalServerError creates a V2EnableClusterOperatorsInternalServerError with default headers values
+func NewV2EnableClusterOperatorsInternalServerError() *V2EnableClusterOperatorsInternalServerError {
+	return &V2EnableClusterOperatorsInternalServerError{}
+}
+
+/*
+V2EnableClusterOperatorsInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2EnableClusterOperatorsInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 enable cluster operators internal server error response has a 2xx status code
+func (o *V2EnableClusterOperatorsInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 enable cluster operators internal server error response has a 3xx status code
+func (o *V2EnableClusterOperatorsInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 enable cluster operators internal server error response has a 4xx status code
+func (o *V2EnableClusterOperatorsInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 enable cluster operators internal server error response has a 5xx status code
+func (o *V2EnableClusterOperatorsInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 enable cluster operators internal server error response a status code equal to that given
+func (o *V2EnableClusterOperatorsInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2EnableClusterOperatorsInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsInternalServerError) String() string {
+	return fmt.Sprintf("[POST /v2/clusters/{cluster_id}/monitored-operators][%d] v2EnableClusterOperatorsInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2EnableClusterOperatorsInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2EnableClusterOperatorsInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetBundleParams creates a new V2GetBundleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2GetBundleParams() *V2GetBundleParams {
+	return &V2GetBundleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2GetBundleParamsWithTimeout creates a new V2GetBundleParams object
+// with the ability to set a timeout on a request.
+func NewV2GetBundleParamsWithTimeout(timeout time.Duration) *V2GetBundleParams {
+	return &V2GetBundleParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2GetBundleParamsWithContext creates a new V2GetBundleParams object
+// with the ability to set a context for a request.
+func NewV2GetBundleParamsWithContext(ctx context.Context) *V2GetBundleParams {
+	return &V2GetBundleParams{
+		Context: ctx,
+	}
+}
+
+// NewV2GetBundleParamsWithHTTPClient creates a new V2GetBundleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2GetBundleParamsWithHTTPClient(client *http.Client) *V2GetBundleParams {
+	return &V2GetBundleParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2GetBundleParams contains all the parameters to send to the API endpoint
+
+	for the v2 get bundle operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2GetBundleParams struct {
+
+	/* BundleID.
+
+	   Identifier of the bundle, for example "virtualization".
+	*/
+	BundleID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 get bundle params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetBundleParams) WithDefaults() *V2GetBundleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 get bundle params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2GetBundleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the v2 get bundle params
+func (o *V2GetBundleParams) WithTimeout(timeout time.Duration) *V2GetBundleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 get bundle params
+func (o *V2GetBundleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 get bundle params
+func (o *V2GetBundleParams) WithContext(ctx context.Context) *V2GetBundleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 get bundle params
+func (o *V2GetBundleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 get bundle params
+func (o *V2GetBundleParams) WithHTTPClient(client *http.Client) *V2GetBundleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 get bundle params
+func (o *V2GetBundleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBundleID adds the bundleID to the v2 get bundle params
+func (o *V2GetBundleParams) WithBundleID(bundleID string) *V2GetBundleParams {
+	o.SetBundleID(bundleID)
+	return o
+}
+
+// SetBundleID adds the bundleId to the v2 get bundle params
+func (o *V2GetBundleParams) SetBundleID(bundleID string) {
+	o.BundleID = bundleID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2GetBundleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param bundle_id
+	if err := r.SetPathParam("bundle_id", o.BundleID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2ListBundlesParams creates a new V2ListBundlesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewV2ListBundlesParams() *V2ListBundlesParams {
+	return &V2ListBundlesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewV2ListBundlesParamsWithTimeout creates a new V2ListBundlesParams object
+// with the ability to set a timeout on a request.
+func NewV2ListBundlesParamsWithTimeout(timeout time.Duration) *V2ListBundlesParams {
+	return &V2ListBundlesParams{
+		timeout: timeout,
+	}
+}
+
+// NewV2ListBundlesParamsWithContext creates a new V2ListBundlesParams object
+// with the ability to set a context for a request.
+func NewV2ListBundlesParamsWithContext(ctx context.Context) *V2ListBundlesParams {
+	return &V2ListBundlesParams{
+		Context: ctx,
+	}
+}
+
+// NewV2ListBundlesParamsWithHTTPClient creates a new V2ListBundlesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewV2ListBundlesParamsWithHTTPClient(client *http.Client) *V2ListBundlesParams {
+	return &V2ListBundlesParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+V2ListBundlesParams contains all the parameters to send to the API endpoint
+
+	for the v2 list bundles operation.
+
+	Typically these are written to a http.Request.
+*/
+type V2ListBundlesParams struct {
+
+	/* CPUArchitecture.
+
+	   The CPU architecture of the cluster.
+
+	   Default: "x86_64"
+	*/
+	CPUArchitecture *string
+
+	/* OpenshiftVersion.
+
+	   Version of the OpenShift cluster.
+	*/
+	OpenshiftVersion *string
+
+	/* PlatformType.
+
+	   The platform of the cluster.
+	*/
+	PlatformType *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the v2 list bundles params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListBundlesParams) WithDefaults() *V2ListBundlesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the v2 list bundles params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *V2ListBundlesParams) SetDefaults() {
+	var (
+		cPUArchitectureDefault = string("x86_64")
+	)
+
+	val := V2ListBundlesParams{
+		CPUArchitecture: &cPUArchitectureDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the v2 list bundles params
+func (o *V2ListBundlesParams) WithTimeout(timeout time.Duration) *V2ListBundlesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the v2 list bundles params
+func (o *V2ListBundlesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the v2 list bundles params
+func (o *V2ListBundlesParams) WithContext(ctx context.Context) *V2ListBundlesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the v2 list bundles params
+func (o *V2ListBundlesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the v2 list bundles params
+func (o *V2ListBundlesParams) WithHTTPClient(client *http.Client) *V2ListBundlesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the v2 list bundles params
+func (o *V2ListBundlesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCPUArchitecture adds the cPUArchitecture to the v2 list bundles params
+func (o *V2ListBundlesParams) WithCPUArchitecture(cPUArchitecture *string) *V2ListBundlesParams {
+	o.SetCPUArchitecture(cPUArchitecture)
+	return o
+}
+
+// SetCPUArchitecture adds the cpuArchitecture to the v2 list bundles params
+func (o *V2ListBundlesParams) SetCPUArchitecture(cPUArchitecture *string) {
+	o.CPUArchitecture = cPUArchitecture
+}
+
+// WithOpenshiftVersion adds the openshiftVersion to the v2 list bundles params
+func (o *V2ListBundlesParams) WithOpenshiftVersion(openshiftVersion *string) *V2ListBundlesParams {
+	o.SetOpenshiftVersion(openshiftVersion)
+	return o
+}
+
+// SetOpenshiftVersion adds the openshiftVersion to the v2 list bundles params
+func (o *V2ListBundlesParams) SetOpenshiftVersion(openshiftVersion *string) {
+	o.OpenshiftVersion = openshiftVersion
+}
+
+// WithPlatformType adds the platformType to the v2 list bundles params
+func (o *V2ListBundlesParams) WithPlatformType(platformType *string) *V2ListBundlesParams {
+	o.SetPlatformType(platformType)
+	return o
+}
+
+// SetPlatformType adds the platformType to the v2 list bundles params
+func (o *V2ListBundlesParams) SetPlatformType(platformType *string) {
+	o.PlatformType = platformType
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *V2ListBundlesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.CPUArchitecture != nil {
+
+		// query param cpu_architecture
+		var qrCPUArchitecture string
+
+		if o.CPUArchitecture != nil {
+			qrCPUArchitecture = *o.CPUArchitecture
+		}
+		qCPUArchitecture := qrCPUArchitecture
+		if qCPUArchitecture != "" {
+
+			if err := r.SetQueryParam("cpu_architecture", qCPUArchitecture); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.OpenshiftVersion != nil {
+
+		// query param openshift_version
+		var qrOpenshiftVersion string
+
+		if o.OpenshiftVersion != nil {
+			qrOpenshiftVersion = *o.OpenshiftVersion
+		}
+		qOpenshiftVersion := qrOpenshiftVersion
+		if qOpenshiftVersion != "" {
+
+			if err := r.SetQueryParam("openshift_version", qOpenshiftVersion); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PlatformType != nil {
+
+		// query param platform_type
+		var qrPlatformType string
+
+		if o.PlatformType != nil {
+			qrPlatformType = *o.PlatformType
+		}
+		qPlatformType := qrPlatformType
+		if qPlatformType != "" {
+
+			if err := r.SetQueryParam("platform_type", qPlatformType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
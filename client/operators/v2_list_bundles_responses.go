@@ -0,0 +1,303 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListBundlesReader is a Reader for the V2ListBundles structure.
+type V2ListBundlesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *V2ListBundlesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewV2ListBundlesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewV2ListBundlesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewV2ListBundlesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewV2ListBundlesInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewV2ListBundlesOK creates a V2ListBundlesOK with default headers values
+func NewV2ListBundlesOK() *V2ListBundlesOK {
+	return &V2ListBundlesOK{}
+}
+
+/*
+V2ListBundlesOK describes a response with status code 200, with default header values.
+
+Success.
+*/
+type V2ListBundlesOK struct {
+	Payload []*models.Bundle
+}
+
+// IsSuccess returns true when this v2 list bundles o k response has a 2xx status code
+func (o *V2ListBundlesOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this v2 list bundles o k response has a 3xx status code
+func (o *V2ListBundlesOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bundles o k response has a 4xx status code
+func (o *V2ListBundlesOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list bundles o k response has a 5xx status code
+func (o *V2ListBundlesOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bundles o k response a status code equal to that given
+func (o *V2ListBundlesOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *V2ListBundlesOK) Error() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListBundlesOK) String() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesOK  %+v", 200, o.Payload)
+}
+
+func (o *V2ListBundlesOK) GetPayload() []*models.Bundle {
+	return o.Payload
+}
+
+func (o *V2ListBundlesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBundlesUnauthorized creates a V2ListBundlesUnauthorized with default headers values
+func NewV2ListBundlesUnauthorized() *V2ListBundlesUnauthorized {
+	return &V2ListBundlesUnauthorized{}
+}
+
+/*
+V2ListBundlesUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized.
+*/
+type V2ListBundlesUnauthorized struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list bundles unauthorized response has a 2xx status code
+func (o *V2ListBundlesUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bundles unauthorized response has a 3xx status code
+func (o *V2ListBundlesUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bundles unauthorized response has a 4xx status code
+func (o *V2ListBundlesUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list bundles unauthorized response has a 5xx status code
+func (o *V2ListBundlesUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bundles unauthorized response a status code equal to that given
+func (o *V2ListBundlesUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+func (o *V2ListBundlesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListBundlesUnauthorized) String() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *V2ListBundlesUnauthorized) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListBundlesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBundlesForbidden creates a V2ListBundlesForbidden with default headers values
+func NewV2ListBundlesForbidden() *V2ListBundlesForbidden {
+	return &V2ListBundlesForbidden{}
+}
+
+/*
+V2ListBundlesForbidden describes a response with status code 403, with default header values.
+
+Forbidden.
+*/
+type V2ListBundlesForbidden struct {
+	Payload *models.InfraError
+}
+
+// IsSuccess returns true when this v2 list bundles forbidden response has a 2xx status code
+func (o *V2ListBundlesForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bundles forbidden response has a 3xx status code
+func (o *V2ListBundlesForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bundles forbidden response has a 4xx status code
+func (o *V2ListBundlesForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this v2 list bundles forbidden response has a 5xx status code
+func (o *V2ListBundlesForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this v2 list bundles forbidden response a status code equal to that given
+func (o *V2ListBundlesForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+func (o *V2ListBundlesForbidden) Error() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListBundlesForbidden) String() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *V2ListBundlesForbidden) GetPayload() *models.InfraError {
+	return o.Payload
+}
+
+func (o *V2ListBundlesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.InfraError)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewV2ListBundlesInternalServerError creates a V2ListBundlesInternalServerError with default headers values
+func NewV2ListBundlesInternalServerError() *V2ListBundlesInternalServerError {
+	return &V2ListBundlesInternalServerError{}
+}
+
+/*
+V2ListBundlesInternalServerError describes a response with status code 500, with default header values.
+
+Error.
+*/
+type V2ListBundlesInternalServerError struct {
+	Payload *models.Error
+}
+
+// IsSuccess returns true when this v2 list bundles internal server error response has a 2xx status code
+func (o *V2ListBundlesInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this v2 list bundles internal server error response has a 3xx status code
+func (o *V2ListBundlesInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this v2 list bundles internal server error response has a 4xx status code
+func (o *V2ListBundlesInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this v2 list bundles internal server error response has a 5xx status code
+func (o *V2ListBundlesInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this v2 list bundles internal server error response a status code equal to that given
+func (o *V2ListBundlesInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+func (o *V2ListBundlesInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListBundlesInternalServerError) String() string {
+	return fmt.Sprintf("[GET /v2/operators/bundles][%d] v2ListBundlesInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *V2ListBundlesInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *V2ListBundlesInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
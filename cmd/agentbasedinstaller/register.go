@@ -329,10 +329,5 @@ func processNMStateConfig(log log.FieldLogger, infraEnv aiv1beta1.InfraEnv, nmSt
 		return nil, err
 	}
 
-	var staticNetworkConfig []*models.HostStaticNetworkConfig
-	staticNetworkConfig = append(staticNetworkConfig, &models.HostStaticNetworkConfig{
-		MacInterfaceMap: controllers.BuildMacInterfaceMap(log, nmStateConfig),
-		NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
-	})
-	return staticNetworkConfig, nil
+	return controllers.BuildHostStaticNetworkConfigs(log, nmStateConfig)
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/openshift/assisted-service/internal/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/feature"
+	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/garbagecollector"
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host"
@@ -38,6 +39,7 @@ import (
 	"github.com/openshift/assisted-service/internal/ignition"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/ipam"
 	internaljson "github.com/openshift/assisted-service/internal/json"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -46,6 +48,8 @@ import (
 	"github.com/openshift/assisted-service/internal/oc"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/handler"
+	"github.com/openshift/assisted-service/internal/operators/monitor"
+	"github.com/openshift/assisted-service/internal/provider/external"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/releasesources"
 	"github.com/openshift/assisted-service/internal/spec"
@@ -116,11 +120,16 @@ var Options struct {
 	GeneratorConfig                      generator.Config
 	InstructionConfig                    hostcommands.InstructionConfig
 	OperatorsConfig                      operators.Options
+	OperatorsHealthMonitorConfig         monitor.Config
+	PlatformPluginsConfig                external.PluginsConfig
 	GCConfig                             garbagecollector.Config
+	IPAMConfig                           ipam.Config
+	DNSConfig                            dns.Config
 	ReleaseSourcesConfig                 releasesources.Config
 	StaticNetworkConfig                  staticnetworkconfig.Config
 	IgnoredOpenshiftVersions             string        `envconfig:"IGNORED_OPENSHIFT_VERSIONS" default:""`
 	ClusterStateMonitorInterval          time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
+	InstallationSchedulerInterval        time.Duration `envconfig:"INSTALLATION_SCHEDULER_INTERVAL" default:"1m"`
 	ClusterEventsUploaderInterval        time.Duration `envconfig:"CLUSTER_EVENTS_UPLOADER_INTERVAL" default:"15m"`
 	S3Config                             s3wrapper.Config
 	HostStateMonitorInterval             time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
@@ -289,7 +298,12 @@ func main() {
 	Options.InstructionConfig.CheckClusterVersion = Options.CheckClusterVersion
 	Options.OperatorsConfig.CheckClusterVersion = Options.CheckClusterVersion
 	//Initialize Provider API
-	providerRegistry := registry.InitProviderRegistry(log.WithField("pkg", "provider"))
+	pluginProviders, pluginPlatforms, err := external.LoadPluginProviders(log.WithField("pkg", "provider"), Options.PlatformPluginsConfig)
+	failOnError(err, "failed to load the platform plugins")
+	for _, platform := range pluginPlatforms {
+		featuresupport.RegisterExternalPlatformPlugin(platform)
+	}
+	providerRegistry := registry.InitProviderRegistry(log.WithField("pkg", "provider"), pluginProviders...)
 	// Make sure that prepare for installation timeout is more than the timeouts of all underlying tools + 2m extra
 	Options.ClusterConfig.PrepareConfig.PrepareForInstallationTimeout = maxDuration(Options.ClusterConfig.PrepareConfig.PrepareForInstallationTimeout,
 		maxDuration(Options.InstructionConfig.DiskCheckTimeout, Options.InstructionConfig.ImageAvailabilityTimeout)+2*time.Minute)
@@ -399,7 +413,7 @@ func main() {
 	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, notificationStream, eventsHandler, hwValidator,
 		instructionApi, &Options.HWValidatorConfig, metricsManager, &Options.HostConfig, lead, operatorsManager, providerRegistry, Options.EnableKubeAPI, objectHandler, versionHandler,
 		Options.EnableSoftTimeouts)
-	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, log)
+	dnsApi := dns.NewDNSHandler(Options.BMConfig.BaseDNSDomains, Options.DNSConfig, log)
 	manifestsGenerator := network.NewManifestsGenerator(manifestsApi, Options.ManifestsGeneratorConfig, db)
 	clusterApi := cluster.NewManager(Options.ClusterConfig, log.WithField("pkg", "cluster-state"), db,
 		notificationStream, eventsHandler, uploadClient, hostApi, metricsManager, manifestsGenerator, lead, operatorsManager,
@@ -489,10 +503,22 @@ func main() {
 	serverInfo := servers.New(Options.HTTPListenPort, swag.StringValue(port), Options.HTTPSKeyFile, Options.HTTPSCertFile)
 	generateInsecureIPXEURLs := serverInfo.HTTP != nil
 
+	ipamApi, err := ipam.NewManager(log.WithField("pkg", "ipam"), db, Options.IPAMConfig)
+	failOnError(err, "failed to create the IP address management")
+
 	bm := bminventory.NewBareMetalInventory(db, notificationStream, log.WithField("pkg", "Inventory"), hostApi, clusterApi, infraEnvApi, Options.BMConfig,
 		generator, eventsHandler, objectHandler, metricsManager, usageManager, operatorsManager, authHandler, authzHandler, ocpClient, ocmClient,
 		lead, pullSecretValidator, versionHandler, osImages, crdUtils, ignitionBuilder, hwValidator, dnsApi, installConfigBuilder, staticNetworkConfig,
-		Options.GCConfig, providerRegistry, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker)
+		Options.GCConfig, providerRegistry, ipamApi, generateInsecureIPXEURLs, Options.GeneratorConfig.InstallInvoker)
+
+	installationScheduler := cluster.NewInstallationScheduler(log.WithField("pkg", "installation-scheduler"), db, eventsHandler, lead,
+		clusterApi, hostApi, objectHandler, bm)
+	installationSchedulerWorker := thread.New(
+		log.WithField("pkg", "installation-scheduler"), "Installation Scheduler", Options.InstallationSchedulerInterval,
+		installationScheduler.ScheduleInstallations)
+	installationSchedulerWorker.Start()
+	defer installationSchedulerWorker.Stop()
+
 	events := events.NewApi(eventsHandler, logrus.WithField("pkg", "eventsApi"))
 
 	//Set inner handler chain. Inner handlers requires access to the Route
@@ -511,6 +537,18 @@ func main() {
 	}
 
 	operatorsHandler := handler.NewHandler(operatorsManager, log.WithField("pkg", "operators"), db, eventsHandler, clusterApi)
+
+	// The operators of the installed clusters are monitored using the kubeconfig stored at the end of the installation
+	if Options.OperatorsHealthMonitorConfig.Enabled {
+		operatorsHealthMonitor := monitor.NewHealthMonitor(log.WithField("pkg", "operators-health-monitor"), db,
+			Options.OperatorsHealthMonitorConfig, lead, objectHandler, spoke_k8s_client.NewSpokeK8sClientFactory(log),
+			operatorsHandler, operatorsManager, eventsHandler, metricsManager)
+		operatorsHealthMonitorThread := thread.New(
+			log.WithField("pkg", "operators-health-monitor"), "Operators Health Monitor",
+			Options.OperatorsHealthMonitorConfig.Interval, operatorsHealthMonitor.MonitorOperators)
+		operatorsHealthMonitorThread.Start()
+		defer operatorsHealthMonitorThread.Stop()
+	}
 	h, api, err := restapi.HandlerAPI(restapi.Config{
 		AuthAgentAuth:       authHandler.AuthAgentAuth,
 		AuthUserAuth:        authHandler.AuthUserAuth,
@@ -11,6 +11,7 @@ import (
 	"net"
 	"net/http"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/go-version"
 	"github.com/kennygrant/sanitize"
 	configv1 "github.com/openshift/api/config/v1"
@@ -39,6 +41,7 @@ import (
 	"github.com/openshift/assisted-service/internal/imageservice"
 	"github.com/openshift/assisted-service/internal/infraenv"
 	installcfg "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/ipam"
 	"github.com/openshift/assisted-service/internal/isoeditor"
 	"github.com/openshift/assisted-service/internal/manifests"
 	"github.com/openshift/assisted-service/internal/metrics"
@@ -47,6 +50,7 @@ import (
 	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/provider/openstack"
 	"github.com/openshift/assisted-service/internal/provider/registry"
 	"github.com/openshift/assisted-service/internal/stream"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -66,6 +70,7 @@ import (
 	pkgvalidations "github.com/openshift/assisted-service/pkg/validations"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	"github.com/pkg/errors"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
 	"gorm.io/gorm"
@@ -118,9 +123,11 @@ type Config struct {
 	DefaultClusterNetworkHostPrefixIPv6 int64             `envconfig:"CLUSTER_NETWORK_HOST_PREFIX_IPV6" default:"64"`
 	DefaultServiceNetworkCidr           string            `envconfig:"SERVICE_NETWORK_CIDR" default:"172.30.0.0/16"`
 	DefaultServiceNetworkCidrIPv6       string            `envconfig:"SERVICE_NETWORK_CIDR_IPV6" default:"fd02::/112"`
+	ReservedNetworks                    string            `envconfig:"RESERVED_NETWORKS" default:""`
 	ISOImageType                        string            `envconfig:"ISO_IMAGE_TYPE" default:"full-iso"`
 	IPv6Support                         bool              `envconfig:"IPV6_SUPPORT" default:"true"`
 	DiskEncryptionSupport               bool              `envconfig:"DISK_ENCRYPTION_SUPPORT" default:"true"`
+	MaxClusterBatchSites                int               `envconfig:"MAX_CLUSTER_BATCH_SITES" default:"100"`
 
 	// InfraEnv ID for the ephemeral installer. Should not be set explicitly.Ephemeral (agent) installer sets this env var
 	InfraEnvID strfmt.UUID `envconfig:"INFRA_ENV_ID" default:""`
@@ -171,6 +178,7 @@ type InstallerInternals interface {
 	RegisterInfraEnvInternal(ctx context.Context, kubeKey *types.NamespacedName, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration, params installer.RegisterInfraEnvParams) (*common.InfraEnv, error)
 	DeregisterInfraEnvInternal(ctx context.Context, params installer.DeregisterInfraEnvParams) error
 	UnbindHostInternal(ctx context.Context, params installer.UnbindHostParams, reclaimHost bool, interactivity Interactivity) (*common.Host, error)
+	DecommissionHostInternal(ctx context.Context, params installer.UnbindHostParams) (*common.Host, error)
 	BindHostInternal(ctx context.Context, params installer.BindHostParams) (*common.Host, error)
 	GetInfraEnvHostsInternal(ctx context.Context, infraEnvId strfmt.UUID) ([]*common.Host, error)
 	GetKnownHostApprovedCounts(clusterID strfmt.UUID) (registered, approved int, err error)
@@ -216,6 +224,7 @@ type bareMetalInventory struct {
 	staticNetworkConfig  staticnetworkconfig.StaticNetworkConfig
 	gcConfig             garbagecollector.Config
 	providerRegistry     registry.ProviderRegistry
+	ipamApi              ipam.API
 	insecureIPXEURLs     bool
 	installerInvoker     string
 }
@@ -250,6 +259,7 @@ func NewBareMetalInventory(
 	staticNetworkConfig staticnetworkconfig.StaticNetworkConfig,
 	gcConfig garbagecollector.Config,
 	providerRegistry registry.ProviderRegistry,
+	ipamApi ipam.API,
 	insecureIPXEURLs bool,
 	installerInvoker string,
 ) *bareMetalInventory {
@@ -283,6 +293,7 @@ func NewBareMetalInventory(
 		staticNetworkConfig:  staticNetworkConfig,
 		gcConfig:             gcConfig,
 		providerRegistry:     providerRegistry,
+		ipamApi:              ipamApi,
 		insecureIPXEURLs:     insecureIPXEURLs,
 		installerInvoker:     installerInvoker,
 	}
@@ -401,6 +412,22 @@ func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *insta
 		return common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = clusterPkg.ValidateMaintenanceWindow(params.NewClusterParams.MaintenanceWindow); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = clusterPkg.ValidateBaremetalProvisioning(params.NewClusterParams.BaremetalProvisioning); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = dns.ValidateDNSRecordsProvisioning(params.NewClusterParams.DNSRecordsProvisioning); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = validateOpenstackCloudsYAML(params.NewClusterParams.OpenstackCloudsYaml); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	if swag.StringValue(params.NewClusterParams.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
 		// verify minimal OCP version
 		err = verifyMinimalOpenShiftVersionForSingleNode(swag.StringValue(params.NewClusterParams.OpenshiftVersion))
@@ -458,9 +485,18 @@ func (b *bareMetalInventory) validateRegisterClusterInternalParams(params *insta
 }
 
 func (b *bareMetalInventory) getOLMMonitoredOperators(log *logrus.Entry, cluster *common.Cluster, params installer.V2RegisterClusterParams, releaseImageVersion string) ([]*models.MonitoredOperator, error) {
-	if params.NewClusterParams.OlmOperators != nil {
+	olmOperators := params.NewClusterParams.OlmOperators
+	if len(params.NewClusterParams.OlmBundles) > 0 {
+		var err error
+		olmOperators, err = b.operatorManagerApi.ExpandBundles(cluster, params.NewClusterParams.OlmBundles, olmOperators)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to expand the bundles %s", strings.Join(params.NewClusterParams.OlmBundles, ", "))
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+	}
+	if olmOperators != nil {
 		var newOLMOperators []*models.MonitoredOperator
-		newOLMOperators, err := b.getOLMOperators(cluster, params.NewClusterParams.OlmOperators, log)
+		newOLMOperators, err := b.getOLMOperators(cluster, olmOperators, log)
 		if err != nil {
 			return nil, err
 		}
@@ -546,9 +582,17 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 				errWrapperLog = log.WithError(err)
 			}
 			errWrapperLog.Errorf(errStr)
+			if params.NewClusterParams.IpamPool != "" && b.ipamApi.Enabled() {
+				if releaseErr := b.ipamApi.Release(ctx, id); releaseErr != nil {
+					log.WithError(releaseErr).Warnf("failed to release the IPAM addresses of cluster %s", id)
+				}
+			}
 		}
 	}()
 
+	if err = b.reserveClusterVips(ctx, id, params.NewClusterParams); err != nil {
+		return nil, err
+	}
 	if err = validations.ValidateClusterCreateIPAddresses(b.IPv6Support, id, params.NewClusterParams); err != nil {
 		b.log.WithError(err).Error("Cannot register cluster. Failed VIP validations")
 		return nil, common.NewApiError(http.StatusBadRequest, err)
@@ -645,7 +689,14 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 			IgnitionEndpoint:             params.NewClusterParams.IgnitionEndpoint,
 			Tags:                         swag.StringValue(params.NewClusterParams.Tags),
 			OrgSoftTimeoutsEnabled:       orgSoftTimeoutsEnabled,
+			ScheduledInstallTime:         params.NewClusterParams.ScheduledInstallTime,
+			MaintenanceWindow:            params.NewClusterParams.MaintenanceWindow,
+			BaremetalProvisioning:        params.NewClusterParams.BaremetalProvisioning,
+			DNSRecordsProvisioning:       params.NewClusterParams.DNSRecordsProvisioning,
+			OpenstackCloudsYamlSet:       swag.StringValue(params.NewClusterParams.OpenstackCloudsYaml) != "",
+			IpamPool:                     params.NewClusterParams.IpamPool,
 		},
+		OpenstackCloudsYAML:         swag.StringValue(params.NewClusterParams.OpenstackCloudsYaml),
 		KubeKeyName:                 kubeKey.Name,
 		KubeKeyNamespace:            kubeKey.Namespace,
 		TriggerMonitorTimestamp:     time.Now(),
@@ -713,10 +764,106 @@ func (b *bareMetalInventory) RegisterClusterInternal(ctx context.Context, kubeKe
 
 	success = true
 	b.metricApi.ClusterRegistered()
+	b.updateDNSRecords(ctx, nil, cluster)
 	cluster, err = b.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: *cluster.ID})
 	return cluster, err
 }
 
+// reserveClusterVips reserves the API and the ingress VIPs of the cluster in the IPAM pool of the cluster, if any
+func (b *bareMetalInventory) reserveClusterVips(ctx context.Context, id strfmt.UUID, params *models.ClusterCreateParams) error {
+	if params.IpamPool == "" {
+		return nil
+	}
+	if !b.ipamApi.Enabled() {
+		return common.NewApiError(http.StatusBadRequest, ipam.ErrDisabled)
+	}
+	if len(params.APIVips) > 0 || len(params.IngressVips) > 0 {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.New("the API and the ingress VIPs are reserved in the IPAM pool, they can't be set as well"))
+	}
+	if swag.BoolValue(params.VipDhcpAllocation) || swag.BoolValue(params.UserManagedNetworking) ||
+		swag.StringValue(params.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
+		return nil
+	}
+	apiVip, err := b.ipamApi.Reserve(ctx, id, params.IpamPool, ipam.PurposeAPIVip)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	ingressVip, err := b.ipamApi.Reserve(ctx, id, params.IpamPool, ipam.PurposeIngressVip)
+	if err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	params.APIVips = []*models.APIVip{{ClusterID: id, IP: models.IP(apiVip.Address)}}
+	params.IngressVips = []*models.IngressVip{{ClusterID: id, IP: models.IP(ingressVip.Address)}}
+	return nil
+}
+
+// reserveUpdatedClusterVips reserves new VIPs in the IPAM pool of the cluster when the update clears them and the
+// cluster still needs them
+func (b *bareMetalInventory) reserveUpdatedClusterVips(ctx context.Context, cluster *common.Cluster, params *models.V2ClusterUpdateParams) error {
+	if cluster.IpamPool == "" {
+		return nil
+	}
+	apiVipsCleared := params.APIVips != nil && len(params.APIVips) == 0
+	ingressVipsCleared := params.IngressVips != nil && len(params.IngressVips) == 0
+	if !apiVipsCleared && !ingressVipsCleared {
+		return nil
+	}
+	vipDhcpAllocation := swag.BoolValue(cluster.VipDhcpAllocation)
+	if params.VipDhcpAllocation != nil {
+		vipDhcpAllocation = *params.VipDhcpAllocation
+	}
+	userManagedNetworking := swag.BoolValue(cluster.UserManagedNetworking)
+	if params.UserManagedNetworking != nil {
+		userManagedNetworking = *params.UserManagedNetworking
+	}
+	if vipDhcpAllocation || userManagedNetworking || swag.StringValue(cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
+		return nil
+	}
+	if !b.ipamApi.Enabled() {
+		return common.NewApiError(http.StatusBadRequest, ipam.ErrDisabled)
+	}
+	if apiVipsCleared {
+		apiVip, err := b.ipamApi.Reserve(ctx, *cluster.ID, cluster.IpamPool, ipam.PurposeAPIVip)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		params.APIVips = []*models.APIVip{{ClusterID: *cluster.ID, IP: models.IP(apiVip.Address)}}
+	}
+	if ingressVipsCleared {
+		ingressVip, err := b.ipamApi.Reserve(ctx, *cluster.ID, cluster.IpamPool, ipam.PurposeIngressVip)
+		if err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+		params.IngressVips = []*models.IngressVip{{ClusterID: *cluster.ID, IP: models.IP(ingressVip.Address)}}
+	}
+	return nil
+}
+
+// releaseUnusedClusterVips releases the addresses that were reserved in the IPAM pool of the cluster and that aren't
+// its VIPs anymore
+func (b *bareMetalInventory) releaseUnusedClusterVips(ctx context.Context, clusterID strfmt.UUID) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := common.GetClusterFromDBWithVips(b.db, clusterID)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get cluster %s to release its unused IPAM addresses", clusterID)
+		return
+	}
+	if cluster.IpamPool == "" {
+		return
+	}
+	var used []string
+	for _, vip := range cluster.APIVips {
+		used = append(used, string(vip.IP))
+	}
+	for _, vip := range cluster.IngressVips {
+		used = append(used, string(vip.IP))
+	}
+	if err = b.ipamApi.ReleaseUnused(ctx, clusterID, used); err != nil {
+		log.WithError(err).Warnf("failed to release the unused IPAM addresses of cluster %s", clusterID)
+	}
+}
+
 // Validates support level features incompatibilities and validates all active features implementing
 // SupportLevelFeatureValidator interface. This function can be used to validate both cluster creation
 // and cluster update as it accepts parameters of type *models.V2ClusterUpdateParams
@@ -850,6 +997,16 @@ func verifyMinimalOpenShiftVersionForSingleNode(requestedOpenshiftVersion string
 	return nil
 }
 
+// validateOpenstackCloudsYAML verifies that the OpenStack clouds.yaml can be used to validate the credentials and to
+// generate the manifests, an empty clouds.yaml removes the credentials
+func validateOpenstackCloudsYAML(cloudsYAML *string) error {
+	if swag.StringValue(cloudsYAML) == "" {
+		return nil
+	}
+	_, err := openstack.ParseCloudsYAML(*cloudsYAML)
+	return err
+}
+
 func verifyMinimalOpenShiftVersionForNutanix(requestedOpenshiftVersion string) error {
 	ocpVersion, err := version.NewVersion(requestedOpenshiftVersion)
 	if err != nil {
@@ -984,6 +1141,7 @@ func (b *bareMetalInventory) V2ImportClusterInternal(ctx context.Context, kubeKe
 		Kind:               swag.String(models.ClusterKindAddHostsCluster),
 		Name:               clusterName,
 		OpenshiftClusterID: common.StrFmtUUIDVal(params.NewImportClusterParams.OpenshiftClusterID),
+		OpenshiftVersion:   params.NewImportClusterParams.OpenshiftVersion,
 		UserName:           ocm.UserNameFromContext(ctx),
 		OrgID:              ocm.OrgIDFromContext(ctx),
 		EmailDomain:        ocm.EmailDomainFromContext(ctx),
@@ -1071,10 +1229,17 @@ func (b *bareMetalInventory) DeregisterClusterInternal(ctx context.Context, clus
 		log.WithError(err).Errorf("failed to deregister cluster %s", cluster.ID)
 		return common.NewApiError(http.StatusNotFound, err)
 	}
+	if cluster.IpamPool != "" {
+		if err := b.ipamApi.Release(ctx, *cluster.ID); err != nil {
+			log.WithError(err).Warnf("failed to release the IPAM addresses of cluster %s", cluster.ID)
+		}
+	}
 	return nil
 }
 
-func (b *bareMetalInventory) deleteOrUnbindHosts(ctx context.Context, cluster *common.Cluster) error {
+// deleteOrUnbindHosts deletes the hosts of the infra-envs bound to the cluster and unbinds the others. When
+// decommissionHosts is set, the unbound hosts are reclaimed and their disks are wiped.
+func (b *bareMetalInventory) deleteOrUnbindHosts(ctx context.Context, cluster *common.Cluster, decommissionHosts bool) error {
 	log := logutil.FromContext(ctx, b.log)
 	for _, h := range cluster.Hosts {
 		infraEnv, err := common.GetInfraEnvFromDB(b.db, h.InfraEnvID)
@@ -1090,7 +1255,7 @@ func (b *bareMetalInventory) deleteOrUnbindHosts(ctx context.Context, cluster *c
 			eventgen.SendHostDeregisteredEvent(ctx, b.eventsHandler, *h.ID, h.InfraEnvID, cluster.ID,
 				hostutil.GetHostnameForMsg(h))
 		} else if h.ClusterID != nil {
-			if err = b.hostApi.UnbindHost(ctx, h, b.db, false); err != nil {
+			if err = b.unbindHostInTransaction(ctx, h, decommissionHosts, decommissionHosts); err != nil {
 				log.WithError(err).Errorf("Failed to unbind host <%s>", h.ID.String())
 				return err
 			}
@@ -1303,6 +1468,11 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		return nil, common.NewApiError(http.StatusNotFound, err)
 	}
 
+	if allowed, reason := clusterPkg.IsInstallationAllowed(cluster, time.Now()); !allowed {
+		return nil, common.NewApiError(http.StatusConflict,
+			errors.Errorf("Cluster installation can't be started now, %s", reason))
+	}
+
 	var autoAssigned bool
 
 	// auto select hosts roles if not selected yet.
@@ -1373,6 +1543,15 @@ func (b *bareMetalInventory) InstallClusterInternal(ctx context.Context, params
 		if err = b.setBootstrapHost(ctx, *cluster, tx); err != nil {
 			return err
 		}
+
+		// The scheduled installation time is used once, so that a reset cluster is not installed again by the
+		// scheduler. The time of clusters managed by the kube API is kept in sync with the AgentClusterInstall.
+		if cluster.ScheduledInstallTime != nil && cluster.KubeKeyName == "" {
+			if err = tx.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).
+				Update("scheduled_install_time", nil).Error; err != nil {
+				return errors.Wrapf(err, "failed to clear scheduled installation time of cluster %s", cluster.ID.String())
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -1462,6 +1641,10 @@ func (b *bareMetalInventory) InstallSingleDay2HostInternal(ctx context.Context,
 		return err
 	}
 
+	if err = b.verifyDay2ControlPlaneCanJoin(&h.Host); err != nil {
+		return err
+	}
+
 	err = b.db.Transaction(func(tx *gorm.DB) error {
 		// in case host monitor already updated the state we need to use FOR UPDATE option
 		if cluster, err = common.GetClusterFromDBForUpdate(tx, clusterId, common.UseEagerLoading); err != nil {
@@ -1537,6 +1720,9 @@ func (b *bareMetalInventory) V2InstallHost(ctx context.Context, params installer
 	if swag.StringValue(h.Status) != models.HostStatusKnown {
 		return common.NewApiError(http.StatusConflict, fmt.Errorf("cannot install host in state %s after refresh", swag.StringValue(h.Status)))
 	}
+	if err = b.verifyDay2ControlPlaneCanJoin(h); err != nil {
+		return common.GenerateErrorResponder(err)
+	}
 	if cluster, err = common.GetClusterFromDB(b.db, *h.ClusterID, common.SkipEagerLoading); err != nil {
 		return common.GenerateErrorResponder(err)
 	}
@@ -1752,6 +1938,20 @@ func (b *bareMetalInventory) setInstallConfigOverridesUsage(featureUsages string
 	return nil
 }
 
+// loadHostBMCPasswords loads the BMC passwords of the hosts of the cluster, which are only added to the install config
+// that is used for the installation
+func (b *bareMetalInventory) loadHostBMCPasswords(cluster *common.Cluster) error {
+	var hosts []*common.Host
+	if err := b.db.Select("id", "bmc_password").Where("cluster_id = ? and bmc_password_set = ?", cluster.ID.String(), true).Find(&hosts).Error; err != nil {
+		return err
+	}
+	cluster.HostBMCPasswords = make(map[strfmt.UUID]string, len(hosts))
+	for _, h := range hosts {
+		cluster.HostBMCPasswords[*h.ID] = h.BMCPassword
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster common.Cluster, clusterInfraenvs []*common.InfraEnv) error {
 	log := logutil.FromContext(ctx, b.log)
 
@@ -1760,6 +1960,11 @@ func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, c
 		rhRootCa = ""
 	}
 
+	if err := b.loadHostBMCPasswords(&cluster); err != nil {
+		log.WithError(err).Errorf("failed to get the BMC passwords of the hosts of cluster %s", cluster.ID)
+		return errors.Wrapf(err, "failed to get the BMC passwords of the hosts of cluster %s", cluster.ID)
+	}
+
 	cfg, err := b.installConfigBuilder.GetInstallConfig(&cluster, clusterInfraenvs, rhRootCa)
 	if err != nil {
 		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
@@ -1924,9 +2129,14 @@ func (b *bareMetalInventory) validateAndUpdateClusterParams(ctx context.Context,
 		return installer.V2UpdateClusterParams{}, err
 	}
 
-	// We don't want to update ControlPlaneCount in day2 clusters as we don't know the previous hosts
-	if params.ClusterUpdateParams.ControlPlaneCount != nil && swag.StringValue(cluster.Kind) != models.ClusterKindAddHostsCluster {
-		if err := validateHighAvailabilityWithControlPlaneCount(
+	if params.ClusterUpdateParams.ControlPlaneCount != nil {
+		// In day2 clusters ControlPlaneCount is the target size of the control plane being expanded,
+		// as we don't know the previous hosts
+		if swag.StringValue(cluster.Kind) == models.ClusterKindAddHostsCluster {
+			if err := validateDay2ControlPlaneExpansion(cluster, swag.Int64Value(params.ClusterUpdateParams.ControlPlaneCount)); err != nil {
+				return installer.V2UpdateClusterParams{}, err
+			}
+		} else if err := validateHighAvailabilityWithControlPlaneCount(
 			swag.StringValue(cluster.HighAvailabilityMode),
 			swag.Int64Value(params.ClusterUpdateParams.ControlPlaneCount),
 			cluster.OpenshiftVersion,
@@ -1984,6 +2194,126 @@ func validateHighAvailabilityWithControlPlaneCount(highAvailabilityMode string,
 	return nil
 }
 
+// validateDay2ControlPlaneExpansion verifies that the control plane of an installed cluster can be grown to
+// the requested number of nodes. Shrinking the control plane is not supported.
+func validateDay2ControlPlaneExpansion(cluster *common.Cluster, controlPlaneCount int64) error {
+	if controlPlaneCount == cluster.ControlPlaneCount {
+		return nil
+	}
+
+	if swag.StringValue(cluster.HighAvailabilityMode) == models.ClusterHighAvailabilityModeNone {
+		return common.NewApiError(
+			http.StatusBadRequest,
+			errors.New("the control plane of single-node clusters can not be expanded"),
+		)
+	}
+
+	if controlPlaneCount < cluster.ControlPlaneCount {
+		return common.NewApiError(
+			http.StatusBadRequest,
+			fmt.Errorf("the control plane can not be shrunk from %d to %d nodes", cluster.ControlPlaneCount, controlPlaneCount),
+		)
+	}
+
+	if controlPlaneCount < common.MinMasterHostsNeededForInstallationInHaMode ||
+		controlPlaneCount > common.MaxMasterHostsNeededForInstallationInHaModeOfOCP418OrNewer {
+		return common.NewApiError(
+			http.StatusBadRequest,
+			fmt.Errorf(
+				"the control plane can only be expanded to %d-%d nodes",
+				common.MinMasterHostsNeededForInstallationInHaMode,
+				common.MaxMasterHostsNeededForInstallationInHaModeOfOCP418OrNewer,
+			),
+		)
+	}
+
+	if controlPlaneCount == common.MinMasterHostsNeededForInstallationInHaMode {
+		return nil
+	}
+
+	if cluster.OpenshiftVersion == "" {
+		return common.NewApiError(
+			http.StatusBadRequest,
+			fmt.Errorf(
+				"the openshift version of the cluster must be known in order to expand the control plane beyond %d nodes",
+				common.MinMasterHostsNeededForInstallationInHaMode,
+			),
+		)
+	}
+
+	cpuArchitecture := cluster.CPUArchitecture
+	if cpuArchitecture == "" {
+		cpuArchitecture = common.DefaultCPUArchitecture
+	}
+	var platformType *models.PlatformType
+	if cluster.Platform != nil {
+		platformType = cluster.Platform.Type
+	}
+	supportLevel := featuresupport.GetSupportLevel(models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE, featuresupport.SupportLevelFilters{
+		OpenshiftVersion: cluster.OpenshiftVersion,
+		CPUArchitecture:  swag.String(cpuArchitecture),
+		PlatformType:     platformType,
+	})
+	if supportLevel == models.SupportLevelUnavailable ||
+		!featuresupport.IsFeatureCompatibleWithArchitecture(models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE, cluster.OpenshiftVersion, cpuArchitecture) {
+		return common.NewApiError(
+			http.StatusBadRequest,
+			fmt.Errorf(
+				"expanding the control plane to %d nodes is not supported for openshift version %s with %s CPU architecture on %s platform",
+				controlPlaneCount,
+				cluster.OpenshiftVersion,
+				cpuArchitecture,
+				getPlatformType(cluster.Platform),
+			),
+		)
+	}
+
+	return nil
+}
+
+// verifyDay2ControlPlaneCanJoin makes sure that no other control plane host of the same cluster is still joining
+// etcd, as adding several etcd members at the same time may break the etcd quorum, and that the control plane hosts
+// of the cluster don't exceed its control plane count. Only the hosts known to the service are counted, the kube-api
+// also verifies the control plane nodes of the spoke cluster before approving a new one.
+func (b *bareMetalInventory) verifyDay2ControlPlaneCanJoin(h *models.Host) error {
+	if !hostutil.IsDay2Host(h) || common.GetEffectiveRole(h) != models.HostRoleMaster {
+		return nil
+	}
+
+	cluster, err := common.GetClusterFromDB(b.db, *h.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var hosts []*models.Host
+	if err = b.db.Where("cluster_id = ? and id != ? and (role = ? or (role = ? and suggested_role = ?))",
+		h.ClusterID.String(), h.ID.String(), models.HostRoleMaster, models.HostRoleAutoAssign, models.HostRoleMaster).
+		Find(&hosts).Error; err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	controlPlaneHosts := 1
+	for _, other := range hosts {
+		if hostutil.IsDay2ControlPlaneHostJoining(other) {
+			return common.NewApiError(
+				http.StatusConflict,
+				fmt.Errorf("control plane host %s can not be installed while control plane host %s is joining the cluster",
+					hostutil.GetHostnameForMsg(h), hostutil.GetHostnameForMsg(other)),
+			)
+		}
+		if funk.ContainsString([]string{models.HostStatusInstalled, models.HostStatusAddedToExistingCluster}, swag.StringValue(other.Status)) {
+			controlPlaneHosts++
+		}
+	}
+	if target := common.GetDay2ControlPlaneTarget(cluster); controlPlaneHosts > target {
+		return common.NewApiError(
+			http.StatusConflict,
+			fmt.Errorf("control plane host %s can not be installed since the control plane of the cluster already has its %d nodes",
+				hostutil.GetHostnameForMsg(h), target),
+		)
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) V2UpdateCluster(ctx context.Context, params installer.V2UpdateClusterParams) middleware.Responder {
 	c, err := b.v2UpdateClusterInternal(ctx, params, Interactive, nil)
 	if err != nil {
@@ -2048,6 +2378,22 @@ func (b *bareMetalInventory) validateUpdateCluster(
 		return params, common.NewApiError(http.StatusBadRequest, err)
 	}
 
+	if err = clusterPkg.ValidateMaintenanceWindow(params.ClusterUpdateParams.MaintenanceWindow); err != nil {
+		return params, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = clusterPkg.ValidateBaremetalProvisioning(params.ClusterUpdateParams.BaremetalProvisioning); err != nil {
+		return params, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = dns.ValidateDNSRecordsProvisioning(params.ClusterUpdateParams.DNSRecordsProvisioning); err != nil {
+		return params, common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	if err = validateOpenstackCloudsYAML(params.ClusterUpdateParams.OpenstackCloudsYaml); err != nil {
+		return params, common.NewApiError(http.StatusBadRequest, err)
+	}
+
 	if err = validations.ValidateHighAvailabilityModeWithPlatform(cluster.HighAvailabilityMode, params.ClusterUpdateParams.Platform); err != nil {
 		return params, common.NewApiError(http.StatusBadRequest, err)
 	}
@@ -2084,15 +2430,27 @@ func (b *bareMetalInventory) setUpdatedPlatformParams(log logrus.FieldLogger, cl
 func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params installer.V2UpdateClusterParams, interactivity Interactivity, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) (*common.Cluster, error) {
 	log := logutil.FromContext(ctx, b.log)
 	var cluster *common.Cluster
+	var previousCluster common.Cluster
 	var err error
 	log.Infof("update cluster %s with params: %+v", params.ClusterID, params.ClusterUpdateParams)
 
+	if params.ClusterUpdateParams.APIVips != nil || params.ClusterUpdateParams.IngressVips != nil ||
+		params.ClusterUpdateParams.VipDhcpAllocation != nil || params.ClusterUpdateParams.UserManagedNetworking != nil {
+		// The VIPs that were reserved in the IPAM pool and aren't used after the update are released
+		defer b.releaseUnusedClusterVips(ctx, params.ClusterID)
+	}
+
 	err = b.db.Transaction(func(tx *gorm.DB) error {
 		// in case host monitor already updated the state we need to use FOR UPDATE option
 		if cluster, err = common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.UseEagerLoading); err != nil {
 			log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
 			return common.NewApiError(http.StatusNotFound, err)
 		}
+		previousCluster = *cluster
+
+		if err = b.reserveUpdatedClusterVips(ctx, cluster, params.ClusterUpdateParams); err != nil {
+			return err
+		}
 
 		params, err = b.validateUpdateCluster(ctx, log, cluster, params)
 		if err != nil {
@@ -2154,6 +2512,10 @@ func (b *bareMetalInventory) v2UpdateClusterInternal(ctx context.Context, params
 		return nil, err
 	}
 
+	if params.ClusterUpdateParams.DNSRecordsProvisioning != nil || dnsRecordsChanged(&previousCluster, cluster) {
+		b.updateDNSRecords(ctx, &previousCluster, cluster)
+	}
+
 	if cluster != nil {
 		notifiableCluster := stream.GetNotifiableCluster(cluster)
 		err = b.stream.Notify(ctx, notifiableCluster)
@@ -2414,6 +2776,53 @@ func (b *bareMetalInventory) updateClusterData(_ context.Context, cluster *commo
 		b.setDiskEncryptionUsage(&cluster.Cluster, params.ClusterUpdateParams.DiskEncryption, usages)
 	}
 
+	if params.ClusterUpdateParams.ScheduledInstallTime != nil {
+		// The zero time removes the scheduled installation time
+		if time.Time(*params.ClusterUpdateParams.ScheduledInstallTime).IsZero() {
+			updates["scheduled_install_time"] = nil
+		} else {
+			updates["scheduled_install_time"] = params.ClusterUpdateParams.ScheduledInstallTime
+		}
+	}
+
+	if params.ClusterUpdateParams.MaintenanceWindow != nil {
+		// An empty start time removes the maintenance window
+		window := params.ClusterUpdateParams.MaintenanceWindow
+		updates["maintenance_window_start_time"] = window.StartTime
+		if window.StartTime == "" {
+			updates["maintenance_window_duration_minutes"] = nil
+			updates["maintenance_window_days"] = ""
+			updates["maintenance_window_time_zone"] = nil
+		} else {
+			updates["maintenance_window_duration_minutes"] = window.DurationMinutes
+			updates["maintenance_window_days"] = window.Days
+			updates["maintenance_window_time_zone"] = window.TimeZone
+		}
+	}
+
+	if params.ClusterUpdateParams.DNSRecordsProvisioning != nil {
+		provisioning := params.ClusterUpdateParams.DNSRecordsProvisioning
+		updates["dns_records_provisioning_enabled"] = provisioning.Enabled
+		updates["dns_records_provisioning_api_ip"] = provisioning.APIIP
+		updates["dns_records_provisioning_ingress_ip"] = provisioning.IngressIP
+	}
+
+	if params.ClusterUpdateParams.BaremetalProvisioning != nil {
+		// An empty provisioning network mode removes the provisioning network configuration
+		provisioning := params.ClusterUpdateParams.BaremetalProvisioning
+		updates["baremetal_provisioning_provisioning_network"] = provisioning.ProvisioningNetwork
+		updates["baremetal_provisioning_provisioning_network_cidr"] = provisioning.ProvisioningNetworkCidr
+		updates["baremetal_provisioning_provisioning_network_interface"] = provisioning.ProvisioningNetworkInterface
+		updates["baremetal_provisioning_cluster_provisioning_ip"] = provisioning.ClusterProvisioningIP
+		updates["baremetal_provisioning_bootstrap_provisioning_ip"] = provisioning.BootstrapProvisioningIP
+		updates["baremetal_provisioning_provisioning_dhcp_range"] = provisioning.ProvisioningDhcpRange
+	}
+
+	if params.ClusterUpdateParams.OpenstackCloudsYaml != nil {
+		updates["openstack_clouds_yaml"] = *params.ClusterUpdateParams.OpenstackCloudsYaml
+		updates["openstack_clouds_yaml_set"] = *params.ClusterUpdateParams.OpenstackCloudsYaml != ""
+	}
+
 	if params.ClusterUpdateParams.IgnitionEndpoint != nil {
 		if params.ClusterUpdateParams.IgnitionEndpoint.URL != nil {
 			optionalParam(params.ClusterUpdateParams.IgnitionEndpoint.URL, "ignition_endpoint_url", updates)
@@ -3392,6 +3801,125 @@ func (b *bareMetalInventory) handleReplyError(params installer.V2PostStepReplyPa
 	case models.StepTypeDownloadBootArtifacts:
 		log.Errorf("Failed to download boot artifacts to reclaim host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
 		return b.hostApi.HandleReclaimFailure(ctx, h)
+
+	case models.StepTypeDiskErase:
+		log.Errorf("Failed to erase disks of host %s, output: %s, error: %s", h.ID, params.Reply.Output, params.Reply.Error)
+		return b.processDiskEraseFailure(ctx, h, params.Reply.Error)
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) processDiskEraseResponse(ctx context.Context, host *models.Host, responseStr string) error {
+	var response models.DiskEraseResponse
+
+	log := logutil.FromContext(ctx, b.log)
+
+	if err := json.Unmarshal([]byte(responseStr), &response); err != nil {
+		log.WithError(err).Warnf("Json unmarshal %s disk erase response from host %s", responseStr, host.ID.String())
+		return err
+	}
+	return b.storeDiskErasureReport(ctx, host, response.Results)
+}
+
+// processDiskEraseFailure records the failure of the disk erase step, so that a report is produced for every
+// erasure request, even when the agent could not erase any of the disks
+func (b *bareMetalInventory) processDiskEraseFailure(ctx context.Context, host *models.Host, reason string) error {
+	results := make([]*models.DiskEraseResult, 0)
+	for _, diskID := range common.GetDisksToEraseIdentifiers(host) {
+		results = append(results, &models.DiskEraseResult{
+			DiskID: diskID,
+			Status: models.DiskEraseStatusFailed,
+			Error:  reason,
+		})
+	}
+	return b.storeDiskErasureReport(ctx, host, results)
+}
+
+// storeDiskErasureReport builds a disk erasure report for the disks pending erasure on the host, signs it and
+// stores it in the object storage. Disks for which the agent didn't report a result are reported as failed.
+func (b *bareMetalInventory) storeDiskErasureReport(ctx context.Context, host *models.Host, results []*models.DiskEraseResult) error {
+	log := logutil.FromContext(ctx, b.log)
+
+	diskIDs := common.GetDisksToEraseIdentifiers(host)
+	if len(diskIDs) == 0 {
+		log.Warnf("Received a disk erase result from host %s that has no disks pending erasure", host.ID.String())
+		return nil
+	}
+
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		log.WithError(err).Warnf("Failed to unmarshal inventory of host %s, disk erasure report will not include serial numbers", host.ID.String())
+		inventory = &models.Inventory{}
+	}
+
+	resultsByDiskID := make(map[string]*models.DiskEraseResult)
+	for _, result := range results {
+		resultsByDiskID[result.DiskID] = result
+	}
+
+	report := models.DiskErasureReport{
+		ID:         strfmt.UUID(uuid.New().String()),
+		HostID:     *host.ID,
+		InfraEnvID: host.InfraEnvID,
+		CreatedAt:  strfmt.DateTime(time.Now()),
+		Disks:      make([]*models.DiskEraseResult, 0, len(diskIDs)),
+	}
+	if inventory.SystemVendor != nil {
+		report.HostSerial = inventory.SystemVendor.SerialNumber
+	}
+
+	failed := false
+	for _, diskID := range diskIDs {
+		result, ok := resultsByDiskID[diskID]
+		if !ok {
+			result = &models.DiskEraseResult{
+				DiskID: diskID,
+				Status: models.DiskEraseStatusFailed,
+				Error:  "no erasure result was reported for the disk",
+			}
+		}
+		if disk := common.GetDiskByIdentifier(inventory, diskID); disk != nil {
+			result.Serial = disk.Serial
+			if result.Path == "" {
+				result.Path = disk.Path
+			}
+		}
+		if result.Status != models.DiskEraseStatusSucceeded {
+			failed = true
+		}
+		report.Disks = append(report.Disks, result)
+	}
+
+	payload, err := json.Marshal(&report)
+	if err != nil {
+		return err
+	}
+	report.Signature, err = gencrypto.DigestJWT(payload)
+	if err != nil {
+		// The erasure stays pending so that it is done again, with a signed report, once the signing key is fixed
+		log.WithError(err).Errorf("Failed to sign disk erasure report %s of host %s", report.ID, host.ID.String())
+		return errors.Wrapf(err, "failed to sign disk erasure report %s of host %s", report.ID, host.ID.String())
+	}
+	data, err := json.Marshal(&report)
+	if err != nil {
+		return err
+	}
+	objectName := fmt.Sprintf("%s%s.json", diskErasureReportsPrefix(host), report.ID)
+	if err = b.objectHandler.Upload(ctx, data, objectName); err != nil {
+		log.WithError(err).Errorf("Failed to upload disk erasure report %s of host %s", report.ID, host.ID.String())
+		return err
+	}
+
+	if err = b.db.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", host.ID.String(), host.InfraEnvID.String()).
+		Updates(map[string]interface{}{"disks_to_erase": "", "disk_erase_started_at": nil}).Error; err != nil {
+		log.WithError(err).Errorf("Failed to clear disks to erase of host %s", host.ID.String())
+		return err
+	}
+
+	if failed {
+		eventgen.SendHostDiskErasureFailedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, hostutil.GetHostnameForMsg(host), host.DisksToErase, report.ID.String())
+	} else {
+		eventgen.SendHostDiskErasureSucceededEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, hostutil.GetHostnameForMsg(host), host.DisksToErase, report.ID.String())
 	}
 	return nil
 }
@@ -3635,6 +4163,10 @@ func handleReplyByType(params installer.V2PostStepReplyParams, b *bareMetalInven
 		err = b.hostApi.HandleReclaimBootArtifactDownload(ctx, &host)
 	case models.StepTypeVerifyVips:
 		err = b.HandleVerifyVipsResponse(ctx, &host, stepReply)
+	case models.StepTypeDiskErase:
+		err = b.processDiskEraseResponse(ctx, &host, stepReply)
+	case models.StepTypeLoadBalancerCheck:
+		err = b.hostApi.UpdateLoadBalancerConnectivityReport(ctx, &host, stepReply)
 	}
 	return err
 }
@@ -3697,6 +4229,10 @@ func filterReplyByType(params installer.V2PostStepReplyParams) (string, error) {
 		stepReply, err = filterReply(&models.UpgradeAgentResponse{}, params.Reply.Output)
 	case models.StepTypeVerifyVips:
 		stepReply, err = filterReply(&models.VerifyVipsResponse{}, params.Reply.Output)
+	case models.StepTypeDiskErase:
+		stepReply, err = filterReply(&models.DiskEraseResponse{}, params.Reply.Output)
+	case models.StepTypeLoadBalancerCheck:
+		stepReply, err = filterReply(&models.LoadBalancerCheckResponse{}, params.Reply.Output)
 	}
 
 	return stepReply, err
@@ -4115,13 +4651,358 @@ func (b *bareMetalInventory) CancelInstallationInternal(ctx context.Context, par
 	return cluster, nil
 }
 
-func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V2ResetHostParams) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-	log.Info("Resetting host: ", params.HostID)
-	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.WithError(err).Errorf("host %s not found", params.HostID.String())
+// StartInstallation starts the installation of a cluster on behalf of the installation scheduler
+func (b *bareMetalInventory) StartInstallation(ctx context.Context, clusterID strfmt.UUID) error {
+	_, err := b.InstallClusterInternal(ctx, installer.V2InstallClusterParams{ClusterID: clusterID})
+	return err
+}
+
+// CancelInstallation cancels the installation of a cluster on behalf of the installation scheduler
+func (b *bareMetalInventory) CancelInstallation(ctx context.Context, clusterID strfmt.UUID) error {
+	_, err := b.CancelInstallationInternal(ctx, installer.V2CancelInstallationParams{ClusterID: clusterID})
+	return err
+}
+
+// clusterBatchInstallingStatuses are the cluster statuses that are reported as an installing batch
+var clusterBatchInstallingStatuses = []string{
+	models.ClusterStatusPreparingForInstallation,
+	models.ClusterStatusInstalling,
+	models.ClusterStatusInstallingPendingUserAction,
+	models.ClusterStatusFinalizing,
+}
+
+func (b *bareMetalInventory) CreateClusterBatchInternal(ctx context.Context, params installer.V2CreateClusterBatchParams) (*models.ClusterBatch, error) {
+	id := strfmt.UUID(uuid.New().String())
+	log := logutil.FromContext(ctx, b.log).WithField("cluster_batch_id", id)
+	batchParams := params.NewClusterBatchParams
+	log.Infof("Create cluster batch %s with id %s and %d sites", swag.StringValue(batchParams.Name), id, len(batchParams.Sites))
+
+	if b.MaxClusterBatchSites > 0 && len(batchParams.Sites) > b.MaxClusterBatchSites {
+		return nil, common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("a cluster batch can't have more than %d sites, got %d", b.MaxClusterBatchSites, len(batchParams.Sites)))
+	}
+
+	names := make(map[string]bool)
+	for _, site := range batchParams.Sites {
+		siteName := swag.StringValue(site.Name)
+		if names[siteName] {
+			return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("site name %s is used more than once in the batch", siteName))
+		}
+		names[siteName] = true
+	}
+
+	// The batch is inserted first so that every cluster refers to an existing batch. The clusters and the
+	// infra-envs are created by their own internal APIs, each in its own transaction and with side effects out of
+	// the database, so they can't share a transaction with the batch. When a site fails, the batch and the objects
+	// that were already created are removed on a best-effort basis.
+	url := installer.V2GetClusterBatchURL{ClusterBatchID: id}
+	batch := &models.ClusterBatch{
+		ID:        &id,
+		Href:      url.String(),
+		Name:      batchParams.Name,
+		UserName:  ocm.UserNameFromContext(ctx),
+		OrgID:     ocm.OrgIDFromContext(ctx),
+		CreatedAt: strfmt.DateTime(time.Now()),
+	}
+	if err := b.db.Create(batch).Error; err != nil {
+		log.WithError(err).Error("failed to create cluster batch")
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	var (
+		clusters  []*common.Cluster
+		infraEnvs []*common.InfraEnv
+		err       error
+	)
+	defer func() {
+		if err == nil {
+			return
+		}
+		for i := len(infraEnvs) - 1; i >= 0; i-- {
+			if deregisterErr := b.DeregisterInfraEnvInternal(ctx, installer.DeregisterInfraEnvParams{InfraEnvID: *infraEnvs[i].ID}); deregisterErr != nil {
+				log.WithError(deregisterErr).Errorf("failed to remove infra-env %s of failed cluster batch", infraEnvs[i].ID)
+			}
+		}
+		for i := len(clusters) - 1; i >= 0; i-- {
+			if deregisterErr := b.DeregisterClusterInternal(ctx, clusters[i]); deregisterErr != nil {
+				log.WithError(deregisterErr).Errorf("failed to remove cluster %s of failed cluster batch", clusters[i].ID)
+			}
+		}
+		if deleteErr := b.db.Delete(&models.ClusterBatch{}, "id = ?", id.String()).Error; deleteErr != nil {
+			log.WithError(deleteErr).Error("failed to remove failed cluster batch")
+		}
+	}()
+
+	for _, site := range batchParams.Sites {
+		var clusterParams *models.ClusterCreateParams
+		if clusterParams, err = clusterParamsForSite(batchParams.ClusterTemplate, site); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		var cluster *common.Cluster
+		cluster, err = b.RegisterClusterInternal(ctx, nil, nil, installer.V2RegisterClusterParams{NewClusterParams: clusterParams})
+		if err != nil {
+			err = wrapClusterBatchSiteError(err, site)
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
+
+		if err = b.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).Update("cluster_batch_id", id).Error; err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to add cluster %s to batch", cluster.ID))
+		}
+
+		var infraEnvParams *models.InfraEnvCreateParams
+		if infraEnvParams, err = infraEnvParamsForSite(batchParams.InfraEnvTemplate, batchParams.ClusterTemplate, site, *cluster.ID); err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, err)
+		}
+		var infraEnv *common.InfraEnv
+		infraEnv, err = b.RegisterInfraEnvInternal(ctx, nil, nil, installer.RegisterInfraEnvParams{InfraenvCreateParams: infraEnvParams})
+		if err != nil {
+			err = wrapClusterBatchSiteError(err, site)
+			return nil, err
+		}
+		infraEnvs = append(infraEnvs, infraEnv)
+	}
+
+	log.Infof("Successfully created cluster batch %s with %d clusters", id, len(clusters))
+	return b.getClusterBatchInternal(ctx, id)
+}
+
+// wrapClusterBatchSiteError adds the failing site to the error while keeping its status code
+func wrapClusterBatchSiteError(err error, site *models.ClusterBatchSite) error {
+	code := int32(http.StatusInternalServerError)
+	var apiErr *common.ApiErrorResponse
+	if errors.As(err, &apiErr) {
+		code = apiErr.StatusCode()
+	}
+	return common.NewApiError(code, errors.Wrapf(err, "failed to create site %s", swag.StringValue(site.Name)))
+}
+
+func cloneClusterBatchTemplate(template interface{}, out interface{}) error {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal cluster batch template")
+	}
+	return errors.Wrap(json.Unmarshal(data, out), "failed to unmarshal cluster batch template")
+}
+
+func clusterParamsForSite(template *models.ClusterCreateParams, site *models.ClusterBatchSite) (*models.ClusterCreateParams, error) {
+	params := &models.ClusterCreateParams{}
+	if err := cloneClusterBatchTemplate(template, params); err != nil {
+		return nil, err
+	}
+	params.Name = swag.String(swag.StringValue(site.Name))
+	if site.BaseDNSDomain != "" {
+		params.BaseDNSDomain = site.BaseDNSDomain
+	}
+	if len(site.APIVips) > 0 {
+		params.APIVips = site.APIVips
+	}
+	if len(site.IngressVips) > 0 {
+		params.IngressVips = site.IngressVips
+	}
+	if len(site.MachineNetworks) > 0 {
+		params.MachineNetworks = site.MachineNetworks
+	}
+	return params, nil
+}
+
+func infraEnvParamsForSite(template *models.InfraEnvCreateParams, clusterTemplate *models.ClusterCreateParams,
+	site *models.ClusterBatchSite, clusterID strfmt.UUID) (*models.InfraEnvCreateParams, error) {
+	params := &models.InfraEnvCreateParams{}
+	if template != nil {
+		if err := cloneClusterBatchTemplate(template, params); err != nil {
+			return nil, err
+		}
+	} else {
+		params.PullSecret = clusterTemplate.PullSecret
+		if clusterTemplate.SSHPublicKey != "" {
+			params.SSHAuthorizedKey = swag.String(clusterTemplate.SSHPublicKey)
+		}
+		params.OpenshiftVersion = swag.StringValue(clusterTemplate.OpenshiftVersion)
+		params.CPUArchitecture = clusterTemplate.CPUArchitecture
+		if clusterTemplate.HTTPProxy != nil || clusterTemplate.HTTPSProxy != nil || clusterTemplate.NoProxy != nil {
+			params.Proxy = &models.Proxy{
+				HTTPProxy:  clusterTemplate.HTTPProxy,
+				HTTPSProxy: clusterTemplate.HTTPSProxy,
+				NoProxy:    clusterTemplate.NoProxy,
+			}
+		}
+	}
+	params.Name = swag.String(swag.StringValue(site.Name))
+	params.ClusterID = &clusterID
+	if len(site.StaticNetworkConfig) > 0 {
+		params.StaticNetworkConfig = site.StaticNetworkConfig
+	}
+	return params, nil
+}
+
+func (b *bareMetalInventory) getClusterBatchFromDB(ctx context.Context, batchID strfmt.UUID) (*models.ClusterBatch, error) {
+	var batch models.ClusterBatch
+	if err := b.authzHandler.OwnedBy(ctx, b.db).Take(&batch, "id = ?", batchID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("cluster batch %s was not found", batchID))
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return &batch, nil
+}
+
+func (b *bareMetalInventory) getClusterBatchClusters(batchID strfmt.UUID) ([]*common.Cluster, error) {
+	clusters, err := common.GetClustersFromDBWhere(b.db.Order("name"), common.SkipEagerLoading, common.SkipDeletedRecords,
+		"cluster_batch_id = ?", batchID.String())
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get the clusters of batch %s", batchID))
+	}
+	return clusters, nil
+}
+
+// setClusterBatchStatus fills the members of the batch and aggregates their statuses
+func (b *bareMetalInventory) setClusterBatchStatus(batch *models.ClusterBatch) error {
+	clusters, err := b.getClusterBatchClusters(*batch.ID)
+	if err != nil {
+		return err
+	}
+	clusterIDs := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		clusterIDs = append(clusterIDs, c.ID.String())
+	}
+	infraEnvIDs := make(map[strfmt.UUID]strfmt.UUID)
+	if len(clusterIDs) > 0 {
+		infraEnvs, err := common.GetInfraEnvsFromDBWhere(b.db, "cluster_id IN (?)", clusterIDs)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get the infra-envs of batch %s", batch.ID))
+		}
+		for _, infraEnv := range infraEnvs {
+			infraEnvIDs[infraEnv.ClusterID] = *infraEnv.ID
+		}
+	}
+
+	batch.Members = make([]*models.ClusterBatchMember, 0, len(clusters))
+	batch.StatusCounts = make(map[string]int64)
+	for _, c := range clusters {
+		status := swag.StringValue(c.Status)
+		batch.Members = append(batch.Members, &models.ClusterBatchMember{
+			SiteName:   c.Name,
+			ClusterID:  *c.ID,
+			InfraEnvID: infraEnvIDs[*c.ID],
+			Status:     status,
+			StatusInfo: swag.StringValue(c.StatusInfo),
+		})
+		batch.StatusCounts[status]++
+	}
+	batch.Status = aggregateClusterBatchStatus(batch.StatusCounts)
+	return nil
+}
+
+func aggregateClusterBatchStatus(statusCounts map[string]int64) string {
+	var total int64
+	for _, count := range statusCounts {
+		total += count
+	}
+	for _, status := range clusterBatchInstallingStatuses {
+		if statusCounts[status] > 0 {
+			return models.ClusterBatchStatusInstalling
+		}
+	}
+	switch {
+	case statusCounts[models.ClusterStatusError] > 0:
+		return models.ClusterBatchStatusError
+	case statusCounts[models.ClusterStatusCancelled] > 0:
+		return models.ClusterBatchStatusCancelled
+	case total > 0 && statusCounts[models.ClusterStatusInstalled] == total:
+		return models.ClusterBatchStatusInstalled
+	default:
+		return models.ClusterBatchStatusPending
+	}
+}
+
+func (b *bareMetalInventory) getClusterBatchInternal(ctx context.Context, batchID strfmt.UUID) (*models.ClusterBatch, error) {
+	batch, err := b.getClusterBatchFromDB(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.setClusterBatchStatus(batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+func (b *bareMetalInventory) ListClusterBatchesInternal(ctx context.Context) ([]*models.ClusterBatch, error) {
+	var batches []*models.ClusterBatch
+	if err := b.authzHandler.OwnedBy(ctx, b.db).Order("created_at").Find(&batches).Error; err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to list cluster batches"))
+	}
+	for _, batch := range batches {
+		if err := b.setClusterBatchStatus(batch); err != nil {
+			return nil, err
+		}
+	}
+	return batches, nil
+}
+
+// InstallClusterBatchInternal starts the installation of every cluster of the batch that is not installing or
+// installed yet. A cluster that fails to start does not prevent the installation of the other clusters.
+func (b *bareMetalInventory) InstallClusterBatchInternal(ctx context.Context, batchID strfmt.UUID) (*models.ClusterBatch, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := b.getClusterBatchFromDB(ctx, batchID); err != nil {
+		return nil, err
+	}
+	clusters, err := b.getClusterBatchClusters(batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var installErr error
+	for _, c := range clusters {
+		status := swag.StringValue(c.Status)
+		if status == models.ClusterStatusInstalled || funk.ContainsString(clusterBatchInstallingStatuses, status) {
+			continue
+		}
+		log.Infof("Installing cluster %s of batch %s", c.ID, batchID)
+		if _, err = b.InstallClusterInternal(ctx, installer.V2InstallClusterParams{ClusterID: *c.ID}); err != nil {
+			installErr = multierror.Append(installErr, errors.Wrapf(err, "cluster %s", c.Name))
+		}
+	}
+	if installErr != nil {
+		return nil, common.NewApiError(http.StatusConflict, errors.Wrapf(installErr, "failed to install the clusters of batch %s", batchID))
+	}
+	return b.getClusterBatchInternal(ctx, batchID)
+}
+
+// CancelClusterBatchInternal cancels the installation of every cluster of the batch that is installing
+func (b *bareMetalInventory) CancelClusterBatchInternal(ctx context.Context, batchID strfmt.UUID) (*models.ClusterBatch, error) {
+	log := logutil.FromContext(ctx, b.log)
+	if _, err := b.getClusterBatchFromDB(ctx, batchID); err != nil {
+		return nil, err
+	}
+	clusters, err := b.getClusterBatchClusters(batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cancelErr error
+	for _, c := range clusters {
+		if !funk.ContainsString(clusterBatchInstallingStatuses, swag.StringValue(c.Status)) {
+			continue
+		}
+		log.Infof("Canceling installation of cluster %s of batch %s", c.ID, batchID)
+		if _, err = b.CancelInstallationInternal(ctx, installer.V2CancelInstallationParams{ClusterID: *c.ID}); err != nil {
+			cancelErr = multierror.Append(cancelErr, errors.Wrapf(err, "cluster %s", c.Name))
+		}
+	}
+	if cancelErr != nil {
+		return nil, common.NewApiError(http.StatusConflict, errors.Wrapf(cancelErr, "failed to cancel the installations of batch %s", batchID))
+	}
+	return b.getClusterBatchInternal(ctx, batchID)
+}
+
+func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V2ResetHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Info("Resetting host: ", params.HostID)
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.WithError(err).Errorf("host %s not found", params.HostID.String())
 			return common.NewApiError(http.StatusNotFound, err)
 		}
 		log.WithError(err).Errorf("failed to get host %s", params.HostID.String())
@@ -4161,10 +5042,199 @@ func (b *bareMetalInventory) V2ResetHost(ctx context.Context, params installer.V
 	return installer.NewV2ResetHostOK().WithPayload(&host.Host)
 }
 
+func (b *bareMetalInventory) V2EraseHostDisks(ctx context.Context, params installer.V2EraseHostDisksParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	log.Infof("Erasing disks %v of host %s", params.DiskEraseParams.DiskIds, params.HostID)
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.WithError(err).Errorf("host %s not found", params.HostID.String())
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		log.WithError(err).Errorf("failed to get host %s", params.HostID.String())
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	// The erasure reports are the proof of erasure, the disks are not erased when the reports can't be signed
+	if _, err = gencrypto.DigestJWT([]byte{}); err != nil {
+		log.WithError(err).Errorf("cannot sign the disk erasure reports of host %s", params.HostID.String())
+		return common.NewApiError(http.StatusConflict, errors.Wrap(err, "disk erasure requires the service to be configured with a key to sign the erasure reports"))
+	}
+
+	diskIDs := funk.UniqString(params.DiskEraseParams.DiskIds)
+	if err = validateDiskErasure(&host.Host, diskIDs); err != nil {
+		log.WithError(err).Errorf("cannot erase disks of host %s", params.HostID.String())
+		return common.GenerateErrorResponder(err)
+	}
+
+	disksToErase := strings.Join(diskIDs, ",")
+	result := b.db.Model(&common.Host{}).
+		Where("id = ? and infra_env_id = ? and (disks_to_erase = '' or disks_to_erase is null)", params.HostID, params.InfraEnvID).
+		Updates(map[string]interface{}{"disks_to_erase": disksToErase, "disk_erase_started_at": nil})
+	if result.Error != nil {
+		log.WithError(result.Error).Errorf("failed to update disks to erase of host %s", params.HostID.String())
+		return common.NewApiError(http.StatusInternalServerError, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("an erasure of the disks of host %s is already in progress", params.HostID))
+	}
+
+	host.DisksToErase = disksToErase
+	eventgen.SendHostDiskErasureStartedEvent(ctx, b.eventsHandler, *host.ID, host.InfraEnvID, hostutil.GetHostnameForMsg(&host.Host), disksToErase)
+	return installer.NewV2EraseHostDisksAccepted().WithPayload(&host.Host)
+}
+
+// validateDiskErasure verifies that the given disks of the host can be securely erased. Disks are only erased
+// on hosts that are not bound to a cluster, so the erasure can't interfere with an installation.
+func validateDiskErasure(host *models.Host, diskIDs []string) error {
+	if host.ClusterID != nil {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("host %s is bound to cluster %s, disks can only be erased on unbound hosts", host.ID, *host.ClusterID))
+	}
+	if !funk.ContainsString([]string{models.HostStatusKnownUnbound, models.HostStatusInsufficientUnbound}, swag.StringValue(host.Status)) {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("host %s is in status %s, disks can only be erased on hosts in status %s or %s",
+			host.ID, swag.StringValue(host.Status), models.HostStatusKnownUnbound, models.HostStatusInsufficientUnbound))
+	}
+	if host.DisksToErase != "" {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("an erasure of the disks of host %s is already in progress", host.ID))
+	}
+	if host.Inventory == "" {
+		return common.NewApiError(http.StatusConflict, errors.Errorf("inventory of host %s was not received yet", host.ID))
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+	for _, diskID := range diskIDs {
+		disk := common.GetDiskByIdentifier(inventory, diskID)
+		if disk == nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("disk %s was not found in the inventory of host %s", diskID, host.ID))
+		}
+		if !common.IsDiskErasable(disk) {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("disk %s of host %s can't be securely erased", diskID, host.ID))
+		}
+	}
+	return nil
+}
+
+func diskErasureReportsPrefix(host *models.Host) string {
+	return fmt.Sprintf("%s/hosts/%s/disk-erasure-reports/", host.InfraEnvID, host.ID)
+}
+
+func (b *bareMetalInventory) V2ListHostDiskErasureReports(ctx context.Context, params installer.V2ListHostDiskErasureReportsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.NewApiError(http.StatusNotFound, err)
+		}
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	objectNames, err := b.objectHandler.ListObjectsByPrefix(ctx, diskErasureReportsPrefix(&host.Host))
+	if err != nil {
+		log.WithError(err).Errorf("failed to list disk erasure reports of host %s", params.HostID)
+		return common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	reports := models.DiskErasureReportList{}
+	for _, objectName := range objectNames {
+		report, err := b.downloadDiskErasureReport(ctx, objectName)
+		if err != nil {
+			log.WithError(err).Errorf("failed to download disk erasure report %s", objectName)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		reports = append(reports, report)
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		return time.Time(reports[i].CreatedAt).Before(time.Time(reports[j].CreatedAt))
+	})
+	return installer.NewV2ListHostDiskErasureReportsOK().WithPayload(reports)
+}
+
+func (b *bareMetalInventory) downloadDiskErasureReport(ctx context.Context, objectName string) (*models.DiskErasureReport, error) {
+	reader, _, err := b.objectHandler.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	var report models.DiskErasureReport
+	if err = json.NewDecoder(reader).Decode(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
 func (b *bareMetalInventory) deleteDNSRecordSets(ctx context.Context, cluster common.Cluster) error {
+	log := logutil.FromContext(ctx, b.log)
+	if records := getProvisionedDNSRecords(log, &cluster); len(records) > 0 {
+		if err := b.dnsApi.DeleteProvisionedDNSRecordSets(ctx, &cluster, records); err != nil {
+			return err
+		}
+	}
 	return b.dnsApi.DeleteDNSRecordSets(ctx, &cluster)
 }
 
+// getProvisionedDNSRecords returns the DNS records that the service created for the cluster
+func getProvisionedDNSRecords(log logrus.FieldLogger, cluster *common.Cluster) []dns.DNSRecord {
+	if cluster.ProvisionedDNSRecords == "" {
+		return nil
+	}
+	var records []dns.DNSRecord
+	if err := json.Unmarshal([]byte(cluster.ProvisionedDNSRecords), &records); err != nil {
+		log.WithError(err).Warnf("failed to parse the provisioned DNS records of cluster %s", cluster.ID.String())
+		return nil
+	}
+	return records
+}
+
+func (b *bareMetalInventory) setProvisionedDNSRecords(clusterID strfmt.UUID, records []dns.DNSRecord) error {
+	var value string
+	if len(records) > 0 {
+		encoded, err := json.Marshal(records)
+		if err != nil {
+			return err
+		}
+		value = string(encoded)
+	}
+	return b.db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Update("provisioned_dns_records", value).Error
+}
+
+// dnsRecordsChanged returns true if the DNS records that the service provisions for the cluster changed
+func dnsRecordsChanged(previous, cluster *common.Cluster) bool {
+	if !dns.IsDNSRecordsProvisioningEnabled(previous) || !dns.IsDNSRecordsProvisioningEnabled(cluster) {
+		return dns.IsDNSRecordsProvisioningEnabled(previous) != dns.IsDNSRecordsProvisioningEnabled(cluster)
+	}
+	return previous.Name != cluster.Name || previous.BaseDNSDomain != cluster.BaseDNSDomain ||
+		*previous.DNSRecordsProvisioning != *cluster.DNSRecordsProvisioning
+}
+
+// updateDNSRecords deletes the DNS records that the service created for the previous settings of the cluster, if
+// they changed, and creates the missing records of the current ones. The records that the service created are stored
+// in the cluster, so that the records that the user created are never deleted. The failures don't fail the request,
+// they are reported as events since the records can be created and deleted manually as well.
+func (b *bareMetalInventory) updateDNSRecords(ctx context.Context, previous, cluster *common.Cluster) {
+	log := logutil.FromContext(ctx, b.log)
+	records := getProvisionedDNSRecords(log, cluster)
+	if previous != nil && len(records) > 0 && dnsRecordsChanged(previous, cluster) {
+		if err := b.dnsApi.DeleteProvisionedDNSRecordSets(ctx, previous, records); err != nil {
+			log.WithError(err).Warnf("failed to delete the previous DNS records of cluster %s", cluster.ID.String())
+			eventgen.SendDnsRecordsProvisioningFailedEvent(ctx, b.eventsHandler, *cluster.ID, err.Error())
+		}
+		records = nil
+	}
+	if dns.IsDNSRecordsProvisioningEnabled(cluster) {
+		created, err := b.dnsApi.ProvisionDNSRecordSets(ctx, cluster)
+		if err != nil {
+			log.WithError(err).Warnf("failed to create the DNS records of cluster %s", cluster.ID.String())
+			eventgen.SendDnsRecordsProvisioningFailedEvent(ctx, b.eventsHandler, *cluster.ID, err.Error())
+		}
+		records = lo.Uniq(append(records, created...))
+	}
+	if err := b.setProvisionedDNSRecords(*cluster.ID, records); err != nil {
+		log.WithError(err).Warnf("failed to store the provisioned DNS records of cluster %s", cluster.ID.String())
+	}
+}
+
 func (b *bareMetalInventory) validateIgnitionEndpoint(ignitionEndpoint *models.IgnitionEndpoint, log logrus.FieldLogger) error {
 	if ignitionEndpoint == nil || ignitionEndpoint.URL == nil {
 		return nil
@@ -4206,6 +5276,10 @@ func (b *bareMetalInventory) validateDNSDomain(cluster common.Cluster, params in
 			log.WithError(err).Errorf("Invalid base DNS domain: %s", clusterBaseDomain)
 			return common.NewApiError(http.StatusConflict, errors.New("Base DNS domain isn't configured properly"))
 		}
+		// The records that the service created for the cluster don't conflict with it
+		if cluster.ProvisionedDNSRecords != "" && clusterName == cluster.Name && clusterBaseDomain == cluster.BaseDNSDomain {
+			return nil
+		}
 		if err = b.dnsApi.ValidateDNSRecords(cluster, dnsDomain); err != nil {
 			log.WithError(err).Errorf("DNS records already exist for cluster: %s", params.ClusterID)
 			return common.NewApiError(http.StatusConflict,
@@ -4503,7 +5577,8 @@ func (b *bareMetalInventory) DeregisterInfraEnvInternal(ctx context.Context, par
 		}
 	}()
 
-	if _, err = common.GetInfraEnvFromDB(b.db, params.InfraEnvID); err != nil {
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, params.InfraEnvID)
+	if err != nil {
 		return common.NewApiError(http.StatusNotFound, err)
 	}
 
@@ -4521,6 +5596,11 @@ func (b *bareMetalInventory) DeregisterInfraEnvInternal(ctx context.Context, par
 		log.WithError(err).Errorf("failed to deregister infraEnv %s", params.InfraEnvID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
+	if infraEnv.IpamPool != "" {
+		if releaseErr := b.ipamApi.Release(ctx, params.InfraEnvID); releaseErr != nil {
+			log.WithError(releaseErr).Warnf("failed to release the IPAM addresses of infra-env %s", params.InfraEnvID)
+		}
+	}
 	success = true
 	return nil
 }
@@ -4687,7 +5767,12 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 		// quirk.
 		params.InfraenvCreateParams.AdditionalTrustBundle = strings.TrimSpace(params.InfraenvCreateParams.AdditionalTrustBundle)
 
-		if err = b.validateInfraEnvCreateParams(ctx, params, cluster); err != nil {
+		if err = b.validateInfraEnvCreateParams(ctx, id, params, cluster); err != nil {
+			return common.NewApiError(http.StatusBadRequest, err)
+		}
+
+		if err = b.reserveStaticNetworkAddresses(ctx, id, params.InfraenvCreateParams.IpamPool,
+			params.InfraenvCreateParams.StaticNetworkConfig); err != nil {
 			return common.NewApiError(http.StatusBadRequest, err)
 		}
 
@@ -4741,6 +5826,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 				OpenshiftVersion:       *osImage.OpenshiftVersion,
 				IgnitionConfigOverride: params.InfraenvCreateParams.IgnitionConfigOverride,
 				StaticNetworkConfig:    staticNetworkConfig,
+				IpamPool:               params.InfraenvCreateParams.IpamPool,
 				Type:                   common.ImageTypePtr(params.InfraenvCreateParams.ImageType),
 				AdditionalNtpSources:   swag.StringValue(params.InfraenvCreateParams.AdditionalNtpSources),
 				SSHAuthorizedKey:       swag.StringValue(params.InfraenvCreateParams.SSHAuthorizedKey),
@@ -4822,6 +5908,11 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 		errMsg = fmt.Sprintf("%s. Error: %s", errMsg, err.Error())
 		log.Errorf(errMsg)
 		eventgen.SendInfraEnvRegistrationFailedEvent(ctx, b.eventsHandler, id, err.Error())
+		if params.InfraenvCreateParams.IpamPool != "" && b.ipamApi.Enabled() {
+			if releaseErr := b.ipamApi.Release(ctx, id); releaseErr != nil {
+				log.WithError(releaseErr).Warnf("failed to release the IPAM addresses of infra-env %s", id)
+			}
+		}
 		return nil, err
 	}
 
@@ -4842,7 +5933,7 @@ func (b *bareMetalInventory) RegisterInfraEnvInternal(ctx context.Context, kubeK
 	return b.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *infraEnv.ID})
 }
 
-func (b *bareMetalInventory) validateInfraEnvCreateParams(ctx context.Context, params installer.RegisterInfraEnvParams, cluster *common.Cluster) error {
+func (b *bareMetalInventory) validateInfraEnvCreateParams(ctx context.Context, id strfmt.UUID, params installer.RegisterInfraEnvParams, cluster *common.Cluster) error {
 	var err error
 
 	if err = validateClusterArchitectureAndVersion(b.versionsHandler, cluster, params.InfraenvCreateParams.CPUArchitecture, params.InfraenvCreateParams.OpenshiftVersion); err != nil {
@@ -4870,6 +5961,11 @@ func (b *bareMetalInventory) validateInfraEnvCreateParams(ctx context.Context, p
 		}
 	}
 
+	if params.InfraenvCreateParams.StaticNetworkConfig, err = b.expandStaticNetworkConfigTemplate(ctx, id, params.InfraenvCreateParams.IpamPool,
+		params.InfraenvCreateParams.StaticNetworkConfig, params.InfraenvCreateParams.StaticNetworkConfigTemplate); err != nil {
+		return err
+	}
+
 	if params.InfraenvCreateParams.StaticNetworkConfig != nil {
 		if err = b.staticNetworkConfig.ValidateStaticConfigParamsYAML(params.InfraenvCreateParams.StaticNetworkConfig, params.InfraenvCreateParams.OpenshiftVersion, params.InfraenvCreateParams.CPUArchitecture, b.installerInvoker); err != nil {
 			return err
@@ -5030,6 +6126,12 @@ func (b *bareMetalInventory) UpdateInfraEnvInternal(ctx context.Context, params
 		params.InfraEnvUpdateParams.PullSecret = pullSecretBackup
 	}
 
+	if params.InfraEnvUpdateParams.StaticNetworkConfig != nil || params.InfraEnvUpdateParams.StaticNetworkConfigTemplate != nil {
+		// The addresses that were reserved in the IPAM pool for the previous static network config, or for the new
+		// one when the update fails, are released
+		defer b.releaseUnusedInfraEnvAddresses(ctx, params.InfraEnvID)
+	}
+
 	if params, err = b.validateAndUpdateInfraEnvParams(ctx, &params); err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, err)
 	}
@@ -5260,6 +6362,23 @@ func (b *bareMetalInventory) validateAndUpdateInfraEnvParams(ctx context.Context
 		*params.InfraEnvUpdateParams.SSHAuthorizedKey = sshPublicKey
 	}
 
+	if params.InfraEnvUpdateParams.StaticNetworkConfig != nil || params.InfraEnvUpdateParams.StaticNetworkConfigTemplate != nil {
+		ipamPool, err := b.getInfraEnvIpamPool(params.InfraEnvID)
+		if err != nil {
+			return installer.UpdateInfraEnvParams{}, err
+		}
+		staticNetworkConfig, err := b.expandStaticNetworkConfigTemplate(ctx, params.InfraEnvID, ipamPool,
+			params.InfraEnvUpdateParams.StaticNetworkConfig, params.InfraEnvUpdateParams.StaticNetworkConfigTemplate)
+		if err != nil {
+			return installer.UpdateInfraEnvParams{}, err
+		}
+		params.InfraEnvUpdateParams.StaticNetworkConfig = staticNetworkConfig
+
+		if err = b.reserveStaticNetworkAddresses(ctx, params.InfraEnvID, ipamPool, staticNetworkConfig); err != nil {
+			return installer.UpdateInfraEnvParams{}, err
+		}
+	}
+
 	// The OpenShift installer validation code for the additional trust bundle
 	// is buggy and doesn't react well to additional newlines at the end of the
 	// certs. We need to strip them out to not bother assisted users with this
@@ -5272,6 +6391,78 @@ func (b *bareMetalInventory) validateAndUpdateInfraEnvParams(ctx context.Context
 	return *params, nil
 }
 
+// getInfraEnvIpamPool returns the IPAM pool of the infra-env, empty when the infra-env doesn't exist since that is
+// reported by the update
+func (b *bareMetalInventory) getInfraEnvIpamPool(infraEnvID strfmt.UUID) (string, error) {
+	var infraEnv common.InfraEnv
+	if err := b.db.Select("ipam_pool").Take(&infraEnv, "id = ?", infraEnvID.String()).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return infraEnv.IpamPool, nil
+}
+
+// reserveStaticNetworkAddresses reserves the addresses of the static network config of an infra-env in its IPAM pool,
+// if any
+func (b *bareMetalInventory) reserveStaticNetworkAddresses(ctx context.Context, infraEnvID strfmt.UUID, ipamPool string,
+	staticNetworkConfig []*models.HostStaticNetworkConfig) error {
+	if ipamPool == "" {
+		return nil
+	}
+	if !b.ipamApi.Enabled() {
+		return ipam.ErrDisabled
+	}
+	return ipam.RenderStaticNetworkConfig(ctx, b.ipamApi, infraEnvID, ipamPool, staticNetworkConfig)
+}
+
+// releaseUnusedInfraEnvAddresses releases the addresses that were reserved in the IPAM pool of the infra-env and that
+// aren't in its static network config anymore
+func (b *bareMetalInventory) releaseUnusedInfraEnvAddresses(ctx context.Context, infraEnvID strfmt.UUID) {
+	log := logutil.FromContext(ctx, b.log)
+	infraEnv, err := common.GetInfraEnvFromDB(b.db, infraEnvID)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get infra-env %s to release its unused IPAM addresses", infraEnvID)
+		return
+	}
+	if infraEnv.IpamPool == "" {
+		return
+	}
+	var staticNetworkConfig []*models.HostStaticNetworkConfig
+	if infraEnv.StaticNetworkConfig != "" {
+		if err = json.Unmarshal([]byte(infraEnv.StaticNetworkConfig), &staticNetworkConfig); err != nil {
+			log.WithError(err).Warnf("failed to parse the static network config of infra-env %s", infraEnvID)
+			return
+		}
+	}
+	used, err := ipam.StaticNetworkConfigAddresses(staticNetworkConfig)
+	if err != nil {
+		log.WithError(err).Warnf("failed to get the addresses of the static network config of infra-env %s", infraEnvID)
+		return
+	}
+	if err = b.ipamApi.ReleaseUnused(ctx, infraEnvID, used); err != nil {
+		log.WithError(err).Warnf("failed to release the unused IPAM addresses of infra-env %s", infraEnvID)
+	}
+}
+
+// expandStaticNetworkConfigTemplate returns the static network config of the hosts, expanded from the template when
+// one is given. The addresses of the hosts of a template without an IP range are reserved in the IPAM pool of the
+// infra-env, if any.
+func (b *bareMetalInventory) expandStaticNetworkConfigTemplate(ctx context.Context, infraEnvID strfmt.UUID, ipamPool string,
+	staticNetworkConfig []*models.HostStaticNetworkConfig, template *models.StaticNetworkConfigTemplate) ([]*models.HostStaticNetworkConfig, error) {
+	if template == nil {
+		return staticNetworkConfig, nil
+	}
+	if staticNetworkConfig != nil {
+		return nil, errors.New("static_network_config and static_network_config_template can't be set together")
+	}
+	if ipamPool != "" && !b.ipamApi.Enabled() {
+		return nil, ipam.ErrDisabled
+	}
+	return staticnetworkconfig.ExpandStaticNetworkConfigTemplate(ctx, template, b.ipamApi, infraEnvID, ipamPool)
+}
+
 func (b *bareMetalInventory) validateInfraEnvIgnitionParams(ctx context.Context, ignitionConfigOverride string) error {
 
 	log := logutil.FromContext(ctx, b.log)
@@ -5738,6 +6929,9 @@ func (b *bareMetalInventory) BindHostInternal(ctx context.Context, params instal
 	if host.ClusterID != nil {
 		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Host %s is already bound to cluster %s", params.HostID, *host.ClusterID))
 	}
+	if host.DisksToErase != "" {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Host %s can't be bound while its disks are being erased", params.HostID))
+	}
 	cluster, err := common.GetClusterFromDB(b.db, *params.BindHostParams.ClusterID, common.SkipEagerLoading)
 	if err != nil {
 		return nil, common.NewApiError(http.StatusBadRequest, errors.Errorf("Failed to find cluster %s", params.BindHostParams.ClusterID))
@@ -5783,6 +6977,11 @@ func (b *bareMetalInventory) BindHostInternal(ctx context.Context, params instal
 }
 
 func (b *bareMetalInventory) UnbindHostInternal(ctx context.Context, params installer.UnbindHostParams, reclaimHost bool, interactivity Interactivity) (*common.Host, error) {
+	return b.unbindHost(ctx, params, reclaimHost, false, interactivity)
+}
+
+// unbindHost unbinds the host from its cluster, see unbindHostInTransaction for wipeDisks
+func (b *bareMetalInventory) unbindHost(ctx context.Context, params installer.UnbindHostParams, reclaimHost, wipeDisks bool, interactivity Interactivity) (*common.Host, error) {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("Unbinding host %s", params.HostID)
 	host, err := common.GetHostFromDB(b.db, params.InfraEnvID.String(), params.HostID.String())
@@ -5804,7 +7003,7 @@ func (b *bareMetalInventory) UnbindHostInternal(ctx context.Context, params inst
 		return nil, common.NewApiError(http.StatusConflict, errors.Errorf("Cannot unbind Host %s. InfraEnv %s is bound to Cluster %s", params.HostID, params.InfraEnvID, infraEnv.ClusterID))
 	}
 
-	if err = b.hostApi.UnbindHost(ctx, &host.Host, b.db, reclaimHost); err != nil {
+	if err = b.unbindHostInTransaction(ctx, &host.Host, reclaimHost, wipeDisks); err != nil {
 		log.WithError(err).Errorf("Failed to unbind host <%s>", params.HostID)
 		return nil, common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -5827,6 +7026,38 @@ func (b *bareMetalInventory) UnbindHostInternal(ctx context.Context, params inst
 	return host, nil
 }
 
+// unbindHostInTransaction runs the unbind transition of the host. When wipeDisks is set the host is marked for disk
+// wipe in the same transaction, before the transition, so that it never gets the reboot for reclaim step without the wipe.
+func (b *bareMetalInventory) unbindHostInTransaction(ctx context.Context, h *models.Host, reclaimHost, wipeDisks bool) error {
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		if wipeDisks {
+			if err := tx.Model(&common.Host{}).Where("id = ? and infra_env_id = ?", h.ID.String(), h.InfraEnvID.String()).
+				Update("wipe_disks_on_reclaim", true).Error; err != nil {
+				return errors.Wrapf(err, "failed to mark host %s for disk wipe", h.ID.String())
+			}
+			h.WipeDisksOnReclaim = true
+		}
+		return b.hostApi.UnbindHost(ctx, h, tx, reclaimHost)
+	})
+}
+
+// DecommissionHostInternal unbinds the host from its cluster and reclaims it back into discovery.
+// The disks of the host are wiped before it reboots into the discovery image.
+func (b *bareMetalInventory) DecommissionHostInternal(ctx context.Context, params installer.UnbindHostParams) (*common.Host, error) {
+	log := logutil.FromContext(ctx, b.log)
+	host, err := b.unbindHost(ctx, params, true, true, NonInteractive)
+	if err != nil {
+		return nil, err
+	}
+
+	if !host.WipeDisksOnReclaim {
+		log.Infof("Host %s was unbound without being reclaimed, its disks will not be wiped", params.HostID)
+		return host, nil
+	}
+	log.Infof("Host %s is being decommissioned, its disks will be wiped before rebooting into discovery", params.HostID)
+	return host, nil
+}
+
 func (b *bareMetalInventory) UnbindHost(ctx context.Context, params installer.UnbindHostParams) middleware.Responder {
 	h, err := b.UnbindHostInternal(ctx, params, false, Interactive)
 	if err != nil {
@@ -6181,6 +7412,10 @@ func (b *bareMetalInventory) V2UpdateHostInternal(ctx context.Context, params in
 		if err != nil {
 			return err
 		}
+		err = b.updateHostBMC(ctx, host, params.HostUpdateParams.Bmc, params.HostUpdateParams.BmcPassword, tx)
+		if err != nil {
+			return err
+		}
 
 		err = b.updateNodeLabels(ctx, host, params.HostUpdateParams.NodeLabels, tx)
 		if err != nil {
@@ -6332,6 +7567,22 @@ func (b *bareMetalInventory) updateHostIgnitionEndpointToken(ctx context.Context
 	return nil
 }
 
+func (b *bareMetalInventory) updateHostBMC(ctx context.Context, host *common.Host, bmc *models.HostBmc, password *string, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, b.log)
+	if bmc == nil && password == nil {
+		log.Infof("No request for BMC update for host %s", host.ID)
+		return nil
+	}
+	err := b.hostApi.UpdateBMC(ctx, db, &host.Host, bmc, password)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to set BMC host <%s> infra env <%s>",
+			host.ID,
+			host.InfraEnvID)
+		return common.NewApiError(http.StatusConflict, err)
+	}
+	return nil
+}
+
 func (b *bareMetalInventory) updateIgnitionEndpointHTTPHeaders(ctx context.Context, host *common.Host, ignitionEndpointHTTPHeadersList []*models.IgnitionEndpointHTTPHeadersParams, db *gorm.DB) error {
 	log := logutil.FromContext(ctx, b.log)
 	if ignitionEndpointHTTPHeadersList == nil {
@@ -6670,3 +7921,170 @@ func getDefaultHighAvailabilityAndMasterCountParams(highAvailabilityMode *string
 	// both are set
 	return highAvailabilityMode, controlPlaneCount
 }
+
+// SuggestNetworksInternal suggests cluster and service networks that don't overlap the networks of the hosts of the
+// cluster, the reserved networks and the networks of the other clusters of the organization
+func (b *bareMetalInventory) SuggestNetworksInternal(ctx context.Context, params installer.V2SuggestNetworksParams) (*models.NetworksSuggestion, error) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, params.ClusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+
+	stackType := swag.StringValue(params.StackType)
+	if stackType == "" {
+		stackType = suggestedStackType(cluster, log)
+	}
+
+	avoided := network.GetHostAvoidedNetworks(cluster.Hosts, log)
+	for _, cidr := range strings.Split(b.Config.ReservedNetworks, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if _, _, err = net.ParseCIDR(cidr); err != nil {
+			log.WithError(err).Warnf("Ignoring invalid reserved network %s", cidr)
+			continue
+		}
+		avoided = append(avoided, &models.AvoidedNetwork{Cidr: models.Subnet(cidr), Source: models.AvoidedNetworkSourceReserved})
+	}
+	db := common.LoadTableFromDB(b.db, common.MachineNetworksTable)
+	db = common.LoadTableFromDB(db, common.ClusterNetworksTable)
+	db = common.LoadTableFromDB(db, common.ServiceNetworksTable)
+	clusters, err := common.GetClustersFromDBWhere(db, common.SkipEagerLoading, common.SkipDeletedRecords,
+		"org_id = ? and id != ?", cluster.OrgID, cluster.ID.String())
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to get the clusters of the organization"))
+	}
+	avoided = append(avoided, network.GetClusterAvoidedNetworks(clusters)...)
+
+	machineNetworks := cluster.MachineNetworks
+	if len(machineNetworks) == 0 {
+		machineNetworks = network.GetHostsCommonMachineNetworks(cluster.Hosts, log)
+	}
+	// The cluster network must have room for the hosts that aren't discovered yet, at least the control plane
+	hostCount := len(cluster.Hosts)
+	if hostCount < int(cluster.ControlPlaneCount) {
+		hostCount = int(cluster.ControlPlaneCount)
+	}
+	suggestion, err := network.SuggestNetworks(stackType, hostCount, machineNetworks, avoided, network.PlanDefaults{
+		ClusterNetworkCidr:           b.Config.DefaultClusterNetworkCidr,
+		ClusterNetworkHostPrefix:     b.Config.DefaultClusterNetworkHostPrefix,
+		ClusterNetworkCidrIPv6:       b.Config.DefaultClusterNetworkCidrIPv6,
+		ClusterNetworkHostPrefixIPv6: b.Config.DefaultClusterNetworkHostPrefixIPv6,
+		ServiceNetworkCidr:           b.Config.DefaultServiceNetworkCidr,
+		ServiceNetworkCidrIPv6:       b.Config.DefaultServiceNetworkCidrIPv6,
+	})
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	return suggestion, nil
+}
+
+// suggestedStackType is the stack type of the configured networks of the cluster, or of the addresses of its hosts
+func suggestedStackType(cluster *common.Cluster, log logrus.FieldLogger) string {
+	ipv4, ipv6, err := network.GetConfiguredAddressFamilies(cluster)
+	if err != nil || (!ipv4 && !ipv6) {
+		ipv4, ipv6, err = network.GetClusterAddressStack(cluster.Hosts)
+		if err != nil {
+			log.WithError(err).Warnf("failed to get the address families of the hosts of cluster %s", cluster.ID)
+		}
+	}
+	switch {
+	case ipv4 && ipv6:
+		return models.NetworksSuggestionStackTypeDualStack
+	case ipv6:
+		return models.NetworksSuggestionStackTypeIPV6
+	default:
+		return models.NetworksSuggestionStackTypeIPV4
+	}
+}
+
+func (b *bareMetalInventory) GetClusterNetworkTopologyInternal(ctx context.Context, clusterID strfmt.UUID) (*models.NetworkTopology, error) {
+	log := logutil.FromContext(ctx, b.log)
+	cluster, err := b.getCluster(ctx, clusterID.String(), common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	topology := network.BuildTopology(cluster.Hosts, log)
+	topology.ClusterID = clusterID
+	return topology, nil
+}
+
+func (b *bareMetalInventory) GetInfraEnvNetworkTopologyInternal(ctx context.Context, infraEnvID strfmt.UUID) (*models.NetworkTopology, error) {
+	log := logutil.FromContext(ctx, b.log)
+	// Check that the InfraEnv exists and is accessible before reading the hosts bound to it
+	if _, err := b.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: infraEnvID}); err != nil {
+		return nil, err
+	}
+	hosts, err := common.GetInfraEnvHostsFromDB(b.db, infraEnvID)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to get the hosts of infra-env %s", infraEnvID))
+	}
+	topology := network.BuildTopology(common.ToModelsHosts(hosts), log)
+	topology.InfraEnvID = infraEnvID
+	return topology, nil
+}
+
+func (b *bareMetalInventory) GetClusterSecondaryNetworksInternal(ctx context.Context, clusterID strfmt.UUID) (models.SecondaryNetworkPolicies, error) {
+	cluster, err := b.getCluster(ctx, clusterID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	policies, err := network.ParseSecondaryNetworks(cluster.SecondaryNetworks)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	return policies, nil
+}
+
+func (b *bareMetalInventory) UpdateClusterSecondaryNetworksInternal(ctx context.Context, params installer.V2UpdateClusterSecondaryNetworksParams) (models.SecondaryNetworkPolicies, error) {
+	log := logutil.FromContext(ctx, b.log)
+	policies := params.SecondaryNetworks
+	if policies == nil {
+		policies = models.SecondaryNetworkPolicies{}
+	}
+	if err := network.ValidateSecondaryNetworkPolicies(policies); err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	secondaryNetworks := ""
+	if len(policies) > 0 {
+		data, err := json.Marshal(policies)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusInternalServerError, errors.Wrap(err, "failed to marshal the secondary network policies"))
+		}
+		secondaryNetworks = string(data)
+	}
+
+	err := b.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDBForUpdate(tx, params.ClusterID, common.SkipEagerLoading)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.NewApiError(http.StatusNotFound, err)
+			}
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = b.clusterApi.VerifyClusterUpdatability(cluster); err != nil {
+			log.WithError(err).Errorf("cluster %s can't be updated in current state", params.ClusterID)
+			return common.NewApiError(http.StatusConflict, err)
+		}
+		if err = tx.Model(&common.Cluster{}).Where("id = ?", params.ClusterID).Update("secondary_networks", secondaryNetworks).Error; err != nil {
+			log.WithError(err).Errorf("failed to update the secondary network policies of cluster %s", params.ClusterID)
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
@@ -5,7 +5,9 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -28,6 +30,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
@@ -56,6 +59,7 @@ import (
 	"github.com/openshift/assisted-service/internal/infraenv"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	installcfg_builder "github.com/openshift/assisted-service/internal/installcfg/builder"
+	"github.com/openshift/assisted-service/internal/ipam"
 	"github.com/openshift/assisted-service/internal/metrics"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -171,6 +175,7 @@ var (
 	mockInstallConfigBuilder          *installcfg_builder.MockInstallConfigBuilder
 	mockStaticNetworkConfig           *staticnetworkconfig.MockStaticNetworkConfig
 	mockProviderRegistry              *registry.MockProviderRegistry
+	mockIpamApi                       *ipam.MockAPI
 	mockMirrorRegistriesConfigBuilder *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
 	secondDayWorkerIgnition           = []byte(`{
 		"ignition": {
@@ -982,6 +987,59 @@ var _ = Describe("v2PostStepReply", func() {
 		})
 	})
 
+	Context("load balancer check", func() {
+		var (
+			hostId    strfmt.UUID
+			clusterId strfmt.UUID
+		)
+		response := models.LoadBalancerCheckResponse{
+			Endpoints: []*models.LoadBalancerEndpointResult{
+				{
+					Endpoint: &models.LoadBalancerEndpoint{
+						Name: swag.String(models.LoadBalancerEndpointNameAPI),
+						Host: swag.String("api.test-cluster.example.com"),
+						Port: swag.Int64(6443),
+					},
+					Reachable: swag.Bool(false),
+					Error:     "connection refused",
+				},
+			},
+		}
+		BeforeEach(func() {
+			clusterId = strfmt.UUID(uuid.New().String())
+			hostId = strfmt.UUID(uuid.New().String())
+			host := models.Host{
+				ID:         &hostId,
+				InfraEnvID: clusterId,
+				ClusterID:  &clusterId,
+				Status:     swag.String(models.HostStatusInsufficient),
+			}
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		})
+		makeStepReply := func(output string) installer.V2PostStepReplyParams {
+			return installer.V2PostStepReplyParams{
+				InfraEnvID: clusterId,
+				HostID:     hostId,
+				Reply: &models.StepReply{
+					Output:   output,
+					StepType: models.StepTypeLoadBalancerCheck,
+				},
+			}
+		}
+
+		It("stores the report", func() {
+			b, err := json.Marshal(&response)
+			Expect(err).ToNot(HaveOccurred())
+			mockHostApi.EXPECT().UpdateLoadBalancerConnectivityReport(ctx, gomock.Any(), string(b)).Return(nil)
+			reply := bm.V2PostStepReply(ctx, makeStepReply(string(b)))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyNoContent()))
+		})
+		It("rejects a malformed report", func() {
+			reply := bm.V2PostStepReply(ctx, makeStepReply("not json"))
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2PostStepReplyBadRequest()))
+		})
+	})
+
 	Context("Dhcp allocation", func() {
 		var (
 			clusterId, hostId *strfmt.UUID
@@ -2581,6 +2639,51 @@ var _ = Describe("cluster", func() {
 					Expect(containsMonitoredOperator(actual.Payload.MonitoredOperators, &expectedMonitoredOperator)).To(BeTrue())
 				})
 
+				It("OLM register bundle", func() {
+					newOperatorName := testOLMOperators[0].Name
+
+					mockClusterRegisterSuccess(true)
+					mockOperatorManager.EXPECT().ExpandBundles(gomock.Any(), []string{models.BundleIDVirtualization}, nil).
+						Return([]*models.OperatorCreateParams{{Name: newOperatorName}}, nil).Times(1)
+					mockGetOperatorByName(newOperatorName)
+					mockOperatorManager.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(commonCluster *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+							return operators, nil
+						}).Times(1)
+					mockOperatorManager.EXPECT().EnsureOperatorPrerequisite(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+					clusterParams := getDefaultClusterCreateParams()
+					clusterParams.OlmBundles = []string{models.BundleIDVirtualization}
+					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+						NewClusterParams: clusterParams,
+					})
+					Expect(reflect.TypeOf(reply)).Should(Equal(reflect.TypeOf(installer.NewV2RegisterClusterCreated())))
+					actual := reply.(*installer.V2RegisterClusterCreated)
+
+					expectedMonitoredOperator := models.MonitoredOperator{
+						Name:             newOperatorName,
+						OperatorType:     testOLMOperators[0].OperatorType,
+						TimeoutSeconds:   testOLMOperators[0].TimeoutSeconds,
+						Namespace:        testOLMOperators[0].Namespace,
+						SubscriptionName: testOLMOperators[0].SubscriptionName,
+						ClusterID:        *actual.Payload.ID,
+					}
+					Expect(containsMonitoredOperator(actual.Payload.MonitoredOperators, &expectedMonitoredOperator)).To(BeTrue())
+				})
+
+				It("OLM register unsupported bundle", func() {
+					mockVersions.EXPECT().GetReleaseImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.ReleaseImage, nil).Times(1)
+					mockOSImages.EXPECT().GetOsImage(gomock.Any(), gomock.Any()).Return(common.TestDefaultConfig.OsImage, nil).Times(1)
+					mockOperatorManager.EXPECT().GetSupportedOperatorsByType(models.OperatorTypeBuiltin).Return([]*models.MonitoredOperator{&common.TestDefaultConfig.MonitoredOperator}).Times(1)
+					mockOperatorManager.EXPECT().ExpandBundles(gomock.Any(), []string{models.BundleIDOpenshiftAi}, nil).
+						Return(nil, errors.New("bundle openshift-ai is not supported")).Times(1)
+					clusterParams := getDefaultClusterCreateParams()
+					clusterParams.OlmBundles = []string{models.BundleIDOpenshiftAi}
+					reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+						NewClusterParams: clusterParams,
+					})
+					verifyApiErrorString(reply, http.StatusBadRequest, "bundle openshift-ai is not supported")
+				})
+
 				It("Resolve OLM dependencies", func() {
 					newOperatorName := testOLMOperators[1].Name
 
@@ -3651,6 +3754,192 @@ var _ = Describe("cluster", func() {
 			})
 		})
 
+		Context("Update Installation Schedule", func() {
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				cluster := &common.Cluster{Cluster: models.Cluster{
+					ID:   &clusterID,
+					Kind: swag.String(models.ClusterKindAddHostsCluster),
+					Platform: &models.Platform{
+						Type: common.PlatformTypePtr(models.PlatformTypeBaremetal),
+					},
+					CPUArchitecture: common.DefaultCPUArchitecture,
+				}}
+				err := db.Create(cluster).Error
+				Expect(err).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+			})
+
+			It("sets and removes the schedule", func() {
+				mockSuccess()
+				scheduledInstallTime := strfmt.DateTime(time.Now().Add(time.Hour).Truncate(time.Second))
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						ScheduledInstallTime: &scheduledInstallTime,
+						MaintenanceWindow: &models.MaintenanceWindow{
+							StartTime:       "22:00",
+							DurationMinutes: swag.Int64(120),
+							Days:            "Sat,Sun",
+							TimeZone:        swag.String("Europe/Berlin"),
+						},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual := reply.(*installer.V2UpdateClusterCreated).Payload
+				Expect(time.Time(*actual.ScheduledInstallTime).Equal(time.Time(scheduledInstallTime))).To(BeTrue())
+				Expect(actual.MaintenanceWindow.StartTime).To(Equal("22:00"))
+				Expect(swag.Int64Value(actual.MaintenanceWindow.DurationMinutes)).To(Equal(int64(120)))
+				Expect(actual.MaintenanceWindow.Days).To(Equal("Sat,Sun"))
+				Expect(swag.StringValue(actual.MaintenanceWindow.TimeZone)).To(Equal("Europe/Berlin"))
+
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(gomock.Any()).Return(nil).Times(1)
+				mockSuccess()
+				reply = bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						ScheduledInstallTime: &strfmt.DateTime{},
+						MaintenanceWindow:    &models.MaintenanceWindow{},
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				actual = reply.(*installer.V2UpdateClusterCreated).Payload
+				Expect(actual.ScheduledInstallTime).To(BeNil())
+				Expect(actual.MaintenanceWindow == nil || actual.MaintenanceWindow.StartTime == "").To(BeTrue())
+			})
+
+			It("rejects an invalid maintenance window", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						MaintenanceWindow: &models.MaintenanceWindow{
+							StartTime:       "22:00",
+							DurationMinutes: swag.Int64(60),
+							TimeZone:        swag.String("Mars/Olympus_Mons"),
+						},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "invalid maintenance window time zone")
+			})
+		})
+
+		Context("Update DNS records provisioning", func() {
+			var mockDNSApi *dns.MockDNSApi
+
+			BeforeEach(func() {
+				clusterID = strfmt.UUID(uuid.New().String())
+				cluster := &common.Cluster{Cluster: models.Cluster{
+					ID:                    &clusterID,
+					Name:                  "test-cluster",
+					BaseDNSDomain:         "example.com",
+					HighAvailabilityMode:  swag.String(models.ClusterHighAvailabilityModeFull),
+					UserManagedNetworking: swag.Bool(true),
+					Platform:              &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeNone)},
+					CPUArchitecture:       common.DefaultCPUArchitecture,
+				}}
+				Expect(db.Create(cluster).Error).ShouldNot(HaveOccurred())
+				mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+				mockDNSApi = dns.NewMockDNSApi(ctrl)
+				mockDNSApi.EXPECT().ValidateDNSName(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockDNSApi.EXPECT().GetDNSDomain(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+				bm.dnsApi = mockDNSApi
+			})
+
+			provisioning := func() *models.DNSRecordsProvisioning {
+				return &models.DNSRecordsProvisioning{Enabled: true, APIIP: "192.168.126.5", IngressIP: "192.168.126.6"}
+			}
+
+			getProvisionedRecords := func() []dns.DNSRecord {
+				cluster, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+				Expect(err).ShouldNot(HaveOccurred())
+				return getProvisionedDNSRecords(common.GetTestLog(), cluster)
+			}
+
+			It("creates the records once enabled", func() {
+				mockSuccess()
+				created := []dns.DNSRecord{{Name: "api.test-cluster.example.com", IP: "192.168.126.5"}}
+				mockDNSApi.EXPECT().ProvisionDNSRecordSets(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *common.Cluster) ([]dns.DNSRecord, error) {
+						Expect(c.DNSRecordsProvisioning).To(Equal(provisioning()))
+						return created, nil
+					}).Times(1)
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{DNSRecordsProvisioning: provisioning()},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				Expect(reply.(*installer.V2UpdateClusterCreated).Payload.DNSRecordsProvisioning).To(Equal(provisioning()))
+				Expect(getProvisionedRecords()).To(Equal(created))
+			})
+
+			It("deletes only the provisioned records once the addresses change", func() {
+				previous := []dns.DNSRecord{{Name: "api-int.test-cluster.example.com", IP: "192.168.126.5"}}
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+					"dns_records_provisioning_enabled":    true,
+					"dns_records_provisioning_api_ip":     "192.168.126.5",
+					"dns_records_provisioning_ingress_ip": "192.168.126.6",
+					"provisioned_dns_records":             `[{"name":"api-int.test-cluster.example.com","ip":"192.168.126.5"}]`,
+				}).Error).ShouldNot(HaveOccurred())
+				mockSuccess()
+				mockDNSApi.EXPECT().DeleteProvisionedDNSRecordSets(gomock.Any(), gomock.Any(), previous).DoAndReturn(
+					func(_ context.Context, c *common.Cluster, _ []dns.DNSRecord) error {
+						Expect(c.DNSRecordsProvisioning.APIIP).To(Equal("192.168.126.5"))
+						return nil
+					}).Times(1)
+				created := []dns.DNSRecord{{Name: "api-int.test-cluster.example.com", IP: "192.168.126.7"}}
+				mockDNSApi.EXPECT().ProvisionDNSRecordSets(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *common.Cluster) ([]dns.DNSRecord, error) {
+						Expect(c.DNSRecordsProvisioning.APIIP).To(Equal("192.168.126.7"))
+						return created, nil
+					}).Times(1)
+				updated := provisioning()
+				updated.APIIP = "192.168.126.7"
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{DNSRecordsProvisioning: updated},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+				Expect(getProvisionedRecords()).To(Equal(created))
+			})
+
+			It("doesn't delete records once disabled when it didn't create any", func() {
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID.String()).Updates(map[string]interface{}{
+					"dns_records_provisioning_enabled":    true,
+					"dns_records_provisioning_api_ip":     "192.168.126.5",
+					"dns_records_provisioning_ingress_ip": "192.168.126.6",
+				}).Error).ShouldNot(HaveOccurred())
+				mockSuccess()
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{DNSRecordsProvisioning: &models.DNSRecordsProvisioning{}},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+			})
+
+			It("reports the records that can't be created without failing the update", func() {
+				mockSuccess()
+				mockDNSApi.EXPECT().ProvisionDNSRecordSets(gomock.Any(), gomock.Any()).Return(nil, errors.New("REFUSED")).Times(1)
+				mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+					eventstest.WithNameMatcher(eventgen.DnsRecordsProvisioningFailedEventName),
+					eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID:           clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{DNSRecordsProvisioning: provisioning()},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+			})
+
+			It("rejects an invalid address", func() {
+				reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+					ClusterID: clusterID,
+					ClusterUpdateParams: &models.V2ClusterUpdateParams{
+						DNSRecordsProvisioning: &models.DNSRecordsProvisioning{Enabled: true, APIIP: "192.168.126.5"},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "the ingress IP address \"\" of the DNS records provisioning isn't a valid IP address")
+			})
+		})
+
 		Context("Update Network", func() {
 			var cluster *common.Cluster
 			BeforeEach(func() {
@@ -4295,6 +4584,65 @@ var _ = Describe("cluster", func() {
 					Expect(actualNetworks).To(Equal(expectedNetworks))
 				})
 
+				Context("IPAM", func() {
+					BeforeEach(func() {
+						Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update("ipam_pool", "lab").Error).ToNot(HaveOccurred())
+						mockIpamApi.EXPECT().Enabled().Return(true).AnyTimes()
+					})
+
+					It("releases the reserved VIPs that aren't used anymore", func() {
+						mockSuccess(1)
+						mockClusterUpdatability(1)
+						mockIpamApi.EXPECT().ReleaseUnused(gomock.Any(), clusterID, []string{"10.11.12.15", "10.11.12.16"}).Return(nil).Times(1)
+
+						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.V2ClusterUpdateParams{
+								APIVips:     []*models.APIVip{{IP: "10.11.12.15"}},
+								IngressVips: []*models.IngressVip{{IP: "10.11.12.16"}},
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+					})
+
+					It("reserves new VIPs when they are cleared", func() {
+						mockSuccess(1)
+						mockClusterUpdatability(1)
+						mockIpamApi.EXPECT().Reserve(gomock.Any(), clusterID, "lab", ipam.PurposeAPIVip).
+							Return(&common.IPAMReservation{Address: "10.11.12.15", PrefixLength: 16}, nil).Times(1)
+						mockIpamApi.EXPECT().Reserve(gomock.Any(), clusterID, "lab", ipam.PurposeIngressVip).
+							Return(&common.IPAMReservation{Address: "10.11.12.16", PrefixLength: 16}, nil).Times(1)
+						mockIpamApi.EXPECT().ReleaseUnused(gomock.Any(), clusterID, []string{"10.11.12.15", "10.11.12.16"}).Return(nil).Times(1)
+
+						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.V2ClusterUpdateParams{
+								APIVips:     []*models.APIVip{},
+								IngressVips: []*models.IngressVip{},
+							},
+						})
+						Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterCreated()))
+						actual := reply.(*installer.V2UpdateClusterCreated)
+						Expect(string(actual.Payload.APIVips[0].IP)).To(Equal("10.11.12.15"))
+						Expect(string(actual.Payload.IngressVips[0].IP)).To(Equal("10.11.12.16"))
+					})
+
+					It("releases the new VIPs when the update fails", func() {
+						mockIpamApi.EXPECT().Reserve(gomock.Any(), clusterID, "lab", ipam.PurposeAPIVip).
+							Return(&common.IPAMReservation{Address: "10.11.12.15", PrefixLength: 16}, nil).Times(1)
+						mockIpamApi.EXPECT().ReleaseUnused(gomock.Any(), clusterID, nil).Return(nil).Times(1)
+
+						reply := bm.V2UpdateCluster(ctx, installer.V2UpdateClusterParams{
+							ClusterID: clusterID,
+							ClusterUpdateParams: &models.V2ClusterUpdateParams{
+								APIVips:     []*models.APIVip{},
+								IngressVips: []*models.IngressVip{{IP: "not.an.ip.test"}},
+							},
+						})
+						verifyApiErrorString(reply, http.StatusBadRequest, "Could not parse VIP ip not.an.ip.test")
+					})
+				})
+
 				It("Machine network CIDR in non dhcp", func() {
 					mockClusterUpdatability(1)
 					apiVip := "10.11.12.15"
@@ -5330,6 +5678,41 @@ var _ = Describe("cluster", func() {
 			Expect(count).To(Equal(int64(1)))
 		})
 
+		It("clears the scheduled installation time", func() {
+			scheduledInstallTime := strfmt.DateTime(time.Now().Add(-time.Minute))
+			cluster := *defaultCluster
+			cluster.ScheduledInstallTime = &scheduledInstallTime
+			createCluster(&cluster)
+			mockDetectAndStoreCollidingIPsForCluster(mockClusterApi, 1)
+			mockAutoAssignSuccess(3)
+			mockClusterRefreshStatusSuccess()
+			mockClusterIsReadyForInstallationSuccess()
+			mockGenerateAdditionalManifestsSuccess()
+			mockGetInstallConfigSuccess(mockInstallConfigBuilder)
+			mockGenerateInstallConfigSuccess(mockGenerator, mockVersions)
+			mockClusterPrepareForInstallationSuccess(mockClusterApi)
+			mockHostPrepareForRefresh(mockHostApi)
+			mockHandlePreInstallationSuccess(mockClusterApi, DoneChannel)
+			setDefaultGetMasterNodesIds(mockClusterApi)
+			setDefaultHostSetBootstrap(mockClusterApi)
+			setIsReadyForInstallationTrue(mockClusterApi)
+			mockClusterRefreshStatus(mockClusterApi)
+			mockClusterDeleteLogsSuccess(mockClusterApi)
+			mockSetConnectivityMajorityGroupsForCluster(mockClusterApi)
+			mockRefreshSchedulableMastersForcedTrue(mockClusterApi, 1)
+			mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), gomock.Any()).MinTimes(0)
+
+			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
+				ClusterID: clusterID,
+			})
+
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2InstallClusterAccepted()))
+			waitForDoneChannel()
+			c, err := common.GetClusterFromDB(db, clusterID, common.SkipEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.ScheduledInstallTime).To(BeNil())
+		})
+
 		It("Should send event in case of ignored validations", func() {
 			By("Start installation with ignored validations and expect events", func() {
 				createCluster(clusterWithIgnoredValidations)
@@ -5550,6 +5933,30 @@ var _ = Describe("cluster", func() {
 			verifyApiError(reply, http.StatusNotFound)
 		})
 
+		It("scheduled installation time did not pass", func() {
+			scheduledInstallTime := strfmt.DateTime(time.Now().Add(time.Hour))
+			cluster := *defaultCluster
+			cluster.ScheduledInstallTime = &scheduledInstallTime
+			createCluster(&cluster)
+			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
+				ClusterID: clusterID,
+			})
+			verifyApiErrorString(reply, http.StatusConflict, "installation is scheduled for")
+		})
+
+		It("maintenance window is closed", func() {
+			cluster := *defaultCluster
+			cluster.MaintenanceWindow = &models.MaintenanceWindow{
+				StartTime:       time.Now().Add(2 * time.Hour).UTC().Format("15:04"),
+				DurationMinutes: swag.Int64(60),
+			}
+			createCluster(&cluster)
+			reply := bm.V2InstallCluster(ctx, installer.V2InstallClusterParams{
+				ClusterID: clusterID,
+			})
+			verifyApiErrorString(reply, http.StatusConflict, "waiting for the maintenance window")
+		})
+
 		It("failed to auto-assign role", func() {
 			createCluster(defaultCluster)
 			mockAutoAssignFailed()
@@ -9547,26 +9954,113 @@ location = "%s"
 				Expect(i.StaticNetworkConfig).To(Equal(staticNetworkFormatRes))
 			})
 
-			It("static network usage should be added when StaticNetworkConfig is set", func() {
-				var err error
-
-				cluster := createCluster(db, models.ClusterStatusInstallingPendingUserAction)
-				err = db.Model(&common.InfraEnv{}).Where("id = ?", *i.ID).Update("cluster_id", cluster.ID).Error
-				Expect(err).ToNot(HaveOccurred())
-
+			It("Update StaticNetwork from a template", func() {
+				mockInfraEnvUpdateSuccess()
 				staticNetworkFormatRes := "static network format result"
-				map1 := models.MacInterfaceMap{
-					&models.MacInterfaceMapItems0{MacAddress: "mac10", LogicalNicName: "nic10"},
-				}
-				staticNetworkConfig := []*models.HostStaticNetworkConfig{
-					common.FormatStaticConfigHostYAML("0200003ef74c", "02000048ba48", "192.168.126.41", "192.168.141.41", "192.168.126.1", map1),
+				template := &models.StaticNetworkConfigTemplate{
+					NetworkYamlTemplate: swag.String("interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n  ipv4:\n    enabled: true\n    address:\n    - ip: {{.IP}}\n      prefix-length: 24\n"),
+					IPRangeStart:        "192.168.126.40",
+					Hosts: []*models.StaticNetworkConfigTemplateHost{
+						{MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "02:00:00:3e:f7:4c", LogicalNicName: "eth0"}}},
+						{MacInterfaceMap: models.MacInterfaceMap{{MacAddress: "02:00:00:3e:f7:3c", LogicalNicName: "eth0"}}},
+					},
 				}
-
-				mockInfraEnvUpdateSuccess()
+				staticNetworkConfig, err := staticnetworkconfig.ExpandStaticNetworkConfigTemplate(context.Background(), template, nil, "", "")
+				Expect(err).ToNot(HaveOccurred())
 				mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParamsYAML(staticNetworkConfig, "4.6", "x86_64", "").Return(nil).Times(1)
 				mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(staticNetworkConfig).Return(staticNetworkFormatRes, nil).Times(1)
-				mockUsage.EXPECT().Add(gomock.Any(), usage.StaticNetworkConfigUsage, nil).Times(1)
-				mockUsage.EXPECT().Save(gomock.Any(), *cluster.ID, gomock.Any()).Times(1)
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						StaticNetworkConfigTemplate: template,
+					},
+				})
+				Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+				i, err = bm.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: *i.ID})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(i.StaticNetworkConfig).To(Equal(staticNetworkFormatRes))
+			})
+
+			It("Update StaticNetwork with both a template and a static network config", func() {
+				reply := bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+					InfraEnvID: *i.ID,
+					InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+						StaticNetworkConfig: []*models.HostStaticNetworkConfig{},
+						StaticNetworkConfigTemplate: &models.StaticNetworkConfigTemplate{
+							NetworkYamlTemplate: swag.String("interfaces: []"),
+							Hosts:               []*models.StaticNetworkConfigTemplateHost{{}},
+						},
+					},
+				})
+				verifyApiErrorString(reply, http.StatusBadRequest, "static_network_config and static_network_config_template can't be set together")
+			})
+
+			Context("IPAM", func() {
+				BeforeEach(func() {
+					Expect(db.Model(&common.InfraEnv{}).Where("id = ?", *i.ID).Update("ipam_pool", "lab").Error).ToNot(HaveOccurred())
+					mockIpamApi.EXPECT().Enabled().Return(true).AnyTimes()
+				})
+
+				updateStaticNetwork := func() middleware.Responder {
+					return bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
+						InfraEnvID: *i.ID,
+						InfraEnvUpdateParams: &models.InfraEnvUpdateParams{
+							StaticNetworkConfig: []*models.HostStaticNetworkConfig{
+								{NetworkYaml: "interfaces:\n- name: eth0\n  type: ethernet\n  state: up\n  ipv4:\n    enabled: true\n"},
+							},
+						},
+					})
+				}
+
+				It("reserves the addresses of the new static network config and releases the previous ones", func() {
+					mockInfraEnvUpdateSuccess()
+					mockIpamApi.EXPECT().Reserve(gomock.Any(), *i.ID, "lab", "eth0 ipv4").
+						Return(&common.IPAMReservation{Address: "10.0.0.8", PrefixLength: 24}, nil).Times(1)
+					mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any(), "4.6", "x86_64", "").Return(nil).Times(1)
+					mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(gomock.Any()).DoAndReturn(
+						func(staticNetworkConfig []*models.HostStaticNetworkConfig) (string, error) {
+							Expect(staticNetworkConfig[0].NetworkYaml).To(ContainSubstring("ip: 10.0.0.8"))
+							b, err := json.Marshal(staticNetworkConfig)
+							return string(b), err
+						}).Times(1)
+					mockIpamApi.EXPECT().ReleaseUnused(gomock.Any(), *i.ID, []string{"10.0.0.8"}).Return(nil).Times(1)
+
+					reply := updateStaticNetwork()
+					Expect(reply).To(BeAssignableToTypeOf(installer.NewUpdateInfraEnvCreated()))
+				})
+
+				It("releases the new addresses when the update fails", func() {
+					mockIpamApi.EXPECT().Reserve(gomock.Any(), *i.ID, "lab", "eth0 ipv4").
+						Return(&common.IPAMReservation{Address: "10.0.0.8", PrefixLength: 24}, nil).Times(1)
+					mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParamsYAML(gomock.Any(), "4.6", "x86_64", "").
+						Return(errors.New("invalid static network config")).Times(1)
+					mockIpamApi.EXPECT().ReleaseUnused(gomock.Any(), *i.ID, nil).Return(nil).Times(1)
+
+					reply := updateStaticNetwork()
+					verifyApiErrorString(reply, http.StatusBadRequest, "invalid static network config")
+				})
+			})
+
+			It("static network usage should be added when StaticNetworkConfig is set", func() {
+				var err error
+
+				cluster := createCluster(db, models.ClusterStatusInstallingPendingUserAction)
+				err = db.Model(&common.InfraEnv{}).Where("id = ?", *i.ID).Update("cluster_id", cluster.ID).Error
+				Expect(err).ToNot(HaveOccurred())
+
+				staticNetworkFormatRes := "static network format result"
+				map1 := models.MacInterfaceMap{
+					&models.MacInterfaceMapItems0{MacAddress: "mac10", LogicalNicName: "nic10"},
+				}
+				staticNetworkConfig := []*models.HostStaticNetworkConfig{
+					common.FormatStaticConfigHostYAML("0200003ef74c", "02000048ba48", "192.168.126.41", "192.168.141.41", "192.168.126.1", map1),
+				}
+
+				mockInfraEnvUpdateSuccess()
+				mockStaticNetworkConfig.EXPECT().ValidateStaticConfigParamsYAML(staticNetworkConfig, "4.6", "x86_64", "").Return(nil).Times(1)
+				mockStaticNetworkConfig.EXPECT().FormatStaticNetworkConfigForDB(staticNetworkConfig).Return(staticNetworkFormatRes, nil).Times(1)
+				mockUsage.EXPECT().Add(gomock.Any(), usage.StaticNetworkConfigUsage, nil).Times(1)
+				mockUsage.EXPECT().Save(gomock.Any(), *cluster.ID, gomock.Any()).Times(1)
 
 				bm.UpdateInfraEnv(ctx, installer.UpdateInfraEnvParams{
 					InfraEnvID: *i.ID,
@@ -13278,6 +13772,126 @@ var _ = Describe("InstallSingleDay2Host test", func() {
 	})
 })
 
+var _ = Describe("verifyDay2ControlPlaneCanJoin", func() {
+
+	var (
+		bm        *bareMetalInventory
+		cfg       Config
+		db        *gorm.DB
+		clusterID strfmt.UUID
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		err := db.Create(&common.Cluster{
+			Cluster: models.Cluster{
+				ID:               &clusterID,
+				Kind:             swag.String(models.ClusterKindAddHostsCluster),
+				OpenshiftVersion: common.TestDefaultConfig.OpenShiftVersion,
+				Status:           swag.String(models.ClusterStatusAddingHosts),
+			},
+			ControlPlaneCount: 4,
+		}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		bm = createInventory(db, cfg)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	addControlPlaneHost := func(role models.HostRole, status string) *models.Host {
+		hostID := strfmt.UUID(uuid.New().String())
+		h := addHost(hostID, role, status, models.HostKindAddToExistingClusterHost, clusterID, clusterID,
+			getInventoryStr("hostname-"+hostID.String(), "bootMode", "1.2.3.4/24"), db)
+		if role == models.HostRoleAutoAssign {
+			Expect(db.Model(&h).Update("suggested_role", models.HostRoleMaster).Error).ShouldNot(HaveOccurred())
+			h.SuggestedRole = models.HostRoleMaster
+		}
+		return &h
+	}
+
+	It("allows a control plane host when no other one is joining", func() {
+		addControlPlaneHost(models.HostRoleMaster, models.HostStatusAddedToExistingCluster)
+		h := addControlPlaneHost(models.HostRoleMaster, models.HostStatusKnown)
+		Expect(bm.verifyDay2ControlPlaneCanJoin(h)).To(Succeed())
+	})
+
+	It("refuses a control plane host while an auto-assigned control plane host is joining", func() {
+		addControlPlaneHost(models.HostRoleAutoAssign, models.HostStatusInstallingInProgress)
+		h := addControlPlaneHost(models.HostRoleMaster, models.HostStatusKnown)
+		err := bm.verifyDay2ControlPlaneCanJoin(h)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+		Expect(err.Error()).To(ContainSubstring("is joining the cluster"))
+	})
+
+	It("refuses a control plane host beyond the control plane count of the cluster", func() {
+		for i := 0; i < 4; i++ {
+			addControlPlaneHost(models.HostRoleMaster, models.HostStatusAddedToExistingCluster)
+		}
+		h := addControlPlaneHost(models.HostRoleAutoAssign, models.HostStatusKnown)
+		err := bm.verifyDay2ControlPlaneCanJoin(h)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+		Expect(err.Error()).To(ContainSubstring("already has its 4 nodes"))
+	})
+
+	It("ignores worker hosts", func() {
+		addControlPlaneHost(models.HostRoleMaster, models.HostStatusInstallingInProgress)
+		h := addControlPlaneHost(models.HostRoleWorker, models.HostStatusKnown)
+		Expect(bm.verifyDay2ControlPlaneCanJoin(h)).To(Succeed())
+	})
+})
+
+var _ = Describe("validateDay2ControlPlaneExpansion", func() {
+	newDay2Cluster := func(highAvailabilityMode, openshiftVersion string, controlPlaneCount int64) *common.Cluster {
+		return &common.Cluster{
+			Cluster: models.Cluster{
+				Kind:                 swag.String(models.ClusterKindAddHostsCluster),
+				HighAvailabilityMode: swag.String(highAvailabilityMode),
+				OpenshiftVersion:     openshiftVersion,
+				CPUArchitecture:      models.ClusterCPUArchitectureX8664,
+				Platform:             &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeBaremetal)},
+			},
+			ControlPlaneCount: controlPlaneCount,
+		}
+	}
+
+	DescribeTable("accepts",
+		func(cluster *common.Cluster, controlPlaneCount int64) {
+			Expect(validateDay2ControlPlaneExpansion(cluster, controlPlaneCount)).To(Succeed())
+		},
+		Entry("the current control plane count", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "4.16", 3), int64(3)),
+		Entry("the default control plane count of an imported cluster without version", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "", 0), int64(3)),
+		Entry("an expansion to 4 nodes with OCP 4.18", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "4.18", 3), int64(4)),
+		Entry("an expansion to 5 nodes with OCP 4.18.3", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "4.18.3", 4), int64(5)),
+	)
+
+	DescribeTable("rejects",
+		func(cluster *common.Cluster, controlPlaneCount int64, expectedErr string) {
+			err := validateDay2ControlPlaneExpansion(cluster, controlPlaneCount)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+		Entry("the expansion of a single-node cluster", newDay2Cluster(models.ClusterHighAvailabilityModeNone, "4.18", 1), int64(3),
+			"the control plane of single-node clusters can not be expanded"),
+		Entry("a smaller control plane", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "4.18", 4), int64(3),
+			"the control plane can not be shrunk from 4 to 3 nodes"),
+		Entry("more than 5 nodes", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "4.18", 3), int64(6),
+			"the control plane can only be expanded to 3-5 nodes"),
+		Entry("an expansion without the openshift version", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "", 3), int64(4),
+			"the openshift version of the cluster must be known"),
+		Entry("an expansion with OCP older than 4.18", newDay2Cluster(models.ClusterHighAvailabilityModeFull, "4.16", 3), int64(4),
+			"expanding the control plane to 4 nodes is not supported for openshift version 4.16"),
+	)
+})
+
 var _ = Describe("Transform day1 cluster to a day2 cluster test", func() {
 
 	var (
@@ -13320,6 +13934,185 @@ var _ = Describe("Transform day1 cluster to a day2 cluster test", func() {
 	})
 })
 
+var _ = Describe("ClusterBatches", func() {
+	var (
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		dbName string
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		db, dbName = common.PrepareTestDB()
+		bm = createInventory(db, cfg)
+		mockUsageReports()
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+		ctrl.Finish()
+	})
+
+	createBatchWithClusters := func(statuses ...string) strfmt.UUID {
+		batchID := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.ClusterBatch{ID: &batchID, Name: swag.String("edge")}).Error).ShouldNot(HaveOccurred())
+		for i, status := range statuses {
+			clusterID := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+				ID:             &clusterID,
+				Name:           fmt.Sprintf("site-%d", i),
+				Status:         swag.String(status),
+				ClusterBatchID: batchID,
+			}}).Error).ShouldNot(HaveOccurred())
+		}
+		return batchID
+	}
+
+	Context("create", func() {
+		var batchParams *models.ClusterBatchCreateParams
+
+		BeforeEach(func() {
+			bm.clusterApi = cluster.NewManager(cluster.Config{}, common.GetTestLog().WithField("pkg", "cluster-monitor"),
+				db, commontesting.GetDummyNotificationStream(ctrl), mockEvents, nil, nil, nil, nil, nil, mockOperatorManager, nil, nil, nil, nil, nil, false)
+			clusterTemplate := getDefaultClusterCreateParams()
+			clusterTemplate.BaseDNSDomain = "example.com"
+			batchParams = &models.ClusterBatchCreateParams{
+				Name:            swag.String("edge"),
+				ClusterTemplate: clusterTemplate,
+				Sites: []*models.ClusterBatchSite{
+					{Name: swag.String("site-a"), BaseDNSDomain: "a.example.com"},
+					{Name: swag.String("site-b")},
+				},
+			}
+		})
+
+		mockSiteSuccess := func() {
+			mockClusterRegisterSuccessWithVersion(models.ClusterCPUArchitectureX8664, common.TestDefaultConfig.OpenShiftVersion)
+			mockAMSSubscription(ctx)
+			mockInfraEnvRegisterSuccess()
+			mockEvents.EXPECT().SendInfraEnvEvent(ctx, eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvRegisteredEventName))).Times(1)
+		}
+
+		It("creates a cluster and an infra-env for each site", func() {
+			mockSiteSuccess()
+			mockSiteSuccess()
+			reply := bm.V2CreateClusterBatch(ctx, installer.V2CreateClusterBatchParams{NewClusterBatchParams: batchParams})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2CreateClusterBatchCreated()))
+			batch := reply.(*installer.V2CreateClusterBatchCreated).Payload
+			Expect(swag.StringValue(batch.Name)).To(Equal("edge"))
+			Expect(batch.Status).To(Equal(models.ClusterBatchStatusPending))
+			Expect(batch.Members).To(HaveLen(2))
+			Expect(batch.Members[0].SiteName).To(Equal("site-a"))
+			Expect(batch.Members[1].SiteName).To(Equal("site-b"))
+
+			c, err := common.GetClusterFromDB(db, batch.Members[0].ClusterID, common.SkipEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.BaseDNSDomain).To(Equal("a.example.com"))
+			Expect(c.ClusterBatchID).To(Equal(*batch.ID))
+			c, err = common.GetClusterFromDB(db, batch.Members[1].ClusterID, common.SkipEagerLoading)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.BaseDNSDomain).To(Equal("example.com"))
+
+			infraEnv, err := common.GetInfraEnvFromDB(db, batch.Members[1].InfraEnvID)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(swag.StringValue(infraEnv.Name)).To(Equal("site-b"))
+			Expect(infraEnv.ClusterID).To(Equal(batch.Members[1].ClusterID))
+		})
+
+		It("rejects duplicate site names", func() {
+			batchParams.Sites[1].Name = swag.String("site-a")
+			reply := bm.V2CreateClusterBatch(ctx, installer.V2CreateClusterBatchParams{NewClusterBatchParams: batchParams})
+			verifyApiErrorString(reply, http.StatusBadRequest, "site name site-a is used more than once in the batch")
+		})
+
+		It("rejects more sites than the maximum", func() {
+			bm.MaxClusterBatchSites = 1
+			reply := bm.V2CreateClusterBatch(ctx, installer.V2CreateClusterBatchParams{NewClusterBatchParams: batchParams})
+			verifyApiErrorString(reply, http.StatusBadRequest, "a cluster batch can't have more than 1 sites, got 2")
+		})
+
+		It("removes the created sites when a site fails", func() {
+			mockSiteSuccess()
+			mockInfraEnvDeRegisterSuccess()
+			mockEvents.EXPECT().SendInfraEnvEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.InfraEnvDeregisteredEventName))).Times(1)
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterDeregisteredEventName))).AnyTimes()
+			batchParams.Sites[1].APIVips = []*models.APIVip{{IP: "1.2.3.4"}}
+			batchParams.Sites[1].IngressVips = []*models.IngressVip{{IP: "1.2.3.4"}}
+
+			reply := bm.V2CreateClusterBatch(ctx, installer.V2CreateClusterBatchParams{NewClusterBatchParams: batchParams})
+			verifyApiErrorString(reply, http.StatusBadRequest, "failed to create site site-b")
+
+			clusters, err := common.GetClustersFromDBWhere(db, common.SkipEagerLoading, common.SkipDeletedRecords)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(clusters).To(BeEmpty())
+			var batches []*models.ClusterBatch
+			Expect(db.Find(&batches).Error).ShouldNot(HaveOccurred())
+			Expect(batches).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("aggregated status",
+		func(statuses []string, expected string) {
+			batchID := createBatchWithClusters(statuses...)
+			reply := bm.V2GetClusterBatch(ctx, installer.V2GetClusterBatchParams{ClusterBatchID: batchID})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2GetClusterBatchOK()))
+			batch := reply.(*installer.V2GetClusterBatchOK).Payload
+			Expect(batch.Status).To(Equal(expected))
+			Expect(batch.Members).To(HaveLen(len(statuses)))
+		},
+		Entry("pending", []string{models.ClusterStatusReady, models.ClusterStatusInsufficient}, models.ClusterBatchStatusPending),
+		Entry("installing", []string{models.ClusterStatusInstalling, models.ClusterStatusError}, models.ClusterBatchStatusInstalling),
+		Entry("error", []string{models.ClusterStatusInstalled, models.ClusterStatusError}, models.ClusterBatchStatusError),
+		Entry("cancelled", []string{models.ClusterStatusInstalled, models.ClusterStatusCancelled}, models.ClusterBatchStatusCancelled),
+		Entry("installed", []string{models.ClusterStatusInstalled, models.ClusterStatusInstalled}, models.ClusterBatchStatusInstalled),
+	)
+
+	It("counts the clusters in each status", func() {
+		batchID := createBatchWithClusters(models.ClusterStatusInstalled, models.ClusterStatusInstalled, models.ClusterStatusError)
+		reply := bm.V2ListClusterBatches(ctx, installer.V2ListClusterBatchesParams{})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2ListClusterBatchesOK()))
+		batches := reply.(*installer.V2ListClusterBatchesOK).Payload
+		Expect(batches).To(HaveLen(1))
+		Expect(*batches[0].ID).To(Equal(batchID))
+		Expect(batches[0].StatusCounts).To(Equal(map[string]int64{
+			models.ClusterStatusInstalled: 2,
+			models.ClusterStatusError:     1,
+		}))
+	})
+
+	It("returns not found for a missing batch", func() {
+		reply := bm.V2GetClusterBatch(ctx, installer.V2GetClusterBatchParams{ClusterBatchID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+
+	It("skips installed and installing clusters when installing the batch", func() {
+		batchID := createBatchWithClusters(models.ClusterStatusInstalled, models.ClusterStatusInstalling)
+		reply := bm.V2InstallClusterBatch(ctx, installer.V2InstallClusterBatchParams{ClusterBatchID: batchID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2InstallClusterBatchAccepted()))
+		Expect(reply.(*installer.V2InstallClusterBatchAccepted).Payload.Status).To(Equal(models.ClusterBatchStatusInstalling))
+	})
+
+	It("cancels only the installing clusters", func() {
+		batchID := createBatchWithClusters(models.ClusterStatusInstalled, models.ClusterStatusInstalling, models.ClusterStatusFinalizing)
+		mockClusterApi.EXPECT().CancelInstallation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		reply := bm.V2CancelClusterBatch(ctx, installer.V2CancelClusterBatchParams{ClusterBatchID: batchID})
+		Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2CancelClusterBatchAccepted()))
+	})
+
+	It("reports the clusters that failed to cancel", func() {
+		batchID := createBatchWithClusters(models.ClusterStatusInstalling)
+		mockClusterApi.EXPECT().CancelInstallation(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(common.NewApiError(http.StatusConflict, errors.New("cannot cancel"))).Times(1)
+		reply := bm.V2CancelClusterBatch(ctx, installer.V2CancelClusterBatchParams{ClusterBatchID: batchID})
+		verifyApiErrorString(reply, http.StatusConflict, "cluster site-0: cannot cancel")
+	})
+})
+
 var _ = Describe("RegisterCluster", func() {
 	var (
 		bm     *bareMetalInventory
@@ -13397,6 +14190,69 @@ var _ = Describe("RegisterCluster", func() {
 		Entry("SDN not supported for versions above 4.15", "4.17.6", models.ClusterCreateParamsNetworkTypeOpenShiftSDN),
 	)
 
+	Context("IPAM", func() {
+		It("reserves the VIPs in the IPAM pool", func() {
+			mockClusterRegisterSuccess(true)
+			mockAMSSubscription(ctx)
+			mockIpamApi.EXPECT().Enabled().Return(true).AnyTimes()
+			mockIpamApi.EXPECT().Reserve(ctx, gomock.Any(), "lab", ipam.PurposeAPIVip).
+				Return(&common.IPAMReservation{Address: "10.0.0.5", PrefixLength: 24}, nil).Times(1)
+			mockIpamApi.EXPECT().Reserve(ctx, gomock.Any(), "lab", ipam.PurposeIngressVip).
+				Return(&common.IPAMReservation{Address: "10.0.0.6", PrefixLength: 24}, nil).Times(1)
+
+			clusterParams := getDefaultClusterCreateParams()
+			clusterParams.IpamPool = "lab"
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: clusterParams,
+			})
+			Expect(reply).Should(BeAssignableToTypeOf(installer.NewV2RegisterClusterCreated()))
+			actual := reply.(*installer.V2RegisterClusterCreated).Payload
+			Expect(actual.IpamPool).To(Equal("lab"))
+			Expect(network.GetApiVipById(&common.Cluster{Cluster: *actual}, 0)).To(Equal("10.0.0.5"))
+			Expect(network.GetIngressVipById(&common.Cluster{Cluster: *actual}, 0)).To(Equal("10.0.0.6"))
+		})
+
+		It("fails when the IPAM isn't configured", func() {
+			mockIpamApi.EXPECT().Enabled().Return(false).AnyTimes()
+
+			clusterParams := getDefaultClusterCreateParams()
+			clusterParams.IpamPool = "lab"
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: clusterParams,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, ipam.ErrDisabled.Error())
+		})
+
+		It("fails when the VIPs are set as well", func() {
+			mockIpamApi.EXPECT().Enabled().Return(true).AnyTimes()
+			mockIpamApi.EXPECT().Release(ctx, gomock.Any()).Return(nil).Times(1)
+
+			clusterParams := getDefaultClusterCreateParams()
+			clusterParams.IpamPool = "lab"
+			clusterParams.APIVips = []*models.APIVip{{IP: "10.0.0.5"}}
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: clusterParams,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, "they can't be set as well")
+		})
+
+		It("releases the reserved VIPs when the registration fails", func() {
+			mockIpamApi.EXPECT().Enabled().Return(true).AnyTimes()
+			mockIpamApi.EXPECT().Reserve(ctx, gomock.Any(), "lab", ipam.PurposeAPIVip).
+				Return(&common.IPAMReservation{Address: "10.0.0.5", PrefixLength: 24}, nil).Times(1)
+			mockIpamApi.EXPECT().Reserve(ctx, gomock.Any(), "lab", ipam.PurposeIngressVip).
+				Return(nil, errors.New("IPAM pool lab has no free address")).Times(1)
+			mockIpamApi.EXPECT().Release(ctx, gomock.Any()).Return(nil).Times(1)
+
+			clusterParams := getDefaultClusterCreateParams()
+			clusterParams.IpamPool = "lab"
+			reply := bm.V2RegisterCluster(ctx, installer.V2RegisterClusterParams{
+				NewClusterParams: clusterParams,
+			})
+			verifyApiErrorString(reply, http.StatusBadRequest, "no free address")
+		})
+	})
+
 	// Testing the default network type setting during register flow, with various combinations of platforms,
 	// OpenShift version and high availability modes
 	// Note: SNO is supported starting OpenShift v4.8, and currently only supports Platform "none"
@@ -16964,6 +17820,27 @@ var _ = Describe("BindHost", func() {
 		Expect(response).To(BeAssignableToTypeOf(&installer.V2DeregisterClusterNoContent{}))
 	})
 
+	It("Deregister cluster - decommission bound hosts", func() {
+		var hostObj models.Host
+		Expect(db.First(&hostObj, "id = ?", hostID).Error).ShouldNot(HaveOccurred())
+		Expect(db.Model(&hostObj).Updates(map[string]interface{}{"cluster_id": clusterID, "status": models.HostStatusInstalled}).Error).ShouldNot(HaveOccurred())
+		deregisterParams := installer.V2DeregisterClusterParams{
+			ClusterID:         clusterID,
+			DecommissionHosts: swag.Bool(true),
+		}
+		mockAccountsMgmt.EXPECT().GetSubscription(ctx, gomock.Any()).Return(&amgmtv1.Subscription{}, nil)
+		mockClusterApi.EXPECT().DeregisterCluster(ctx, gomock.Any())
+		mockHostApi.EXPECT().UnbindHost(ctx, gomock.Any(), gomock.Any(), true).DoAndReturn(
+			func(ctx context.Context, h *models.Host, db *gorm.DB, reclaim bool) error {
+				var dbHost common.Host
+				Expect(db.Take(&dbHost, "id = ?", h.ID.String()).Error).ToNot(HaveOccurred())
+				Expect(dbHost.WipeDisksOnReclaim).To(BeTrue())
+				return nil
+			}).Times(1)
+		response := bm.V2DeregisterCluster(ctx, deregisterParams)
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2DeregisterClusterNoContent{}))
+	})
+
 	It("Deregister cluster - mixed bind host", func() {
 		infraEnv2ID := strfmt.UUID(uuid.New().String())
 		err := db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnv2ID, ClusterID: clusterID}}).Error
@@ -17300,6 +18177,292 @@ var _ = Describe("UnbindHost", func() {
 		verifyApiError(response, http.StatusInternalServerError)
 	})
 
+	Context("decommission", func() {
+		var params installer.UnbindHostParams
+
+		BeforeEach(func() {
+			params = installer.UnbindHostParams{
+				HostID:     hostID,
+				InfraEnvID: infraEnvID,
+			}
+		})
+
+		It("marks a reclaimed host for disk wipe", func() {
+			mockHostApi.EXPECT().UnbindHost(ctx, gomock.Any(), gomock.Any(), true).DoAndReturn(
+				func(ctx context.Context, h *models.Host, db *gorm.DB, reclaim bool) error {
+					// the host is marked for disk wipe before the transition
+					Expect(h.WipeDisksOnReclaim).To(BeTrue())
+					var dbHost common.Host
+					Expect(db.Take(&dbHost, "id = ?", h.ID.String()).Error).ToNot(HaveOccurred())
+					Expect(dbHost.WipeDisksOnReclaim).To(BeTrue())
+					return db.Model(&common.Host{}).Where("id = ?", h.ID.String()).Update("status", models.HostStatusReclaiming).Error
+				}).Times(1)
+			mockClusterApi.EXPECT().RefreshSchedulableMastersForcedTrue(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			h, err := bm.DecommissionHostInternal(ctx, params)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(h.WipeDisksOnReclaim).To(BeTrue())
+			dbHost, err := common.GetHostFromDB(db, infraEnvID.String(), hostID.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbHost.WipeDisksOnReclaim).To(BeTrue())
+		})
+
+		It("doesn't mark a host that was not reclaimed", func() {
+			mockHostApi.EXPECT().UnbindHost(ctx, gomock.Any(), gomock.Any(), true).DoAndReturn(
+				func(ctx context.Context, h *models.Host, db *gorm.DB, reclaim bool) error {
+					Expect(h.WipeDisksOnReclaim).To(BeTrue())
+					return db.Model(&common.Host{}).Where("id = ?", h.ID.String()).
+						Updates(map[string]interface{}{"status": models.HostStatusUnbinding, "wipe_disks_on_reclaim": false}).Error
+				}).Times(1)
+			mockClusterApi.EXPECT().RefreshSchedulableMastersForcedTrue(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			h, err := bm.DecommissionHostInternal(ctx, params)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(h.WipeDisksOnReclaim).To(BeFalse())
+			dbHost, err := common.GetHostFromDB(db, infraEnvID.String(), hostID.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dbHost.WipeDisksOnReclaim).To(BeFalse())
+		})
+
+		It("fails when the host can't be unbound", func() {
+			mockHostApi.EXPECT().UnbindHost(ctx, gomock.Any(), gomock.Any(), true).Return(errors.Errorf("Transition failed")).Times(1)
+			_, err := bm.DecommissionHostInternal(ctx, params)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("Disk erasure", func() {
+	var (
+		bm         *bareMetalInventory
+		cfg        Config
+		db         *gorm.DB
+		ctx        = context.Background()
+		hostID     strfmt.UUID
+		infraEnvID strfmt.UUID
+		dbName     string
+		pub        string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		hostID = strfmt.UUID(uuid.New().String())
+		infraEnvID = strfmt.UUID(uuid.New().String())
+		bm = createInventory(db, cfg)
+		var priv string
+		var err error
+		pub, priv, err = gencrypto.ECDSAKeyPairPEM()
+		Expect(err).NotTo(HaveOccurred())
+		os.Setenv("EC_PRIVATE_KEY_PEM", priv)
+		Expect(db.Create(&common.InfraEnv{InfraEnv: models.InfraEnv{ID: &infraEnvID}}).Error).ShouldNot(HaveOccurred())
+		inventory := models.Inventory{
+			SystemVendor: &models.SystemVendor{SerialNumber: "host-serial"},
+			Disks: []*models.Disk{
+				{ID: "/dev/disk/by-id/wwn-0x1111", Path: "/dev/sda", Serial: "disk-serial-1"},
+				{ID: "/dev/disk/by-id/wwn-0x2222", Path: "/dev/nvme0n1", Serial: "disk-serial-2"},
+				{ID: "/dev/disk/by-id/wwn-0x3333", Path: "/dev/sr0", IsInstallationMedia: true},
+			},
+		}
+		inventoryBytes, err := json.Marshal(&inventory)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.Create(&common.Host{Host: models.Host{
+			ID:         &hostID,
+			InfraEnvID: infraEnvID,
+			Status:     swag.String(models.HostStatusKnownUnbound),
+			Inventory:  string(inventoryBytes),
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		common.DeleteTestDB(db, dbName)
+	})
+
+	eraseDisks := func(diskIDs ...string) middleware.Responder {
+		return bm.V2EraseHostDisks(ctx, installer.V2EraseHostDisksParams{
+			HostID:          hostID,
+			InfraEnvID:      infraEnvID,
+			DiskEraseParams: &models.DiskEraseParams{DiskIds: diskIDs},
+		})
+	}
+
+	getHost := func() *common.Host {
+		h, err := common.GetHostFromDB(db, infraEnvID.String(), hostID.String())
+		Expect(err).ShouldNot(HaveOccurred())
+		return h
+	}
+
+	Context("V2EraseHostDisks", func() {
+		It("marks the disks of an unbound host for erasure", func() {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiskErasureStartedEventName),
+				eventstest.WithHostIdMatcher(hostID.String()),
+				eventstest.WithInfraEnvIdMatcher(infraEnvID.String())))
+			response := eraseDisks("/dev/disk/by-id/wwn-0x1111", "/dev/disk/by-id/wwn-0x2222")
+			Expect(response).To(BeAssignableToTypeOf(&installer.V2EraseHostDisksAccepted{}))
+			Expect(getHost().DisksToErase).To(Equal("/dev/disk/by-id/wwn-0x1111,/dev/disk/by-id/wwn-0x2222"))
+		})
+
+		It("rejects a host that is bound to a cluster", func() {
+			clusterID := strfmt.UUID(uuid.New().String())
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("cluster_id", clusterID).Error).ShouldNot(HaveOccurred())
+			verifyApiError(eraseDisks("/dev/disk/by-id/wwn-0x1111"), http.StatusConflict)
+		})
+
+		It("rejects a host that is not known or insufficient", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("status", models.HostStatusReclaiming).Error).ShouldNot(HaveOccurred())
+			verifyApiError(eraseDisks("/dev/disk/by-id/wwn-0x1111"), http.StatusConflict)
+		})
+
+		It("rejects a host with an erasure in progress", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("disks_to_erase", "/dev/disk/by-id/wwn-0x1111").Error).ShouldNot(HaveOccurred())
+			verifyApiError(eraseDisks("/dev/disk/by-id/wwn-0x2222"), http.StatusConflict)
+		})
+
+		It("rejects an unknown disk", func() {
+			verifyApiError(eraseDisks("/dev/disk/by-id/wwn-0x4444"), http.StatusBadRequest)
+		})
+
+		It("rejects the installation media", func() {
+			verifyApiError(eraseDisks("/dev/disk/by-id/wwn-0x3333"), http.StatusBadRequest)
+		})
+
+		It("rejects the erasure when the reports can't be signed", func() {
+			os.Unsetenv("EC_PRIVATE_KEY_PEM")
+			verifyApiError(eraseDisks("/dev/disk/by-id/wwn-0x1111"), http.StatusConflict)
+			Expect(getHost().DisksToErase).To(BeEmpty())
+		})
+
+		It("resets the dispatch time of a previous erasure", func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("disk_erase_started_at", time.Now()).Error).ShouldNot(HaveOccurred())
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), gomock.Any())
+			Expect(eraseDisks("/dev/disk/by-id/wwn-0x1111")).To(BeAssignableToTypeOf(&installer.V2EraseHostDisksAccepted{}))
+			Expect(getHost().DiskEraseStartedAt.IsZero()).To(BeTrue())
+		})
+	})
+
+	It("keeps the erasure pending when its report can't be signed", func() {
+		Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Updates(map[string]interface{}{
+			"disks_to_erase":        "/dev/disk/by-id/wwn-0x1111",
+			"disk_erase_started_at": time.Now(),
+		}).Error).ShouldNot(HaveOccurred())
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		response := models.DiskEraseResponse{Results: []*models.DiskEraseResult{
+			{DiskID: "/dev/disk/by-id/wwn-0x1111", Method: models.DiskEraseMethodOverwrite, Status: models.DiskEraseStatusSucceeded},
+		}}
+		responseBytes, err := json.Marshal(&response)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bm.processDiskEraseResponse(ctx, &getHost().Host, string(responseBytes))).ToNot(Succeed())
+		Expect(getHost().DisksToErase).To(Equal("/dev/disk/by-id/wwn-0x1111"))
+	})
+
+	It("rejects binding a host with an erasure in progress", func() {
+		Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("disks_to_erase", "/dev/disk/by-id/wwn-0x1111").Error).ShouldNot(HaveOccurred())
+		clusterID := strfmt.UUID(uuid.New().String())
+		_, err := bm.BindHostInternal(ctx, installer.BindHostParams{
+			HostID:         hostID,
+			InfraEnvID:     infraEnvID,
+			BindHostParams: &models.BindHostParams{ClusterID: &clusterID},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(Equal(int32(http.StatusConflict)))
+	})
+
+	Context("disk erase step reply", func() {
+		var uploaded *models.DiskErasureReport
+
+		BeforeEach(func() {
+			Expect(db.Model(&common.Host{}).Where("id = ?", hostID.String()).Update("disks_to_erase", "/dev/disk/by-id/wwn-0x1111,/dev/disk/by-id/wwn-0x2222").Error).ShouldNot(HaveOccurred())
+			uploaded = nil
+			mockS3Client.EXPECT().Upload(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, data []byte, objectName string) error {
+					Expect(objectName).To(HavePrefix(fmt.Sprintf("%s/hosts/%s/disk-erasure-reports/", infraEnvID, hostID)))
+					uploaded = &models.DiskErasureReport{}
+					return json.Unmarshal(data, uploaded)
+				}).Times(1)
+		})
+
+		It("stores a signed report when all the disks were erased", func() {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiskErasureSucceededEventName),
+				eventstest.WithHostIdMatcher(hostID.String())))
+
+			response := models.DiskEraseResponse{Results: []*models.DiskEraseResult{
+				{DiskID: "/dev/disk/by-id/wwn-0x1111", Method: models.DiskEraseMethodAtaSecureErase, Status: models.DiskEraseStatusSucceeded},
+				{DiskID: "/dev/disk/by-id/wwn-0x2222", Method: models.DiskEraseMethodNvmeSanitize, Status: models.DiskEraseStatusSucceeded},
+			}}
+			responseBytes, err := json.Marshal(&response)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bm.processDiskEraseResponse(ctx, &getHost().Host, string(responseBytes))).To(Succeed())
+
+			Expect(uploaded).ToNot(BeNil())
+			Expect(uploaded.HostSerial).To(Equal("host-serial"))
+			Expect(uploaded.Disks).To(HaveLen(2))
+			Expect(uploaded.Disks[0].Serial).To(Equal("disk-serial-1"))
+			Expect(uploaded.Disks[1].Path).To(Equal("/dev/nvme0n1"))
+			Expect(getHost().DisksToErase).To(BeEmpty())
+
+			publicKey, err := jwt.ParseECPublicKeyFromPEM([]byte(pub))
+			Expect(err).ShouldNot(HaveOccurred())
+			token, err := jwt.Parse(uploaded.Signature, func(t *jwt.Token) (interface{}, error) { return publicKey, nil })
+			Expect(err).ShouldNot(HaveOccurred())
+			signature := uploaded.Signature
+			uploaded.Signature = ""
+			payload, err := json.Marshal(uploaded)
+			Expect(err).ShouldNot(HaveOccurred())
+			digest := sha256.Sum256(payload)
+			Expect(token.Claims.(jwt.MapClaims)["sha256"]).To(Equal(hex.EncodeToString(digest[:])))
+			Expect(signature).ToNot(BeEmpty())
+		})
+
+		It("reports disks without a result as failed", func() {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiskErasureFailedEventName),
+				eventstest.WithHostIdMatcher(hostID.String())))
+
+			response := models.DiskEraseResponse{Results: []*models.DiskEraseResult{
+				{DiskID: "/dev/disk/by-id/wwn-0x1111", Method: models.DiskEraseMethodOverwrite, Status: models.DiskEraseStatusSucceeded},
+			}}
+			responseBytes, err := json.Marshal(&response)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(bm.processDiskEraseResponse(ctx, &getHost().Host, string(responseBytes))).To(Succeed())
+
+			Expect(uploaded.Disks).To(HaveLen(2))
+			Expect(uploaded.Disks[1].DiskID).To(Equal("/dev/disk/by-id/wwn-0x2222"))
+			Expect(uploaded.Disks[1].Status).To(Equal(models.DiskEraseStatusFailed))
+			Expect(getHost().DisksToErase).To(BeEmpty())
+		})
+
+		It("stores a failed report when the step failed", func() {
+			mockEvents.EXPECT().SendHostEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.HostDiskErasureFailedEventName),
+				eventstest.WithHostIdMatcher(hostID.String())))
+
+			Expect(bm.processDiskEraseFailure(ctx, &getHost().Host, "device busy")).To(Succeed())
+			Expect(uploaded.Disks).To(HaveLen(2))
+			for _, disk := range uploaded.Disks {
+				Expect(disk.Status).To(Equal(models.DiskEraseStatusFailed))
+				Expect(disk.Error).To(Equal("device busy"))
+			}
+		})
+	})
+
+	It("lists the disk erasure reports of the host", func() {
+		prefix := fmt.Sprintf("%s/hosts/%s/disk-erasure-reports/", infraEnvID, hostID)
+		newer := models.DiskErasureReport{ID: strfmt.UUID(uuid.New().String()), CreatedAt: strfmt.DateTime(time.Now())}
+		older := models.DiskErasureReport{ID: strfmt.UUID(uuid.New().String()), CreatedAt: strfmt.DateTime(time.Now().Add(-time.Hour))}
+		mockS3Client.EXPECT().ListObjectsByPrefix(ctx, prefix).Return([]string{prefix + "newer.json", prefix + "older.json"}, nil)
+		for name, report := range map[string]models.DiskErasureReport{"newer.json": newer, "older.json": older} {
+			data, err := json.Marshal(&report)
+			Expect(err).ShouldNot(HaveOccurred())
+			mockS3Client.EXPECT().Download(ctx, prefix+name).Return(io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil)
+		}
+
+		response := bm.V2ListHostDiskErasureReports(ctx, installer.V2ListHostDiskErasureReportsParams{HostID: hostID, InfraEnvID: infraEnvID})
+		Expect(response).To(BeAssignableToTypeOf(&installer.V2ListHostDiskErasureReportsOK{}))
+		reports := response.(*installer.V2ListHostDiskErasureReportsOK).Payload
+		Expect(reports).To(HaveLen(2))
+		Expect(reports[0].ID).To(Equal(older.ID))
+		Expect(reports[1].ID).To(Equal(newer.ID))
+	})
 })
 
 var _ = Describe("V2UpdateHostInstallerArgs", func() {
@@ -17749,14 +18912,15 @@ func createInventory(db *gorm.DB, cfg Config) *bareMetalInventory {
 	mockInstallConfigBuilder = installcfg_builder.NewMockInstallConfigBuilder(ctrl)
 	mockHwValidator = hardware.NewMockValidator(ctrl)
 	mockStaticNetworkConfig = staticnetworkconfig.NewMockStaticNetworkConfig(ctrl)
-	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, common.GetTestLog())
+	mockIpamApi = ipam.NewMockAPI(ctrl)
+	dnsApi := dns.NewDNSHandler(cfg.BaseDNSDomains, dns.Config{}, common.GetTestLog())
 	gcConfig := garbagecollector.Config{DeregisterInactiveAfter: 20 * 24 * time.Hour}
 
 	bm := NewBareMetalInventory(db, mockStream, common.GetTestLog(), mockHostApi, mockClusterApi, mockInfraEnvApi, cfg,
 		mockGenerator, mockEvents, mockS3Client, mockMetric, mockUsage, mockOperatorManager,
 		getTestAuthHandler(), getTestAuthzHandler(), mockK8sClient, ocmClient, nil, mockSecretValidator, mockVersions,
 		mockOSImages, mockCRDUtils, mockIgnitionBuilder, mockHwValidator, dnsApi, mockInstallConfigBuilder,
-		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, true, "")
+		mockStaticNetworkConfig, gcConfig, mockProviderRegistry, mockIpamApi, true, "")
 
 	bm.ImageServiceBaseURL = imageServiceBaseURL
 	bm.ServiceBaseURL = serviceBaseURL
@@ -19541,3 +20705,231 @@ func createClusterWithMonitoredOperator(db *gorm.DB, operator models.MonitoredOp
 	Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
 	return &c
 }
+
+var _ = Describe("V2SuggestNetworks", func() {
+	var (
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		dbName string
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		cfg.ReservedNetworks = "10.132.0.0/14, 172.30.0.0/16"
+		bm = createInventory(db, cfg)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	suggest := func(clusterID strfmt.UUID, stackType *string) *models.NetworksSuggestion {
+		reply := bm.V2SuggestNetworks(ctx, installer.V2SuggestNetworksParams{ClusterID: clusterID, StackType: stackType})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2SuggestNetworksOK()))
+		return reply.(*installer.V2SuggestNetworksOK).Payload
+	}
+
+	It("avoids the networks of the hosts, the reserved networks and the other clusters", func() {
+		cluster := createCluster(db, models.ClusterStatusInsufficient)
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		createInfraEnv(db, infraEnvID, *cluster.ID)
+		for _, address := range []string{"10.129.0.5/24", "10.129.0.6/24"} {
+			addHost(strfmt.UUID(uuid.New().String()), models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost,
+				infraEnvID, *cluster.ID, getInventoryStr("host", "bios", address), db)
+		}
+		other := createCluster(db, models.ClusterStatusInstalled)
+		Expect(db.Create(&models.ClusterNetwork{ClusterID: *other.ID, Cidr: "10.0.0.0/14", HostPrefix: 23}).Error).ToNot(HaveOccurred())
+
+		suggestion := suggest(*cluster.ID, nil)
+		Expect(suggestion.StackType).To(Equal(models.NetworksSuggestionStackTypeIPV4))
+		Expect(suggestion.MachineNetworks).To(Equal([]*models.MachineNetwork{{Cidr: "10.129.0.0/24"}}))
+		Expect(suggestion.ClusterNetworks).To(Equal([]*models.ClusterNetwork{{Cidr: "10.4.0.0/14", HostPrefix: 23}}))
+		Expect(suggestion.ServiceNetworks).To(Equal([]*models.ServiceNetwork{{Cidr: "172.16.0.0/16"}}))
+		Expect(suggestion.AvoidedNetworks).To(ContainElements(
+			&models.AvoidedNetwork{Cidr: "10.129.0.0/24", Source: models.AvoidedNetworkSourceHostAddress},
+			&models.AvoidedNetwork{Cidr: "10.132.0.0/14", Source: models.AvoidedNetworkSourceReserved},
+			&models.AvoidedNetwork{Cidr: "10.0.0.0/14", Source: models.AvoidedNetworkSourceCluster, ClusterID: *other.ID},
+		))
+	})
+
+	It("suggests dual-stack networks", func() {
+		cluster := createCluster(db, models.ClusterStatusInsufficient)
+		suggestion := suggest(*cluster.ID, swag.String(models.NetworksSuggestionStackTypeDualStack))
+		Expect(suggestion.ClusterNetworks).To(Equal([]*models.ClusterNetwork{
+			{Cidr: "10.128.0.0/14", HostPrefix: 23},
+			{Cidr: "fd01::/48", HostPrefix: 64},
+		}))
+		Expect(suggestion.ServiceNetworks).To(Equal([]*models.ServiceNetwork{{Cidr: "172.16.0.0/16"}, {Cidr: "fd02::/112"}}))
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := bm.V2SuggestNetworks(ctx, installer.V2SuggestNetworksParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("V2GetClusterNetworkTopology", func() {
+	var (
+		bm     *bareMetalInventory
+		cfg    Config
+		db     *gorm.DB
+		dbName string
+		ctx    = context.Background()
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		bm = createInventory(db, cfg)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns the hosts and the links of the cluster", func() {
+		cluster := createCluster(db, models.ClusterStatusInsufficient)
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		createInfraEnv(db, infraEnvID, *cluster.ID)
+		hostID, remoteHostID := strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String())
+		addHost(remoteHostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost,
+			infraEnvID, *cluster.ID, getInventoryStr("remote", "bios", "10.11.50.81/16"), db)
+		h := addHost(hostID, models.HostRoleMaster, models.HostStatusKnown, models.HostKindHost,
+			infraEnvID, *cluster.ID, getInventoryStr("host", "bios", "10.11.50.80/16"), db)
+		connectivity, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+			HostID:         remoteHostID,
+			L3Connectivity: []*models.L3Connectivity{{OutgoingNic: "eth0", RemoteIPAddress: "10.11.50.81", Successful: true}},
+		}}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(db.Model(&h).Update("connectivity", string(connectivity)).Error).ToNot(HaveOccurred())
+
+		reply := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{ClusterID: *cluster.ID})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2GetClusterNetworkTopologyOK()))
+		topology := reply.(*installer.V2GetClusterNetworkTopologyOK).Payload
+		Expect(topology.ClusterID).To(Equal(*cluster.ID))
+		Expect(topology.Hosts).To(HaveLen(2))
+		Expect(topology.Links).To(HaveLen(1))
+		Expect(topology.Links[0].SourceHostID).To(Equal(hostID))
+		Expect(topology.Links[0].DestinationHostID).To(Equal(remoteHostID))
+
+		reply = bm.V2DownloadClusterNetworkTopology(ctx, installer.V2DownloadClusterNetworkTopologyParams{ClusterID: *cluster.ID})
+		Expect(reply).To(BeAssignableToTypeOf(&filemiddleware.FileMiddlewareResponder{}))
+	})
+
+	It("returns the hosts of the infra-env", func() {
+		infraEnvID := strfmt.UUID(uuid.New().String())
+		createInfraEnv(db, infraEnvID, "")
+		addHost(strfmt.UUID(uuid.New().String()), models.HostRoleAutoAssign, models.HostStatusKnown, models.HostKindHost,
+			infraEnvID, "", getInventoryStr("host", "bios", "10.11.50.80/16"), db)
+
+		reply := bm.V2GetInfraEnvNetworkTopology(ctx, installer.V2GetInfraEnvNetworkTopologyParams{InfraEnvID: infraEnvID})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2GetInfraEnvNetworkTopologyOK()))
+		topology := reply.(*installer.V2GetInfraEnvNetworkTopologyOK).Payload
+		Expect(topology.InfraEnvID).To(Equal(infraEnvID))
+		Expect(topology.Hosts).To(HaveLen(1))
+		Expect(topology.Hosts[0].Nics).ToNot(BeEmpty())
+	})
+
+	It("fails for a missing cluster or infra-env", func() {
+		reply := bm.V2GetClusterNetworkTopology(ctx, installer.V2GetClusterNetworkTopologyParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+		reply = bm.V2DownloadInfraEnvNetworkTopology(ctx, installer.V2DownloadInfraEnvNetworkTopologyParams{InfraEnvID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
+
+var _ = Describe("V2UpdateClusterSecondaryNetworks", func() {
+	var (
+		bm      *bareMetalInventory
+		cfg     Config
+		db      *gorm.DB
+		dbName  string
+		ctx     = context.Background()
+		cluster *common.Cluster
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		bm = createInventory(db, cfg)
+		cluster = createCluster(db, models.ClusterStatusInsufficient)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	policies := func(names ...string) models.SecondaryNetworkPolicies {
+		ret := models.SecondaryNetworkPolicies{}
+		for _, name := range names {
+			ret = append(ret, &models.SecondaryNetworkPolicy{
+				Name:         swag.String(name),
+				Role:         swag.String(models.SecondaryNetworkPolicyRoleWorker),
+				DesiredState: swag.String("interfaces:\n- name: eth1\n  type: ethernet\n  state: up\n"),
+			})
+		}
+		return ret
+	}
+
+	It("stores and returns the policies", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(1)
+		reply := bm.V2UpdateClusterSecondaryNetworks(ctx, installer.V2UpdateClusterSecondaryNetworksParams{
+			ClusterID:         *cluster.ID,
+			SecondaryNetworks: policies("storage"),
+		})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterSecondaryNetworksOK()))
+
+		reply = bm.V2GetClusterSecondaryNetworks(ctx, installer.V2GetClusterSecondaryNetworksParams{ClusterID: *cluster.ID})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2GetClusterSecondaryNetworksOK()))
+		Expect(reply.(*installer.V2GetClusterSecondaryNetworksOK).Payload).To(Equal(policies("storage")))
+	})
+
+	It("clears the policies", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(nil).Times(2)
+		bm.V2UpdateClusterSecondaryNetworks(ctx, installer.V2UpdateClusterSecondaryNetworksParams{
+			ClusterID:         *cluster.ID,
+			SecondaryNetworks: policies("storage"),
+		})
+		reply := bm.V2UpdateClusterSecondaryNetworks(ctx, installer.V2UpdateClusterSecondaryNetworksParams{
+			ClusterID:         *cluster.ID,
+			SecondaryNetworks: policies(),
+		})
+		Expect(reply).To(BeAssignableToTypeOf(installer.NewV2UpdateClusterSecondaryNetworksOK()))
+		c, err := common.GetClusterFromDB(db, *cluster.ID, common.SkipEagerLoading)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.SecondaryNetworks).To(BeEmpty())
+	})
+
+	It("rejects invalid policies", func() {
+		reply := bm.V2UpdateClusterSecondaryNetworks(ctx, installer.V2UpdateClusterSecondaryNetworksParams{
+			ClusterID:         *cluster.ID,
+			SecondaryNetworks: policies("storage", "storage"),
+		})
+		verifyApiErrorString(reply, http.StatusBadRequest, "secondary network policy storage is defined more than once")
+	})
+
+	It("fails when the cluster can't be updated", func() {
+		mockClusterApi.EXPECT().VerifyClusterUpdatability(createClusterIdMatcher(cluster)).Return(errors.New("wrong state")).Times(1)
+		reply := bm.V2UpdateClusterSecondaryNetworks(ctx, installer.V2UpdateClusterSecondaryNetworksParams{
+			ClusterID:         *cluster.ID,
+			SecondaryNetworks: policies("storage"),
+		})
+		verifyApiError(reply, http.StatusConflict)
+	})
+
+	It("fails for a missing cluster", func() {
+		reply := bm.V2GetClusterSecondaryNetworks(ctx, installer.V2GetClusterSecondaryNetworksParams{ClusterID: strfmt.UUID(uuid.New().String())})
+		verifyApiError(reply, http.StatusNotFound)
+		reply = bm.V2UpdateClusterSecondaryNetworks(ctx, installer.V2UpdateClusterSecondaryNetworksParams{
+			ClusterID:         strfmt.UUID(uuid.New().String()),
+			SecondaryNetworks: policies("storage"),
+		})
+		verifyApiError(reply, http.StatusNotFound)
+	})
+})
@@ -18,9 +18,11 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/internal/dns"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/imageservice"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	"github.com/openshift/assisted-service/pkg/filemiddleware"
@@ -32,7 +34,10 @@ import (
 	"gorm.io/gorm"
 )
 
-const clusterOperatorReportKey string = "CLUSTER_OPERATORS_REPORT"
+const (
+	clusterOperatorReportKey string = "CLUSTER_OPERATORS_REPORT"
+	networkTopologyFileName  string = "network-topology.dot"
+)
 
 func (b *bareMetalInventory) V2UpdateHost(ctx context.Context, params installer.V2UpdateHostParams) middleware.Responder {
 	host, err := b.V2UpdateHostInternal(ctx, params, Interactive)
@@ -113,7 +118,7 @@ func (b *bareMetalInventory) V2DeregisterCluster(ctx context.Context, params ins
 		log.Warnf("failed to delete DNS record sets for base domain: %s", cluster.BaseDNSDomain)
 	}
 
-	if err = b.deleteOrUnbindHosts(ctx, cluster); err != nil {
+	if err = b.deleteOrUnbindHosts(ctx, cluster, swag.BoolValue(params.DecommissionHosts)); err != nil {
 		log.WithError(err).Errorf("failed delete or unbind hosts when deregistering cluster: %s", params.ClusterID)
 		return common.NewApiError(http.StatusInternalServerError, err)
 	}
@@ -167,6 +172,46 @@ func (b *bareMetalInventory) V2CancelInstallation(ctx context.Context, params in
 	return installer.NewV2CancelInstallationAccepted().WithPayload(&cluster.Cluster)
 }
 
+func (b *bareMetalInventory) V2CreateClusterBatch(ctx context.Context, params installer.V2CreateClusterBatchParams) middleware.Responder {
+	batch, err := b.CreateClusterBatchInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CreateClusterBatchCreated().WithPayload(batch)
+}
+
+func (b *bareMetalInventory) V2ListClusterBatches(ctx context.Context, params installer.V2ListClusterBatchesParams) middleware.Responder {
+	batches, err := b.ListClusterBatchesInternal(ctx)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2ListClusterBatchesOK().WithPayload(batches)
+}
+
+func (b *bareMetalInventory) V2GetClusterBatch(ctx context.Context, params installer.V2GetClusterBatchParams) middleware.Responder {
+	batch, err := b.getClusterBatchInternal(ctx, params.ClusterBatchID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterBatchOK().WithPayload(batch)
+}
+
+func (b *bareMetalInventory) V2InstallClusterBatch(ctx context.Context, params installer.V2InstallClusterBatchParams) middleware.Responder {
+	batch, err := b.InstallClusterBatchInternal(ctx, params.ClusterBatchID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2InstallClusterBatchAccepted().WithPayload(batch)
+}
+
+func (b *bareMetalInventory) V2CancelClusterBatch(ctx context.Context, params installer.V2CancelClusterBatchParams) middleware.Responder {
+	batch, err := b.CancelClusterBatchInternal(ctx, params.ClusterBatchID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2CancelClusterBatchAccepted().WithPayload(batch)
+}
+
 func (b *bareMetalInventory) TransformClusterToDay2(ctx context.Context, params installer.TransformClusterToDay2Params) middleware.Responder {
 	// Does the same thing as TransformClusterToAddingHosts, endpoint maintained for legacy purposes
 	cluster, err := b.TransformClusterToDay2Internal(ctx, params.ClusterID)
@@ -215,8 +260,11 @@ func (b *bareMetalInventory) V2ResetCluster(ctx context.Context, params installe
 			return common.NewApiError(http.StatusInternalServerError, err)
 		}
 
-		if err := b.deleteDNSRecordSets(ctx, *cluster); err != nil {
-			log.Warnf("failed to delete DNS record sets for base domain: %s", cluster.BaseDNSDomain)
+		// The records that the service provisioned are kept for the next installation of the cluster
+		if !dns.IsDNSRecordsProvisioningEnabled(cluster) {
+			if err := b.deleteDNSRecordSets(ctx, *cluster); err != nil {
+				log.Warnf("failed to delete DNS record sets for base domain: %s", cluster.BaseDNSDomain)
+			}
 		}
 		return nil
 	})
@@ -241,6 +289,66 @@ func (b *bareMetalInventory) V2GetPreflightRequirements(ctx context.Context, par
 	return installer.NewV2GetPreflightRequirementsOK().WithPayload(requirements)
 }
 
+func (b *bareMetalInventory) V2SuggestNetworks(ctx context.Context, params installer.V2SuggestNetworksParams) middleware.Responder {
+	suggestion, err := b.SuggestNetworksInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2SuggestNetworksOK().WithPayload(suggestion)
+}
+
+func (b *bareMetalInventory) V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	topology, err := b.GetClusterNetworkTopologyInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterNetworkTopologyOK().WithPayload(topology)
+}
+
+func (b *bareMetalInventory) V2DownloadClusterNetworkTopology(ctx context.Context, params installer.V2DownloadClusterNetworkTopologyParams) middleware.Responder {
+	topology, err := b.GetClusterNetworkTopologyInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	dot := network.TopologyToDot(params.ClusterID.String(), topology)
+	return filemiddleware.NewResponder(installer.NewV2DownloadClusterNetworkTopologyOK().WithPayload(io.NopCloser(strings.NewReader(dot))),
+		networkTopologyFileName, int64(len(dot)), nil)
+}
+
+func (b *bareMetalInventory) V2GetInfraEnvNetworkTopology(ctx context.Context, params installer.V2GetInfraEnvNetworkTopologyParams) middleware.Responder {
+	topology, err := b.GetInfraEnvNetworkTopologyInternal(ctx, params.InfraEnvID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetInfraEnvNetworkTopologyOK().WithPayload(topology)
+}
+
+func (b *bareMetalInventory) V2DownloadInfraEnvNetworkTopology(ctx context.Context, params installer.V2DownloadInfraEnvNetworkTopologyParams) middleware.Responder {
+	topology, err := b.GetInfraEnvNetworkTopologyInternal(ctx, params.InfraEnvID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	dot := network.TopologyToDot(params.InfraEnvID.String(), topology)
+	return filemiddleware.NewResponder(installer.NewV2DownloadInfraEnvNetworkTopologyOK().WithPayload(io.NopCloser(strings.NewReader(dot))),
+		networkTopologyFileName, int64(len(dot)), nil)
+}
+
+func (b *bareMetalInventory) V2GetClusterSecondaryNetworks(ctx context.Context, params installer.V2GetClusterSecondaryNetworksParams) middleware.Responder {
+	policies, err := b.GetClusterSecondaryNetworksInternal(ctx, params.ClusterID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2GetClusterSecondaryNetworksOK().WithPayload(policies)
+}
+
+func (b *bareMetalInventory) V2UpdateClusterSecondaryNetworks(ctx context.Context, params installer.V2UpdateClusterSecondaryNetworksParams) middleware.Responder {
+	policies, err := b.UpdateClusterSecondaryNetworksInternal(ctx, params)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return installer.NewV2UpdateClusterSecondaryNetworksOK().WithPayload(policies)
+}
+
 func (b *bareMetalInventory) V2UploadClusterIngressCert(ctx context.Context, params installer.V2UploadClusterIngressCertParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("UploadClusterIngressCert for cluster %s with params %s", params.ClusterID, params.IngressCertParams)
@@ -84,6 +84,21 @@ func (mr *MockInstallerInternalsMockRecorder) CreateHostInKubeKeyNamespace(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHostInKubeKeyNamespace", reflect.TypeOf((*MockInstallerInternals)(nil).CreateHostInKubeKeyNamespace), arg0, arg1, arg2)
 }
 
+// DecommissionHostInternal mocks base method.
+func (m *MockInstallerInternals) DecommissionHostInternal(arg0 context.Context, arg1 installer.UnbindHostParams) (*common.Host, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecommissionHostInternal", arg0, arg1)
+	ret0, _ := ret[0].(*common.Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecommissionHostInternal indicates an expected call of DecommissionHostInternal.
+func (mr *MockInstallerInternalsMockRecorder) DecommissionHostInternal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionHostInternal", reflect.TypeOf((*MockInstallerInternals)(nil).DecommissionHostInternal), arg0, arg1)
+}
+
 // DeregisterClusterInternal mocks base method.
 func (m *MockInstallerInternals) DeregisterClusterInternal(arg0 context.Context, arg1 *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,245 @@
+package cluster
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	ProvisioningNetworkManaged   = "Managed"
+	ProvisioningNetworkUnmanaged = "Unmanaged"
+	ProvisioningNetworkDisabled  = "Disabled"
+)
+
+// IsBaremetalProvisioningSet returns true if the provisioning network of the baremetal platform is configured
+func IsBaremetalProvisioningSet(provisioning *models.BaremetalProvisioning) bool {
+	return provisioning != nil && provisioning.ProvisioningNetwork != ""
+}
+
+// ValidateBaremetalProvisioning verifies that the provisioning network configuration is consistent. An unset
+// configuration is always valid.
+func ValidateBaremetalProvisioning(provisioning *models.BaremetalProvisioning) error {
+	if provisioning == nil {
+		return nil
+	}
+	mode := provisioning.ProvisioningNetwork
+	switch mode {
+	case "":
+		if *provisioning != (models.BaremetalProvisioning{}) {
+			return errors.New("the provisioning network mode must be set to configure the provisioning network")
+		}
+		return nil
+	case ProvisioningNetworkManaged, ProvisioningNetworkUnmanaged, ProvisioningNetworkDisabled:
+	default:
+		return errors.Errorf("invalid provisioning network mode %s, expected one of %s, %s, %s", mode,
+			ProvisioningNetworkManaged, ProvisioningNetworkUnmanaged, ProvisioningNetworkDisabled)
+	}
+
+	var cidr *net.IPNet
+	if provisioning.ProvisioningNetworkCidr != "" {
+		var err error
+		if _, cidr, err = net.ParseCIDR(provisioning.ProvisioningNetworkCidr); err != nil {
+			return errors.Errorf("invalid provisioning network CIDR %s", provisioning.ProvisioningNetworkCidr)
+		}
+	}
+	if mode == ProvisioningNetworkDisabled {
+		if provisioning.ProvisioningNetworkInterface != "" {
+			return errors.New("the provisioning network interface can't be set when the provisioning network is Disabled")
+		}
+		// Without a provisioning network the provisioning IPs are addresses of the machine network
+		cidr = nil
+	}
+	if provisioning.ProvisioningDhcpRange != "" && mode != ProvisioningNetworkManaged {
+		return errors.Errorf("the provisioning DHCP range can only be set when the provisioning network is %s", ProvisioningNetworkManaged)
+	}
+
+	ips := map[string]net.IP{}
+	for _, address := range []struct {
+		name  string
+		value string
+	}{
+		{"cluster provisioning IP", provisioning.ClusterProvisioningIP},
+		{"bootstrap provisioning IP", provisioning.BootstrapProvisioningIP},
+	} {
+		if address.value == "" {
+			continue
+		}
+		ip := net.ParseIP(address.value)
+		if ip == nil {
+			return errors.Errorf("invalid %s %s", address.name, address.value)
+		}
+		if cidr != nil && !cidr.Contains(ip) {
+			return errors.Errorf("the %s %s isn't in the provisioning network %s", address.name, address.value, cidr)
+		}
+		ips[address.name] = ip
+	}
+	if ips["cluster provisioning IP"] != nil && ips["cluster provisioning IP"].Equal(ips["bootstrap provisioning IP"]) {
+		return errors.New("the cluster and the bootstrap provisioning IPs must be different")
+	}
+
+	if provisioning.ProvisioningDhcpRange != "" {
+		start, end, err := parseProvisioningDHCPRange(provisioning.ProvisioningDhcpRange)
+		if err != nil {
+			return err
+		}
+		if cidr != nil && (!cidr.Contains(start) || !cidr.Contains(end)) {
+			return errors.Errorf("the provisioning DHCP range %s isn't in the provisioning network %s", provisioning.ProvisioningDhcpRange, cidr)
+		}
+		for name, ip := range ips {
+			if ipInRange(ip, start, end) {
+				return errors.Errorf("the %s %s is in the provisioning DHCP range %s", name, ip, provisioning.ProvisioningDhcpRange)
+			}
+		}
+	}
+	return nil
+}
+
+// parseProvisioningDHCPRange parses a "start,end" DHCP range of addresses of the same family
+func parseProvisioningDHCPRange(dhcpRange string) (net.IP, net.IP, error) {
+	parts := strings.Split(dhcpRange, ",")
+	if len(parts) != 2 {
+		return nil, nil, errors.Errorf("the provisioning DHCP range %s isn't a comma-separated start and end addresses", dhcpRange)
+	}
+	start := net.ParseIP(strings.TrimSpace(parts[0]))
+	end := net.ParseIP(strings.TrimSpace(parts[1]))
+	if start == nil || end == nil || (start.To4() == nil) != (end.To4() == nil) {
+		return nil, nil, errors.Errorf("invalid provisioning DHCP range %s", dhcpRange)
+	}
+	if compareIPs(start, end) > 0 {
+		return nil, nil, errors.Errorf("the start of the provisioning DHCP range %s is after its end", dhcpRange)
+	}
+	return start, end, nil
+}
+
+func compareIPs(a, b net.IP) int {
+	a16, b16 := a.To16(), b.To16()
+	for i := range a16 {
+		if a16[i] != b16[i] {
+			if a16[i] < b16[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func ipInRange(ip, start, end net.IP) bool {
+	return compareIPs(ip, start) >= 0 && compareIPs(ip, end) <= 0
+}
+
+// validateProvisioningNetwork verifies the provisioning network of a baremetal cluster against its networks and the
+// inventory of its control plane hosts
+func validateProvisioningNetwork(c *common.Cluster) (ValidationStatus, string) {
+	provisioning := c.BaremetalProvisioning
+	if c.Platform == nil || common.PlatformTypeValue(c.Platform.Type) != models.PlatformTypeBaremetal || !IsBaremetalProvisioningSet(provisioning) {
+		return ValidationSuccess, "The provisioning network isn't configured."
+	}
+	if err := ValidateBaremetalProvisioning(provisioning); err != nil {
+		return ValidationFailure, fmt.Sprintf("The provisioning network configuration is invalid: %s.", err)
+	}
+	if provisioning.ProvisioningNetwork == ProvisioningNetworkDisabled {
+		return validateDisabledProvisioningNetwork(c)
+	}
+
+	if provisioning.ProvisioningNetworkCidr != "" {
+		for _, overlapping := range []struct {
+			name  string
+			cidrs []string
+		}{
+			{"machine network", network.GetMachineNetworkCidrs(c)},
+			{"cluster network", network.GetClusterNetworkCidrs(c)},
+			{"service network", network.GetServiceNetworkCidrs(c)},
+		} {
+			for _, cidr := range overlapping.cidrs {
+				if err := network.VerifyNetworksNotOverlap(provisioning.ProvisioningNetworkCidr, cidr); err != nil {
+					return ValidationFailure, fmt.Sprintf("The provisioning network %s overlaps with the %s %s.",
+						provisioning.ProvisioningNetworkCidr, overlapping.name, cidr)
+				}
+			}
+		}
+	}
+
+	if provisioning.ProvisioningNetworkInterface == "" {
+		return ValidationSuccess, "The provisioning network is valid."
+	}
+	var machineNetworks []*net.IPNet
+	for _, cidr := range network.GetMachineNetworkCidrs(c) {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			machineNetworks = append(machineNetworks, ipNet)
+		}
+	}
+	var failures []string
+	for _, h := range c.Hosts {
+		if common.GetEffectiveRole(h) != models.HostRoleMaster || h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			continue
+		}
+		hostname := hostutil.GetHostnameForMsg(h)
+		iface := findInterface(inventory, provisioning.ProvisioningNetworkInterface)
+		if iface == nil {
+			failures = append(failures, fmt.Sprintf("host %s has no interface %s", hostname, provisioning.ProvisioningNetworkInterface))
+			continue
+		}
+		for _, address := range append(append([]string{}, iface.IPV4Addresses...), iface.IPV6Addresses...) {
+			ip, _, err := net.ParseCIDR(address)
+			if err != nil {
+				continue
+			}
+			for _, machineNetwork := range machineNetworks {
+				if machineNetwork.Contains(ip) {
+					failures = append(failures, fmt.Sprintf("interface %s of host %s has the address %s of the machine network %s",
+						iface.Name, hostname, address, machineNetwork))
+				}
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return ValidationFailure, fmt.Sprintf("The provisioning network interface is invalid: %s.", strings.Join(failures, "; "))
+	}
+	return ValidationSuccess, "The provisioning network is valid."
+}
+
+// validateDisabledProvisioningNetwork verifies that the provisioning IPs are in the machine network, as there is no
+// provisioning network
+func validateDisabledProvisioningNetwork(c *common.Cluster) (ValidationStatus, string) {
+	machineNetworks := network.GetMachineNetworkCidrs(c)
+	for _, address := range []string{c.BaremetalProvisioning.ClusterProvisioningIP, c.BaremetalProvisioning.BootstrapProvisioningIP} {
+		if address == "" {
+			continue
+		}
+		if len(machineNetworks) == 0 {
+			return ValidationPending, "The machine network isn't defined yet."
+		}
+		found := false
+		for _, cidr := range machineNetworks {
+			if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ipNet.Contains(net.ParseIP(address)) {
+				found = true
+			}
+		}
+		if !found {
+			return ValidationFailure, fmt.Sprintf("The provisioning IP %s isn't in the machine networks %s, as required when the provisioning network is %s.",
+				address, strings.Join(machineNetworks, ", "), ProvisioningNetworkDisabled)
+		}
+	}
+	return ValidationSuccess, "The provisioning network is valid."
+}
+
+func findInterface(inventory *models.Inventory, name string) *models.Interface {
+	for _, iface := range inventory.Interfaces {
+		if iface.Name == name {
+			return iface
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package cluster
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Baremetal provisioning network", func() {
+	managed := func() *models.BaremetalProvisioning {
+		return &models.BaremetalProvisioning{
+			ProvisioningNetwork:          ProvisioningNetworkManaged,
+			ProvisioningNetworkCidr:      "172.22.0.0/24",
+			ProvisioningNetworkInterface: "eth1",
+			ClusterProvisioningIP:        "172.22.0.3",
+			BootstrapProvisioningIP:      "172.22.0.2",
+			ProvisioningDhcpRange:        "172.22.0.10,172.22.0.254",
+		}
+	}
+
+	DescribeTable("ValidateBaremetalProvisioning",
+		func(update func(*models.BaremetalProvisioning) *models.BaremetalProvisioning, expectedError string) {
+			err := ValidateBaremetalProvisioning(update(managed()))
+			if expectedError == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedError)))
+			}
+		},
+		Entry("unset", func(*models.BaremetalProvisioning) *models.BaremetalProvisioning { return nil }, ""),
+		Entry("removed", func(*models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			return &models.BaremetalProvisioning{}
+		}, ""),
+		Entry("managed", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning { return p }, ""),
+		Entry("unmanaged", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningNetwork = ProvisioningNetworkUnmanaged
+			p.ProvisioningDhcpRange = ""
+			return p
+		}, ""),
+		Entry("disabled", func(*models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			return &models.BaremetalProvisioning{ProvisioningNetwork: ProvisioningNetworkDisabled, ClusterProvisioningIP: "192.168.111.3"}
+		}, ""),
+		Entry("fields without mode", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningNetwork = ""
+			return p
+		}, "the provisioning network mode must be set"),
+		Entry("invalid mode", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningNetwork = "managed"
+			return p
+		}, "invalid provisioning network mode managed"),
+		Entry("invalid CIDR", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningNetworkCidr = "172.22.0.0"
+			return p
+		}, "invalid provisioning network CIDR"),
+		Entry("IP outside of the CIDR", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.BootstrapProvisioningIP = "172.22.1.2"
+			return p
+		}, "the bootstrap provisioning IP 172.22.1.2 isn't in the provisioning network 172.22.0.0/24"),
+		Entry("same IPs", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.BootstrapProvisioningIP = p.ClusterProvisioningIP
+			return p
+		}, "must be different"),
+		Entry("DHCP range with unmanaged network", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningNetwork = ProvisioningNetworkUnmanaged
+			return p
+		}, "the provisioning DHCP range can only be set when the provisioning network is Managed"),
+		Entry("interface with disabled network", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningNetwork = ProvisioningNetworkDisabled
+			p.ProvisioningDhcpRange = ""
+			return p
+		}, "the provisioning network interface can't be set"),
+		Entry("invalid DHCP range", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningDhcpRange = "172.22.0.10"
+			return p
+		}, "isn't a comma-separated start and end addresses"),
+		Entry("reversed DHCP range", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningDhcpRange = "172.22.0.254,172.22.0.10"
+			return p
+		}, "is after its end"),
+		Entry("DHCP range outside of the CIDR", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ProvisioningDhcpRange = "172.22.0.10,172.22.1.254"
+			return p
+		}, "isn't in the provisioning network"),
+		Entry("IP in the DHCP range", func(p *models.BaremetalProvisioning) *models.BaremetalProvisioning {
+			p.ClusterProvisioningIP = "172.22.0.20"
+			return p
+		}, "the cluster provisioning IP 172.22.0.20 is in the provisioning DHCP range"),
+	)
+
+	Context("validateProvisioningNetwork", func() {
+		var cluster *common.Cluster
+
+		addMaster := func(interfaces ...*models.Interface) {
+			inventory, err := json.Marshal(&models.Inventory{Hostname: "master", Interfaces: interfaces})
+			Expect(err).ToNot(HaveOccurred())
+			id := strfmt.UUID(uuid.New().String())
+			cluster.Hosts = append(cluster.Hosts, &models.Host{ID: &id, Role: models.HostRoleMaster, Inventory: string(inventory)})
+		}
+
+		BeforeEach(func() {
+			cluster = &common.Cluster{Cluster: models.Cluster{
+				Platform:              &models.Platform{Type: models.PlatformTypeBaremetal.Pointer()},
+				MachineNetworks:       []*models.MachineNetwork{{Cidr: "192.168.111.0/24"}},
+				ClusterNetworks:       []*models.ClusterNetwork{{Cidr: "10.128.0.0/14"}},
+				ServiceNetworks:       []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}},
+				BaremetalProvisioning: managed(),
+			}}
+		})
+
+		It("succeeds when the provisioning network isn't configured", func() {
+			cluster.BaremetalProvisioning = nil
+			status, _ := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationSuccess))
+			cluster.BaremetalProvisioning = managed()
+			cluster.Platform.Type = models.PlatformTypeNone.Pointer()
+			status, _ = validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationSuccess))
+		})
+
+		It("succeeds when the control plane hosts have the provisioning interface", func() {
+			addMaster(&models.Interface{Name: "eth0", IPV4Addresses: []string{"192.168.111.10/24"}}, &models.Interface{Name: "eth1"})
+			status, message := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The provisioning network is valid."))
+		})
+
+		It("fails when a control plane host doesn't have the provisioning interface", func() {
+			addMaster(&models.Interface{Name: "eth0", IPV4Addresses: []string{"192.168.111.10/24"}})
+			status, message := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The provisioning network interface is invalid: host master has no interface eth1."))
+		})
+
+		It("fails when the provisioning interface is on the machine network", func() {
+			addMaster(&models.Interface{Name: "eth1", IPV4Addresses: []string{"192.168.111.10/24"}})
+			status, message := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("interface eth1 of host master has the address 192.168.111.10/24 of the machine network 192.168.111.0/24"))
+		})
+
+		It("fails when the provisioning network overlaps with the cluster networks", func() {
+			cluster.BaremetalProvisioning = &models.BaremetalProvisioning{
+				ProvisioningNetwork:     ProvisioningNetworkUnmanaged,
+				ProvisioningNetworkCidr: "172.30.1.0/24",
+			}
+			status, message := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The provisioning network 172.30.1.0/24 overlaps with the service network 172.30.0.0/16."))
+		})
+
+		It("requires the provisioning IPs in the machine network when the provisioning network is disabled", func() {
+			cluster.BaremetalProvisioning = &models.BaremetalProvisioning{
+				ProvisioningNetwork:   ProvisioningNetworkDisabled,
+				ClusterProvisioningIP: "192.168.111.3",
+			}
+			status, _ := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationSuccess))
+
+			cluster.BaremetalProvisioning.BootstrapProvisioningIP = "172.22.0.2"
+			status, message := validateProvisioningNetwork(cluster)
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("The provisioning IP 172.22.0.2 isn't in the machine networks 192.168.111.0/24"))
+		})
+	})
+})
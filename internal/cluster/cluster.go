@@ -147,6 +147,7 @@ type Config struct {
 	InstallationTimeout time.Duration `envconfig:"INSTALLATION_TIMEOUT" default:"24h"`
 	FinalizingTimeout   time.Duration `envconfig:"FINALIZING_TIMEOUT" default:"5h"`
 	MonitorBatchSize    int           `envconfig:"CLUSTER_MONITOR_BATCH_SIZE" default:"100"`
+	PlatformCredentials PlatformCredentialsConfig
 }
 
 type Manager struct {
@@ -198,7 +199,7 @@ func NewManager(cfg Config, log logrus.FieldLogger, db *gorm.DB, stream stream.N
 		sm:                    NewClusterStateMachine(th),
 		metricAPI:             metricApi,
 		manifestsGeneratorAPI: manifestsGeneratorAPI,
-		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi),
+		rp:                    newRefreshPreprocessor(log, hostAPI, operatorsApi, db, cfg.PlatformCredentials),
 		hostAPI:               hostAPI,
 		leaderElector:         leaderElector,
 		prevMonitorInvokedAt:  time.Now(),
@@ -792,9 +793,9 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, clusterID strfmt.UU
 		return err
 	}
 
-	// day2 cluster isn't a real cluster and doesn't have a real progress
+	// day2 cluster isn't a real cluster and doesn't have a real progress, unless its control plane is being expanded
 	if *cluster.Kind == models.ClusterKindAddHostsCluster {
-		return nil
+		return m.updateControlPlaneExpansionProgress(ctx, cluster)
 	}
 
 	var hostsCount []struct {
@@ -840,6 +841,43 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, clusterID strfmt.UU
 	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).UpdateColumns(updates).Error
 }
 
+// updateControlPlaneExpansionProgress tracks the progress of day2 control plane hosts of an installed cluster.
+// Such a host reaches the done stage only once it became a healthy etcd member.
+func (m *Manager) updateControlPlaneExpansionProgress(ctx context.Context, cluster *common.Cluster) error {
+	log := logutil.FromContext(ctx, m.log)
+
+	var hostsCount []struct {
+		Count        int
+		CurrentStage models.HostStage
+	}
+	err := m.db.Table("hosts").Select("count(*) as count, progress_current_stage as current_stage").
+		Group("current_stage").Where("cluster_id = ? and (role = ? or (role = ? and suggested_role = ?)) and kind = ?",
+		cluster.ID.String(), models.HostRoleMaster, models.HostRoleAutoAssign, models.HostRoleMaster, models.HostKindAddToExistingClusterHost).
+		Scan(&hostsCount).Error
+	if err != nil {
+		log.WithError(err).Error("Failed to get control plane hosts count from DB")
+		return err
+	}
+	if len(hostsCount) == 0 {
+		return nil
+	}
+
+	stages := host.FindMatchingStages(models.HostRoleMaster, false, false)
+	var totalHostsDoneStages, totalHostsStages float64
+	for _, h := range hostsCount {
+		currentIndex := m.hostAPI.IndexOfStage(h.CurrentStage, stages)
+		totalHostsDoneStages += float64((currentIndex + 1) * h.Count)
+		totalHostsStages += float64(len(stages) * h.Count)
+	}
+	installingStagePercentage := int64((totalHostsDoneStages / totalHostsStages) * 100)
+
+	updates := map[string]interface{}{
+		"progress_installing_stage_percentage": installingStagePercentage,
+		"progress_total_percentage":            installingStagePercentage,
+	}
+	return m.db.Model(&common.Cluster{}).Where("id = ?", cluster.ID.String()).UpdateColumns(updates).Error
+}
+
 func (m *Manager) UpdateFinalizingProgress(ctx context.Context, db *gorm.DB, clusterID strfmt.UUID) error {
 	log := logutil.FromContext(ctx, m.log)
 
@@ -1644,6 +1682,10 @@ func (m *Manager) GenerateAdditionalManifests(ctx context.Context, cluster *comm
 	if err := m.manifestsGeneratorAPI.AddNicReapply(ctx, log, cluster); err != nil {
 		return errors.Wrap(err, "failed to add nic reapply manifest")
 	}
+
+	if err := m.manifestsGeneratorAPI.AddSecondaryNetworksManifests(ctx, log, cluster); err != nil {
+		return errors.Wrap(err, "failed to add secondary networks manifests")
+	}
 	return nil
 }
 
@@ -1763,7 +1805,9 @@ func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt
 			}
 			switch vipResponse.VipType {
 			case models.VipTypeAPI:
-				apiVip, _ := funk.Find(cluster.APIVips, func(apiVip *models.APIVip) bool { return apiVip.IP == vipResponse.Vip }).(*models.APIVip)
+				apiVip, _ := funk.Find(cluster.APIVips, func(apiVip *models.APIVip) bool {
+					return network.NormalizeIP(string(apiVip.IP)) == network.NormalizeIP(string(vipResponse.Vip))
+				}).(*models.APIVip)
 				if apiVip != nil {
 					if apiVip.Verification == nil || *apiVip.Verification != *vipResponse.Verification {
 						apiVip.Verification = vipResponse.Verification
@@ -1775,7 +1819,9 @@ func (m *Manager) HandleVerifyVipsResponse(ctx context.Context, clusterID strfmt
 					}
 				}
 			case models.VipTypeIngress:
-				ingressVip, _ := funk.Find(cluster.IngressVips, func(ingressVip *models.IngressVip) bool { return ingressVip.IP == vipResponse.Vip }).(*models.IngressVip)
+				ingressVip, _ := funk.Find(cluster.IngressVips, func(ingressVip *models.IngressVip) bool {
+					return network.NormalizeIP(string(ingressVip.IP)) == network.NormalizeIP(string(vipResponse.Vip))
+				}).(*models.IngressVip)
 				if ingressVip != nil {
 					if ingressVip.Verification == nil || *ingressVip.Verification != *vipResponse.Verification {
 						ingressVip.Verification = vipResponse.Verification
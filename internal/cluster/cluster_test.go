@@ -102,6 +102,7 @@ var _ = Describe("stateMachine", func() {
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied)},
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied)},
 				{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)},
+				{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)},
 			}, nil)
 		})
 
@@ -176,6 +177,7 @@ var _ = Describe("TestClusterMonitoring", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -776,6 +778,7 @@ var _ = Describe("lease timeout event", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -899,6 +902,7 @@ var _ = Describe("Auto assign machine CIDR", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -2286,6 +2290,7 @@ var _ = Describe("Majority groups", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -2614,6 +2619,7 @@ var _ = Describe("ready_state", func() {
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied)},
 			{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)},
+			{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)},
 		}, nil)
 	})
 
@@ -3009,6 +3015,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 		manifestsGenerator.EXPECT().AddTelemeterManifest(ctx, gomock.Any(), &c).Return(nil)
 		manifestsGenerator.EXPECT().AddDiskEncryptionManifest(ctx, gomock.Any(), &c).Return(nil)
 		manifestsGenerator.EXPECT().AddNicReapply(ctx, gomock.Any(), &c).Return(nil)
+		manifestsGenerator.EXPECT().AddSecondaryNetworksManifests(ctx, gomock.Any(), &c).Return(nil)
 		mockOperatorMgr.EXPECT().GenerateManifests(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		c.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
 		err := capi.GenerateAdditionalManifests(ctx, &c)
@@ -3032,6 +3039,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 		manifestsGenerator.EXPECT().AddTelemeterManifest(ctx, gomock.Any(), &c).Return(nil)
 		manifestsGenerator.EXPECT().AddDiskEncryptionManifest(ctx, gomock.Any(), &c).Return(nil)
 		manifestsGenerator.EXPECT().AddNicReapply(ctx, gomock.Any(), &c).Return(nil)
+		manifestsGenerator.EXPECT().AddSecondaryNetworksManifests(ctx, gomock.Any(), &c).Return(nil)
 		mockOperatorMgr.EXPECT().GenerateManifests(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		c.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
 		err := capi.GenerateAdditionalManifests(ctx, &c)
@@ -3057,6 +3065,7 @@ var _ = Describe("GenerateAdditionalManifests", func() {
 			manifestsGenerator.EXPECT().AddTelemeterManifest(ctx, gomock.Any(), &c).Return(nil)
 			manifestsGenerator.EXPECT().AddDiskEncryptionManifest(ctx, gomock.Any(), &c).Return(nil)
 			manifestsGenerator.EXPECT().AddNicReapply(ctx, gomock.Any(), &c).Return(nil)
+			manifestsGenerator.EXPECT().AddSecondaryNetworksManifests(ctx, gomock.Any(), &c).Return(nil)
 
 			err := capi.GenerateAdditionalManifests(ctx, &c)
 			Expect(err).To(Not(HaveOccurred()))
@@ -0,0 +1,188 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
+)
+
+const statusInfoScheduledInstallationAborted = "Installation was aborted because the maintenance window closed"
+
+// Host stages in which the installation disk of the host was not modified yet
+var stagesBeforeWritingImage = []models.HostStage{
+	"",
+	models.HostStageStartingInstallation,
+	models.HostStageInstalling,
+}
+
+// InstallationTrigger starts and cancels cluster installations, it is implemented by the inventory
+//
+//go:generate mockgen -source=installation_scheduler.go -package=cluster -destination=mock_installation_trigger.go
+type InstallationTrigger interface {
+	StartInstallation(ctx context.Context, clusterID strfmt.UUID) error
+	CancelInstallation(ctx context.Context, clusterID strfmt.UUID) error
+}
+
+// InstallationScheduler starts the installation of clusters that have a scheduled installation time once they are
+// ready and their maintenance window is open, and aborts installations that did not start writing the image to the
+// disks of the hosts before the maintenance window closed. A maintenance window without a scheduled installation time
+// only restricts the time at which the installation may be started by the user.
+type InstallationScheduler struct {
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	eventsHandler eventsapi.Handler
+	leaderElector leader.Leader
+	clusterAPI    API
+	hostAPI       host.API
+	objectHandler s3wrapper.API
+	trigger       InstallationTrigger
+
+	// last reason reported for each cluster that is waiting, used to avoid sending the same event on every run
+	waitingMutex sync.Mutex
+	waiting      map[strfmt.UUID]string
+}
+
+func NewInstallationScheduler(log logrus.FieldLogger, db *gorm.DB, eventsHandler eventsapi.Handler, leaderElector leader.Leader,
+	clusterAPI API, hostAPI host.API, objectHandler s3wrapper.API, trigger InstallationTrigger) *InstallationScheduler {
+	return &InstallationScheduler{
+		log:           log,
+		db:            db,
+		eventsHandler: eventsHandler,
+		leaderElector: leaderElector,
+		clusterAPI:    clusterAPI,
+		hostAPI:       hostAPI,
+		objectHandler: objectHandler,
+		trigger:       trigger,
+		waiting:       make(map[strfmt.UUID]string),
+	}
+}
+
+func (s *InstallationScheduler) ScheduleInstallations() {
+	if !s.leaderElector.IsLeader() {
+		s.log.Debugf("Not a leader, exiting ScheduleInstallations")
+		return
+	}
+	var (
+		requestID = requestid.NewID()
+		ctx       = requestid.ToContext(context.Background(), requestID)
+		log       = requestid.RequestIDLogger(s.log, requestID)
+		now       = time.Now()
+	)
+
+	// Installations of clusters that are managed by the kube API are started by the controllers
+	clusters, err := common.GetClustersFromDBWhere(s.db, common.UseEagerLoading, common.SkipDeletedRecords,
+		"status = ? and scheduled_install_time is not null and (kube_key_name = '' or kube_key_name is null)", models.ClusterStatusReady)
+	if err != nil {
+		log.WithError(err).Error("failed to get clusters with scheduled installations")
+		return
+	}
+	for _, c := range clusters {
+		s.scheduleInstallation(ctx, log, c, now)
+	}
+
+	clusters, err = common.GetClustersFromDBWhere(s.db, common.UseEagerLoading, common.SkipDeletedRecords,
+		"status = ? and maintenance_window_start_time is not null and maintenance_window_start_time != ''", models.ClusterStatusInstalling)
+	if err != nil {
+		log.WithError(err).Error("failed to get installing clusters with maintenance windows")
+		return
+	}
+	for _, c := range clusters {
+		s.abortInstallationOutsideWindow(ctx, log, c, now)
+	}
+}
+
+func (s *InstallationScheduler) scheduleInstallation(ctx context.Context, log logrus.FieldLogger, c *common.Cluster, now time.Time) {
+	allowed, reason := IsInstallationAllowed(c, now)
+	if !allowed {
+		if s.setWaitingReason(*c.ID, reason) {
+			log.Infof("cluster %s is ready, %s", c.ID, reason)
+			eventgen.SendScheduledInstallationWaitingEvent(ctx, s.eventsHandler, *c.ID, reason)
+		}
+		return
+	}
+
+	s.setWaitingReason(*c.ID, "")
+	log.Infof("starting scheduled installation of cluster %s", c.ID)
+	eventgen.SendScheduledInstallationStartedEvent(ctx, s.eventsHandler, *c.ID)
+	if err := s.trigger.StartInstallation(ctx, *c.ID); err != nil {
+		log.WithError(err).Errorf("failed to start scheduled installation of cluster %s", c.ID)
+		eventgen.SendScheduledInstallationFailedEvent(ctx, s.eventsHandler, *c.ID, err.Error())
+	}
+}
+
+// setWaitingReason stores the reason for which the cluster is waiting and returns true if it changed
+func (s *InstallationScheduler) setWaitingReason(clusterID strfmt.UUID, reason string) bool {
+	s.waitingMutex.Lock()
+	defer s.waitingMutex.Unlock()
+	if reason == "" {
+		delete(s.waiting, clusterID)
+		return false
+	}
+	if s.waiting[clusterID] == reason {
+		return false
+	}
+	s.waiting[clusterID] = reason
+	return true
+}
+
+func canAbortInstallation(c *common.Cluster) bool {
+	for _, h := range c.Hosts {
+		if h.Progress != nil && !funk.Contains(stagesBeforeWritingImage, h.Progress.CurrentStage) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *InstallationScheduler) abortInstallationOutsideWindow(ctx context.Context, log logrus.FieldLogger, c *common.Cluster, now time.Time) {
+	if !IsMaintenanceWindowSet(c.MaintenanceWindow) {
+		return
+	}
+	if open, err := IsMaintenanceWindowOpen(c.MaintenanceWindow, now); err != nil || open {
+		return
+	}
+	if !canAbortInstallation(c) {
+		log.Debugf("maintenance window of cluster %s closed after the hosts started writing the image, installation continues", c.ID)
+		return
+	}
+
+	log.Infof("aborting installation of cluster %s, the maintenance window closed", c.ID)
+	if err := s.trigger.CancelInstallation(ctx, *c.ID); err != nil {
+		log.WithError(err).Errorf("failed to cancel installation of cluster %s", c.ID)
+		return
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		cluster, err := common.GetClusterFromDBForUpdate(tx, *c.ID, common.UseEagerLoading)
+		if err != nil {
+			return err
+		}
+		if err := s.clusterAPI.ResetCluster(ctx, cluster, statusInfoScheduledInstallationAborted, tx); err != nil {
+			return err
+		}
+		for _, h := range cluster.Hosts {
+			if err := s.hostAPI.ResetHost(ctx, h, statusInfoScheduledInstallationAborted, tx); err != nil {
+				return err
+			}
+		}
+		return s.clusterAPI.ResetClusterFiles(ctx, cluster, s.objectHandler)
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to reset cluster %s after aborting its installation", c.ID)
+		return
+	}
+	eventgen.SendScheduledInstallationAbortedEvent(ctx, s.eventsHandler, *c.ID)
+}
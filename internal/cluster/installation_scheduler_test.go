@@ -0,0 +1,187 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("InstallationScheduler", func() {
+	var (
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockEvents    *eventsapi.MockHandler
+		mockCluster   *MockAPI
+		mockHost      *host.MockAPI
+		mockS3Client  *s3wrapper.MockAPI
+		mockTrigger   *MockInstallationTrigger
+		scheduler     *InstallationScheduler
+		clusterID     strfmt.UUID
+		schedulerTime time.Time
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockCluster = NewMockAPI(ctrl)
+		mockHost = host.NewMockAPI(ctrl)
+		mockS3Client = s3wrapper.NewMockAPI(ctrl)
+		mockTrigger = NewMockInstallationTrigger(ctrl)
+		scheduler = NewInstallationScheduler(common.GetTestLog(), db, mockEvents, &leader.DummyElector{},
+			mockCluster, mockHost, mockS3Client, mockTrigger)
+		clusterID = strfmt.UUID(uuid.New().String())
+		schedulerTime = time.Now()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	// windowAt returns a window of one hour that opens at the given offset from now
+	windowAt := func(offset time.Duration) *models.MaintenanceWindow {
+		return &models.MaintenanceWindow{
+			StartTime:       schedulerTime.Add(offset).UTC().Format("15:04"),
+			DurationMinutes: swag.Int64(60),
+			TimeZone:        swag.String("UTC"),
+		}
+	}
+
+	createCluster := func(status string, scheduledInstallTime *time.Time, window *models.MaintenanceWindow, kubeKeyName string) {
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:                &clusterID,
+				Status:            swag.String(status),
+				MaintenanceWindow: window,
+			},
+			KubeKeyName: kubeKeyName,
+		}
+		if scheduledInstallTime != nil {
+			t := strfmt.DateTime(*scheduledInstallTime)
+			c.ScheduledInstallTime = &t
+		}
+		Expect(db.Create(&c).Error).ToNot(HaveOccurred())
+	}
+
+	createHostAtStage := func(stage models.HostStage) {
+		hostID := strfmt.UUID(uuid.New().String())
+		h := models.Host{
+			ID:         &hostID,
+			InfraEnvID: clusterID,
+			ClusterID:  &clusterID,
+			Status:     swag.String(models.HostStatusInstallingInProgress),
+			Progress:   &models.HostProgressInfo{CurrentStage: stage},
+		}
+		Expect(db.Create(&h).Error).ToNot(HaveOccurred())
+	}
+
+	expectEvent := func(name string) {
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(name),
+			eventstest.WithClusterIdMatcher(clusterID.String()))).Times(1)
+	}
+
+	Context("starting installations", func() {
+		It("starts the installation once the scheduled time passed", func() {
+			past := schedulerTime.Add(-time.Minute)
+			createCluster(models.ClusterStatusReady, &past, nil, "")
+			expectEvent(eventgen.ScheduledInstallationStartedEventName)
+			mockTrigger.EXPECT().StartInstallation(gomock.Any(), clusterID).Return(nil).Times(1)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("starts the installation when the maintenance window is open", func() {
+			past := schedulerTime.Add(-time.Minute)
+			createCluster(models.ClusterStatusReady, &past, windowAt(-30*time.Minute), "")
+			expectEvent(eventgen.ScheduledInstallationStartedEventName)
+			mockTrigger.EXPECT().StartInstallation(gomock.Any(), clusterID).Return(nil).Times(1)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("reports a failure to start the installation", func() {
+			past := schedulerTime.Add(-time.Minute)
+			createCluster(models.ClusterStatusReady, &past, nil, "")
+			expectEvent(eventgen.ScheduledInstallationStartedEventName)
+			expectEvent(eventgen.ScheduledInstallationFailedEventName)
+			mockTrigger.EXPECT().StartInstallation(gomock.Any(), clusterID).Return(errors.New("not ready")).Times(1)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("waits for the scheduled time and reports it once", func() {
+			future := schedulerTime.Add(time.Hour)
+			createCluster(models.ClusterStatusReady, &future, nil, "")
+			expectEvent(eventgen.ScheduledInstallationWaitingEventName)
+			scheduler.ScheduleInstallations()
+			scheduler.ScheduleInstallations()
+		})
+
+		It("waits for the maintenance window", func() {
+			past := schedulerTime.Add(-time.Minute)
+			createCluster(models.ClusterStatusReady, &past, windowAt(2*time.Hour), "")
+			expectEvent(eventgen.ScheduledInstallationWaitingEventName)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("ignores clusters without a scheduled installation time", func() {
+			createCluster(models.ClusterStatusReady, nil, windowAt(-30*time.Minute), "")
+			scheduler.ScheduleInstallations()
+		})
+
+		It("ignores clusters that are managed by the kube API", func() {
+			past := schedulerTime.Add(-time.Minute)
+			createCluster(models.ClusterStatusReady, &past, nil, "cluster-deployment")
+			scheduler.ScheduleInstallations()
+		})
+	})
+
+	Context("aborting installations", func() {
+		It("aborts the installation when the window closed before writing the image", func() {
+			createCluster(models.ClusterStatusInstalling, nil, windowAt(-2*time.Hour), "")
+			createHostAtStage(models.HostStageInstalling)
+			createHostAtStage(models.HostStageStartingInstallation)
+			mockTrigger.EXPECT().CancelInstallation(gomock.Any(), clusterID).Return(nil).Times(1)
+			mockCluster.EXPECT().ResetCluster(gomock.Any(), gomock.Any(), statusInfoScheduledInstallationAborted, gomock.Any()).Return(nil).Times(1)
+			mockHost.EXPECT().ResetHost(gomock.Any(), gomock.Any(), statusInfoScheduledInstallationAborted, gomock.Any()).Return(nil).Times(2)
+			mockCluster.EXPECT().ResetClusterFiles(gomock.Any(), gomock.Any(), mockS3Client).Return(nil).Times(1)
+			expectEvent(eventgen.ScheduledInstallationAbortedEventName)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("continues the installation after the hosts started writing the image", func() {
+			createCluster(models.ClusterStatusInstalling, nil, windowAt(-2*time.Hour), "")
+			createHostAtStage(models.HostStageInstalling)
+			createHostAtStage(models.HostStageWritingImageToDisk)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("continues the installation while the window is open", func() {
+			createCluster(models.ClusterStatusInstalling, nil, windowAt(-30*time.Minute), "")
+			createHostAtStage(models.HostStageInstalling)
+			scheduler.ScheduleInstallations()
+		})
+
+		It("does not reset the cluster when the cancellation failed", func() {
+			createCluster(models.ClusterStatusInstalling, nil, windowAt(-2*time.Hour), "")
+			createHostAtStage(models.HostStageInstalling)
+			mockTrigger.EXPECT().CancelInstallation(gomock.Any(), clusterID).Return(errors.New("failed")).Times(1)
+			scheduler.ScheduleInstallations()
+		})
+	})
+})
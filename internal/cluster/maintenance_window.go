@@ -0,0 +1,160 @@
+package cluster
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	maintenanceWindowStartTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+	maintenanceWindowWeekdays = map[string]time.Weekday{
+		"sun": time.Sunday,
+		"mon": time.Monday,
+		"tue": time.Tuesday,
+		"wed": time.Wednesday,
+		"thu": time.Thursday,
+		"fri": time.Friday,
+		"sat": time.Saturday,
+	}
+)
+
+// IsMaintenanceWindowSet returns true if the window restricts the time at which the installation may start
+func IsMaintenanceWindowSet(window *models.MaintenanceWindow) bool {
+	return window != nil && window.StartTime != ""
+}
+
+// ValidateMaintenanceWindow verifies that the window can be evaluated. An unset window is always valid.
+func ValidateMaintenanceWindow(window *models.MaintenanceWindow) error {
+	_, err := parseMaintenanceWindow(window)
+	return err
+}
+
+type maintenanceWindow struct {
+	hour     int
+	minute   int
+	duration time.Duration
+	days     map[time.Weekday]bool
+	location *time.Location
+}
+
+func parseMaintenanceWindow(window *models.MaintenanceWindow) (*maintenanceWindow, error) {
+	if !IsMaintenanceWindowSet(window) {
+		return nil, nil
+	}
+	if !maintenanceWindowStartTimeRegex.MatchString(window.StartTime) {
+		return nil, errors.Errorf("maintenance window start time %s is not in HH:MM format", window.StartTime)
+	}
+	ret := &maintenanceWindow{}
+	if _, err := fmt.Sscanf(window.StartTime, "%d:%d", &ret.hour, &ret.minute); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse maintenance window start time %s", window.StartTime)
+	}
+	durationMinutes := swag.Int64Value(window.DurationMinutes)
+	if durationMinutes <= 0 || durationMinutes > 24*60 {
+		return nil, errors.Errorf("maintenance window duration must be between 1 and %d minutes", 24*60)
+	}
+	ret.duration = time.Duration(durationMinutes) * time.Minute
+
+	timeZone := swag.StringValue(window.TimeZone)
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid maintenance window time zone %s", timeZone)
+	}
+	ret.location = location
+
+	if strings.TrimSpace(window.Days) != "" {
+		ret.days = make(map[time.Weekday]bool)
+		for _, day := range strings.Split(window.Days, ",") {
+			weekday, ok := maintenanceWindowWeekdays[strings.ToLower(strings.TrimSpace(day))]
+			if !ok {
+				return nil, errors.Errorf("invalid maintenance window day %s, expected one of Mon, Tue, Wed, Thu, Fri, Sat, Sun", day)
+			}
+			ret.days[weekday] = true
+		}
+	}
+	return ret, nil
+}
+
+// startOn returns the time at which the window opens on the given date, and false if it does not open on that date
+func (w *maintenanceWindow) startOn(year int, month time.Month, day int) (time.Time, bool) {
+	start := time.Date(year, month, day, w.hour, w.minute, 0, 0, w.location)
+	if w.days != nil && !w.days[start.Weekday()] {
+		return start, false
+	}
+	return start, true
+}
+
+func (w *maintenanceWindow) isOpen(now time.Time) bool {
+	local := now.In(w.location)
+	// A window that opened the day before may still be open after midnight
+	for _, offset := range []int{0, -1} {
+		start, ok := w.startOn(local.Year(), local.Month(), local.Day()+offset)
+		if ok && !now.Before(start) && now.Before(start.Add(w.duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *maintenanceWindow) nextStart(now time.Time) time.Time {
+	local := now.In(w.location)
+	for offset := 0; offset <= 7; offset++ {
+		start, ok := w.startOn(local.Year(), local.Month(), local.Day()+offset)
+		if ok && start.After(now) {
+			return start
+		}
+	}
+	return time.Time{}
+}
+
+// IsMaintenanceWindowOpen returns true if the installation may start at the given time according to the window.
+// An unset window is always open.
+func IsMaintenanceWindowOpen(window *models.MaintenanceWindow, now time.Time) (bool, error) {
+	w, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return false, err
+	}
+	if w == nil {
+		return true, nil
+	}
+	return w.isOpen(now), nil
+}
+
+// NextMaintenanceWindowStart returns the time at which the window opens next after the given time
+func NextMaintenanceWindowStart(window *models.MaintenanceWindow, now time.Time) (time.Time, error) {
+	w, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if w == nil {
+		return now, nil
+	}
+	return w.nextStart(now), nil
+}
+
+// IsInstallationAllowed checks the scheduled installation time and the maintenance window of the cluster.
+// When the installation is not allowed, the returned message explains when it will be.
+func IsInstallationAllowed(c *common.Cluster, now time.Time) (bool, string) {
+	if c.ScheduledInstallTime != nil && now.Before(time.Time(*c.ScheduledInstallTime)) {
+		return false, fmt.Sprintf("installation is scheduled for %s", time.Time(*c.ScheduledInstallTime).UTC().Format(time.RFC3339))
+	}
+	open, err := IsMaintenanceWindowOpen(c.MaintenanceWindow, now)
+	if err != nil {
+		return false, fmt.Sprintf("maintenance window is invalid: %s", err.Error())
+	}
+	if !open {
+		next, _ := NextMaintenanceWindowStart(c.MaintenanceWindow, now)
+		return false, fmt.Sprintf("waiting for the maintenance window that opens at %s", next.UTC().Format(time.RFC3339))
+	}
+	return true, ""
+}
@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Maintenance window", func() {
+	newWindow := func(startTime string, durationMinutes int64, days, timeZone string) *models.MaintenanceWindow {
+		return &models.MaintenanceWindow{
+			StartTime:       startTime,
+			DurationMinutes: swag.Int64(durationMinutes),
+			Days:            days,
+			TimeZone:        swag.String(timeZone),
+		}
+	}
+
+	// 2024-05-04 is a Saturday
+	at := func(value string) time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	Context("ValidateMaintenanceWindow", func() {
+		It("accepts an unset window", func() {
+			Expect(ValidateMaintenanceWindow(nil)).To(Succeed())
+			Expect(ValidateMaintenanceWindow(&models.MaintenanceWindow{})).To(Succeed())
+		})
+
+		It("accepts a valid window", func() {
+			Expect(ValidateMaintenanceWindow(newWindow("22:30", 120, "Sat, sun", "Europe/Berlin"))).To(Succeed())
+		})
+
+		It("defaults to UTC", func() {
+			window := newWindow("22:30", 120, "", "")
+			Expect(ValidateMaintenanceWindow(window)).To(Succeed())
+			window.TimeZone = nil
+			Expect(ValidateMaintenanceWindow(window)).To(Succeed())
+		})
+
+		DescribeTable("rejects an invalid window",
+			func(window *models.MaintenanceWindow, expectedError string) {
+				err := ValidateMaintenanceWindow(window)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedError))
+			},
+			Entry("start time", newWindow("24:00", 60, "", "UTC"), "is not in HH:MM format"),
+			Entry("zero duration", newWindow("22:00", 0, "", "UTC"), "duration must be between 1 and 1440 minutes"),
+			Entry("long duration", newWindow("22:00", 1441, "", "UTC"), "duration must be between 1 and 1440 minutes"),
+			Entry("time zone", newWindow("22:00", 60, "", "Mars/Olympus_Mons"), "invalid maintenance window time zone"),
+			Entry("day", newWindow("22:00", 60, "Sat,Holiday", "UTC"), "invalid maintenance window day Holiday"),
+		)
+	})
+
+	DescribeTable("IsMaintenanceWindowOpen",
+		func(window *models.MaintenanceWindow, now string, expected bool) {
+			open, err := IsMaintenanceWindowOpen(window, at(now))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(open).To(Equal(expected))
+		},
+		Entry("unset window", nil, "2024-05-04T10:00:00Z", true),
+		Entry("before the window", newWindow("22:00", 120, "", "UTC"), "2024-05-04T21:59:00Z", false),
+		Entry("at the start of the window", newWindow("22:00", 120, "", "UTC"), "2024-05-04T22:00:00Z", true),
+		Entry("after midnight", newWindow("22:00", 180, "", "UTC"), "2024-05-05T00:30:00Z", true),
+		Entry("at the end of the window", newWindow("22:00", 120, "", "UTC"), "2024-05-05T00:00:00Z", false),
+		Entry("on a window day", newWindow("10:00", 60, "Sat", "UTC"), "2024-05-04T10:30:00Z", true),
+		Entry("on another day", newWindow("10:00", 60, "Sun", "UTC"), "2024-05-04T10:30:00Z", false),
+		Entry("after midnight of a window day", newWindow("23:00", 120, "Sat", "UTC"), "2024-05-05T00:30:00Z", true),
+		Entry("in the time zone of the window", newWindow("10:00", 60, "", "Asia/Tokyo"), "2024-05-04T01:30:00Z", true),
+		Entry("outside the time zone of the window", newWindow("10:00", 60, "", "Asia/Tokyo"), "2024-05-04T10:30:00Z", false),
+	)
+
+	It("NextMaintenanceWindowStart", func() {
+		next, err := NextMaintenanceWindowStart(newWindow("22:00", 60, "Mon,Wed", "UTC"), at("2024-05-04T23:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next.UTC()).To(Equal(at("2024-05-06T22:00:00Z")))
+	})
+
+	Context("IsInstallationAllowed", func() {
+		var c *common.Cluster
+
+		BeforeEach(func() {
+			c = &common.Cluster{}
+		})
+
+		It("allows the installation without a schedule", func() {
+			allowed, reason := IsInstallationAllowed(c, at("2024-05-04T10:00:00Z"))
+			Expect(allowed).To(BeTrue())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("waits for the scheduled installation time", func() {
+			scheduledInstallTime := strfmt.DateTime(at("2024-05-04T12:00:00Z"))
+			c.ScheduledInstallTime = &scheduledInstallTime
+			allowed, reason := IsInstallationAllowed(c, at("2024-05-04T10:00:00Z"))
+			Expect(allowed).To(BeFalse())
+			Expect(reason).To(Equal("installation is scheduled for 2024-05-04T12:00:00Z"))
+			allowed, _ = IsInstallationAllowed(c, at("2024-05-04T12:00:00Z"))
+			Expect(allowed).To(BeTrue())
+		})
+
+		It("waits for the maintenance window", func() {
+			c.MaintenanceWindow = newWindow("22:00", 60, "", "UTC")
+			allowed, reason := IsInstallationAllowed(c, at("2024-05-04T10:00:00Z"))
+			Expect(allowed).To(BeFalse())
+			Expect(reason).To(Equal("waiting for the maintenance window that opens at 2024-05-04T22:00:00Z"))
+			allowed, _ = IsInstallationAllowed(c, at("2024-05-04T22:30:00Z"))
+			Expect(allowed).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: installation_scheduler.go
+
+// Package cluster is a generated GoMock package.
+package cluster
+
+import (
+	context "context"
+	reflect "reflect"
+
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockInstallationTrigger is a mock of InstallationTrigger interface.
+type MockInstallationTrigger struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstallationTriggerMockRecorder
+}
+
+// MockInstallationTriggerMockRecorder is the mock recorder for MockInstallationTrigger.
+type MockInstallationTriggerMockRecorder struct {
+	mock *MockInstallationTrigger
+}
+
+// NewMockInstallationTrigger creates a new mock instance.
+func NewMockInstallationTrigger(ctrl *gomock.Controller) *MockInstallationTrigger {
+	mock := &MockInstallationTrigger{ctrl: ctrl}
+	mock.recorder = &MockInstallationTriggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstallationTrigger) EXPECT() *MockInstallationTriggerMockRecorder {
+	return m.recorder
+}
+
+// CancelInstallation mocks base method.
+func (m *MockInstallationTrigger) CancelInstallation(ctx context.Context, clusterID strfmt.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelInstallation", ctx, clusterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelInstallation indicates an expected call of CancelInstallation.
+func (mr *MockInstallationTriggerMockRecorder) CancelInstallation(ctx, clusterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInstallation", reflect.TypeOf((*MockInstallationTrigger)(nil).CancelInstallation), ctx, clusterID)
+}
+
+// StartInstallation mocks base method.
+func (m *MockInstallationTrigger) StartInstallation(ctx context.Context, clusterID strfmt.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInstallation", ctx, clusterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartInstallation indicates an expected call of StartInstallation.
+func (mr *MockInstallationTriggerMockRecorder) StartInstallation(ctx, clusterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstallation", reflect.TypeOf((*MockInstallationTrigger)(nil).StartInstallation), ctx, clusterID)
+}
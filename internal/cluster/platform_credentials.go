@@ -0,0 +1,190 @@
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/provider/nutanix"
+	"github.com/openshift/assisted-service/internal/provider/openstack"
+	"github.com/openshift/assisted-service/internal/provider/vsphere"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PlatformCredentialsConfig configures the validation of the platform credentials of vSphere, Nutanix and OpenStack
+// clusters against the API of the platform
+type PlatformCredentialsConfig struct {
+	Enabled  bool          `envconfig:"PLATFORM_CREDENTIALS_VALIDATION_ENABLED" default:"false"`
+	Timeout  time.Duration `envconfig:"PLATFORM_CREDENTIALS_VALIDATION_TIMEOUT" default:"2m"`
+	Interval time.Duration `envconfig:"PLATFORM_CREDENTIALS_VALIDATION_INTERVAL" default:"10m"`
+	Insecure bool          `envconfig:"PLATFORM_CREDENTIALS_VALIDATION_INSECURE" default:"false"`
+}
+
+var platformCredentialsValidators = map[models.PlatformType]provider.CredentialsValidator{
+	models.PlatformTypeVsphere:   vsphere.ValidateCredentials,
+	models.PlatformTypeNutanix:   nutanix.ValidateCredentials,
+	models.PlatformTypeOpenstack: openstack.ValidateCredentials,
+}
+
+// platformCredentialsCheckResult is the result of the last validation of the platform credentials of a cluster, as
+// it is stored in the cluster
+type platformCredentialsCheckResult struct {
+	Configured bool     `json:"configured"`
+	Failures   []string `json:"failures,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// platformCredentialsChecker validates the platform credentials of the clusters in the background, as the API of the
+// platform can be slow to answer. The result of a validation is stored in the cluster, so that all the replicas of the
+// service use it, until the credentials or the hosts of the cluster change, or until the validation interval passes.
+type platformCredentialsChecker struct {
+	log        logrus.FieldLogger
+	db         *gorm.DB
+	config     PlatformCredentialsConfig
+	validators map[models.PlatformType]provider.CredentialsValidator
+}
+
+func newPlatformCredentialsChecker(log logrus.FieldLogger, db *gorm.DB, config PlatformCredentialsConfig) *platformCredentialsChecker {
+	return &platformCredentialsChecker{
+		log:        log,
+		db:         db,
+		config:     config,
+		validators: platformCredentialsValidators,
+	}
+}
+
+func (p *platformCredentialsChecker) check(cluster *common.Cluster) (ValidationStatus, string) {
+	if !p.config.Enabled {
+		return ValidationSuccess, "The validation of the platform credentials is disabled."
+	}
+	var platformType models.PlatformType
+	if cluster.Platform != nil {
+		platformType = common.PlatformTypeValue(cluster.Platform.Type)
+	}
+	validator, ok := p.validators[platformType]
+	if !ok {
+		return ValidationSuccess, fmt.Sprintf("The platform credentials aren't validated for platform %s.", platformType)
+	}
+
+	key := platformCredentialsKey(cluster)
+	if cluster.PlatformCredentialsCheckKey != key || time.Since(cluster.PlatformCredentialsCheckedAt) >= p.config.Interval {
+		p.startValidation(cluster, key, validator)
+	}
+
+	// The validation doesn't hold back the cluster until its first result, so that a ready cluster isn't made
+	// insufficient whenever its hosts change
+	if cluster.PlatformCredentialsCheckKey != key || cluster.PlatformCredentialsCheckResult == "" {
+		return ValidationSuccess, "The platform credentials weren't validated yet."
+	}
+	var result platformCredentialsCheckResult
+	if err := json.Unmarshal([]byte(cluster.PlatformCredentialsCheckResult), &result); err != nil {
+		p.log.WithError(err).Warnf("Failed to parse the result of the validation of the platform credentials of cluster %s", cluster.ID)
+		return ValidationSuccess, "The platform credentials weren't validated yet."
+	}
+	switch {
+	case result.Error != "":
+		return ValidationFailure, fmt.Sprintf("Failed to validate the platform credentials: %s.", result.Error)
+	case !result.Configured:
+		return ValidationSuccess, "The platform credentials aren't set."
+	case len(result.Failures) > 0:
+		return ValidationFailure, fmt.Sprintf("The validation of the platform credentials failed: %s.", strings.Join(result.Failures, "; "))
+	default:
+		return ValidationSuccess, "The platform credentials are valid."
+	}
+}
+
+// startValidation validates the credentials in the background, unless another refresh of the cluster, possibly by
+// another replica of the service, already started it. The result of the previous validation is kept while the
+// credentials are validated again after the interval, and cleared when they changed.
+func (p *platformCredentialsChecker) startValidation(cluster *common.Cluster, key string, validator provider.CredentialsValidator) {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"platform_credentials_check_key":  key,
+		"platform_credentials_checked_at": now,
+	}
+	if cluster.PlatformCredentialsCheckKey != key {
+		updates["platform_credentials_check_result"] = ""
+	}
+	result := p.db.Model(&common.Cluster{}).
+		Where("id = ? and (platform_credentials_check_key is distinct from ? or platform_credentials_checked_at is null or platform_credentials_checked_at <= ?)",
+			cluster.ID.String(), key, now.Add(-p.config.Interval)).
+		Updates(updates)
+	if result.Error != nil {
+		p.log.WithError(result.Error).Warnf("Failed to start the validation of the platform credentials of cluster %s", cluster.ID)
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+	go p.validate(snapshotCluster(cluster), key, validator)
+}
+
+func (p *platformCredentialsChecker) validate(cluster *common.Cluster, key string, validator provider.CredentialsValidator) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.Timeout)
+	defer cancel()
+	log := p.log.WithField("cluster_id", cluster.ID)
+	var checkResult platformCredentialsCheckResult
+	result, err := validator(ctx, log, cluster, p.config.Insecure)
+	if err != nil {
+		log.WithError(err).Warn("Failed to validate the platform credentials")
+		checkResult.Error = err.Error()
+	} else if result != nil {
+		checkResult.Configured = result.Configured
+		checkResult.Failures = result.Failures
+	}
+
+	data, err := json.Marshal(&checkResult)
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal the result of the validation of the platform credentials")
+		return
+	}
+	// The result is dropped when the credentials changed while they were validated
+	if err = p.db.Model(&common.Cluster{}).Where("id = ? and platform_credentials_check_key = ?", cluster.ID.String(), key).
+		Update("platform_credentials_check_result", string(data)).Error; err != nil {
+		log.WithError(err).Error("Failed to store the result of the validation of the platform credentials")
+	}
+}
+
+// snapshotCluster copies the cluster and its hosts, which are validated while the refresh of the cluster goes on
+func snapshotCluster(cluster *common.Cluster) *common.Cluster {
+	snapshot := *cluster
+	snapshot.Hosts = make([]*models.Host, 0, len(cluster.Hosts))
+	for _, h := range cluster.Hosts {
+		hostCopy := *h
+		snapshot.Hosts = append(snapshot.Hosts, &hostCopy)
+	}
+	return &snapshot
+}
+
+// platformCredentialsKey identifies the data that the validation depends on: the platform, the install config
+// overrides, the OpenStack clouds.yaml and the serial numbers of the hosts
+func platformCredentialsKey(cluster *common.Cluster) string {
+	hosts := make([]string, 0, len(cluster.Hosts))
+	for _, h := range cluster.Hosts {
+		serialNumber := ""
+		if h.Inventory != "" {
+			if inventory, err := common.UnmarshalInventory(h.Inventory); err == nil && inventory.SystemVendor != nil {
+				serialNumber = inventory.SystemVendor.SerialNumber
+			}
+		}
+		hosts = append(hosts, fmt.Sprintf("%s=%s", h.ID, serialNumber))
+	}
+	sort.Strings(hosts)
+
+	var platformType models.PlatformType
+	if cluster.Platform != nil {
+		platformType = common.PlatformTypeValue(cluster.Platform.Type)
+	}
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%s\n%s\n%s", platformType, cluster.InstallConfigOverrides, cluster.OpenstackCloudsYAML, strings.Join(hosts, ","))
+	return hex.EncodeToString(hash.Sum(nil))
+}
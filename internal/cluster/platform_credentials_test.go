@@ -0,0 +1,165 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Platform credentials validation", func() {
+	var (
+		db          *gorm.DB
+		dbName      string
+		checker     *platformCredentialsChecker
+		clusterID   strfmt.UUID
+		mu          sync.Mutex
+		validations int
+		result      *provider.CredentialsResult
+		resultErr   error
+		release     chan struct{}
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		checker = newPlatformCredentialsChecker(common.GetTestLog(), db, PlatformCredentialsConfig{
+			Enabled:  true,
+			Timeout:  time.Minute,
+			Interval: time.Hour,
+		})
+		validations = 0
+		result = &provider.CredentialsResult{Configured: true}
+		resultErr = nil
+		release = make(chan struct{})
+		close(release)
+		validator := func(_ context.Context, _ logrus.FieldLogger, _ *common.Cluster, _ bool) (*provider.CredentialsResult, error) {
+			<-release
+			mu.Lock()
+			defer mu.Unlock()
+			validations++
+			return result, resultErr
+		}
+		checker.validators = map[models.PlatformType]provider.CredentialsValidator{models.PlatformTypeVsphere: validator}
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:                     &clusterID,
+			Platform:               &models.Platform{Type: models.PlatformTypeVsphere.Pointer()},
+			InstallConfigOverrides: `{"platform":{"vsphere":{}}}`,
+		}}).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	getValidations := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return validations
+	}
+
+	// check validates the cluster as it is stored, like the refresh of the cluster does
+	check := func() (ValidationStatus, string) {
+		cluster, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		return checker.check(cluster)
+	}
+
+	checkMessage := func() string {
+		_, message := check()
+		return message
+	}
+
+	It("succeeds when the validation is disabled", func() {
+		checker.config.Enabled = false
+		status, message := check()
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The validation of the platform credentials is disabled."))
+	})
+
+	It("succeeds for the platforms without credentials", func() {
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).Update("platform_type", models.PlatformTypeBaremetal).Error).ShouldNot(HaveOccurred())
+		status, message := check()
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The platform credentials aren't validated for platform baremetal."))
+	})
+
+	It("succeeds until the credentials are validated", func() {
+		release = make(chan struct{})
+		status, message := check()
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The platform credentials weren't validated yet."))
+		close(release)
+
+		Eventually(checkMessage).Should(Equal("The platform credentials are valid."))
+	})
+
+	It("fails with the failures of the validation", func() {
+		result.Failures = []string{"vCenter vcenter.example.com: datacenter DC1 not found", "vCenter vcenter.example.com: network missing of failure domain fd-0 not found"}
+		Eventually(checkMessage).Should(Equal("The validation of the platform credentials failed: vCenter vcenter.example.com: datacenter DC1 not found; " +
+			"vCenter vcenter.example.com: network missing of failure domain fd-0 not found."))
+		status, _ := check()
+		Expect(status).To(Equal(ValidationFailure))
+	})
+
+	It("fails when the credentials couldn't be validated", func() {
+		resultErr = errors.New("failed to parse the install config overrides")
+		Eventually(checkMessage).Should(Equal("Failed to validate the platform credentials: failed to parse the install config overrides."))
+		status, _ := check()
+		Expect(status).To(Equal(ValidationFailure))
+	})
+
+	It("succeeds when the credentials aren't configured", func() {
+		result.Configured = false
+		Eventually(checkMessage).Should(Equal("The platform credentials aren't set."))
+	})
+
+	It("shares the result between the replicas of the service", func() {
+		Eventually(checkMessage).Should(Equal("The platform credentials are valid."))
+
+		replica := newPlatformCredentialsChecker(common.GetTestLog(), db, checker.config)
+		replica.validators = checker.validators
+		cluster, err := common.GetClusterFromDB(db, clusterID, common.UseEagerLoading)
+		Expect(err).ShouldNot(HaveOccurred())
+		status, message := replica.check(cluster)
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("The platform credentials are valid."))
+		Expect(getValidations()).To(Equal(1))
+	})
+
+	It("validates again when the credentials change", func() {
+		Eventually(checkMessage).Should(Equal("The platform credentials are valid."))
+		check()
+		Expect(getValidations()).To(Equal(1))
+
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+			Update("install_config_overrides", `{"platform":{"vsphere":{"vcenters":[]}}}`).Error).ShouldNot(HaveOccurred())
+		Expect(checkMessage()).To(Equal("The platform credentials weren't validated yet."))
+		Eventually(checkMessage).Should(Equal("The platform credentials are valid."))
+		Expect(getValidations()).To(Equal(2))
+
+		Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterID).
+			Update("openstack_clouds_yaml", "clouds: {}").Error).ShouldNot(HaveOccurred())
+		Eventually(checkMessage).Should(Equal("The platform credentials are valid."))
+		Expect(getValidations()).To(Equal(3))
+	})
+
+	It("validates again after the interval", func() {
+		checker.config.Interval = 0
+		Eventually(func() int {
+			check()
+			return getValidations()
+		}).Should(BeNumerically(">=", 2))
+	})
+})
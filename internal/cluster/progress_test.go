@@ -148,6 +148,85 @@ var _ = Describe("Progress bar test", func() {
 		})
 	})
 
+	It("UpdateInstallProgress of a day2 cluster expanding its control plane", func() {
+		clusterId := strfmt.UUID(uuid.New().String())
+		hid1 := strfmt.UUID(uuid.New().String())
+		hid2 := strfmt.UUID(uuid.New().String())
+		hid3 := strfmt.UUID(uuid.New().String())
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:   &clusterId,
+				Kind: swag.String(models.ClusterKindAddHostsCluster),
+				Hosts: []*models.Host{
+					{
+						ID:         &hid1,
+						ClusterID:  &clusterId,
+						InfraEnvID: clusterId,
+						Kind:       swag.String(models.HostKindAddToExistingClusterHost),
+						Role:       models.HostRoleMaster,
+						Status:     swag.String(models.HostStatusAddedToExistingCluster),
+					},
+					{
+						ID:         &hid2,
+						ClusterID:  &clusterId,
+						InfraEnvID: clusterId,
+						Kind:       swag.String(models.HostKindAddToExistingClusterHost),
+						Role:       models.HostRoleMaster,
+						Status:     swag.String(models.HostStatusKnown),
+					},
+					{
+						ID:         &hid3,
+						ClusterID:  &clusterId,
+						InfraEnvID: clusterId,
+						Kind:       swag.String(models.HostKindAddToExistingClusterHost),
+						Role:       models.HostRoleWorker,
+						Status:     swag.String(models.HostStatusInstalling),
+					},
+				},
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+		Expect(db.Model(&models.Host{}).Where("id = ?", hid1.String()).Update("progress_current_stage", models.HostStageJoined).Error).ShouldNot(HaveOccurred())
+
+		joinedIndex := 5
+		mockHostAPI.EXPECT().IndexOfStage(models.HostStageJoined, host.MasterStages[:]).Return(joinedIndex).Times(1)
+		mockHostAPI.EXPECT().IndexOfStage(models.HostStage(""), host.MasterStages[:]).Return(-1).Times(1)
+
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterId)).ShouldNot(HaveOccurred())
+
+		cluster := getClusterFromDB(clusterId, db)
+		expectedPercentage := int64(float64(joinedIndex+1) * 100 / float64(2*len(host.MasterStages[:])))
+		Expect(cluster.Progress.InstallingStagePercentage).To(Equal(expectedPercentage))
+		Expect(cluster.Progress.TotalPercentage).To(Equal(expectedPercentage))
+	})
+
+	It("UpdateInstallProgress of a day2 cluster adding workers", func() {
+		clusterId := strfmt.UUID(uuid.New().String())
+		hid := strfmt.UUID(uuid.New().String())
+		c := common.Cluster{
+			Cluster: models.Cluster{
+				ID:   &clusterId,
+				Kind: swag.String(models.ClusterKindAddHostsCluster),
+				Hosts: []*models.Host{
+					{
+						ID:         &hid,
+						ClusterID:  &clusterId,
+						InfraEnvID: clusterId,
+						Kind:       swag.String(models.HostKindAddToExistingClusterHost),
+						Role:       models.HostRoleWorker,
+						Status:     swag.String(models.HostStatusInstalling),
+					},
+				},
+			},
+		}
+		Expect(db.Create(&c).Error).ShouldNot(HaveOccurred())
+
+		Expect(clusterApi.UpdateInstallProgress(ctx, clusterId)).ShouldNot(HaveOccurred())
+
+		cluster := getClusterFromDB(clusterId, db)
+		Expect(cluster.Progress.TotalPercentage).To(BeZero())
+	})
+
 	It("UpdateInstallProgress in Installed state", func() {
 
 		var clusterId strfmt.UUID
@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
+	"gorm.io/gorm"
 )
 
 type ValidationResult struct {
@@ -36,10 +37,12 @@ type refreshPreprocessor struct {
 	operatorsAPI operators.API
 }
 
-func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API) *refreshPreprocessor {
+func newRefreshPreprocessor(log logrus.FieldLogger, hostAPI host.API, operatorsAPI operators.API, db *gorm.DB,
+	platformCredentialsConfig PlatformCredentialsConfig) *refreshPreprocessor {
 	v := clusterValidator{
-		log:     log,
-		hostAPI: hostAPI,
+		log:                 log,
+		hostAPI:             hostAPI,
+		platformCredentials: newPlatformCredentialsChecker(log, db, platformCredentialsConfig),
 	}
 
 	return &refreshPreprocessor{
@@ -201,10 +204,22 @@ func newValidations(v *clusterValidator) []validation {
 			id:        NetworksSameAddressFamilies,
 			condition: v.isNetworksSameAddressFamilies,
 		},
+		{
+			id:        IsProvisioningNetworkValid,
+			condition: v.isProvisioningNetworkValid,
+		},
+		{
+			id:        IsMtuConsistent,
+			condition: v.isMtuConsistent,
+		},
 		{
 			id:        PlatformRequirementsSatisfied,
 			condition: v.platformRequirementsSatisfied,
 		},
+		{
+			id:        ArePlatformCredentialsValid,
+			condition: v.arePlatformCredentialsValid,
+		},
 	}
 	return ret
 }
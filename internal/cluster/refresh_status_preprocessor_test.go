@@ -43,6 +43,8 @@ var _ = Describe("Cluster Refresh Status Preprocessor", func() {
 			logrus.New(),
 			mockHostApi,
 			mockOperatorManager,
+			db,
+			PlatformCredentialsConfig{},
 		)
 	})
 
@@ -126,12 +126,16 @@ func NewClusterStateMachine(th TransitionHandler) stateswitch.StateMachine {
 		If(IsMtvRequirementsSatisfied),
 		If(isNetworkTypeValid),
 		If(NetworksSameAddressFamilies),
+		If(IsProvisioningNetworkValid),
+		If(IsMtuConsistent),
 		If(IsNodeFeatureDiscoveryRequirementsSatisfied),
 		If(IsNvidiaGPURequirementsSatisfied),
 		If(IsPipelinesRequirementsSatisfied),
 		If(IsServiceMeshRequirementsSatisfied),
 		If(IsServerLessRequirementsSatisfied),
 		If(IsOpenShiftAIRequirementsSatisfied),
+		If(IsCustomOperatorsRequirementsSatisfied),
+		If(ArePlatformCredentialsValid),
 	)
 
 	// Refresh cluster status conditions - Non DHCP
@@ -1873,7 +1873,7 @@ var _ = Describe("RefreshCluster - preparing for install", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil)
-		dnsApi := dns.NewDNSHandler(nil, common.GetTestLog())
+		dnsApi := dns.NewDNSHandler(nil, dns.Config{}, common.GetTestLog())
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db, commontesting.GetDummyNotificationStream(ctrl),
 			mockEvents, nil, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, dnsApi, nil, nil, false)
 
@@ -4795,7 +4795,7 @@ var _ = Describe("Single node", func() {
 		mockHostAPI = host.NewMockAPI(ctrl)
 		mockMetric = metrics.NewMockAPI(ctrl)
 		operatorsManager := operators.NewManager(common.GetTestLog(), nil, operators.Options{}, nil)
-		dnsApi := dns.NewDNSHandler(nil, common.GetTestLog())
+		dnsApi := dns.NewDNSHandler(nil, dns.Config{}, common.GetTestLog())
 		clusterApi = NewManager(getDefaultConfig(), common.GetTestLog().WithField("pkg", "cluster-monitor"), db, commontesting.GetDummyNotificationStream(ctrl),
 			mockEvents, nil, mockHostAPI, mockMetric, nil, nil, operatorsManager, nil, nil, dnsApi, nil, nil, false)
 		hid1 = strfmt.UUID(uuid.New().String())
@@ -18,6 +18,8 @@ const (
 	IsMachineCidrDefined                        = ValidationID(models.ClusterValidationIDMachineCidrDefined)
 	IsMachineCidrEqualsToCalculatedCidr         = ValidationID(models.ClusterValidationIDMachineCidrEqualsToCalculatedCidr)
 	NetworksSameAddressFamilies                 = ValidationID(models.ClusterValidationIDNetworksSameAddressFamilies)
+	IsProvisioningNetworkValid                  = ValidationID(models.ClusterValidationIDProvisioningNetworkValid)
+	IsMtuConsistent                             = ValidationID(models.ClusterValidationIDMtuConsistent)
 	AreApiVipsDefined                           = ValidationID(models.ClusterValidationIDAPIVipsDefined)
 	AreApiVipsValid                             = ValidationID(models.ClusterValidationIDAPIVipsValid)
 	isNetworkTypeValid                          = ValidationID(models.ClusterValidationIDNetworkTypeValid)
@@ -35,23 +37,25 @@ const (
 	IsMceRequirementsSatisfied                  = ValidationID(models.ClusterValidationIDMceRequirementsSatisfied)
 	IsMtvRequirementsSatisfied                  = ValidationID(models.ClusterValidationIDMtvRequirementsSatisfied)
 	PlatformRequirementsSatisfied               = ValidationID(models.ClusterValidationIDPlatformRequirementsSatisfied)
+	ArePlatformCredentialsValid                 = ValidationID(models.ClusterValidationIDPlatformCredentialsValid)
 	IsNodeFeatureDiscoveryRequirementsSatisfied = ValidationID(models.ClusterValidationIDNodeFeatureDiscoveryRequirementsSatisfied)
 	IsNvidiaGPURequirementsSatisfied            = ValidationID(models.ClusterValidationIDNvidiaGpuRequirementsSatisfied)
 	IsPipelinesRequirementsSatisfied            = ValidationID(models.ClusterValidationIDPipelinesRequirementsSatisfied)
 	IsServiceMeshRequirementsSatisfied          = ValidationID(models.ClusterValidationIDServicemeshRequirementsSatisfied)
 	IsServerLessRequirementsSatisfied           = ValidationID(models.ClusterValidationIDServerlessRequirementsSatisfied)
 	IsOpenShiftAIRequirementsSatisfied          = ValidationID(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied)
+	IsCustomOperatorsRequirementsSatisfied      = ValidationID(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)
 )
 
 func (v ValidationID) Category() (string, error) {
 	switch v {
 	case IsMachineCidrDefined, IsMachineCidrEqualsToCalculatedCidr, AreApiVipsDefined, AreApiVipsValid, AreIngressVipsDefined,
 		AreIngressVipsValid, isClusterCidrDefined, isServiceCidrDefined, noCidrOverlapping, networkPrefixValid,
-		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, NetworksSameAddressFamilies:
+		IsDNSDomainDefined, IsNtpServerConfigured, isNetworkTypeValid, NetworksSameAddressFamilies, IsProvisioningNetworkValid, IsMtuConsistent:
 		return "network", nil
 	case AllHostsAreReadyToInstall, SufficientMastersCount:
 		return "hosts-data", nil
-	case IsPullSecretSet, PlatformRequirementsSatisfied:
+	case IsPullSecretSet, PlatformRequirementsSatisfied, ArePlatformCredentialsValid:
 		return "configuration", nil
 	case IsOdfRequirementsSatisfied,
 		IsLsoRequirementsSatisfied,
@@ -64,7 +68,8 @@ func (v ValidationID) Category() (string, error) {
 		IsPipelinesRequirementsSatisfied,
 		IsServiceMeshRequirementsSatisfied,
 		IsServerLessRequirementsSatisfied,
-		IsOpenShiftAIRequirementsSatisfied:
+		IsOpenShiftAIRequirementsSatisfied,
+		IsCustomOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected cluster validation id %s", string(v)))
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/installcfg"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/usage"
@@ -79,8 +81,9 @@ func validationStatusToBool(v ValidationStatus) bool {
 }
 
 type clusterValidator struct {
-	log     logrus.FieldLogger
-	hostAPI host.API
+	log                 logrus.FieldLogger
+	hostAPI             host.API
+	platformCredentials *platformCredentialsChecker
 }
 
 func (v *clusterValidator) isMachineCidrDefined(c *clusterPreprocessContext) (ValidationStatus, string) {
@@ -238,7 +241,7 @@ func (v *clusterValidator) areVipsValid(c *clusterPreprocessContext, vipsWrapper
 
 	failed := false
 	for i := 0; i != vipsWrapper.Len(); i++ {
-		verification, err := network.VerifyVip(c.cluster.Hosts, network.GetMachineCidrById(c.cluster, i), vipsWrapper.IP(i), name,
+		verification, err := network.VerifyVip(c.cluster.Hosts, network.GetMachineCidrForVip(c.cluster, i, vipsWrapper.IP(i)), vipsWrapper.IP(i), name,
 			vipsWrapper.Verification(i), v.log)
 		failed = failed || verification != models.VipVerificationSucceeded
 		if err != nil {
@@ -405,6 +408,51 @@ func (v *clusterValidator) platformRequirementsSatisfied(c *clusterPreprocessCon
 	return ValidationFailure, "The custom manifest required for Oracle Cloud Infrastructure platform integration has not been added. Add a custom manifest to continue."
 }
 
+func (v *clusterValidator) arePlatformCredentialsValid(c *clusterPreprocessContext) (ValidationStatus, string) {
+	return v.platformCredentials.check(c.cluster)
+}
+
+func (v *clusterValidator) isProvisioningNetworkValid(c *clusterPreprocessContext) (ValidationStatus, string) {
+	return validateProvisioningNetwork(c.cluster)
+}
+
+func (v *clusterValidator) isMtuConsistent(c *clusterPreprocessContext) (ValidationStatus, string) {
+	if !network.IsMachineCidrAvailable(c.cluster) {
+		if swag.BoolValue(c.cluster.UserManagedNetworking) {
+			return ValidationSuccess, "The MTU of the hosts isn't validated without a machine network."
+		}
+		return ValidationPending, "The machine network is undefined."
+	}
+	hostsByMtu := make(map[int64][]string)
+	for _, h := range c.cluster.Hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil {
+			v.log.WithError(err).Warnf("Failed to parse the inventory of host %s", h.ID)
+			continue
+		}
+		nic := network.GetMachineNetworkInterface(v.log, c.cluster, inventory)
+		if nic == nil || nic.Mtu <= 0 {
+			continue
+		}
+		hostsByMtu[nic.Mtu] = append(hostsByMtu[nic.Mtu], hostutil.GetHostnameForMsg(h))
+	}
+	if len(hostsByMtu) <= 1 {
+		return ValidationSuccess, "All the hosts use the same MTU on the machine network."
+	}
+	mtus := funk.Keys(hostsByMtu).([]int64)
+	sort.Slice(mtus, func(i, j int) bool { return mtus[i] < mtus[j] })
+	var groups []string
+	for _, mtu := range mtus {
+		sort.Strings(hostsByMtu[mtu])
+		groups = append(groups, fmt.Sprintf("%d (%s)", mtu, strings.Join(hostsByMtu[mtu], ", ")))
+	}
+	return ValidationFailure, fmt.Sprintf("The hosts use different MTUs on the machine network: %s. Configure the same MTU on the machine network interfaces of all the hosts.",
+		strings.Join(groups, ", "))
+}
+
 func (v *clusterValidator) isDNSDomainDefined(c *clusterPreprocessContext) (ValidationStatus, string) {
 	if c.cluster.BaseDNSDomain != "" {
 		return ValidationSuccess, "The base domain is defined."
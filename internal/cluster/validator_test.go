@@ -893,3 +893,66 @@ var _ = Describe("SufficientMastersCount", func() {
 		})
 	})
 })
+
+var _ = Describe("isMtuConsistent", func() {
+	var (
+		validator clusterValidator
+		cluster   *common.Cluster
+		clusterID strfmt.UUID
+	)
+
+	createHost := func(hostname, address string, mtu int64) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		return &models.Host{
+			ID: &id,
+			Inventory: common.GenerateTestInventoryWithMutate(func(inventory *models.Inventory) {
+				inventory.Hostname = hostname
+				inventory.Interfaces = []*models.Interface{
+					{Name: "eth0", IPV4Addresses: []string{"10.0.0.5/24"}, Mtu: 1500},
+					{Name: "eth1", IPV4Addresses: []string{address}, Mtu: mtu},
+				}
+			}),
+		}
+	}
+
+	BeforeEach(func() {
+		validator = clusterValidator{log: logrus.New()}
+		clusterID = strfmt.UUID(uuid.New().String())
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			MachineNetworks: []*models.MachineNetwork{{Cidr: "192.168.10.0/24"}},
+		}}
+	})
+
+	It("passes when the hosts use the same MTU on the machine network", func() {
+		cluster.Hosts = []*models.Host{
+			createHost("master-0", "192.168.10.10/24", 9000),
+			createHost("master-1", "192.168.10.11/24", 9000),
+			{ID: &clusterID}, // host without inventory
+		}
+		status, message := validator.isMtuConsistent(&clusterPreprocessContext{cluster: cluster})
+		Expect(status).To(Equal(ValidationSuccess))
+		Expect(message).To(Equal("All the hosts use the same MTU on the machine network."))
+	})
+
+	It("fails when the hosts use different MTUs on the machine network", func() {
+		cluster.Hosts = []*models.Host{
+			createHost("master-0", "192.168.10.10/24", 9000),
+			createHost("master-2", "192.168.10.12/24", 1500),
+			createHost("master-1", "192.168.10.11/24", 9000),
+		}
+		status, message := validator.isMtuConsistent(&clusterPreprocessContext{cluster: cluster})
+		Expect(status).To(Equal(ValidationFailure))
+		Expect(message).To(Equal("The hosts use different MTUs on the machine network: 1500 (master-2), 9000 (master-0, master-1). " +
+			"Configure the same MTU on the machine network interfaces of all the hosts."))
+	})
+
+	It("waits for the machine network", func() {
+		cluster.MachineNetworks = nil
+		status, _ := validator.isMtuConsistent(&clusterPreprocessContext{cluster: cluster})
+		Expect(status).To(Equal(ValidationPending))
+		cluster.UserManagedNetworking = swag.Bool(true)
+		status, _ = validator.isMtuConsistent(&clusterPreprocessContext{cluster: cluster})
+		Expect(status).To(Equal(ValidationSuccess))
+	})
+})
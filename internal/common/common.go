@@ -194,6 +194,16 @@ func IsImportedCluster(cluster *Cluster) bool {
 	return swag.BoolValue(cluster.Imported)
 }
 
+// GetDay2ControlPlaneTarget returns the number of control plane nodes that an installed cluster may have.
+// It is the control plane count of the cluster, or the default size of a highly available control plane when
+// the count was never set, e.g. for imported clusters.
+func GetDay2ControlPlaneTarget(cluster *Cluster) int {
+	if cluster.ControlPlaneCount > 0 {
+		return int(cluster.ControlPlaneCount)
+	}
+	return MinMasterHostsNeededForInstallationInHaMode
+}
+
 // AreMastersSchedulable returns whether a given cluster masters will be schedulable
 // It will get correct result only when all hosts roles are assigned.
 func AreMastersSchedulable(cluster *Cluster) bool {
@@ -74,6 +74,18 @@ type Cluster struct {
 	// Timestamp to trigger monitor. Monitor will be triggered if timestamp is recent
 	TriggerMonitorTimestamp time.Time
 
+	// Identifies the credentials and hosts that the last validation of the platform credentials used
+	PlatformCredentialsCheckKey string
+
+	// Time the last validation of the platform credentials started
+	PlatformCredentialsCheckedAt time.Time
+
+	// Result of the last validation of the platform credentials, empty until it completes
+	PlatformCredentialsCheckResult string `gorm:"type:text"`
+
+	// A JSON list of the DNS records that the service created for the cluster, the only ones that it deletes
+	ProvisionedDNSRecords string `gorm:"type:text"`
+
 	// StaticNetworkConfigured indicates if static network configuration was set for the ISO used by clusters' nodes
 	StaticNetworkConfigured bool `json:"static_network_configured"`
 
@@ -90,6 +102,13 @@ type Cluster struct {
 
 	// A JSON blob in which holds the cluster mirror registry if set
 	MirrorRegistryConfiguration string `json:"mirror_registry_configuration" gorm:"type:TEXT"`
+
+	// The clouds.yaml with the credentials of the OpenStack cloud of the cluster.
+	OpenstackCloudsYAML string `json:"openstack_clouds_yaml" gorm:"type:TEXT"`
+
+	// The BMC passwords of the hosts by host ID, they aren't stored with the cluster and are only loaded to
+	// generate the install config
+	HostBMCPasswords map[strfmt.UUID]string `json:"-" gorm:"-"`
 }
 
 func (c *Cluster) GetClusterID() *strfmt.UUID {
@@ -138,6 +157,23 @@ type Event struct {
 	models.Event
 }
 
+// IPAMReservation is an address reserved in a pool of the IP address management system for a cluster or an
+// infra-env, it is released when its owner is deregistered
+type IPAMReservation struct {
+	ID uint `gorm:"primarykey"`
+	// The cluster or the infra-env that the address is reserved for
+	OwnerID strfmt.UUID `gorm:"index"`
+	Pool    string      `gorm:"uniqueIndex:idx_ipam_reservations_pool_address"`
+	Address string      `gorm:"uniqueIndex:idx_ipam_reservations_pool_address"`
+	// The prefix length of the network of the pool
+	PrefixLength int
+	// What the address is used for, e.g. the API VIP or an interface of a host
+	Purpose string
+	// The reference of the address in the external IP address management system, used to release it
+	ExternalID string
+	CreatedAt  time.Time
+}
+
 func (e *Event) GetClusterID() *strfmt.UUID {
 	return e.ClusterID
 }
@@ -171,6 +207,12 @@ type Host struct {
 
 	// Json formatted string of the additional HTTP headers when fetching the ignition.
 	IgnitionEndpointHTTPHeaders string `json:"ignition_endpoint_http_headers,omitempty" gorm:"type:TEXT"`
+
+	// The password of the baseboard management controller of the host.
+	BMCPassword string `json:"bmc_password" gorm:"type:TEXT"`
+
+	// Time the pending disk erasure was sent to the agent, it is not sent again until the erasure timeout passes
+	DiskEraseStartedAt time.Time
 }
 
 func (h *Host) GetClusterID() *strfmt.UUID {
@@ -315,6 +357,8 @@ func AutoMigrate(db *gorm.DB) error {
 		&models.MachineNetwork{},
 		&models.APIVip{},
 		&models.IngressVip{},
+		&models.ClusterBatch{},
+		&IPAMReservation{},
 	)
 }
 
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/thoas/go-funk"
+)
+
+// GetDisksToEraseIdentifiers returns the identifiers of the host disks that are pending a secure erasure
+func GetDisksToEraseIdentifiers(host *models.Host) []string {
+	if host.DisksToErase != "" {
+		return strings.Split(host.DisksToErase, ",")
+	}
+
+	return []string{}
+}
+
+// IsDiskErasable returns true when the disk is a local device that the agent is able to securely erase.
+// Remote and virtual devices are excluded because erasing them would not erase the underlying hardware.
+func IsDiskErasable(disk *models.Disk) bool {
+	skipDriveTypes := []string{string(models.DriveTypeFC), string(models.DriveTypeISCSI), string(models.DriveTypeLVM), string(models.DriveTypeMultipath)}
+	return !disk.IsInstallationMedia && !funk.Contains(skipDriveTypes, string(disk.DriveType))
+}
+
+// GetDiskByIdentifier returns the inventory disk with the given identifier, or nil if there is no such disk
+func GetDiskByIdentifier(inventory *models.Inventory, identifier string) *models.Disk {
+	for _, disk := range inventory.Disks {
+		if GetDeviceIdentifier(disk) == identifier {
+			return disk
+		}
+	}
+	return nil
+}
@@ -1329,6 +1329,355 @@ func (e *ResetInstallationFailedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event scheduled_installation_waiting
+//
+type ScheduledInstallationWaitingEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Reason string
+}
+
+var ScheduledInstallationWaitingEventName string = "scheduled_installation_waiting"
+
+func NewScheduledInstallationWaitingEvent(
+    clusterId strfmt.UUID,
+    reason string,
+) *ScheduledInstallationWaitingEvent {
+    return &ScheduledInstallationWaitingEvent{
+        eventName: ScheduledInstallationWaitingEventName,
+        ClusterId: clusterId,
+        Reason: reason,
+    }
+}
+
+func SendScheduledInstallationWaitingEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    reason string,) {
+    ev := NewScheduledInstallationWaitingEvent(
+        clusterId,
+        reason,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationWaitingEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    reason string,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationWaitingEvent(
+        clusterId,
+        reason,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationWaitingEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationWaitingEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ScheduledInstallationWaitingEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationWaitingEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{reason}", fmt.Sprint(e.Reason),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationWaitingEvent) FormatMessage() string {
+    s := "Cluster is ready, {reason}"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_installation_started
+//
+type ScheduledInstallationStartedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+}
+
+var ScheduledInstallationStartedEventName string = "scheduled_installation_started"
+
+func NewScheduledInstallationStartedEvent(
+    clusterId strfmt.UUID,
+) *ScheduledInstallationStartedEvent {
+    return &ScheduledInstallationStartedEvent{
+        eventName: ScheduledInstallationStartedEventName,
+        ClusterId: clusterId,
+    }
+}
+
+func SendScheduledInstallationStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,) {
+    ev := NewScheduledInstallationStartedEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationStartedEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ScheduledInstallationStartedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationStartedEvent) FormatMessage() string {
+    s := "Starting the scheduled installation of the cluster"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_installation_failed
+//
+type ScheduledInstallationFailedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Error string
+}
+
+var ScheduledInstallationFailedEventName string = "scheduled_installation_failed"
+
+func NewScheduledInstallationFailedEvent(
+    clusterId strfmt.UUID,
+    error string,
+) *ScheduledInstallationFailedEvent {
+    return &ScheduledInstallationFailedEvent{
+        eventName: ScheduledInstallationFailedEventName,
+        ClusterId: clusterId,
+        Error: error,
+    }
+}
+
+func SendScheduledInstallationFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,) {
+    ev := NewScheduledInstallationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *ScheduledInstallationFailedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationFailedEvent) FormatMessage() string {
+    s := "Failed to start the scheduled installation of the cluster: {error}"
+    return e.format(&s)
+}
+
+//
+// Event scheduled_installation_aborted
+//
+type ScheduledInstallationAbortedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+}
+
+var ScheduledInstallationAbortedEventName string = "scheduled_installation_aborted"
+
+func NewScheduledInstallationAbortedEvent(
+    clusterId strfmt.UUID,
+) *ScheduledInstallationAbortedEvent {
+    return &ScheduledInstallationAbortedEvent{
+        eventName: ScheduledInstallationAbortedEventName,
+        ClusterId: clusterId,
+    }
+}
+
+func SendScheduledInstallationAbortedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,) {
+    ev := NewScheduledInstallationAbortedEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendScheduledInstallationAbortedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    eventTime time.Time) {
+    ev := NewScheduledInstallationAbortedEvent(
+        clusterId,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ScheduledInstallationAbortedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ScheduledInstallationAbortedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *ScheduledInstallationAbortedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ScheduledInstallationAbortedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ScheduledInstallationAbortedEvent) FormatMessage() string {
+    s := "Installation was aborted because the maintenance window closed before the hosts started writing the image to disk"
+    return e.format(&s)
+}
+
+//
+// Event dns_records_provisioning_failed
+//
+type DnsRecordsProvisioningFailedEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    Error string
+}
+
+var DnsRecordsProvisioningFailedEventName string = "dns_records_provisioning_failed"
+
+func NewDnsRecordsProvisioningFailedEvent(
+    clusterId strfmt.UUID,
+    error string,
+) *DnsRecordsProvisioningFailedEvent {
+    return &DnsRecordsProvisioningFailedEvent{
+        eventName: DnsRecordsProvisioningFailedEventName,
+        ClusterId: clusterId,
+        Error: error,
+    }
+}
+
+func SendDnsRecordsProvisioningFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,) {
+    ev := NewDnsRecordsProvisioningFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendDnsRecordsProvisioningFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    error string,
+    eventTime time.Time) {
+    ev := NewDnsRecordsProvisioningFailedEvent(
+        clusterId,
+        error,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *DnsRecordsProvisioningFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *DnsRecordsProvisioningFailedEvent) GetSeverity() string {
+    return "warning"
+}
+func (e *DnsRecordsProvisioningFailedEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *DnsRecordsProvisioningFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{error}", fmt.Sprint(e.Error),
+    )
+    return r.Replace(*message)
+}
+
+func (e *DnsRecordsProvisioningFailedEvent) FormatMessage() string {
+    s := "Failed to provision the DNS records of the cluster: {error}"
+    return e.format(&s)
+}
+
 //
 // Event api_ingress_vip_updated
 //
@@ -1872,125 +2221,206 @@ func SendClusterOperatorReportEventAtTime(
     clusterId strfmt.UUID,
     report string,
     eventTime time.Time) {
-    ev := NewClusterOperatorReportEvent(
+    ev := NewClusterOperatorReportEvent(
+        clusterId,
+        report,
+    )
+    eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *ClusterOperatorReportEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *ClusterOperatorReportEvent) GetSeverity() string {
+    return "info"
+}
+func (e *ClusterOperatorReportEvent) GetClusterId() strfmt.UUID {
+    return e.ClusterId
+}
+
+
+
+func (e *ClusterOperatorReportEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{cluster_id}", fmt.Sprint(e.ClusterId),
+        "{report}", fmt.Sprint(e.Report),
+    )
+    return r.Replace(*message)
+}
+
+func (e *ClusterOperatorReportEvent) FormatMessage() string {
+    s := "The following operators are experiencing issues: {report}"
+    return e.format(&s)
+}
+
+//
+// Event cluster_operator_status
+//
+type ClusterOperatorStatusEvent struct {
+    eventName string
+    ClusterId strfmt.UUID
+    OperatorName string
+    Status string
+    StatusInfo string
+}
+
+var ClusterOperatorStatusEventName string = "cluster_operator_status"
+
+func NewClusterOperatorStatusEvent(
+    clusterId strfmt.UUID,
+    operatorName string,
+    status string,
+    statusInfo string,
+) *ClusterOperatorStatusEvent {
+    return &ClusterOperatorStatusEvent{
+        eventName: ClusterOperatorStatusEventName,
+        ClusterId: clusterId,
+        OperatorName: operatorName,
+        Status: status,
+        StatusInfo: statusInfo,
+    }
+}
+
+func SendClusterOperatorStatusEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    status string,
+    statusInfo string,) {
+    ev := NewClusterOperatorStatusEvent(
+        clusterId,
+        operatorName,
+        status,
+        statusInfo,
+    )
+    eventsHandler.SendClusterEvent(ctx, ev)
+}
+
+func SendClusterOperatorStatusEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    clusterId strfmt.UUID,
+    operatorName string,
+    status string,
+    statusInfo string,
+    eventTime time.Time) {
+    ev := NewClusterOperatorStatusEvent(
         clusterId,
-        report,
+        operatorName,
+        status,
+        statusInfo,
     )
     eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
 }
 
-func (e *ClusterOperatorReportEvent) GetName() string {
+func (e *ClusterOperatorStatusEvent) GetName() string {
     return e.eventName
 }
 
-func (e *ClusterOperatorReportEvent) GetSeverity() string {
+func (e *ClusterOperatorStatusEvent) GetSeverity() string {
     return "info"
 }
-func (e *ClusterOperatorReportEvent) GetClusterId() strfmt.UUID {
+func (e *ClusterOperatorStatusEvent) GetClusterId() strfmt.UUID {
     return e.ClusterId
 }
 
 
 
-func (e *ClusterOperatorReportEvent) format(message *string) string {
+func (e *ClusterOperatorStatusEvent) format(message *string) string {
     r := strings.NewReplacer(
         "{cluster_id}", fmt.Sprint(e.ClusterId),
-        "{report}", fmt.Sprint(e.Report),
+        "{operator_name}", fmt.Sprint(e.OperatorName),
+        "{status}", fmt.Sprint(e.Status),
+        "{status_info}", fmt.Sprint(e.StatusInfo),
     )
     return r.Replace(*message)
 }
 
-func (e *ClusterOperatorReportEvent) FormatMessage() string {
-    s := "The following operators are experiencing issues: {report}"
+func (e *ClusterOperatorStatusEvent) FormatMessage() string {
+    s := "Operator {operator_name} status: {status} message: {status_info}"
     return e.format(&s)
 }
 
 //
-// Event cluster_operator_status
+// Event cluster_operator_degraded
 //
-type ClusterOperatorStatusEvent struct {
+type ClusterOperatorDegradedEvent struct {
     eventName string
     ClusterId strfmt.UUID
     OperatorName string
-    Status string
     StatusInfo string
 }
 
-var ClusterOperatorStatusEventName string = "cluster_operator_status"
+var ClusterOperatorDegradedEventName string = "cluster_operator_degraded"
 
-func NewClusterOperatorStatusEvent(
+func NewClusterOperatorDegradedEvent(
     clusterId strfmt.UUID,
     operatorName string,
-    status string,
     statusInfo string,
-) *ClusterOperatorStatusEvent {
-    return &ClusterOperatorStatusEvent{
-        eventName: ClusterOperatorStatusEventName,
+) *ClusterOperatorDegradedEvent {
+    return &ClusterOperatorDegradedEvent{
+        eventName: ClusterOperatorDegradedEventName,
         ClusterId: clusterId,
         OperatorName: operatorName,
-        Status: status,
         StatusInfo: statusInfo,
     }
 }
 
-func SendClusterOperatorStatusEvent(
+func SendClusterOperatorDegradedEvent(
     ctx context.Context,
     eventsHandler eventsapi.Sender,
     clusterId strfmt.UUID,
     operatorName string,
-    status string,
     statusInfo string,) {
-    ev := NewClusterOperatorStatusEvent(
+    ev := NewClusterOperatorDegradedEvent(
         clusterId,
         operatorName,
-        status,
         statusInfo,
     )
     eventsHandler.SendClusterEvent(ctx, ev)
 }
 
-func SendClusterOperatorStatusEventAtTime(
+func SendClusterOperatorDegradedEventAtTime(
     ctx context.Context,
     eventsHandler eventsapi.Sender,
     clusterId strfmt.UUID,
     operatorName string,
-    status string,
     statusInfo string,
     eventTime time.Time) {
-    ev := NewClusterOperatorStatusEvent(
+    ev := NewClusterOperatorDegradedEvent(
         clusterId,
         operatorName,
-        status,
         statusInfo,
     )
     eventsHandler.SendClusterEventAtTime(ctx, ev, eventTime)
 }
 
-func (e *ClusterOperatorStatusEvent) GetName() string {
+func (e *ClusterOperatorDegradedEvent) GetName() string {
     return e.eventName
 }
 
-func (e *ClusterOperatorStatusEvent) GetSeverity() string {
-    return "info"
+func (e *ClusterOperatorDegradedEvent) GetSeverity() string {
+    return "warning"
 }
-func (e *ClusterOperatorStatusEvent) GetClusterId() strfmt.UUID {
+func (e *ClusterOperatorDegradedEvent) GetClusterId() strfmt.UUID {
     return e.ClusterId
 }
 
 
 
-func (e *ClusterOperatorStatusEvent) format(message *string) string {
+func (e *ClusterOperatorDegradedEvent) format(message *string) string {
     r := strings.NewReplacer(
         "{cluster_id}", fmt.Sprint(e.ClusterId),
         "{operator_name}", fmt.Sprint(e.OperatorName),
-        "{status}", fmt.Sprint(e.Status),
         "{status_info}", fmt.Sprint(e.StatusInfo),
     )
     return r.Replace(*message)
 }
 
-func (e *ClusterOperatorStatusEvent) FormatMessage() string {
-    s := "Operator {operator_name} status: {status} message: {status_info}"
+func (e *ClusterOperatorDegradedEvent) FormatMessage() string {
+    s := "Operator {operator_name} of the installed cluster is degraded: {status_info}"
     return e.format(&s)
 }
 
@@ -6067,6 +6497,307 @@ func (e *HostUnbindFailedEvent) FormatMessage() string {
     return e.format(&s)
 }
 
+//
+// Event host_disk_erasure_started
+//
+type HostDiskErasureStartedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    HostName string
+    Disks string
+}
+
+var HostDiskErasureStartedEventName string = "host_disk_erasure_started"
+
+func NewHostDiskErasureStartedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+) *HostDiskErasureStartedEvent {
+    return &HostDiskErasureStartedEvent{
+        eventName: HostDiskErasureStartedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        HostName: hostName,
+        Disks: disks,
+    }
+}
+
+func SendHostDiskErasureStartedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,) {
+    ev := NewHostDiskErasureStartedEvent(
+        hostId,
+        infraEnvId,
+        hostName,
+        disks,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDiskErasureStartedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    eventTime time.Time) {
+    ev := NewHostDiskErasureStartedEvent(
+        hostId,
+        infraEnvId,
+        hostName,
+        disks,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDiskErasureStartedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDiskErasureStartedEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostDiskErasureStartedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *HostDiskErasureStartedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDiskErasureStartedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDiskErasureStartedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{disks}", fmt.Sprint(e.Disks),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDiskErasureStartedEvent) FormatMessage() string {
+    s := "Host {host_name}: Secure erasure of disks {disks} was requested"
+    return e.format(&s)
+}
+
+//
+// Event host_disk_erasure_succeeded
+//
+type HostDiskErasureSucceededEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    HostName string
+    Disks string
+    ReportId string
+}
+
+var HostDiskErasureSucceededEventName string = "host_disk_erasure_succeeded"
+
+func NewHostDiskErasureSucceededEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    reportId string,
+) *HostDiskErasureSucceededEvent {
+    return &HostDiskErasureSucceededEvent{
+        eventName: HostDiskErasureSucceededEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        HostName: hostName,
+        Disks: disks,
+        ReportId: reportId,
+    }
+}
+
+func SendHostDiskErasureSucceededEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    reportId string,) {
+    ev := NewHostDiskErasureSucceededEvent(
+        hostId,
+        infraEnvId,
+        hostName,
+        disks,
+        reportId,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDiskErasureSucceededEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    reportId string,
+    eventTime time.Time) {
+    ev := NewHostDiskErasureSucceededEvent(
+        hostId,
+        infraEnvId,
+        hostName,
+        disks,
+        reportId,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDiskErasureSucceededEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDiskErasureSucceededEvent) GetSeverity() string {
+    return "info"
+}
+func (e *HostDiskErasureSucceededEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *HostDiskErasureSucceededEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDiskErasureSucceededEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDiskErasureSucceededEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{disks}", fmt.Sprint(e.Disks),
+        "{report_id}", fmt.Sprint(e.ReportId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDiskErasureSucceededEvent) FormatMessage() string {
+    s := "Host {host_name}: Disks {disks} were securely erased, see disk erasure report {report_id}"
+    return e.format(&s)
+}
+
+//
+// Event host_disk_erasure_failed
+//
+type HostDiskErasureFailedEvent struct {
+    eventName string
+    HostId strfmt.UUID
+    InfraEnvId strfmt.UUID
+    HostName string
+    Disks string
+    ReportId string
+}
+
+var HostDiskErasureFailedEventName string = "host_disk_erasure_failed"
+
+func NewHostDiskErasureFailedEvent(
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    reportId string,
+) *HostDiskErasureFailedEvent {
+    return &HostDiskErasureFailedEvent{
+        eventName: HostDiskErasureFailedEventName,
+        HostId: hostId,
+        InfraEnvId: infraEnvId,
+        HostName: hostName,
+        Disks: disks,
+        ReportId: reportId,
+    }
+}
+
+func SendHostDiskErasureFailedEvent(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    reportId string,) {
+    ev := NewHostDiskErasureFailedEvent(
+        hostId,
+        infraEnvId,
+        hostName,
+        disks,
+        reportId,
+    )
+    eventsHandler.SendHostEvent(ctx, ev)
+}
+
+func SendHostDiskErasureFailedEventAtTime(
+    ctx context.Context,
+    eventsHandler eventsapi.Sender,
+    hostId strfmt.UUID,
+    infraEnvId strfmt.UUID,
+    hostName string,
+    disks string,
+    reportId string,
+    eventTime time.Time) {
+    ev := NewHostDiskErasureFailedEvent(
+        hostId,
+        infraEnvId,
+        hostName,
+        disks,
+        reportId,
+    )
+    eventsHandler.SendHostEventAtTime(ctx, ev, eventTime)
+}
+
+func (e *HostDiskErasureFailedEvent) GetName() string {
+    return e.eventName
+}
+
+func (e *HostDiskErasureFailedEvent) GetSeverity() string {
+    return "error"
+}
+func (e *HostDiskErasureFailedEvent) GetClusterId() *strfmt.UUID {
+    return nil
+}
+func (e *HostDiskErasureFailedEvent) GetHostId() strfmt.UUID {
+    return e.HostId
+}
+func (e *HostDiskErasureFailedEvent) GetInfraEnvId() strfmt.UUID {
+    return e.InfraEnvId
+}
+
+
+
+func (e *HostDiskErasureFailedEvent) format(message *string) string {
+    r := strings.NewReplacer(
+        "{host_id}", fmt.Sprint(e.HostId),
+        "{infra_env_id}", fmt.Sprint(e.InfraEnvId),
+        "{host_name}", fmt.Sprint(e.HostName),
+        "{disks}", fmt.Sprint(e.Disks),
+        "{report_id}", fmt.Sprint(e.ReportId),
+    )
+    return r.Replace(*message)
+}
+
+func (e *HostDiskErasureFailedEvent) FormatMessage() string {
+    s := "Host {host_name}: Failed to securely erase disks {disks}, see disk erasure report {report_id}"
+    return e.format(&s)
+}
+
 //
 // Event inactive_clusters_deregistered
 //
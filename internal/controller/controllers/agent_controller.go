@@ -66,6 +66,7 @@ import (
 const (
 	AgentFinalizerName                   = "agent." + aiv1beta1.Group + "/ai-deprovision"
 	AgentSkipSpokeCleanupAnnotation      = "agent." + aiv1beta1.Group + "/skip-spoke-cleanup"
+	AgentDecommissionAnnotation          = "agent." + aiv1beta1.Group + "/decommission"
 	AgentStateAnnotation                 = "agent." + aiv1beta1.Group + "/state"
 	BaseLabelPrefix                      = aiv1beta1.Group + "/"
 	InventoryLabelPrefix                 = "inventory." + BaseLabelPrefix
@@ -261,9 +262,35 @@ func updateAnnotations(log logrus.FieldLogger, agent *v1beta1.Agent, h *models.H
 	if h.Progress != nil {
 		updated = setAgentAnnotation(log, agent, AgentCurrentStageAnnotation, string(h.Progress.CurrentStage))
 	}
+	updated = removeAgentDecommissionAnnotationIfDone(log, agent, h) || updated
 	return updated
 }
 
+func isAgentDecommissioning(agent *aiv1beta1.Agent) bool {
+	_, ok := agent.GetAnnotations()[AgentDecommissionAnnotation]
+	return ok
+}
+
+// removeAgentDecommissionAnnotationIfDone removes the decommission annotation once the host is back in the
+// infra-env pool, so that later unbinds of the agent are not treated as decommissions
+func removeAgentDecommissionAnnotationIfDone(log logrus.FieldLogger, agent *aiv1beta1.Agent, h *models.Host) bool {
+	if !isAgentDecommissioning(agent) || h.ClusterID != nil {
+		return false
+	}
+	decommissionedStatuses := []string{
+		models.HostStatusDiscoveringUnbound,
+		models.HostStatusKnownUnbound,
+		models.HostStatusInsufficientUnbound,
+		models.HostStatusDisconnectedUnbound,
+	}
+	if !funk.ContainsString(decommissionedStatuses, swag.StringValue(h.Status)) {
+		return false
+	}
+	log.Infof("Agent %s/%s was decommissioned, removing annotation %s", agent.Namespace, agent.Name, AgentDecommissionAnnotation)
+	delete(agent.Annotations, AgentDecommissionAnnotation)
+	return true
+}
+
 // shouldCleanUnboundSpokeNode returns true if the agent has deprovision info set and the host is not in the process of unbinding
 func shouldCleanUnboundSpokeNode(agent *aiv1beta1.Agent, host *common.Host) bool {
 	if host.Status == nil {
@@ -586,6 +613,34 @@ func (r *AgentReconciler) tryApproveDay2CSRs(ctx context.Context, agent *aiv1bet
 	r.approveAIHostsCSRs(ctx, client, agent, validateNodeCsr)
 }
 
+// canDay2ControlPlaneJoin checks whether the CSRs of a new control plane node may be approved.
+// The node joins etcd right after it is approved, so etcd has to be stable and the control plane must not
+// have reached the control plane count of the cluster yet.
+func (r *AgentReconciler) canDay2ControlPlaneJoin(ctx context.Context, log logrus.FieldLogger, agent *aiv1beta1.Agent, h *models.Host, client spoke_k8s_client.SpokeK8sClient) bool {
+	cluster, err := r.Installer.GetClusterInternal(ctx, installer.V2GetClusterParams{ClusterID: *h.ClusterID})
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get cluster %s of control plane agent %s/%s", h.ClusterID, agent.Namespace, agent.Name)
+		return false
+	}
+	canJoin, reason, err := canControlPlaneNodeJoin(ctx, client, common.GetDay2ControlPlaneTarget(cluster))
+	if err != nil {
+		log.WithError(err).Errorf("Failed to check if control plane agent %s/%s can join the cluster", agent.Namespace, agent.Name)
+		return false
+	}
+	if !canJoin {
+		log.Infof("Postponing CSRs approval of control plane agent %s/%s: %s", agent.Namespace, agent.Name, reason)
+	}
+	return canJoin
+}
+
+func (r *AgentReconciler) isDay2ControlPlaneMemberReady(ctx context.Context, node *corev1.Node, client spoke_k8s_client.SpokeK8sClient) (bool, error) {
+	ready, err := isEtcdMemberReady(ctx, client, node.Name)
+	if err != nil || !ready {
+		return false, err
+	}
+	return isEtcdOperatorStable(ctx, client)
+}
+
 func (r *AgentReconciler) bmhExists(ctx context.Context, agent *aiv1beta1.Agent) (bool, error) {
 	bmhName, ok := agent.ObjectMeta.Labels[AGENT_BMH_LABEL]
 	if !ok {
@@ -686,7 +741,13 @@ func (r *AgentReconciler) unbindHost(ctx context.Context, log logrus.FieldLogger
 		HostID:     *h.ID,
 		InfraEnvID: h.InfraEnvID,
 	}
-	host, err := r.Installer.UnbindHostInternal(ctx, params, reclaim, bminventory.NonInteractive)
+	var host *common.Host
+	if reclaim && isAgentDecommissioning(origAgent) {
+		log.Infof("Decommissioning agent %s, its disks will be wiped", agent.Name)
+		host, err = r.Installer.DecommissionHostInternal(ctx, params)
+	} else {
+		host, err = r.Installer.UnbindHostInternal(ctx, params, reclaim, bminventory.NonInteractive)
+	}
 	if err != nil {
 		return r.updateStatus(ctx, log, agent, origAgent, &h.Host, nil, err, !IsUserError(err))
 	}
@@ -851,6 +912,9 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 					}
 					node = nil
 				}
+				if shouldAutoApproveCSRs && node == nil && common.GetEffectiveRole(h) == models.HostRoleMaster {
+					shouldAutoApproveCSRs = r.canDay2ControlPlaneJoin(ctx, log, agent, h, spokeClient)
+				}
 				if shouldAutoApproveCSRs {
 					r.tryApproveDay2CSRs(ctx, agent, node, spokeClient)
 				}
@@ -858,7 +922,7 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 					log.WithError(err).Errorf("Failed to apply labels for day2 node %s/%s", agent.Namespace, agent.Name)
 					return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
 				}
-				if err = r.UpdateDay2InstallPogress(ctx, h, agent, node); err != nil {
+				if err = r.UpdateDay2InstallPogress(ctx, h, agent, node, spokeClient); err != nil {
 					return ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
 				}
 				if agent.Status.Progress.CurrentStage != models.HostStageDone {
@@ -917,7 +981,7 @@ func (r *AgentReconciler) updateStatus(ctx context.Context, log logrus.FieldLogg
 	return ret, nil
 }
 
-func (r *AgentReconciler) UpdateDay2InstallPogress(ctx context.Context, h *models.Host, agent *aiv1beta1.Agent, node *corev1.Node) error {
+func (r *AgentReconciler) UpdateDay2InstallPogress(ctx context.Context, h *models.Host, agent *aiv1beta1.Agent, node *corev1.Node, spokeClient spoke_k8s_client.SpokeK8sClient) error {
 	if node == nil {
 		// In case the node not found we get the stage from the host
 		agent.Status.Progress.CurrentStage = h.Progress.CurrentStage
@@ -925,12 +989,24 @@ func (r *AgentReconciler) UpdateDay2InstallPogress(ctx context.Context, h *model
 		return nil
 	}
 	var err error
-	if isNodeReady(node) {
-		err = r.updateHostInstallProgress(ctx, h, models.HostStageDone)
+	done := isNodeReady(node)
+	progressInfo := ""
+	// A control plane node is done only once it became a healthy etcd member
+	if done && common.GetEffectiveRole(h) == models.HostRoleMaster {
+		if done, err = r.isDay2ControlPlaneMemberReady(ctx, node, spokeClient); err != nil {
+			r.Log.WithError(err).Errorf("Failed to check etcd member of node %s", node.Name)
+			return err
+		}
+		if !done {
+			progressInfo = "Waiting for the etcd member to join the cluster"
+		}
+	}
+	if done {
+		err = r.updateHostInstallProgress(ctx, h, models.HostStageDone, progressInfo)
 		agent.Status.Progress.CurrentStage = models.HostStageDone
 		// now that the node is done there is no need to requeue
 	} else {
-		err = r.updateHostInstallProgress(ctx, h, models.HostStageJoined)
+		err = r.updateHostInstallProgress(ctx, h, models.HostStageJoined, progressInfo)
 		agent.Status.Progress.CurrentStage = models.HostStageJoined
 	}
 	if err != nil {
@@ -1663,6 +1739,12 @@ func (r *AgentReconciler) updateIfNeeded(ctx context.Context, log logrus.FieldLo
 		}
 	}
 
+	bmcUpdated, err := r.updateBMC(ctx, log, internalHost, agent, params)
+	if err != nil {
+		return internalHost, err
+	}
+	hostUpdate = hostUpdate || bmcUpdated
+
 	if hostUpdate {
 		var hostStatusesBeforeInstallationOrUnbound = []string{
 			models.HostStatusDiscovering, models.HostStatusKnown, models.HostStatusDisconnected,
@@ -1714,6 +1796,49 @@ func (r *AgentReconciler) getIgnitionToken(ctx context.Context, ignitionEndpoint
 	return string(token), nil
 }
 
+// updateBMC sets the BMC of the host from the BareMetalHost of the agent, so that it is added to the install config
+func (r *AgentReconciler) updateBMC(ctx context.Context, log logrus.FieldLogger, internalHost *common.Host, agent *aiv1beta1.Agent, params *installer.V2UpdateHostParams) (bool, error) {
+	bmhName, ok := agent.ObjectMeta.Labels[AGENT_BMH_LABEL]
+	if !ok {
+		return false, nil
+	}
+	bmh := &bmh_v1alpha1.BareMetalHost{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: bmhName, Namespace: agent.Namespace}, bmh); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		log.WithError(err).Errorf("Failed to get BareMetalHost %s/%s", agent.Namespace, bmhName)
+		return false, err
+	}
+	if bmh.Spec.BMC.Address == "" {
+		return false, nil
+	}
+
+	var username, password string
+	if bmh.Spec.BMC.CredentialsName != "" {
+		secretRef := types.NamespacedName{Namespace: bmh.Namespace, Name: bmh.Spec.BMC.CredentialsName}
+		secret, err := getSecret(ctx, r.Client, r.APIReader, secretRef)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to get the BMC credentials of BareMetalHost %s/%s", bmh.Namespace, bmh.Name)
+			return false, err
+		}
+		username = string(secret.Data["username"])
+		password = string(secret.Data["password"])
+	}
+
+	bmc := &models.HostBmc{
+		Address:                        bmh.Spec.BMC.Address,
+		Username:                       username,
+		DisableCertificateVerification: bmh.Spec.BMC.DisableCertificateVerification,
+	}
+	if internalHost.Bmc != nil && *internalHost.Bmc == *bmc && internalHost.BMCPassword == password {
+		return false, nil
+	}
+	params.HostUpdateParams.Bmc = bmc
+	params.HostUpdateParams.BmcPassword = &password
+	return true, nil
+}
+
 func (r *AgentReconciler) setInfraEnvNameLabel(ctx context.Context, log logrus.FieldLogger, h *common.Host, agent *aiv1beta1.Agent) error {
 	infraEnv, err := r.Installer.GetInfraEnvInternal(ctx, installer.GetInfraEnvParams{InfraEnvID: h.InfraEnvID})
 	if err != nil {
@@ -1767,13 +1892,15 @@ func (r *AgentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *AgentReconciler) updateHostInstallProgress(ctx context.Context, host *models.Host, stage models.HostStage) error {
+func (r *AgentReconciler) updateHostInstallProgress(ctx context.Context, host *models.Host, stage models.HostStage, progressInfo string) error {
 	r.Log.Infof("Updating host %s install progress to %s", host.ID, stage)
 	err := r.Installer.V2UpdateHostInstallProgressInternal(ctx, installer.V2UpdateHostInstallProgressParams{
 		InfraEnvID: host.InfraEnvID,
 		HostID:     *host.ID,
 		HostProgress: &models.HostProgress{
-			CurrentStage: stage},
+			CurrentStage: stage,
+			ProgressInfo: progressInfo,
+		},
 	})
 	return err
 }
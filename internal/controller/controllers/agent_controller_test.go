@@ -473,6 +473,59 @@ var _ = Describe("agent reconcile", func() {
 		Expect(c.Get(ctx, key, agent)).To(BeNil())
 	})
 
+	It("Sets the BMC of the host from the BareMetalHost", func() {
+		hostId := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		commonHost := &common.Host{
+			Host: models.Host{
+				ID:         &hostId,
+				ClusterID:  &sId,
+				Inventory:  common.GenerateTestDefaultInventory(),
+				Status:     swag.String(models.HostStatusKnown),
+				StatusInfo: swag.String("Some status info"),
+				InfraEnvID: infraEnvId,
+			},
+		}
+		backEndCluster = &common.Cluster{Cluster: models.Cluster{
+			ID: &sId,
+			Hosts: []*models.Host{
+				&commonHost.Host,
+			}}}
+
+		bmcSecret := newSecret("bmc-secret", testNamespace, map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("secret"),
+		})
+		Expect(c.Create(ctx, bmcSecret)).To(BeNil())
+		bmh := newBMH("testBMH", &bmh_v1alpha1.BareMetalHostSpec{BMC: bmh_v1alpha1.BMCDetails{
+			Address:                        "redfish-virtualmedia://192.168.111.1/redfish/v1/Systems/1",
+			CredentialsName:                bmcSecret.Name,
+			DisableCertificateVerification: true,
+		}})
+		Expect(c.Create(ctx, bmh)).To(BeNil())
+		host := newAgent("host", testNamespace, v1beta1.AgentSpec{ClusterDeploymentName: &v1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace}})
+		host.ObjectMeta.Labels = map[string]string{AGENT_BMH_LABEL: bmh.Name}
+		clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+		Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+
+		mockInstallerInternal.EXPECT().GetHostByKubeKey(gomock.Any()).Return(commonHost, nil).AnyTimes()
+		mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+		mockInstallerInternal.EXPECT().V2UpdateHostInternal(gomock.Any(), gomock.Any(), bminventory.NonInteractive).
+			Do(func(ctx context.Context, params installer.V2UpdateHostParams, interactivity bminventory.Interactivity) {
+				Expect(params.HostUpdateParams.Bmc).To(Equal(&models.HostBmc{
+					Address:                        "redfish-virtualmedia://192.168.111.1/redfish/v1/Systems/1",
+					Username:                       "admin",
+					DisableCertificateVerification: true,
+				}))
+				Expect(params.HostUpdateParams.BmcPassword).To(Equal(swag.String("secret")))
+			}).Return(commonHost, nil).Times(1)
+		allowGetInfraEnvInternal(mockInstallerInternal, infraEnvId, "infraEnvName")
+		Expect(c.Create(ctx, host)).To(BeNil())
+		result, err := hr.Reconcile(ctx, newHostRequest(host))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
 	Context("node labels", func() {
 		var (
 			hostId, infraEnvId strfmt.UUID
@@ -994,6 +1047,36 @@ var _ = Describe("agent reconcile", func() {
 			assertAgentConditionsSuccess()
 		})
 
+		It("decommission without a BMH reclaims the host and wipes its disks", func() {
+			host.Annotations = map[string]string{AgentDecommissionAnnotation: "true"}
+			Expect(c.Update(ctx, host)).To(Succeed())
+			createKubeconfigSecret()
+			expectDBClusterWithKubeKeys()
+
+			mockClient := spoke_k8s_client.NewMockSpokeK8sClient(mockCtrl)
+			mockClientFactory.EXPECT().CreateFromSecret(gomock.Any()).Return(mockClient, nil).AnyTimes()
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			mockInstallerInternal.EXPECT().DecommissionHostInternal(gomock.Any(), gomock.Any()).Return(commonHost, nil)
+			result, err := hr.Reconcile(ctx, newHostRequest(host))
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+			assertAgentConditionsSuccess()
+		})
+
+		It("decommission falls back to unbind if the host can't be reclaimed", func() {
+			host.Annotations = map[string]string{AgentDecommissionAnnotation: "true"}
+			Expect(c.Update(ctx, host)).To(Succeed())
+			expectDBClusterWithKubeKeys()
+
+			mockInstallerInternal.EXPECT().UnbindHostInternal(gomock.Any(), gomock.Any(), false, bminventory.NonInteractive).Return(commonHost, nil)
+			result, err := hr.Reconcile(ctx, newHostRequest(host))
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+			assertAgentConditionsSuccess()
+		})
+
 		It("unbind does not attempt to reclaim if kubeconfig secret is missing", func() {
 			expectDBClusterWithKubeKeys()
 
@@ -4703,3 +4786,33 @@ var _ = Describe("createNewHost", func() {
 		Expect(host.ClusterID).To(BeNil())
 	})
 })
+
+var _ = Describe("removeAgentDecommissionAnnotationIfDone", func() {
+	var (
+		agent     *v1beta1.Agent
+		clusterID = strfmt.UUID(uuid.New().String())
+	)
+
+	BeforeEach(func() {
+		agent = newAgent("decommissioned-agent", testNamespace, v1beta1.AgentSpec{})
+		agent.Annotations = map[string]string{AgentDecommissionAnnotation: "true"}
+	})
+
+	It("removes the annotation once the host is back in discovery", func() {
+		h := &models.Host{Status: swag.String(models.HostStatusDiscoveringUnbound)}
+		Expect(removeAgentDecommissionAnnotationIfDone(common.GetTestLog(), agent, h)).To(BeTrue())
+		Expect(agent.Annotations).ToNot(HaveKey(AgentDecommissionAnnotation))
+	})
+
+	It("keeps the annotation while the host is reclaimed", func() {
+		h := &models.Host{Status: swag.String(models.HostStatusReclaimingRebooting)}
+		Expect(removeAgentDecommissionAnnotationIfDone(common.GetTestLog(), agent, h)).To(BeFalse())
+		Expect(agent.Annotations).To(HaveKey(AgentDecommissionAnnotation))
+	})
+
+	It("keeps the annotation while the host is still bound", func() {
+		h := &models.Host{ClusterID: &clusterID, Status: swag.String(models.HostStatusInstalled)}
+		Expect(removeAgentDecommissionAnnotationIfDone(common.GetTestLog(), agent, h)).To(BeFalse())
+		Expect(agent.Annotations).To(HaveKey(AgentDecommissionAnnotation))
+	})
+})
@@ -273,7 +273,7 @@ func (r *BMACReconciler) Reconcile(origCtx context.Context, req ctrl.Request) (c
 	}
 
 	if agent != nil && agentIsUnbindingPendingUserAction(agent) {
-		result = reconcileUnboundAgent(log, bmh, r.ConvergedFlowEnabled)
+		result = reconcileUnboundAgent(log, bmh, r.ConvergedFlowEnabled, isAgentDecommissioning(agent))
 		if res := r.handleReconcileResult(ctx, log, result, bmh); res != nil {
 			return res.Result()
 		}
@@ -785,10 +785,16 @@ func agentIsUnbindingPendingUserAction(agent *aiv1beta1.Agent) bool {
 //
 // By re-attaching the BMH and clearing the Image field on it, BMAC will clear
 // the Image data to force the boot from ISO
-func reconcileUnboundAgent(log logrus.FieldLogger, bmh *bmh_v1alpha1.BareMetalHost, converged bool) reconcileResult {
+//
+// When the agent is decommissioned in the converged flow, the BMH is cleaned
+// during deprovisioning so that the disks of the host are wiped
+func reconcileUnboundAgent(log logrus.FieldLogger, bmh *bmh_v1alpha1.BareMetalHost, converged bool, wipeDisks bool) reconcileResult {
 	log.Debugf("Started unbound agent reconcile")
 
 	if !converged {
+		if wipeDisks {
+			log.Warnf("Disks of BMH %s/%s will not be wiped, automated cleaning requires the converged flow", bmh.Namespace, bmh.Name)
+		}
 		// proceed with BMH unbind only when the detached annotation is in place (which means that we have not dealt with this case before)
 		if _, isDetached := bmh.ObjectMeta.Annotations[BMH_DETACHED_ANNOTATION]; !isDetached {
 			log.Debugf("Skipping Unbound Agent reconcile for bmh %v", bmh)
@@ -812,6 +818,12 @@ func reconcileUnboundAgent(log logrus.FieldLogger, bmh *bmh_v1alpha1.BareMetalHo
 	var dirty, stop bool
 	switch bmh.Status.Provisioning.State {
 	case bmh_v1alpha1.StateProvisioned:
+		// cleaning has to be enabled before deprovisioning starts
+		if wipeDisks && bmh.Spec.AutomatedCleaningMode != bmh_v1alpha1.CleaningModeMetadata {
+			log.Infof("setting BMH cleaning mode to %s to wipe the disks", bmh_v1alpha1.CleaningModeMetadata)
+			bmh.Spec.AutomatedCleaningMode = bmh_v1alpha1.CleaningModeMetadata
+			dirty = true
+		}
 		// unset customDeploy to initiate deprovisioning
 		if bmh.Spec.CustomDeploy != nil {
 			log.Info("deprovisioning BMH")
@@ -2536,6 +2536,22 @@ var _ = Describe("bmac reconcile - converged flow enabled", func() {
 					Expect(updatedHost.ObjectMeta.Annotations).ToNot(HaveKey(BMH_PAUSED_ANNOTATION))
 					Expect(updatedHost.Spec.CustomDeploy).To(BeNil())
 				})
+				It("enables metadata cleaning when the agent is decommissioned", func() {
+					agent.Annotations = map[string]string{AgentDecommissionAnnotation: "true"}
+					Expect(c.Update(ctx, agent)).To(BeNil())
+					host.Spec.AutomatedCleaningMode = bmh_v1alpha1.CleaningModeDisabled
+					host.Status.Provisioning.State = bmh_v1alpha1.StateProvisioned
+					Expect(c.Update(ctx, host)).To(Succeed())
+
+					result, err := bmhr.Reconcile(ctx, newBMHRequest(host))
+					Expect(err).To(BeNil())
+					Expect(result).To(Equal(ctrl.Result{}))
+
+					updatedHost := &bmh_v1alpha1.BareMetalHost{}
+					Expect(c.Get(ctx, types.NamespacedName{Name: "bmh-reconcile", Namespace: testNamespace}, updatedHost)).To(BeNil())
+					Expect(updatedHost.Spec.AutomatedCleaningMode).To(Equal(bmh_v1alpha1.CleaningModeMetadata))
+					Expect(updatedHost.Spec.CustomDeploy).To(BeNil())
+				})
 				It("resets customDeploy when the BMH is available", func() {
 					host.Spec.CustomDeploy = nil
 					host.Status.Provisioning.State = bmh_v1alpha1.StateAvailable
@@ -32,6 +32,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
+	configv1 "github.com/openshift/api/config/v1"
 	. "github.com/openshift/assisted-service/api/common"
 	hiveext "github.com/openshift/assisted-service/api/hiveextension/v1beta1"
 	aiv1beta1 "github.com/openshift/assisted-service/api/v1beta1"
@@ -44,6 +45,7 @@ import (
 	"github.com/openshift/assisted-service/internal/controller/controllers/mirrorregistry"
 	"github.com/openshift/assisted-service/internal/gencrypto"
 	"github.com/openshift/assisted-service/internal/host"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
@@ -85,11 +87,14 @@ const (
 	InstallConfigOverrides            = aiv1beta1.Group + "/install-config-overrides"
 	ClusterDeploymentFinalizerName    = "clusterdeployments." + aiv1beta1.Group + "/ai-deprovision"
 	AgentClusterInstallFinalizerName  = "agentclusterinstall." + aiv1beta1.Group + "/ai-deprovision"
+	DecommissionHostsAnnotation       = "clusterdeployments." + aiv1beta1.Group + "/decommission-hosts"
 )
 
 const HighAvailabilityModeNone = "None"
 const defaultRequeueAfterOnError = 10 * time.Second
 const longerRequeueAfterOnError = 1 * time.Minute
+const decommissionTimeout = 10 * time.Minute
+const installationScheduleRequeueAfter = 1 * time.Minute
 
 // from https://github.com/openshift/hive/blob/04f2f4f8768b4d8aa413feb5dc5410b5f6e3dcfa/pkg/constants/constants.go#L153
 // not importing this code because it will create a lot of vendoring issues
@@ -332,6 +337,11 @@ func (r *ClusterDeploymentsReconciler) agentClusterInstallFinalizer(ctx context.
 				return &ctrl.Result{Requeue: true}, err
 			}
 
+			// decommissioned hosts are reclaimed through the spoke cluster, so they must be unbound before the cluster is gone
+			if waiting, err := r.isWaitingForHostsDecommission(ctx, log, req.NamespacedName, clusterInstall); waiting || err != nil {
+				return &ctrl.Result{RequeueAfter: defaultRequeueAfterOnError}, err
+			}
+
 			// deletion finalizer found, deregister the backend cluster
 			reply, cleanUpErr := r.deregisterClusterIfNeeded(ctx, log, req.NamespacedName)
 			if cleanUpErr != nil {
@@ -434,6 +444,14 @@ func (r *ClusterDeploymentsReconciler) installDay1(ctx context.Context, log logr
 	}
 
 	if ready {
+		if allowed, reason := isInstallationAllowed(clusterInstall, time.Now()); !allowed {
+			log.Infof("Installation of clusterDeployment %s %s is not allowed yet, %s", clusterDeployment.Name, clusterDeployment.Namespace, reason)
+			if _, err = r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, nil); err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+			return ctrl.Result{Requeue: true, RequeueAfter: installationScheduleRequeueAfter}, nil
+		}
+
 		// create custom manifests if needed before installation
 		err = r.addCustomManifests(ctx, log, clusterInstall, cluster)
 		if err != nil {
@@ -480,6 +498,19 @@ func (r *ClusterDeploymentsReconciler) spokeKubeClient(ctx context.Context, clus
 	return r.SpokeK8sClientFactory.CreateFromSecret(secret)
 }
 
+// getSpokeOpenshiftVersion returns the version of the installed spoke cluster from its ClusterVersion
+func (r *ClusterDeploymentsReconciler) getSpokeOpenshiftVersion(ctx context.Context, clusterDeployment *hivev1.ClusterDeployment) (string, error) {
+	spokeClient, err := r.spokeKubeClient(ctx, clusterDeployment)
+	if err != nil {
+		return "", err
+	}
+	clusterVersion := &configv1.ClusterVersion{}
+	if err = spokeClient.Get(ctx, types.NamespacedName{Name: "version"}, clusterVersion); err != nil {
+		return "", errors.Wrap(err, "failed to get spoke cluster version")
+	}
+	return clusterVersion.Status.Desired.Version, nil
+}
+
 func (r *ClusterDeploymentsReconciler) updateWorkerMcpPaused(ctx context.Context, log logrus.FieldLogger, clusterInstall *hiveext.AgentClusterInstall, clusterDeployment *hivev1.ClusterDeployment) error {
 	agents, err := findAgentsByAgentClusterInstall(r.Client, ctx, log, clusterInstall)
 	if err != nil {
@@ -514,13 +545,31 @@ func (r *ClusterDeploymentsReconciler) updateWorkerMcpPaused(ctx context.Context
 	return nil
 }
 
+func isDay2ControlPlaneJoining(cluster *common.Cluster) bool {
+	for _, h := range cluster.Hosts {
+		if hostutil.IsDay2ControlPlaneHostJoining(h) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ClusterDeploymentsReconciler) installDay2Hosts(ctx context.Context, log logrus.FieldLogger, clusterDeployment *hivev1.ClusterDeployment, clusterInstall *hiveext.AgentClusterInstall, cluster *common.Cluster) (ctrl.Result, error) {
 	hosts, err := r.Installer.GetKnownApprovedHosts(*cluster.ID)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ready and approved hosts for cluster %s", cluster.ID.String())
 		return r.updateStatus(ctx, log, clusterInstall, clusterDeployment, cluster, err)
 	}
+	controlPlaneJoining := isDay2ControlPlaneJoining(cluster)
 	for _, h := range hosts {
+		// etcd members are added one at a time, the other control plane hosts are installed on later reconciles
+		if common.GetEffectiveRole(&h.Host) == models.HostRoleMaster {
+			if controlPlaneJoining {
+				log.Infof("Postponing installation of Day2 control plane host %s until the previous one joins the cluster", *h.ID)
+				continue
+			}
+			controlPlaneJoining = true
+		}
 		log.Infof("Installing Day2 host %s in %s %s", *h.ID, clusterDeployment.Name, clusterDeployment.Namespace)
 		err = r.Installer.InstallSingleDay2HostInternal(ctx, *cluster.ID, h.InfraEnvID, *h.ID)
 		if err != nil {
@@ -801,6 +850,10 @@ func getPlatform(aciSpec hiveext.AgentClusterInstallSpec) (*models.Platform, err
 		return &models.Platform{
 			Type: common.PlatformTypePtr(models.PlatformTypeNutanix),
 		}, nil
+	case hiveext.OpenStackPlatformType:
+		return &models.Platform{
+			Type: common.PlatformTypePtr(models.PlatformTypeOpenstack),
+		}, nil
 	default:
 		return nil, nil
 	}
@@ -820,6 +873,8 @@ func getPlatformType(platform *models.Platform) hiveext.PlatformType {
 		return hiveext.VSpherePlatformType
 	case models.PlatformTypeNutanix:
 		return hiveext.NutanixPlatformType
+	case models.PlatformTypeOpenstack:
+		return hiveext.OpenStackPlatformType
 	case models.PlatformTypeExternal:
 		return hiveext.ExternalPlatformType
 	default:
@@ -1129,6 +1184,36 @@ func (r *ClusterDeploymentsReconciler) updateIfNeeded(
 		}
 	}
 
+	scheduledInstallTime := getScheduledInstallTime(clusterInstall)
+	if !isSameScheduledInstallTime(scheduledInstallTime, cluster.ScheduledInstallTime) {
+		// The zero time removes the scheduled installation time
+		params.ScheduledInstallTime = &strfmt.DateTime{}
+		if scheduledInstallTime != nil {
+			params.ScheduledInstallTime = scheduledInstallTime
+		}
+		update = true
+	}
+
+	maintenanceWindow := getMaintenanceWindow(clusterInstall)
+	if !isSameMaintenanceWindow(maintenanceWindow, cluster.MaintenanceWindow) {
+		// An empty start time removes the maintenance window
+		params.MaintenanceWindow = &models.MaintenanceWindow{}
+		if maintenanceWindow != nil {
+			params.MaintenanceWindow = maintenanceWindow
+		}
+		update = true
+	}
+
+	baremetalProvisioning := getBaremetalProvisioning(clusterInstall)
+	if !isSameBaremetalProvisioning(baremetalProvisioning, cluster.BaremetalProvisioning) {
+		// An empty provisioning network mode removes the provisioning network configuration
+		params.BaremetalProvisioning = &models.BaremetalProvisioning{}
+		if baremetalProvisioning != nil {
+			params.BaremetalProvisioning = baremetalProvisioning
+		}
+		update = true
+	}
+
 	if clusterInstall.Spec.Proxy != nil {
 		updateString(swag.StringValue(&clusterInstall.Spec.Proxy.HTTPProxy), cluster.HTTPProxy, &params.HTTPProxy)
 		updateString(swag.StringValue(&clusterInstall.Spec.Proxy.HTTPSProxy), cluster.HTTPSProxy, &params.HTTPSProxy)
@@ -1404,6 +1489,10 @@ func CreateClusterParams(clusterDeployment *hivev1.ClusterDeployment, clusterIns
 		clusterParams.Hyperthreading = getHyperthreading(clusterInstall)
 	}
 
+	clusterParams.ScheduledInstallTime = getScheduledInstallTime(clusterInstall)
+	clusterParams.MaintenanceWindow = getMaintenanceWindow(clusterInstall)
+	clusterParams.BaremetalProvisioning = getBaremetalProvisioning(clusterInstall)
+
 	if isDiskEncryptionEnabled(clusterInstall) {
 		clusterParams.DiskEncryption = &models.DiskEncryption{
 			EnableOn:    clusterInstall.Spec.DiskEncryption.EnableOn,
@@ -1500,6 +1589,13 @@ func (r *ClusterDeploymentsReconciler) createNewDay2Cluster(
 		clusterParams.OpenshiftClusterID = &cid
 	}
 
+	// the openshift version is only required to expand the control plane, don't fail the import without it
+	if version, err := r.getSpokeOpenshiftVersion(ctx, clusterDeployment); err != nil {
+		log.WithError(err).Warnf("failed to get the openshift version of day2 cluster %s %s", clusterDeployment.Name, clusterDeployment.Namespace)
+	} else {
+		clusterParams.OpenshiftVersion = version
+	}
+
 	c, err := r.Installer.V2ImportClusterInternal(ctx, &key, &id, installer.V2ImportClusterParams{
 		NewImportClusterParams: clusterParams,
 	})
@@ -1661,12 +1757,51 @@ func (r *ClusterDeploymentsReconciler) shouldDeleteAgentOnUnbind(ctx context.Con
 	return false, nil
 }
 
+// isDecommissionRequested returns true if the hosts of the cluster deployment should have their disks wiped and be
+// returned to the infra-env pool when the cluster is deleted
+func (r *ClusterDeploymentsReconciler) isDecommissionRequested(ctx context.Context, clusterDeployment types.NamespacedName) (bool, error) {
+	cd := &hivev1.ClusterDeployment{}
+	if err := r.Get(ctx, clusterDeployment, cd); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	decommission, err := strconv.ParseBool(cd.GetAnnotations()[DecommissionHostsAnnotation])
+	return err == nil && decommission, nil
+}
+
+func (r *ClusterDeploymentsReconciler) isWaitingForHostsDecommission(ctx context.Context, log logrus.FieldLogger,
+	clusterDeployment types.NamespacedName, clusterInstall *hiveext.AgentClusterInstall) (bool, error) {
+	decommission, err := r.isDecommissionRequested(ctx, clusterDeployment)
+	if err != nil || !decommission {
+		return false, err
+	}
+	cluster, err := r.Installer.GetClusterByKubeKey(clusterDeployment)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if len(cluster.Hosts) == 0 {
+		return false, nil
+	}
+	if time.Since(clusterInstall.DeletionTimestamp.Time) > decommissionTimeout {
+		log.Warnf("Timed out waiting for %d hosts of cluster %s to be decommissioned", len(cluster.Hosts), cluster.ID.String())
+		return false, nil
+	}
+	log.Infof("Waiting for %d hosts of cluster %s to be decommissioned", len(cluster.Hosts), cluster.ID.String())
+	return true, nil
+}
+
 func (r *ClusterDeploymentsReconciler) unbindAgents(ctx context.Context, log logrus.FieldLogger, clusterDeployment types.NamespacedName) error {
 	agents := &aiv1beta1.AgentList{}
 	log = log.WithFields(logrus.Fields{"clusterDeployment": clusterDeployment.Name, "namespace": clusterDeployment.Namespace})
 	if err := r.List(ctx, agents); err != nil {
 		return err
 	}
+	decommission, err := r.isDecommissionRequested(ctx, clusterDeployment)
+	if err != nil {
+		return err
+	}
 	for i, clusterAgent := range agents.Items {
 		if clusterAgent.Spec.ClusterDeploymentName != nil &&
 			clusterAgent.Spec.ClusterDeploymentName.Name == clusterDeployment.Name &&
@@ -1684,6 +1819,10 @@ func (r *ClusterDeploymentsReconciler) unbindAgents(ctx context.Context, log log
 			} else {
 				log.Infof("unbind agent %s namespace %s", clusterAgent.Name, clusterAgent.Namespace)
 				agents.Items[i].Spec.ClusterDeploymentName = nil
+				if decommission {
+					log.Infof("decommission agent %s namespace %s", clusterAgent.Name, clusterAgent.Namespace)
+					setAnnotation(&agents.Items[i].ObjectMeta, AgentDecommissionAnnotation, "true")
+				}
 				if err := r.Update(ctx, &agents.Items[i]); err != nil {
 					log.WithError(err).Errorf("failed to add unbind resource %s %s", clusterAgent.Name, clusterAgent.Namespace)
 					return err
@@ -2101,6 +2240,82 @@ func IsHoldInstallationSet(ci *hiveext.AgentClusterInstall, cd *hivev1.ClusterDe
 	return false
 }
 
+func getScheduledInstallTime(clusterInstall *hiveext.AgentClusterInstall) *strfmt.DateTime {
+	if clusterInstall.Spec.ScheduledInstallTime == nil {
+		return nil
+	}
+	scheduledInstallTime := strfmt.DateTime(clusterInstall.Spec.ScheduledInstallTime.Time)
+	return &scheduledInstallTime
+}
+
+func getMaintenanceWindow(clusterInstall *hiveext.AgentClusterInstall) *models.MaintenanceWindow {
+	window := clusterInstall.Spec.MaintenanceWindow
+	if window == nil {
+		return nil
+	}
+	days := make([]string, 0, len(window.Days))
+	for _, day := range window.Days {
+		days = append(days, string(day))
+	}
+	timeZone := window.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	return &models.MaintenanceWindow{
+		StartTime:       window.StartTime,
+		DurationMinutes: swag.Int64(window.DurationMinutes),
+		Days:            strings.Join(days, ","),
+		TimeZone:        swag.String(timeZone),
+	}
+}
+
+func isSameScheduledInstallTime(desired, current *strfmt.DateTime) bool {
+	if desired == nil || current == nil {
+		return desired == nil && (current == nil || time.Time(*current).IsZero())
+	}
+	return time.Time(*desired).Equal(time.Time(*current))
+}
+
+func isSameMaintenanceWindow(desired, current *models.MaintenanceWindow) bool {
+	if !cluster.IsMaintenanceWindowSet(desired) || !cluster.IsMaintenanceWindowSet(current) {
+		return cluster.IsMaintenanceWindowSet(desired) == cluster.IsMaintenanceWindowSet(current)
+	}
+	return desired.StartTime == current.StartTime &&
+		swag.Int64Value(desired.DurationMinutes) == swag.Int64Value(current.DurationMinutes) &&
+		desired.Days == current.Days &&
+		swag.StringValue(desired.TimeZone) == swag.StringValue(current.TimeZone)
+}
+
+func getBaremetalProvisioning(clusterInstall *hiveext.AgentClusterInstall) *models.BaremetalProvisioning {
+	provisioning := clusterInstall.Spec.BareMetalProvisioning
+	if provisioning == nil {
+		return nil
+	}
+	return &models.BaremetalProvisioning{
+		ProvisioningNetwork:          provisioning.ProvisioningNetwork,
+		ProvisioningNetworkCidr:      provisioning.ProvisioningNetworkCIDR,
+		ProvisioningNetworkInterface: provisioning.ProvisioningNetworkInterface,
+		ClusterProvisioningIP:        provisioning.ClusterProvisioningIP,
+		BootstrapProvisioningIP:      provisioning.BootstrapProvisioningIP,
+		ProvisioningDhcpRange:        provisioning.ProvisioningDHCPRange,
+	}
+}
+
+func isSameBaremetalProvisioning(desired, current *models.BaremetalProvisioning) bool {
+	if !cluster.IsBaremetalProvisioningSet(desired) || !cluster.IsBaremetalProvisioningSet(current) {
+		return cluster.IsBaremetalProvisioningSet(desired) == cluster.IsBaremetalProvisioningSet(current)
+	}
+	return *desired == *current
+}
+
+// isInstallationAllowed checks the scheduled installation time and the maintenance window of the AgentClusterInstall
+func isInstallationAllowed(clusterInstall *hiveext.AgentClusterInstall, now time.Time) (bool, string) {
+	return cluster.IsInstallationAllowed(&common.Cluster{Cluster: models.Cluster{
+		ScheduledInstallTime: getScheduledInstallTime(clusterInstall),
+		MaintenanceWindow:    getMaintenanceWindow(clusterInstall),
+	}}, now)
+}
+
 func clusterCompleted(clusterInstall *hiveext.AgentClusterInstall, clusterDeployment *hivev1.ClusterDeployment,
 	status, statusInfo string, opers []*models.MonitoredOperator) {
 
@@ -2131,6 +2346,9 @@ func clusterCompleted(clusterInstall *hiveext.AgentClusterInstall, clusterDeploy
 		if IsHoldInstallationSet(clusterInstall, clusterDeployment) {
 			reason = hiveext.ClusterInstallationOnHoldReason
 			msg = hiveext.ClusterInstallationOnHoldMsg
+		} else if allowed, waitReason := isInstallationAllowed(clusterInstall, time.Now()); !allowed {
+			reason = hiveext.ClusterInstallationScheduledReason
+			msg = fmt.Sprintf("%s %s", hiveext.ClusterInstallationScheduledMsg, waitReason)
 		}
 	case models.ClusterStatusInsufficient, models.ClusterStatusPendingForInput:
 		condStatus = corev1.ConditionFalse
@@ -2072,6 +2072,40 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterCompletedCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
 
+		It("waits for the scheduled installation time", func() {
+			backEndCluster.Status = swag.String(models.ClusterStatusReady)
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(1)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockClusterApi.EXPECT().IsReadyForInstallation(gomock.Any()).Return(true, "").Times(1)
+			mockInstallerInternal.EXPECT().GetKnownHostApprovedCounts(gomock.Any()).Return(5, 5, nil).AnyTimes()
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil).AnyTimes()
+			mockClusterApi.EXPECT().GetHostCountByRole(*backEndCluster.ID, models.HostRoleMaster, true).Return(swag.Int64(3), nil).AnyTimes()
+			mockClusterApi.EXPECT().GetHostCountByRole(*backEndCluster.ID, models.HostRoleWorker, true).Return(swag.Int64(2), nil).AnyTimes()
+			mockInstallerInternal.EXPECT().InstallClusterInternal(gomock.Any(), gomock.Any()).Times(0)
+
+			scheduledInstallTime := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, params installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) {
+					Expect(params.ClusterUpdateParams.ScheduledInstallTime).NotTo(BeNil())
+					Expect(time.Time(*params.ClusterUpdateParams.ScheduledInstallTime).Equal(scheduledInstallTime.Time)).To(BeTrue())
+				}).Return(backEndCluster, nil).Times(1)
+
+			aci = getTestClusterInstall()
+			aci.Spec.ScheduledInstallTime = &scheduledInstallTime
+			Expect(c.Update(ctx, aci)).To(BeNil())
+
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{Requeue: true, RequeueAfter: installationScheduleRequeueAfter}))
+
+			aci = getTestClusterInstall()
+			condition := FindStatusCondition(aci.Status.Conditions, hiveext.ClusterCompletedCondition)
+			Expect(condition.Reason).To(Equal(hiveext.ClusterInstallationScheduledReason))
+			Expect(condition.Message).To(HavePrefix(hiveext.ClusterInstallationScheduledMsg + " installation is scheduled for"))
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+		})
+
 		It("hold installation by cluster deployment annotation", func() {
 			backEndCluster.Status = swag.String(models.ClusterStatusReady)
 			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil).Times(2)
@@ -3470,6 +3504,67 @@ var _ = Describe("cluster reconcile", func() {
 			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterRequirementsMetCondition).Status).To(Equal(corev1.ConditionFalse))
 		})
 
+		It("update baremetal provisioning network configuration", func() {
+			backEndCluster := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:               &sId,
+					Name:             clusterName,
+					OpenshiftVersion: "4.8",
+					ClusterNetworks:  clusterNetworksEntriesToArray(defaultAgentClusterInstallSpec.Networking.ClusterNetwork),
+					ServiceNetworks:  serviceNetworksEntriesToArray(defaultAgentClusterInstallSpec.Networking.ServiceNetwork),
+					NetworkType:      swag.String(models.ClusterNetworkTypeOpenShiftSDN),
+					Status:           swag.String(models.ClusterStatusInsufficient),
+					IngressVips:      common.TestIPv4Networking.IngressVips,
+					APIVips:          common.TestIPv4Networking.APIVips,
+					BaseDNSDomain:    defaultClusterSpec.BaseDomain,
+					SSHPublicKey:     defaultAgentClusterInstallSpec.SSHPublicKey,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockInstallerInternal.EXPECT().HostWithCollectedLogsExists(gomock.Any()).Return(false, nil)
+			mockVersions.EXPECT().GetReleaseImageByURL(gomock.Any(), gomock.Any(), gomock.Any()).Return(releaseImage, nil)
+
+			provisioning := &models.BaremetalProvisioning{
+				ProvisioningNetwork:          "Managed",
+				ProvisioningNetworkCidr:      "172.22.0.0/24",
+				ProvisioningNetworkInterface: "enp1s0",
+				ClusterProvisioningIP:        "172.22.0.3",
+				BootstrapProvisioningIP:      "172.22.0.2",
+				ProvisioningDhcpRange:        "172.22.0.10,172.22.0.254",
+			}
+			updateReply := &common.Cluster{
+				Cluster: models.Cluster{
+					ID:                    &sId,
+					Status:                swag.String(models.ClusterStatusInsufficient),
+					BaremetalProvisioning: provisioning,
+				},
+				PullSecret: testPullSecretVal,
+			}
+			mockInstallerInternal.EXPECT().UpdateClusterNonInteractive(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, param installer.V2UpdateClusterParams, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) {
+					Expect(param.ClusterUpdateParams.BaremetalProvisioning).To(Equal(provisioning))
+				}).Return(updateReply, nil)
+
+			aci.Spec.BareMetalProvisioning = &hiveext.BareMetalProvisioning{
+				ProvisioningNetwork:          "Managed",
+				ProvisioningNetworkCIDR:      "172.22.0.0/24",
+				ProvisioningNetworkInterface: "enp1s0",
+				ClusterProvisioningIP:        "172.22.0.3",
+				BootstrapProvisioningIP:      "172.22.0.2",
+				ProvisioningDHCPRange:        "172.22.0.10,172.22.0.254",
+			}
+			Expect(c.Update(ctx, aci)).Should(BeNil())
+			request := newClusterDeploymentRequest(cluster)
+			result, err := cr.Reconcile(ctx, request)
+			Expect(err).To(BeNil())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			aci = getTestClusterInstall()
+			Expect(FindStatusCondition(aci.Status.Conditions, hiveext.ClusterSpecSyncedCondition).Reason).To(Equal(hiveext.ClusterSyncedOkReason))
+		})
+
 		Context("Networks", func() {
 			BeforeEach(func() {
 				mockMirrorRegistries.EXPECT().IsMirrorRegistriesConfigured().AnyTimes().Return(false)
@@ -4972,6 +5067,39 @@ var _ = Describe("unbindAgents", func() {
 		Expect(agent.Spec.ClusterDeploymentName).To(BeNil())
 	})
 
+	It("marks unbound agents for decommission when the cluster deployment requests it", func() {
+		infraEnv := &aiv1beta1.InfraEnv{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      infraEnvName,
+				Namespace: testNamespace,
+			},
+		}
+		Expect(c.Create(ctx, infraEnv)).To(Succeed())
+
+		clusterDeployment := &hivev1.ClusterDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   clusterReference.Namespace,
+				Name:        clusterReference.Name,
+				Annotations: map[string]string{DecommissionHostsAnnotation: "true"},
+			},
+			Spec: hivev1.ClusterDeploymentSpec{},
+		}
+		Expect(c.Create(ctx, clusterDeployment)).To(Succeed())
+
+		cdName := types.NamespacedName{
+			Name:      clusterReference.Name,
+			Namespace: clusterReference.Namespace,
+		}
+		Expect(cr.unbindAgents(ctx, common.GetTestLog(), cdName)).To(Succeed())
+
+		for _, name := range []string{"test-agent1", "test-agent2"} {
+			agent := &aiv1beta1.Agent{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: testNamespace}, agent)).To(Succeed())
+			Expect(agent.Spec.ClusterDeploymentName).To(BeNil())
+			Expect(agent.GetAnnotations()).To(HaveKeyWithValue(AgentDecommissionAnnotation, "true"))
+		}
+	})
+
 	It("deletes agents when infraEnv references the cluster", func() {
 		infraEnv := &aiv1beta1.InfraEnv{
 			TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	etcdNamespace        = "openshift-etcd"
+	etcdClusterOperator  = "etcd"
+	etcdPodLabel         = "app"
+	etcdPodLabelValue    = "etcd"
+	controlPlaneNodeRole = "node-role.kubernetes.io/master"
+)
+
+// isEtcdOperatorStable returns true when the etcd cluster operator of the spoke cluster is available and is
+// neither progressing nor degraded. New etcd members should only be added to a stable etcd cluster.
+func isEtcdOperatorStable(ctx context.Context, c client.Client) (bool, error) {
+	co := &configv1.ClusterOperator{}
+	if err := c.Get(ctx, types.NamespacedName{Name: etcdClusterOperator}, co); err != nil {
+		return false, errors.Wrap(err, "failed to get etcd cluster operator")
+	}
+
+	conditions := map[configv1.ClusterStatusConditionType]configv1.ConditionStatus{}
+	for _, cond := range co.Status.Conditions {
+		conditions[cond.Type] = cond.Status
+	}
+	return conditions[configv1.OperatorAvailable] == configv1.ConditionTrue &&
+		conditions[configv1.OperatorProgressing] != configv1.ConditionTrue &&
+		conditions[configv1.OperatorDegraded] != configv1.ConditionTrue, nil
+}
+
+// isEtcdMemberReady returns true when the etcd pod running on the given node is ready
+func isEtcdMemberReady(ctx context.Context, c client.Client, nodeName string) (bool, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(etcdNamespace), client.MatchingLabels{etcdPodLabel: etcdPodLabelValue}); err != nil {
+		return false, errors.Wrap(err, "failed to list etcd pods")
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName != nodeName {
+			continue
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// countControlPlaneNodes returns the number of control plane nodes of the spoke cluster
+func countControlPlaneNodes(ctx context.Context, c client.Client) (int, error) {
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes, client.HasLabels{controlPlaneNodeRole}); err != nil {
+		return 0, errors.Wrap(err, "failed to list control plane nodes")
+	}
+	return len(nodes.Items), nil
+}
+
+// canControlPlaneNodeJoin verifies that a new control plane node can be added to the spoke cluster:
+// etcd must be stable and the control plane must not exceed the given number of nodes
+func canControlPlaneNodeJoin(ctx context.Context, c client.Client, controlPlaneCount int) (bool, string, error) {
+	stable, err := isEtcdOperatorStable(ctx, c)
+	if err != nil {
+		return false, "", err
+	}
+	if !stable {
+		return false, "etcd cluster operator is not stable", nil
+	}
+	count, err := countControlPlaneNodes(ctx, c)
+	if err != nil {
+		return false, "", err
+	}
+	if count >= controlPlaneCount {
+		return false, fmt.Sprintf("the control plane already has %d of its %d nodes", count, controlPlaneCount), nil
+	}
+	return true, "", nil
+}
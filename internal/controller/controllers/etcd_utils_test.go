@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("etcd utils", func() {
+	var (
+		ctx = context.Background()
+		c   client.Client
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(spoke_k8s_client.GetKubeClientSchemes()).Build()
+	})
+
+	createEtcdOperator := func(available, progressing, degraded configv1.ConditionStatus) {
+		Expect(c.Create(ctx, &configv1.ClusterOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: etcdClusterOperator},
+			Status: configv1.ClusterOperatorStatus{
+				Conditions: []configv1.ClusterOperatorStatusCondition{
+					{Type: configv1.OperatorAvailable, Status: available},
+					{Type: configv1.OperatorProgressing, Status: progressing},
+					{Type: configv1.OperatorDegraded, Status: degraded},
+				},
+			},
+		})).To(Succeed())
+	}
+
+	createEtcdPod := func(nodeName string, ready corev1.ConditionStatus) {
+		Expect(c.Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("etcd-%s", nodeName),
+				Namespace: etcdNamespace,
+				Labels:    map[string]string{etcdPodLabel: etcdPodLabelValue},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		})).To(Succeed())
+	}
+
+	createControlPlaneNodes := func(count int) {
+		for i := 0; i < count; i++ {
+			Expect(c.Create(ctx, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   fmt.Sprintf("master-%d", i),
+					Labels: map[string]string{controlPlaneNodeRole: ""},
+				},
+			})).To(Succeed())
+		}
+	}
+
+	Context("isEtcdOperatorStable", func() {
+		It("is stable when available and not progressing", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionFalse, configv1.ConditionFalse)
+			stable, err := isEtcdOperatorStable(ctx, c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stable).To(BeTrue())
+		})
+
+		It("is not stable while progressing", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionTrue, configv1.ConditionFalse)
+			stable, err := isEtcdOperatorStable(ctx, c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stable).To(BeFalse())
+		})
+
+		It("is not stable when degraded", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionFalse, configv1.ConditionTrue)
+			stable, err := isEtcdOperatorStable(ctx, c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stable).To(BeFalse())
+		})
+
+		It("fails when the operator is missing", func() {
+			_, err := isEtcdOperatorStable(ctx, c)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("isEtcdMemberReady", func() {
+		It("is ready when the etcd pod on the node is ready", func() {
+			createEtcdPod("master-3", corev1.ConditionTrue)
+			ready, err := isEtcdMemberReady(ctx, c, "master-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(BeTrue())
+		})
+
+		It("is not ready when the etcd pod on the node is not ready", func() {
+			createEtcdPod("master-3", corev1.ConditionFalse)
+			ready, err := isEtcdMemberReady(ctx, c, "master-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(BeFalse())
+		})
+
+		It("is not ready when there is no etcd pod on the node", func() {
+			createEtcdPod("master-0", corev1.ConditionTrue)
+			ready, err := isEtcdMemberReady(ctx, c, "master-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ready).To(BeFalse())
+		})
+	})
+
+	Context("canControlPlaneNodeJoin", func() {
+		It("allows a new member when etcd is stable and the control plane is not full", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionFalse, configv1.ConditionFalse)
+			createControlPlaneNodes(3)
+			canJoin, reason, err := canControlPlaneNodeJoin(ctx, c, 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canJoin).To(BeTrue())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("refuses a new member while etcd is progressing", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionTrue, configv1.ConditionFalse)
+			createControlPlaneNodes(3)
+			canJoin, reason, err := canControlPlaneNodeJoin(ctx, c, 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canJoin).To(BeFalse())
+			Expect(reason).To(ContainSubstring("not stable"))
+		})
+
+		It("refuses a new member when the control plane is full", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionFalse, configv1.ConditionFalse)
+			createControlPlaneNodes(5)
+			canJoin, reason, err := canControlPlaneNodeJoin(ctx, c, 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canJoin).To(BeFalse())
+			Expect(reason).To(Equal("the control plane already has 5 of its 5 nodes"))
+		})
+
+		It("refuses a new member when the control plane reached the control plane count of the cluster", func() {
+			createEtcdOperator(configv1.ConditionTrue, configv1.ConditionFalse, configv1.ConditionFalse)
+			createControlPlaneNodes(4)
+			canJoin, reason, err := canControlPlaneNodeJoin(ctx, c, 4)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canJoin).To(BeFalse())
+			Expect(reason).To(Equal("the control plane already has 4 of its 4 nodes"))
+		})
+	})
+})
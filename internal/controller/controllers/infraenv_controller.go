@@ -39,6 +39,7 @@ import (
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/auth"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/staticnetworkconfig"
 	"github.com/openshift/assisted-service/restapi/operations/installer"
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	hivev1 "github.com/openshift/hive/apis/hive/v1"
@@ -291,6 +292,41 @@ func BuildMacInterfaceMap(log logrus.FieldLogger, nmStateConfig aiv1beta1.NMStat
 	return macInterfaceMap
 }
 
+// BuildHostStaticNetworkConfigs returns the static network config of the hosts of the NMStateConfig, expanding its
+// config for every host when it is a template
+func BuildHostStaticNetworkConfigs(log logrus.FieldLogger, nmStateConfig aiv1beta1.NMStateConfig) ([]*models.HostStaticNetworkConfig, error) {
+	if nmStateConfig.Spec.Template == nil {
+		return []*models.HostStaticNetworkConfig{{
+			MacInterfaceMap: BuildMacInterfaceMap(log, nmStateConfig),
+			NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
+		}}, nil
+	}
+	template := &models.StaticNetworkConfigTemplate{
+		NetworkYamlTemplate: swag.String(string(nmStateConfig.Spec.NetConfig.Raw)),
+		IPRangeStart:        nmStateConfig.Spec.Template.IPRangeStart,
+		IPRangeEnd:          nmStateConfig.Spec.Template.IPRangeEnd,
+	}
+	for _, host := range nmStateConfig.Spec.Template.Hosts {
+		macInterfaceMap := make(models.MacInterfaceMap, 0, len(host.Interfaces))
+		for _, cfg := range host.Interfaces {
+			macInterfaceMap = append(macInterfaceMap, &models.MacInterfaceMapItems0{
+				MacAddress:     cfg.MacAddress,
+				LogicalNicName: cfg.Name,
+			})
+		}
+		template.Hosts = append(template.Hosts, &models.StaticNetworkConfigTemplateHost{
+			MacInterfaceMap: macInterfaceMap,
+			Variables:       host.Variables,
+		})
+	}
+	staticNetworkConfig, err := staticnetworkconfig.ExpandStaticNetworkConfigTemplate(context.Background(), template, nil, "", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to expand the template of NMStateConfig %s/%s", nmStateConfig.Namespace, nmStateConfig.Name)
+	}
+	log.Debugf("expanded the template of NMStateConfig %s/%s for %d hosts", nmStateConfig.Namespace, nmStateConfig.Name, len(staticNetworkConfig))
+	return staticNetworkConfig, nil
+}
+
 func (r *InfraEnvReconciler) processNMStateConfig(ctx context.Context, log logrus.FieldLogger, infraEnv *aiv1beta1.InfraEnv) ([]*models.HostStaticNetworkConfig, error) {
 	var staticNetworkConfig []*models.HostStaticNetworkConfig
 	var selector labels.Selector
@@ -314,10 +350,12 @@ func (r *InfraEnvReconciler) processNMStateConfig(ctx context.Context, log logru
 	}
 
 	for _, nmStateConfig := range nmStateConfigs.Items {
-		staticNetworkConfig = append(staticNetworkConfig, &models.HostStaticNetworkConfig{
-			MacInterfaceMap: BuildMacInterfaceMap(log, nmStateConfig),
-			NetworkYaml:     string(nmStateConfig.Spec.NetConfig.Raw),
-		})
+		var hostsConfig []*models.HostStaticNetworkConfig
+		hostsConfig, err = BuildHostStaticNetworkConfigs(log, nmStateConfig)
+		if err != nil {
+			return staticNetworkConfig, err
+		}
+		staticNetworkConfig = append(staticNetworkConfig, hostsConfig...)
 	}
 	return staticNetworkConfig, nil
 }
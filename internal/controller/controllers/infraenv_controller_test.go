@@ -1364,6 +1364,50 @@ var _ = Describe("infraEnv reconcile", func() {
 			Expect(conditionsv1.FindStatusCondition(infraEnvImage.Status.Conditions, aiv1beta1.ImageCreatedCondition).Status).To(Equal(corev1.ConditionTrue))
 		})
 
+		It("create new infraEnv image with a templated nmstate config - success", func() {
+			nmstateConfig := newNMStateConfig("NMStateConfig", testNamespace, NMStateLabelName, NMStateLabelValue,
+				aiv1beta1.NMStateConfigSpec{
+					NetConfig: aiv1beta1.NetConfig{Raw: []byte("interfaces:\n- name: eth0\n  type: ethernet\n  ipv4:\n    address:\n    - ip: '{{.IP}}'\n      prefix-length: 24\n")},
+					Template: &aiv1beta1.NMStateConfigTemplate{
+						IPRangeStart: ip4Primary,
+						Hosts: []aiv1beta1.NMStateConfigTemplateHost{
+							{Interfaces: []*aiv1beta1.Interface{{Name: "eth0", MacAddress: macPrimary}}},
+							{Interfaces: []*aiv1beta1.Interface{{Name: "eth0", MacAddress: macSecondary}}},
+						},
+					},
+				})
+			Expect(c.Create(ctx, nmstateConfig)).To(BeNil())
+			clusterDeployment := newClusterDeployment("clusterDeployment", testNamespace, getDefaultClusterDeploymentSpec("clusterDeployment-test", "test-cluster-aci", "pull-secret"))
+			Expect(c.Create(ctx, clusterDeployment)).To(BeNil())
+
+			mockInstallerInternal.EXPECT().GetClusterByKubeKey(gomock.Any()).Return(backEndCluster, nil)
+			mockInstallerInternal.EXPECT().GetInfraEnvByKubeKey(gomock.Any()).Return(backendInfraEnv, nil)
+			mockInstallerInternal.EXPECT().ValidatePullSecret(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			mockInstallerInternal.EXPECT().UpdateInfraEnvInternal(gomock.Any(), gomock.Any(), nil, nil).
+				Do(func(ctx context.Context, params installer.UpdateInfraEnvParams, internalIgnitionConfig *string, mirrorRegistryConfiguration *common.MirrorRegistryConfiguration) {
+					Expect(params.InfraEnvUpdateParams.StaticNetworkConfig).To(HaveLen(2))
+					Expect(params.InfraEnvUpdateParams.StaticNetworkConfig[0].MacInterfaceMap).To(Equal(models.MacInterfaceMap{
+						{MacAddress: macPrimary, LogicalNicName: "eth0"},
+					}))
+					Expect(params.InfraEnvUpdateParams.StaticNetworkConfig[0].NetworkYaml).To(ContainSubstring("ip: '192.168.126.30'"))
+					Expect(params.InfraEnvUpdateParams.StaticNetworkConfig[1].MacInterfaceMap).To(Equal(models.MacInterfaceMap{
+						{MacAddress: macSecondary, LogicalNicName: "eth0"},
+					}))
+					Expect(params.InfraEnvUpdateParams.StaticNetworkConfig[1].NetworkYaml).To(ContainSubstring("ip: '192.168.126.31'"))
+				}).Return(
+				&common.InfraEnv{InfraEnv: models.InfraEnv{ClusterID: sId, ID: &sId, DownloadURL: downloadURL, CPUArchitecture: infraEnvArch}}, nil).Times(1)
+
+			infraEnvImage := newInfraEnvImage("infraEnvImage", testNamespace, aiv1beta1.InfraEnvSpec{
+				NMStateConfigLabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{NMStateLabelName: NMStateLabelValue}},
+				ClusterRef:                 &aiv1beta1.ClusterReference{Name: "clusterDeployment", Namespace: testNamespace},
+				PullSecretRef:              &corev1.LocalObjectReference{Name: "pull-secret"},
+			})
+			Expect(c.Create(ctx, infraEnvImage)).To(BeNil())
+			res, err := ir.Reconcile(ctx, newInfraEnvRequest(infraEnvImage))
+			Expect(err).To(BeNil())
+			Expect(res).To(Equal(ctrl.Result{}))
+		})
+
 		It("create new infraEnv image with an invalid nmstate config - fail", func() {
 			hostStaticNetworkConfig.NetworkYaml = "interfaces:\n    - foo: badConfig"
 			nmstateConfig := newNMStateConfig("NMStateConfig", testNamespace, NMStateLabelName, NMStateLabelValue,
@@ -0,0 +1,177 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// errWildcardRecordsUnsupported is returned for the wildcard names, which the etcd plugin of CoreDNS can't serve
+var errWildcardRecordsUnsupported = errors.New("the etcd plugin of CoreDNS doesn't support wildcard records")
+
+// etcdKV is the subset of the etcd client that the CoreDNS provider uses
+type etcdKV interface {
+	Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error)
+	Put(ctx context.Context, key, val string, opts ...clientv3.OpOption) (*clientv3.PutResponse, error)
+	Delete(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.DeleteResponse, error)
+}
+
+// coreDNSEtcdProvider manages the records that the etcd plugin of CoreDNS serves. A record is stored in the key
+// that is the reversed labels of its name under the prefix, e.g. /skydns/com/example/cluster/api/<address>.
+type coreDNSEtcdProvider struct {
+	RecordSet dnsproviders.RecordSet
+	Prefix    string
+	Zone      string
+	Timeout   time.Duration
+	// client returns the client of the etcd cluster, it is connected on the first use
+	client func() (etcdKV, error)
+}
+
+type coreDNSRecord struct {
+	Host string `json:"host"`
+	TTL  int64  `json:"ttl,omitempty"`
+}
+
+// CreateRecordSet adds the address to the records of the name
+func (p coreDNSEtcdProvider) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	key, err := p.key(recordSetName, recordSetValue)
+	if err != nil {
+		return "", err
+	}
+	value, err := json.Marshal(coreDNSRecord{Host: recordSetValue, TTL: p.RecordSet.TTL})
+	if err != nil {
+		return "", err
+	}
+	return "", p.withClient(func(ctx context.Context, kv etcdKV) error {
+		_, err := kv.Put(ctx, key, string(value))
+		return errors.Wrapf(err, "failed to put etcd key %s", key)
+	})
+}
+
+// UpdateRecordSet replaces the records of the name with the address
+func (p coreDNSEtcdProvider) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	if err := p.deleteRecords(recordSetName, func(address string) bool { return address != recordSetValue }); err != nil {
+		return "", err
+	}
+	return p.CreateRecordSet(recordSetName, recordSetValue)
+}
+
+// DeleteRecordSet removes the address from the records of the name
+func (p coreDNSEtcdProvider) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return "", p.deleteRecords(recordSetName, func(address string) bool { return address == recordSetValue })
+}
+
+// deleteRecords deletes the keys of the records of the name whose address matches
+func (p coreDNSEtcdProvider) deleteRecords(recordSetName string, match func(address string) bool) error {
+	records, err := p.records(recordSetName)
+	if err != nil {
+		return err
+	}
+	return p.withClient(func(ctx context.Context, kv etcdKV) error {
+		for key, address := range records {
+			if !match(address) {
+				continue
+			}
+			if _, err := kv.Delete(ctx, key); err != nil {
+				return errors.Wrapf(err, "failed to delete etcd key %s", key)
+			}
+		}
+		return nil
+	})
+}
+
+// GetRecordSet returns the comma separated addresses of the name, empty when it has none
+func (p coreDNSEtcdProvider) GetRecordSet(recordSetName string) (string, error) {
+	records, err := p.records(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	var addresses []string
+	for _, address := range records {
+		if !funk.ContainsString(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+	return strings.Join(addresses, ","), nil
+}
+
+// GetDomainName returns the configured zone, the etcd plugin has no notion of zones
+func (p coreDNSEtcdProvider) GetDomainName() (string, error) {
+	return strings.TrimSuffix(p.Zone, "."), nil
+}
+
+// records returns the keys of the records of the record type of the name and their address
+func (p coreDNSEtcdProvider) records(recordSetName string) (map[string]string, error) {
+	path, err := p.path(recordSetName)
+	if err != nil {
+		return nil, err
+	}
+	records := map[string]string{}
+	err = p.withClient(func(ctx context.Context, kv etcdKV) error {
+		resp, err := kv.Get(ctx, path, clientv3.WithPrefix())
+		if err != nil {
+			return errors.Wrapf(err, "failed to get etcd keys %s", path)
+		}
+		for _, kvs := range resp.Kvs {
+			// The prefix also matches the names that start with the last label, e.g. api-int for api
+			if string(kvs.Key) != path && !strings.HasPrefix(string(kvs.Key), path+"/") {
+				continue
+			}
+			var record coreDNSRecord
+			if err := json.Unmarshal(kvs.Value, &record); err != nil {
+				continue
+			}
+			ip := net.ParseIP(record.Host)
+			if ip == nil || (ip.To4() != nil) != (p.RecordSet.RecordSetType != "AAAA") {
+				continue
+			}
+			records[string(kvs.Key)] = record.Host
+		}
+		return nil
+	})
+	return records, err
+}
+
+func (p coreDNSEtcdProvider) path(recordSetName string) (string, error) {
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(recordSetName, ".")), ".")
+	for _, label := range labels {
+		if label == "*" {
+			return "", errWildcardRecordsUnsupported
+		}
+	}
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.TrimSuffix(p.Prefix, "/") + "/" + strings.Join(labels, "/"), nil
+}
+
+// key returns the key of an address of the name, each address has its own key so that a name has several ones
+func (p coreDNSEtcdProvider) key(recordSetName, recordSetValue string) (string, error) {
+	path, err := p.path(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	if net.ParseIP(recordSetValue) == nil {
+		return "", errors.Errorf("invalid IP address %q", recordSetValue)
+	}
+	return path + "/" + strings.NewReplacer(".", "-", ":", "-").Replace(recordSetValue), nil
+}
+
+func (p coreDNSEtcdProvider) withClient(f func(ctx context.Context, kv etcdKV) error) error {
+	kv, err := p.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.Timeout)
+	defer cancel()
+	return f(ctx, kv)
+}
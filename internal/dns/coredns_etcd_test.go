@@ -0,0 +1,100 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// fakeEtcdKV keeps the keys in memory, Get always returns the keys with the prefix
+type fakeEtcdKV struct {
+	keys map[string]string
+}
+
+func (f *fakeEtcdKV) Get(_ context.Context, key string, _ ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	resp := &clientv3.GetResponse{}
+	for k, v := range f.keys {
+		if strings.HasPrefix(k, key) {
+			resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte(v)})
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeEtcdKV) Put(_ context.Context, key, val string, _ ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	f.keys[key] = val
+	return &clientv3.PutResponse{}, nil
+}
+
+func (f *fakeEtcdKV) Delete(_ context.Context, key string, _ ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	delete(f.keys, key)
+	return &clientv3.DeleteResponse{}, nil
+}
+
+var _ = Describe("CoreDNS etcd provider", func() {
+	var (
+		kv       *fakeEtcdKV
+		provider coreDNSEtcdProvider
+	)
+
+	BeforeEach(func() {
+		kv = &fakeEtcdKV{keys: map[string]string{
+			"/skydns/com/example/test/api-int/x1": `{"host":"192.168.126.9"}`,
+		}}
+		provider = coreDNSEtcdProvider{
+			RecordSet: dnsproviders.RecordSet{RecordSetType: "A", TTL: 60},
+			Prefix:    "/skydns/",
+			Zone:      "example.com",
+			Timeout:   time.Second,
+			client:    func() (etcdKV, error) { return kv, nil },
+		}
+	})
+
+	It("stores a record under the reversed labels of its name", func() {
+		_, err := provider.CreateRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.keys).To(HaveKeyWithValue("/skydns/com/example/test/api/192-168-126-5", `{"host":"192.168.126.5","ttl":60}`))
+	})
+
+	It("gets the addresses of the record type of a name", func() {
+		kv.keys["/skydns/com/example/test/api/a"] = `{"host":"192.168.126.7"}`
+		kv.keys["/skydns/com/example/test/api/b"] = `{"host":"192.168.126.5"}`
+		kv.keys["/skydns/com/example/test/api/c"] = `{"host":"2001:db8::5"}`
+		Expect(provider.GetRecordSet("api.test.example.com")).To(Equal("192.168.126.5,192.168.126.7"))
+		provider.RecordSet.RecordSetType = "AAAA"
+		Expect(provider.GetRecordSet("api.test.example.com")).To(Equal("2001:db8::5"))
+	})
+
+	It("replaces the records of a name", func() {
+		_, err := provider.UpdateRecordSet("api-int.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.GetRecordSet("api-int.test.example.com")).To(Equal("192.168.126.5"))
+	})
+
+	It("deletes a record", func() {
+		_, err := provider.CreateRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider.DeleteRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.GetRecordSet("api.test.example.com")).To(BeEmpty())
+		Expect(kv.keys).To(HaveLen(1))
+	})
+
+	It("rejects the wildcard names", func() {
+		_, err := provider.CreateRecordSet("*.apps.test.example.com", "192.168.126.6")
+		Expect(errors.Is(err, errWildcardRecordsUnsupported)).To(BeTrue())
+	})
+
+	It("fails when the etcd cluster isn't reachable", func() {
+		provider.client = func() (etcdKV, error) { return nil, errors.New("connection refused") }
+		_, err := provider.GetRecordSet("api.test.example.com")
+		Expect(err).To(MatchError("connection refused"))
+	})
+})
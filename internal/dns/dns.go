@@ -3,8 +3,12 @@ package dns
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/http"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
 	"github.com/go-openapi/swag"
@@ -15,6 +19,7 @@ import (
 	"github.com/openshift/assisted-service/pkg/validations"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 const (
@@ -24,8 +29,33 @@ const (
 	dnsDomainLabelLen      = 63
 	dnsDomainTotalLen      = 255
 	dnsDomainPrefixMaxLen  = dnsDomainTotalLen - dnsDomainLabelLen - 1 // reserve for another label and the separating '.'
+
+	ProviderRoute53     = "route53"
+	ProviderRFC2136     = "rfc2136"
+	ProviderPowerDNS    = "powerdns"
+	ProviderCoreDNSEtcd = "coredns-etcd"
 )
 
+// Config is the configuration of the DNS providers of the base domains. The base domains that are managed with
+// rfc2136, powerdns or coredns-etcd have the name of their zone as ID, e.g. example.com:example.com/rfc2136.
+type Config struct {
+	RecordTTL int64         `envconfig:"DNS_RECORD_TTL" default:"60"`
+	Timeout   time.Duration `envconfig:"DNS_TIMEOUT" default:"10s"`
+
+	// RFC2136Server is the primary name server of the zones that receives the dynamic updates, host:port
+	RFC2136Server        string `envconfig:"DNS_RFC2136_SERVER" default:""`
+	RFC2136TSIGKeyName   string `envconfig:"DNS_RFC2136_TSIG_KEY_NAME" default:""`
+	RFC2136TSIGSecret    string `envconfig:"DNS_RFC2136_TSIG_SECRET" default:""`
+	RFC2136TSIGAlgorithm string `envconfig:"DNS_RFC2136_TSIG_ALGORITHM" default:"hmac-sha256"`
+
+	PowerDNSURL      string `envconfig:"DNS_POWERDNS_URL" default:""`
+	PowerDNSAPIKey   string `envconfig:"DNS_POWERDNS_API_KEY" default:""`
+	PowerDNSServerID string `envconfig:"DNS_POWERDNS_SERVER_ID" default:"localhost"`
+
+	CoreDNSEtcdEndpoints []string `envconfig:"DNS_COREDNS_ETCD_ENDPOINTS" default:""`
+	CoreDNSEtcdPrefix    string   `envconfig:"DNS_COREDNS_ETCD_PREFIX" default:"/skydns"`
+}
+
 type DNSDomain struct {
 	Name              string
 	ID                string
@@ -35,9 +65,17 @@ type DNSDomain struct {
 	IngressDomainName string
 }
 
+// DNSRecord is a record that the service created in the DNS provider of the base domain of a cluster
+type DNSRecord struct {
+	Name string `json:"name"`
+	IP   string `json:"ip"`
+}
+
 type DNSApi interface {
 	CreateDNSRecordSets(ctx context.Context, cluster *common.Cluster) error
 	DeleteDNSRecordSets(ctx context.Context, cluster *common.Cluster) error
+	ProvisionDNSRecordSets(ctx context.Context, cluster *common.Cluster) ([]DNSRecord, error)
+	DeleteProvisionedDNSRecordSets(ctx context.Context, cluster *common.Cluster, records []DNSRecord) error
 	GetDNSDomain(clusterName, baseDNSDomainName string) (*DNSDomain, error)
 	ValidateDNSName(clusterName, baseDNSDomainName string) error
 	ValidateBaseDNS(domain *DNSDomain) error
@@ -51,7 +89,21 @@ type DNSProviderFactory interface {
 }
 
 type defaultDNSProviderFactory struct {
-	log logrus.FieldLogger
+	log        logrus.FieldLogger
+	config     Config
+	httpClient *http.Client
+
+	etcdOnce   sync.Once
+	etcdClient etcdKV
+	etcdErr    error
+}
+
+func newDefaultDNSProviderFactory(config Config, log logrus.FieldLogger) *defaultDNSProviderFactory {
+	return &defaultDNSProviderFactory{
+		log:        log,
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout},
+	}
 }
 
 type handler struct {
@@ -60,8 +112,8 @@ type handler struct {
 	providerFactory DNSProviderFactory
 }
 
-func NewDNSHandler(baseDNSDomains map[string]string, log logrus.FieldLogger) DNSApi {
-	return NewDNSHandlerWithProviders(baseDNSDomains, log, &defaultDNSProviderFactory{log})
+func NewDNSHandler(baseDNSDomains map[string]string, config Config, log logrus.FieldLogger) DNSApi {
+	return NewDNSHandlerWithProviders(baseDNSDomains, log, newDefaultDNSProviderFactory(config, log))
 }
 
 func NewDNSHandlerWithProviders(baseDNSDomains map[string]string, log logrus.FieldLogger, providers DNSProviderFactory) DNSApi {
@@ -74,6 +126,10 @@ func NewDNSHandlerWithProviders(baseDNSDomains map[string]string, log logrus.Fie
 
 func (h *handler) CreateDNSRecordSets(ctx context.Context, cluster *common.Cluster) error {
 	log := logutil.FromContext(ctx, h.log)
+	if IsDNSRecordsProvisioningEnabled(cluster) {
+		// The records are provisioned when the cluster is registered or updated
+		return nil
+	}
 	ok, err := h.updateDNSRecordSet(log, cluster, h.createDNSRecord)
 	if err != nil {
 		return err
@@ -85,6 +141,10 @@ func (h *handler) CreateDNSRecordSets(ctx context.Context, cluster *common.Clust
 
 func (h *handler) DeleteDNSRecordSets(ctx context.Context, cluster *common.Cluster) error {
 	log := logutil.FromContext(ctx, h.log)
+	if IsDNSRecordsProvisioningEnabled(cluster) {
+		// Only the provisioned records are deleted, with DeleteProvisionedDNSRecordSets
+		return nil
+	}
 	ok, err := h.updateDNSRecordSet(log, cluster, h.deleteDNSRecord)
 	if err != nil {
 		return err
@@ -94,6 +154,54 @@ func (h *handler) DeleteDNSRecordSets(ctx context.Context, cluster *common.Clust
 	return nil
 }
 
+// ProvisionDNSRecordSets creates the records of the external load balancers of the cluster that don't exist yet, and
+// returns the records that it created. The records that exist already, e.g. because the user created them, are kept.
+// The records that were created before a failure are returned with the error.
+func (h *handler) ProvisionDNSRecordSets(ctx context.Context, cluster *common.Cluster) ([]DNSRecord, error) {
+	log := logutil.FromContext(ctx, h.log)
+	if !IsDNSRecordsProvisioningEnabled(cluster) {
+		return nil, nil
+	}
+	var created []DNSRecord
+	createRecord := func(log logrus.FieldLogger, domain *DNSDomain, name, ip string) error {
+		ok, err := h.createMissingDNSRecord(log, domain, name, ip)
+		if ok {
+			created = append(created, DNSRecord{Name: name, IP: ip})
+		}
+		return err
+	}
+	ok, err := h.updateDNSRecordSet(log, cluster, createRecord)
+	if err != nil {
+		return created, err
+	} else if ok {
+		log.Infof("Successfully provisioned DNS records for base domain: %s", cluster.BaseDNSDomain)
+	}
+	return created, nil
+}
+
+// DeleteProvisionedDNSRecordSets deletes the records that ProvisionDNSRecordSets created for the cluster
+func (h *handler) DeleteProvisionedDNSRecordSets(ctx context.Context, cluster *common.Cluster, records []DNSRecord) error {
+	log := logutil.FromContext(ctx, h.log)
+	if len(records) == 0 {
+		return nil
+	}
+	domain, err := h.GetDNSDomain(cluster.Name, cluster.BaseDNSDomain)
+	if err != nil {
+		return err
+	}
+	if domain == nil {
+		log.Debug("No supported base DNS domain specified")
+		return nil
+	}
+	for _, record := range records {
+		if err := h.deleteDNSRecord(log, domain, record.Name, record.IP); err != nil {
+			return err
+		}
+	}
+	log.Infof("Successfully deleted the provisioned DNS records for base domain: %s", cluster.BaseDNSDomain)
+	return nil
+}
+
 func (h *handler) updateDNSRecordSet(log logrus.FieldLogger, cluster *common.Cluster, updateRecordFunc func(logrus.FieldLogger, *DNSDomain, string, string) error) (bool, error) {
 	domain, err := h.GetDNSDomain(cluster.Name, cluster.BaseDNSDomain)
 	if err != nil {
@@ -104,6 +212,21 @@ func (h *handler) updateDNSRecordSet(log logrus.FieldLogger, cluster *common.Clu
 		return false, nil
 	}
 
+	if IsDNSRecordsProvisioningEnabled(cluster) {
+		// The records point at the external load balancers of the cluster
+		provisioning := cluster.DNSRecordsProvisioning
+		for _, record := range [][2]string{
+			{domain.APIDomainName, provisioning.APIIP},
+			{domain.APIINTDomainName, provisioning.APIIP},
+			{domain.IngressDomainName, provisioning.IngressIP},
+		} {
+			if err := updateRecordFunc(log, domain, record[0], record[1]); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
 	apiVip := network.GetApiVipById(cluster, 0)
 	ingressVip := network.GetIngressVipById(cluster, 0)
 	if common.IsSingleNodeCluster(cluster) {
@@ -140,6 +263,32 @@ func (h *handler) createDNSRecord(log logrus.FieldLogger, domain *DNSDomain, nam
 	return nil
 }
 
+// createMissingDNSRecord creates the record only when the name has no record of the same type yet, so that the
+// records that the user created are kept. It returns true if it created the record.
+func (h *handler) createMissingDNSRecord(log logrus.FieldLogger, domain *DNSDomain, name, ip string) (bool, error) {
+	provider := h.providerFactory.GetProviderByRecordType(domain, getDNSRecordType(ip))
+	if provider == nil {
+		return false, errors.Errorf("no DNS provider %s for base domain %s", domain.Provider, domain.Name)
+	}
+	existing, err := provider.GetRecordSet(name)
+	if err == nil && existing == "" {
+		_, err = provider.CreateRecordSet(name, ip)
+	}
+	if errors.Is(err, errWildcardRecordsUnsupported) {
+		log.WithError(err).Warnf("DNS record %s must be created manually", name)
+		return false, nil
+	}
+	if err != nil {
+		log.WithError(err).Errorf("failed to create DNS record: (%s, %s)", name, ip)
+		return false, err
+	}
+	if existing != "" {
+		log.Infof("DNS record %s already exists with %s", name, existing)
+		return false, nil
+	}
+	return true, nil
+}
+
 func getDNSRecordType(ipAddress string) string {
 	if network.IsIPv4Addr(ipAddress) {
 		return "A"
@@ -217,6 +366,9 @@ func (h *handler) ValidateDNSName(clusterName, baseDNSDomainName string) error {
 // ValidateBaseDNS validates the specified base domain name
 func (h *handler) ValidateBaseDNS(domain *DNSDomain) error {
 	dnsProvider := h.providerFactory.GetProvider(domain)
+	if dnsProvider == nil {
+		return errors.Errorf("Can't validate base DNS domain: DNS provider %s isn't supported or configured", domain.Provider)
+	}
 	dnsNameFromService, err := dnsProvider.GetDomainName()
 	if err != nil {
 		return errors.Errorf("Can't validate base DNS domain: %v", err)
@@ -265,29 +417,95 @@ func (h *handler) checkDNSRecordsExists(names []string, domain *DNSDomain, recor
 }
 
 func (f *defaultDNSProviderFactory) GetProviderByRecordType(domain *DNSDomain, recordType string) dnsproviders.Provider {
+	return f.newProvider(domain, dnsproviders.RecordSet{RecordSetType: recordType, TTL: f.config.RecordTTL})
+}
+
+func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.Provider {
+	return f.newProvider(domain, dnsproviders.RecordSet{})
+}
+
+func (f *defaultDNSProviderFactory) newProvider(domain *DNSDomain, recordSet dnsproviders.RecordSet) dnsproviders.Provider {
 	switch domain.Provider {
-	case "route53":
+	case ProviderRoute53:
 		return dnsproviders.Route53{
-			RecordSet: dnsproviders.RecordSet{
-				RecordSetType: recordType,
-				TTL:           60,
-			},
+			RecordSet:    recordSet,
 			HostedZoneID: domain.ID,
 			SharedCreds:  true,
 		}
+	case ProviderRFC2136:
+		if f.config.RFC2136Server == "" {
+			break
+		}
+		return rfc2136Provider{
+			RecordSet:     recordSet,
+			Server:        f.config.RFC2136Server,
+			Zone:          domain.ID,
+			TSIGKeyName:   f.config.RFC2136TSIGKeyName,
+			TSIGSecret:    f.config.RFC2136TSIGSecret,
+			TSIGAlgorithm: f.config.RFC2136TSIGAlgorithm,
+			Timeout:       f.config.Timeout,
+		}
+	case ProviderPowerDNS:
+		if f.config.PowerDNSURL == "" {
+			break
+		}
+		return powerDNSProvider{
+			RecordSet: recordSet,
+			URL:       f.config.PowerDNSURL,
+			APIKey:    f.config.PowerDNSAPIKey,
+			ServerID:  f.config.PowerDNSServerID,
+			Zone:      domain.ID,
+			Client:    f.httpClient,
+		}
+	case ProviderCoreDNSEtcd:
+		if len(f.config.CoreDNSEtcdEndpoints) == 0 {
+			break
+		}
+		return coreDNSEtcdProvider{
+			RecordSet: recordSet,
+			Prefix:    f.config.CoreDNSEtcdPrefix,
+			Zone:      domain.ID,
+			Timeout:   f.config.Timeout,
+			client:    f.getEtcdClient,
+		}
 	}
 	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
 }
 
-func (f *defaultDNSProviderFactory) GetProvider(domain *DNSDomain) dnsproviders.Provider {
-	switch domain.Provider {
-	case "route53":
-		return dnsproviders.Route53{
-			HostedZoneID: domain.ID,
-			SharedCreds:  true,
+// getEtcdClient connects to the etcd cluster of CoreDNS once, the connection is shared by the providers
+func (f *defaultDNSProviderFactory) getEtcdClient() (etcdKV, error) {
+	f.etcdOnce.Do(func() {
+		client, err := clientv3.New(clientv3.Config{Endpoints: f.config.CoreDNSEtcdEndpoints})
+		if err != nil {
+			f.etcdErr = errors.Wrap(err, "failed to connect to the etcd cluster of CoreDNS")
+			return
+		}
+		f.etcdClient = client
+	})
+	return f.etcdClient, f.etcdErr
+}
+
+// IsDNSRecordsProvisioningEnabled returns true if the service creates the DNS records of the cluster, which is
+// supported for multi node clusters with user managed networking
+func IsDNSRecordsProvisioningEnabled(cluster *common.Cluster) bool {
+	return cluster.DNSRecordsProvisioning != nil && cluster.DNSRecordsProvisioning.Enabled &&
+		swag.BoolValue(cluster.UserManagedNetworking) && !common.IsSingleNodeCluster(cluster)
+}
+
+// ValidateDNSRecordsProvisioning verifies that the addresses that the records point at are set when the
+// provisioning of the DNS records is enabled
+func ValidateDNSRecordsProvisioning(provisioning *models.DNSRecordsProvisioning) error {
+	if provisioning == nil || !provisioning.Enabled {
+		return nil
+	}
+	for _, address := range []struct{ name, ip string }{
+		{"API", provisioning.APIIP},
+		{"ingress", provisioning.IngressIP},
+	} {
+		if net.ParseIP(address.ip) == nil {
+			return errors.Errorf("the %s IP address %q of the DNS records provisioning isn't a valid IP address", address.name, address.ip)
 		}
 	}
-	f.log.Debugf("No suitable implementation for DNS provider %s", domain.Provider)
 	return nil
 }
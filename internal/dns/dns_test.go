@@ -13,6 +13,7 @@ import (
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,7 +25,7 @@ var _ = Describe("DNS tests", func() {
 
 	BeforeEach(func() {
 		baseDNSDomains = make(map[string]string)
-		dnsApi = NewDNSHandler(baseDNSDomains, logrus.New())
+		dnsApi = NewDNSHandler(baseDNSDomains, Config{}, logrus.New())
 	})
 
 	It("get DNS domain success", func() {
@@ -276,6 +277,101 @@ var _ = Describe("DNS record set update tests", func() {
 		err = dns.ValidateDNSRecords(*cluster, domain)
 		Expect(err).Should(HaveOccurred())
 	})
+	Context("DNS records provisioning", func() {
+		var (
+			apiName     string
+			apiIntName  string
+			ingressName string
+		)
+
+		BeforeEach(func() {
+			cluster.UserManagedNetworking = swag.Bool(true)
+			cluster.APIVips = nil
+			cluster.IngressVips = nil
+			cluster.DNSRecordsProvisioning = &models.DNSRecordsProvisioning{
+				Enabled:   true,
+				APIIP:     "192.168.126.5",
+				IngressIP: "192.168.126.6",
+			}
+			apiName = fmt.Sprintf("api.%s.%s", cluster.Name, cluster.BaseDNSDomain)
+			apiIntName = fmt.Sprintf("api-int.%s.%s", cluster.Name, cluster.BaseDNSDomain)
+			ingressName = fmt.Sprintf("*.apps.%s.%s", cluster.Name, cluster.BaseDNSDomain)
+		})
+
+		It("creates the missing records with the addresses of the load balancers", func() {
+			mockProv := NewMockDNSProvider(ctrl)
+			mockProv.EXPECT().GetRecordSet(apiName).Return("192.168.126.5", nil)
+			mockProv.EXPECT().GetRecordSet(apiIntName).Return("", nil)
+			mockProv.EXPECT().GetRecordSet(ingressName).Return("", nil)
+			mockProv.EXPECT().CreateRecordSet(apiIntName, "192.168.126.5").Times(1)
+			mockProv.EXPECT().CreateRecordSet(ingressName, "192.168.126.6").Times(1)
+			mockProviders.EXPECT().GetProviderByRecordType(gomock.Any(), "A").Times(3).Return(mockProv)
+			records, err := dns.ProvisionDNSRecordSets(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(Equal([]DNSRecord{{Name: apiIntName, IP: "192.168.126.5"}, {Name: ingressName, IP: "192.168.126.6"}}))
+		})
+		It("deletes only the provisioned records", func() {
+			mockProv := NewMockDNSProvider(ctrl)
+			mockProv.EXPECT().DeleteRecordSet(apiIntName, "192.168.126.5").Times(1)
+			mockProviders.EXPECT().GetProviderByRecordType(gomock.Any(), "A").Times(1).Return(mockProv)
+			Expect(dns.DeleteProvisionedDNSRecordSets(ctx, cluster, []DNSRecord{{Name: apiIntName, IP: "192.168.126.5"}})).To(Succeed())
+		})
+		It("doesn't create or delete the records of the VIPs", func() {
+			Expect(dns.CreateDNSRecordSets(ctx, cluster)).To(Succeed())
+			Expect(dns.DeleteDNSRecordSets(ctx, cluster)).To(Succeed())
+		})
+		It("skips the wildcard record when the provider doesn't support it", func() {
+			mockProv := NewMockDNSProvider(ctrl)
+			mockProv.EXPECT().GetRecordSet(apiName).Return("", nil)
+			mockProv.EXPECT().GetRecordSet(apiIntName).Return("", nil)
+			mockProv.EXPECT().GetRecordSet(ingressName).Return("", errWildcardRecordsUnsupported)
+			mockProv.EXPECT().CreateRecordSet(apiName, "192.168.126.5").Times(1)
+			mockProv.EXPECT().CreateRecordSet(apiIntName, "192.168.126.5").Times(1)
+			mockProviders.EXPECT().GetProviderByRecordType(gomock.Any(), "A").Times(3).Return(mockProv)
+			records, err := dns.ProvisionDNSRecordSets(ctx, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(HaveLen(2))
+		})
+		It("fails when a record can't be created", func() {
+			mockProv := NewMockDNSProvider(ctrl)
+			mockProv.EXPECT().GetRecordSet(apiName).Return("", nil)
+			mockProv.EXPECT().CreateRecordSet(apiName, "192.168.126.5").Return("", errors.New("REFUSED"))
+			mockProviders.EXPECT().GetProviderByRecordType(gomock.Any(), "A").Times(1).Return(mockProv)
+			records, err := dns.ProvisionDNSRecordSets(ctx, cluster)
+			Expect(err).To(HaveOccurred())
+			Expect(records).To(BeEmpty())
+		})
+		It("is disabled for clusters with cluster managed networking", func() {
+			cluster.UserManagedNetworking = swag.Bool(false)
+			Expect(IsDNSRecordsProvisioningEnabled(cluster)).To(BeFalse())
+		})
+		It("is disabled for single node clusters", func() {
+			cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+			Expect(IsDNSRecordsProvisioningEnabled(cluster)).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("DNS records provisioning validation", func() {
+	It("accepts a disabled provisioning without addresses", func() {
+		Expect(ValidateDNSRecordsProvisioning(nil)).To(Succeed())
+		Expect(ValidateDNSRecordsProvisioning(&models.DNSRecordsProvisioning{})).To(Succeed())
+	})
+	It("accepts the addresses of both families", func() {
+		Expect(ValidateDNSRecordsProvisioning(&models.DNSRecordsProvisioning{
+			Enabled:   true,
+			APIIP:     "192.168.126.5",
+			IngressIP: "2001:db8::6",
+		})).To(Succeed())
+	})
+	It("rejects a missing ingress address", func() {
+		err := ValidateDNSRecordsProvisioning(&models.DNSRecordsProvisioning{Enabled: true, APIIP: "192.168.126.5"})
+		Expect(err).To(MatchError(ContainSubstring("ingress IP address")))
+	})
+	It("rejects an invalid API address", func() {
+		err := ValidateDNSRecordsProvisioning(&models.DNSRecordsProvisioning{Enabled: true, APIIP: "api", IngressIP: "192.168.126.6"})
+		Expect(err).To(MatchError(ContainSubstring("API IP address")))
+	})
 })
 
 var _ = Describe("Record type by IP address", func() {
@@ -298,11 +394,11 @@ var _ = Describe("Default DNS provider tests", func() {
 		domain = &DNSDomain{
 			Provider: "route53",
 		}
-		providers = &defaultDNSProviderFactory{logrus.New()}
+		providers = newDefaultDNSProviderFactory(Config{}, logrus.New())
 	})
 
 	It("default provider is used when no provider factory specified", func() {
-		dns := NewDNSHandler(make(map[string]string), logrus.New())
+		dns := NewDNSHandler(make(map[string]string), Config{}, logrus.New())
 		h, ok := dns.(*handler)
 		Expect(ok).To(BeTrue())
 		Expect(h.providerFactory).To(BeAssignableToTypeOf(providers))
@@ -323,6 +419,30 @@ var _ = Describe("Default DNS provider tests", func() {
 		Expect(ok).To(BeTrue())
 		Expect(r53.RecordSet.RecordSetType).To(Equal("A"))
 	})
+	It("return nil when the provider isn't configured", func() {
+		Expect(providers.GetProvider(&DNSDomain{ID: "example.com", Provider: ProviderRFC2136})).To(BeNil())
+		Expect(providers.GetProvider(&DNSDomain{ID: "example.com", Provider: ProviderPowerDNS})).To(BeNil())
+		Expect(providers.GetProvider(&DNSDomain{ID: "example.com", Provider: ProviderCoreDNSEtcd})).To(BeNil())
+	})
+	It("providers of the zones of the base domains", func() {
+		providers = newDefaultDNSProviderFactory(Config{
+			RecordTTL:            30,
+			RFC2136Server:        "192.168.126.1:53",
+			PowerDNSURL:          "http://192.168.126.1:8081",
+			CoreDNSEtcdEndpoints: []string{"http://192.168.126.1:2379"},
+		}, logrus.New())
+		rfc2136, ok := providers.GetProviderByRecordType(&DNSDomain{ID: "example.com", Provider: ProviderRFC2136}, "A").(rfc2136Provider)
+		Expect(ok).To(BeTrue())
+		Expect(rfc2136.Zone).To(Equal("example.com"))
+		Expect(rfc2136.RecordSet).To(Equal(dnsproviders.RecordSet{RecordSetType: "A", TTL: 30}))
+		powerDNS, ok := providers.GetProviderByRecordType(&DNSDomain{ID: "example.com", Provider: ProviderPowerDNS}, "AAAA").(powerDNSProvider)
+		Expect(ok).To(BeTrue())
+		Expect(powerDNS.Zone).To(Equal("example.com"))
+		Expect(powerDNS.RecordSet.RecordSetType).To(Equal("AAAA"))
+		coreDNS, ok := providers.GetProvider(&DNSDomain{ID: "example.com", Provider: ProviderCoreDNSEtcd}).(coreDNSEtcdProvider)
+		Expect(ok).To(BeTrue())
+		Expect(coreDNS.GetDomainName()).To(Equal("example.com"))
+	})
 })
 
 var _ = Describe("Base DNS domain validation", func() {
@@ -64,6 +64,20 @@ func (mr *MockDNSApiMockRecorder) DeleteDNSRecordSets(ctx, cluster interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSRecordSets", reflect.TypeOf((*MockDNSApi)(nil).DeleteDNSRecordSets), ctx, cluster)
 }
 
+// DeleteProvisionedDNSRecordSets mocks base method.
+func (m *MockDNSApi) DeleteProvisionedDNSRecordSets(ctx context.Context, cluster *common.Cluster, records []DNSRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProvisionedDNSRecordSets", ctx, cluster, records)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProvisionedDNSRecordSets indicates an expected call of DeleteProvisionedDNSRecordSets.
+func (mr *MockDNSApiMockRecorder) DeleteProvisionedDNSRecordSets(ctx, cluster, records interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProvisionedDNSRecordSets", reflect.TypeOf((*MockDNSApi)(nil).DeleteProvisionedDNSRecordSets), ctx, cluster, records)
+}
+
 // GetDNSDomain mocks base method.
 func (m *MockDNSApi) GetDNSDomain(clusterName, baseDNSDomainName string) (*DNSDomain, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +93,21 @@ func (mr *MockDNSApiMockRecorder) GetDNSDomain(clusterName, baseDNSDomainName in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSDomain", reflect.TypeOf((*MockDNSApi)(nil).GetDNSDomain), clusterName, baseDNSDomainName)
 }
 
+// ProvisionDNSRecordSets mocks base method.
+func (m *MockDNSApi) ProvisionDNSRecordSets(ctx context.Context, cluster *common.Cluster) ([]DNSRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionDNSRecordSets", ctx, cluster)
+	ret0, _ := ret[0].([]DNSRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionDNSRecordSets indicates an expected call of ProvisionDNSRecordSets.
+func (mr *MockDNSApiMockRecorder) ProvisionDNSRecordSets(ctx, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionDNSRecordSets", reflect.TypeOf((*MockDNSApi)(nil).ProvisionDNSRecordSets), ctx, cluster)
+}
+
 // ValidateBaseDNS mocks base method.
 func (m *MockDNSApi) ValidateBaseDNS(domain *DNSDomain) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,177 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+)
+
+// powerDNSProvider manages the records of a zone with the HTTP API of the PowerDNS authoritative server
+type powerDNSProvider struct {
+	RecordSet dnsproviders.RecordSet
+	URL       string
+	APIKey    string
+	ServerID  string
+	Zone      string
+	Client    *http.Client
+}
+
+type powerDNSZone struct {
+	Name   string          `json:"name,omitempty"`
+	RRSets []powerDNSRRSet `json:"rrsets"`
+}
+
+type powerDNSRRSet struct {
+	Name       string           `json:"name"`
+	Type       string           `json:"type"`
+	TTL        int64            `json:"ttl,omitempty"`
+	ChangeType string           `json:"changetype,omitempty"`
+	Records    []powerDNSRecord `json:"records"`
+}
+
+type powerDNSRecord struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+// CreateRecordSet adds the address to the records of the name
+func (p powerDNSProvider) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	addresses, err := p.addresses(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	for _, address := range addresses {
+		if address == recordSetValue {
+			return "", nil
+		}
+	}
+	return p.replace(recordSetName, append(addresses, recordSetValue))
+}
+
+// UpdateRecordSet replaces the records of the name with the address
+func (p powerDNSProvider) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.replace(recordSetName, []string{recordSetValue})
+}
+
+// DeleteRecordSet removes the address from the records of the name
+func (p powerDNSProvider) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	addresses, err := p.addresses(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	var remaining []string
+	for _, address := range addresses {
+		if address != recordSetValue {
+			remaining = append(remaining, address)
+		}
+	}
+	if len(remaining) == len(addresses) {
+		return "", nil
+	}
+	return p.replace(recordSetName, remaining)
+}
+
+// GetRecordSet returns the comma separated addresses of the name, empty when it has none
+func (p powerDNSProvider) GetRecordSet(recordSetName string) (string, error) {
+	addresses, err := p.addresses(recordSetName)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(addresses, ","), nil
+}
+
+// GetDomainName returns the name of the zone
+func (p powerDNSProvider) GetDomainName() (string, error) {
+	var zone powerDNSZone
+	if err := p.do(http.MethodGet, p.zonePath()+"?rrsets=false", nil, &zone); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(zone.Name, "."), nil
+}
+
+func (p powerDNSProvider) zonePath() string {
+	return "/api/v1/servers/" + url.PathEscape(p.ServerID) + "/zones/" + url.PathEscape(fqdn(p.Zone))
+}
+
+func (p powerDNSProvider) addresses(recordSetName string) ([]string, error) {
+	var zone powerDNSZone
+	if err := p.do(http.MethodGet, p.zonePath(), nil, &zone); err != nil {
+		return nil, err
+	}
+	name := strings.ToLower(fqdn(recordSetName))
+	var addresses []string
+	for _, rrset := range zone.RRSets {
+		if strings.ToLower(rrset.Name) != name || rrset.Type != p.RecordSet.RecordSetType {
+			continue
+		}
+		for _, record := range rrset.Records {
+			if !record.Disabled {
+				addresses = append(addresses, record.Content)
+			}
+		}
+	}
+	return addresses, nil
+}
+
+// replace replaces the records of the name with the addresses, the record set is deleted when there are none
+func (p powerDNSProvider) replace(recordSetName string, addresses []string) (string, error) {
+	rrset := powerDNSRRSet{
+		Name:       fqdn(recordSetName),
+		Type:       p.RecordSet.RecordSetType,
+		TTL:        p.RecordSet.TTL,
+		ChangeType: "REPLACE",
+		Records:    []powerDNSRecord{},
+	}
+	for _, address := range addresses {
+		rrset.Records = append(rrset.Records, powerDNSRecord{Content: address})
+	}
+	if len(addresses) == 0 {
+		rrset.ChangeType = "DELETE"
+		rrset.TTL = 0
+	}
+	body := powerDNSZone{RRSets: []powerDNSRRSet{rrset}}
+	if err := p.do(http.MethodPatch, p.zonePath(), body, nil); err != nil {
+		return "", err
+	}
+	return rrset.ChangeType, nil
+}
+
+func (p powerDNSProvider) do(method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, strings.TrimSuffix(p.URL, "/")+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-API-Key", p.APIKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the request to PowerDNS")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("PowerDNS responded to %s %s with status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if result == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(result), "failed to decode the response of PowerDNS")
+}
@@ -0,0 +1,126 @@
+package dns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PowerDNS provider", func() {
+	var (
+		server   *httptest.Server
+		provider powerDNSProvider
+		zone     powerDNSZone
+		patches  []powerDNSRRSet
+	)
+
+	BeforeEach(func() {
+		patches = nil
+		zone = powerDNSZone{
+			Name: "example.com.",
+			RRSets: []powerDNSRRSet{
+				{Name: "example.com.", Type: "SOA", Records: []powerDNSRecord{{Content: "ns.example.com. admin.example.com. 1 10800 3600 604800 3600"}}},
+				{Name: "api.test.example.com.", Type: "A", Records: []powerDNSRecord{{Content: "192.168.126.5"}, {Content: "192.168.126.9", Disabled: true}}},
+				{Name: "api-int.test.example.com.", Type: "A", Records: []powerDNSRecord{{Content: "192.168.126.5"}, {Content: "192.168.126.7"}}},
+			},
+		}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-API-Key") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Path != "/api/v1/servers/localhost/zones/example.com." {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error": "Could not find domain"}`))
+				return
+			}
+			switch r.Method {
+			case http.MethodGet:
+				Expect(json.NewEncoder(w).Encode(zone)).To(Succeed())
+			case http.MethodPatch:
+				var body powerDNSZone
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				patches = append(patches, body.RRSets...)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		provider = powerDNSProvider{
+			RecordSet: dnsproviders.RecordSet{RecordSetType: "A", TTL: 60},
+			URL:       server.URL + "/",
+			APIKey:    "secret",
+			ServerID:  "localhost",
+			Zone:      "example.com",
+			Client:    server.Client(),
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("gets the zone", func() {
+		Expect(provider.GetDomainName()).To(Equal("example.com"))
+	})
+
+	It("fails to get a zone that doesn't exist", func() {
+		provider.Zone = "other.com"
+		_, err := provider.GetDomainName()
+		Expect(err).To(MatchError(ContainSubstring("status 404: {\"error\": \"Could not find domain\"}")))
+	})
+
+	It("gets the enabled addresses of a name", func() {
+		Expect(provider.GetRecordSet("api.test.example.com")).To(Equal("192.168.126.5"))
+		Expect(provider.GetRecordSet("*.apps.test.example.com")).To(BeEmpty())
+	})
+
+	It("adds an address to the records of a name", func() {
+		_, err := provider.CreateRecordSet("*.apps.test.example.com", "192.168.126.6")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(Equal([]powerDNSRRSet{{
+			Name:       "*.apps.test.example.com.",
+			Type:       "A",
+			TTL:        60,
+			ChangeType: "REPLACE",
+			Records:    []powerDNSRecord{{Content: "192.168.126.6"}},
+		}}))
+	})
+
+	It("doesn't add an address that the name already has", func() {
+		_, err := provider.CreateRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(BeEmpty())
+	})
+
+	It("replaces the records of a name", func() {
+		_, err := provider.UpdateRecordSet("api-int.test.example.com", "192.168.126.8")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].Records).To(Equal([]powerDNSRecord{{Content: "192.168.126.8"}}))
+	})
+
+	It("keeps the other addresses of a name when one is deleted", func() {
+		_, err := provider.DeleteRecordSet("api-int.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].ChangeType).To(Equal("REPLACE"))
+		Expect(patches[0].Records).To(Equal([]powerDNSRecord{{Content: "192.168.126.7"}}))
+	})
+
+	It("deletes the record set of the last address of a name", func() {
+		_, err := provider.DeleteRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].ChangeType).To(Equal("DELETE"))
+		Expect(patches[0].Records).To(BeEmpty())
+	})
+
+	It("fails when the API key is rejected", func() {
+		provider.APIKey = "wrong"
+		_, err := provider.CreateRecordSet("*.apps.test.example.com", "192.168.126.6")
+		Expect(err).To(MatchError(ContainSubstring("status 401")))
+	})
+})
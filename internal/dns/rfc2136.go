@@ -0,0 +1,364 @@
+package dns
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // #nosec G505 hmac-sha1 is still a common TSIG algorithm
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"hash"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	"github.com/pkg/errors"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// opCodeUpdate is the operation code of the dynamic updates (RFC 2136)
+	opCodeUpdate dnsmessage.OpCode = 5
+	// classNone is the class of the updates that delete a single record (RFC 2136)
+	classNone dnsmessage.Class = 254
+	typeTSIG  dnsmessage.Type  = 250
+	// tsigFudge is the number of seconds of clock skew that the server accepts (RFC 8945)
+	tsigFudge = 300
+)
+
+var tsigAlgorithms = map[string]func() hash.Hash{
+	"hmac-sha1":   sha1.New,
+	"hmac-sha256": sha256.New,
+	"hmac-sha512": sha512.New,
+}
+
+// rfc2136Provider manages the records of a zone with dynamic updates (RFC 2136), e.g. in BIND. The updates are
+// signed with TSIG (RFC 8945) when a key is configured.
+type rfc2136Provider struct {
+	RecordSet dnsproviders.RecordSet
+	// Server is the address of the primary name server of the zone, host:port
+	Server string
+	Zone   string
+	// TSIGKeyName, TSIGSecret (base64) and TSIGAlgorithm are the key that signs the messages
+	TSIGKeyName   string
+	TSIGSecret    string
+	TSIGAlgorithm string
+	Timeout       time.Duration
+	// now returns the time that the messages are signed at
+	now func() time.Time
+}
+
+// CreateRecordSet adds the address to the records of the name
+func (p rfc2136Provider) CreateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.update(func(b *dnsmessage.Builder) error {
+		return p.addressResource(b, recordSetName, recordSetValue, dnsmessage.ClassINET, p.RecordSet.TTL)
+	})
+}
+
+// UpdateRecordSet replaces the records of the name with the address
+func (p rfc2136Provider) UpdateRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.update(func(b *dnsmessage.Builder) error {
+		name, err := dnsmessage.NewName(fqdn(recordSetName))
+		if err != nil {
+			return err
+		}
+		// Deleting an RRset is a record of class ANY without data
+		header := dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassANY}
+		if err = b.UnknownResource(header, dnsmessage.UnknownResource{Type: p.recordType()}); err != nil {
+			return err
+		}
+		return p.addressResource(b, recordSetName, recordSetValue, dnsmessage.ClassINET, p.RecordSet.TTL)
+	})
+}
+
+// DeleteRecordSet removes the address from the records of the name
+func (p rfc2136Provider) DeleteRecordSet(recordSetName, recordSetValue string) (string, error) {
+	return p.update(func(b *dnsmessage.Builder) error {
+		return p.addressResource(b, recordSetName, recordSetValue, classNone, 0)
+	})
+}
+
+// GetRecordSet returns the comma separated addresses of the name, empty when it has none
+func (p rfc2136Provider) GetRecordSet(recordSetName string) (string, error) {
+	var addresses []string
+	err := p.query(recordSetName, p.recordType(), func(parser *dnsmessage.Parser, header dnsmessage.ResourceHeader) error {
+		switch header.Type {
+		case dnsmessage.TypeA:
+			r, err := parser.AResource()
+			if err != nil {
+				return err
+			}
+			addresses = append(addresses, net.IP(r.A[:]).String())
+		case dnsmessage.TypeAAAA:
+			r, err := parser.AAAAResource()
+			if err != nil {
+				return err
+			}
+			addresses = append(addresses, net.IP(r.AAAA[:]).String())
+		default:
+			return parser.SkipAnswer()
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(addresses, ","), nil
+}
+
+// GetDomainName returns the name of the zone once the server confirmed that it serves it
+func (p rfc2136Provider) GetDomainName() (string, error) {
+	found := false
+	err := p.query(p.Zone, dnsmessage.TypeSOA, func(parser *dnsmessage.Parser, header dnsmessage.ResourceHeader) error {
+		found = found || header.Type == dnsmessage.TypeSOA
+		return parser.SkipAnswer()
+	})
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", errors.Errorf("DNS server %s isn't authoritative for zone %s", p.Server, p.Zone)
+	}
+	return strings.TrimSuffix(p.Zone, "."), nil
+}
+
+func (p rfc2136Provider) recordType() dnsmessage.Type {
+	if p.RecordSet.RecordSetType == "AAAA" {
+		return dnsmessage.TypeAAAA
+	}
+	return dnsmessage.TypeA
+}
+
+func (p rfc2136Provider) addressResource(b *dnsmessage.Builder, recordSetName, recordSetValue string, class dnsmessage.Class, ttl int64) error {
+	name, err := dnsmessage.NewName(fqdn(recordSetName))
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(recordSetValue)
+	if ip == nil {
+		return errors.Errorf("invalid IP address %q", recordSetValue)
+	}
+	header := dnsmessage.ResourceHeader{Name: name, Class: class, TTL: uint32(ttl)}
+	if p.recordType() == dnsmessage.TypeAAAA {
+		var r dnsmessage.AAAAResource
+		copy(r.AAAA[:], ip.To16())
+		return b.AAAAResource(header, r)
+	}
+	if ip.To4() == nil {
+		return errors.Errorf("%s isn't an IPv4 address", recordSetValue)
+	}
+	var r dnsmessage.AResource
+	copy(r.A[:], ip.To4())
+	return b.AResource(header, r)
+}
+
+// update sends an update of the zone, the updates are added to the update (authority) section by the function
+func (p rfc2136Provider) update(addUpdates func(b *dnsmessage.Builder) error) (string, error) {
+	zone, err := dnsmessage.NewName(fqdn(p.Zone))
+	if err != nil {
+		return "", err
+	}
+	id, err := messageID()
+	if err != nil {
+		return "", err
+	}
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id, OpCode: opCodeUpdate})
+	if err = b.StartQuestions(); err != nil {
+		return "", err
+	}
+	// The zone section has the same format as the question section
+	if err = b.Question(dnsmessage.Question{Name: zone, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET}); err != nil {
+		return "", err
+	}
+	if err = b.StartAuthorities(); err != nil {
+		return "", err
+	}
+	if err = addUpdates(&b); err != nil {
+		return "", errors.Wrap(err, "failed to build the DNS update")
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build the DNS update")
+	}
+	header, err := p.exchange(msg, func(*dnsmessage.Parser, dnsmessage.ResourceHeader) error { return nil })
+	if err != nil {
+		return "", err
+	}
+	return header.RCode.String(), nil
+}
+
+// query sends a query and calls the function for each answer, which must consume or skip it
+func (p rfc2136Provider) query(recordSetName string, recordType dnsmessage.Type, onAnswer func(*dnsmessage.Parser, dnsmessage.ResourceHeader) error) error {
+	name, err := dnsmessage.NewName(fqdn(recordSetName))
+	if err != nil {
+		return err
+	}
+	id, err := messageID()
+	if err != nil {
+		return err
+	}
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id})
+	if err = b.StartQuestions(); err != nil {
+		return err
+	}
+	if err = b.Question(dnsmessage.Question{Name: name, Type: recordType, Class: dnsmessage.ClassINET}); err != nil {
+		return err
+	}
+	msg, err := b.Finish()
+	if err != nil {
+		return err
+	}
+	header, err := p.exchange(msg, onAnswer)
+	if err != nil && header.RCode == dnsmessage.RCodeNameError {
+		// The name doesn't exist, it has no records
+		return nil
+	}
+	return err
+}
+
+// exchange signs and sends the message over TCP and returns the header of the response, which is an error when
+// the server didn't succeed
+func (p rfc2136Provider) exchange(msg []byte, onAnswer func(*dnsmessage.Parser, dnsmessage.ResourceHeader) error) (dnsmessage.Header, error) {
+	msg, err := p.sign(msg)
+	if err != nil {
+		return dnsmessage.Header{}, err
+	}
+	conn, err := net.DialTimeout("tcp", p.Server, p.Timeout)
+	if err != nil {
+		return dnsmessage.Header{}, errors.Wrapf(err, "failed to connect to DNS server %s", p.Server)
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(p.Timeout)); err != nil {
+		return dnsmessage.Header{}, err
+	}
+	// Messages over TCP are prefixed with their length
+	if _, err = conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(msg))), msg...)); err != nil {
+		return dnsmessage.Header{}, errors.Wrapf(err, "failed to send the message to DNS server %s", p.Server)
+	}
+	var length [2]byte
+	if _, err = io.ReadFull(conn, length[:]); err != nil {
+		return dnsmessage.Header{}, errors.Wrapf(err, "failed to read the response of DNS server %s", p.Server)
+	}
+	response := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err = io.ReadFull(conn, response); err != nil {
+		return dnsmessage.Header{}, errors.Wrapf(err, "failed to read the response of DNS server %s", p.Server)
+	}
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(response)
+	if err != nil {
+		return dnsmessage.Header{}, errors.Wrapf(err, "invalid response of DNS server %s", p.Server)
+	}
+	if header.ID != binary.BigEndian.Uint16(msg) {
+		return header, errors.Errorf("DNS server %s responded to another message", p.Server)
+	}
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return header, errors.Errorf("DNS server %s responded with %s", p.Server, header.RCode)
+	}
+	if err = parser.SkipAllQuestions(); err != nil {
+		return header, errors.Wrapf(err, "invalid response of DNS server %s", p.Server)
+	}
+	for {
+		answer, err := parser.AnswerHeader()
+		if err == dnsmessage.ErrSectionDone {
+			return header, nil
+		}
+		if err != nil {
+			return header, errors.Wrapf(err, "invalid response of DNS server %s", p.Server)
+		}
+		if err = onAnswer(&parser, answer); err != nil {
+			return header, errors.Wrapf(err, "invalid response of DNS server %s", p.Server)
+		}
+	}
+}
+
+// sign appends the TSIG record of the message when a key is configured
+func (p rfc2136Provider) sign(msg []byte) ([]byte, error) {
+	if p.TSIGKeyName == "" {
+		return msg, nil
+	}
+	algorithm := strings.ToLower(strings.TrimSuffix(p.TSIGAlgorithm, "."))
+	newHash, ok := tsigAlgorithms[algorithm]
+	if !ok {
+		return nil, errors.Errorf("unsupported TSIG algorithm %s", p.TSIGAlgorithm)
+	}
+	secret, err := base64.StdEncoding.DecodeString(p.TSIGSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "the TSIG secret isn't valid base64")
+	}
+	keyName, err := wireName(p.TSIGKeyName)
+	if err != nil {
+		return nil, err
+	}
+	algorithmName, err := wireName(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now
+	if p.now != nil {
+		now = p.now
+	}
+	signed := uint64(now().Unix())
+	timers := append(binary.BigEndian.AppendUint16(nil, uint16(signed>>32)), binary.BigEndian.AppendUint32(nil, uint32(signed))...)
+	timers = binary.BigEndian.AppendUint16(timers, tsigFudge)
+
+	// The MAC covers the message and the TSIG variables: the key name, the class, the TTL, the algorithm, the
+	// timers, the error and the other data
+	mac := hmac.New(newHash, secret)
+	mac.Write(msg)
+	mac.Write(keyName)
+	mac.Write(binary.BigEndian.AppendUint16(nil, uint16(dnsmessage.ClassANY)))
+	mac.Write(binary.BigEndian.AppendUint32(nil, 0))
+	mac.Write(algorithmName)
+	mac.Write(timers)
+	mac.Write(binary.BigEndian.AppendUint32(nil, 0))
+	sum := mac.Sum(nil)
+
+	rdata := append([]byte{}, algorithmName...)
+	rdata = append(rdata, timers...)
+	rdata = binary.BigEndian.AppendUint16(rdata, uint16(len(sum)))
+	rdata = append(rdata, sum...)
+	rdata = append(rdata, msg[0:2]...) // original ID
+	rdata = binary.BigEndian.AppendUint32(rdata, 0)
+
+	signedMsg := append(append([]byte{}, msg...), keyName...)
+	signedMsg = binary.BigEndian.AppendUint16(signedMsg, uint16(typeTSIG))
+	signedMsg = binary.BigEndian.AppendUint16(signedMsg, uint16(dnsmessage.ClassANY))
+	signedMsg = binary.BigEndian.AppendUint32(signedMsg, 0)
+	signedMsg = binary.BigEndian.AppendUint16(signedMsg, uint16(len(rdata)))
+	signedMsg = append(signedMsg, rdata...)
+	// The TSIG record is the last one of the additional section
+	binary.BigEndian.PutUint16(signedMsg[10:], binary.BigEndian.Uint16(msg[10:])+1)
+	return signedMsg, nil
+}
+
+// wireName returns the canonical, uncompressed wire format of the name
+func wireName(name string) ([]byte, error) {
+	var wire []byte
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(name), "."), ".") {
+		if label == "" || len(label) > dnsDomainLabelLen {
+			return nil, errors.Errorf("invalid DNS name %s", name)
+		}
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, label...)
+	}
+	return append(wire, 0), nil
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func messageID() (uint16, error) {
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(id[:]), nil
+}
@@ -0,0 +1,253 @@
+package dns
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/danielerez/go-dns-client/pkg/dnsproviders"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// fakeDNSServer answers the DNS messages that it receives over TCP with the response of the function
+type fakeDNSServer struct {
+	listener net.Listener
+	mutex    sync.Mutex
+	requests []dnsmessage.Message
+	respond  func(request dnsmessage.Message) dnsmessage.Message
+}
+
+func newFakeDNSServer(respond func(request dnsmessage.Message) dnsmessage.Message) *fakeDNSServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	s := &fakeDNSServer{listener: listener, respond: respond}
+	go s.serve()
+	return s
+}
+
+func (s *fakeDNSServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.handle(conn)
+	}
+}
+
+func (s *fakeDNSServer) handle(conn net.Conn) {
+	defer conn.Close()
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return
+	}
+	data := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, data); err != nil {
+		return
+	}
+	request, err := unpackRequest(data)
+	if err != nil {
+		return
+	}
+	s.mutex.Lock()
+	s.requests = append(s.requests, request)
+	s.mutex.Unlock()
+	response := s.respond(request)
+	response.Header.ID = request.Header.ID
+	response.Header.Response = true
+	response.Questions = request.Questions
+	packed, err := response.Pack()
+	if err != nil {
+		return
+	}
+	_, _ = conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(packed))), packed...))
+}
+
+// unpackRequest unpacks a query or an update, the updates that delete RRsets have records without data that
+// Message.Unpack rejects
+func unpackRequest(data []byte) (dnsmessage.Message, error) {
+	var p dnsmessage.Parser
+	var m dnsmessage.Message
+	var err error
+	if m.Header, err = p.Start(data); err != nil {
+		return m, err
+	}
+	if m.Questions, err = p.AllQuestions(); err != nil {
+		return m, err
+	}
+	if m.Answers, err = p.AllAnswers(); err != nil {
+		return m, err
+	}
+	for {
+		header, err := p.AuthorityHeader()
+		if err == dnsmessage.ErrSectionDone {
+			break
+		}
+		if err != nil {
+			return m, err
+		}
+		if header.Length == 0 {
+			r, err := p.UnknownResource()
+			if err != nil {
+				return m, err
+			}
+			m.Authorities = append(m.Authorities, dnsmessage.Resource{Header: header, Body: &r})
+			continue
+		}
+		r, err := p.Authority()
+		if err != nil {
+			return m, err
+		}
+		m.Authorities = append(m.Authorities, r)
+	}
+	m.Additionals, err = p.AllAdditionals()
+	return m, err
+}
+
+func (s *fakeDNSServer) received() []dnsmessage.Message {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests
+}
+
+var _ = Describe("RFC2136 DNS provider", func() {
+	var (
+		server   *fakeDNSServer
+		provider rfc2136Provider
+		answers  []dnsmessage.Resource
+		rcode    dnsmessage.RCode
+	)
+
+	BeforeEach(func() {
+		answers = nil
+		rcode = dnsmessage.RCodeSuccess
+		server = newFakeDNSServer(func(dnsmessage.Message) dnsmessage.Message {
+			return dnsmessage.Message{Header: dnsmessage.Header{RCode: rcode}, Answers: answers}
+		})
+		provider = rfc2136Provider{
+			RecordSet:     dnsproviders.RecordSet{RecordSetType: "A", TTL: 60},
+			Server:        server.listener.Addr().String(),
+			Zone:          "example.com",
+			TSIGKeyName:   "assisted-key",
+			TSIGSecret:    "YXNzaXN0ZWQtc2VydmljZS10c2lnLXNlY3JldA==",
+			TSIGAlgorithm: "hmac-sha256",
+			Timeout:       5 * time.Second,
+		}
+	})
+
+	AfterEach(func() {
+		server.listener.Close()
+	})
+
+	name := func(s string) dnsmessage.Name {
+		return dnsmessage.MustNewName(s)
+	}
+
+	It("signs the messages with TSIG", func() {
+		provider.now = func() time.Time { return time.Unix(1700000000, 0) }
+		msg, err := hex.DecodeString("123428000001000000000000076578616d706c6503636f6d0000060001")
+		Expect(err).ToNot(HaveOccurred())
+		signed, err := provider.sign(msg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hex.EncodeToString(signed)).To(Equal("123428000001000000000001076578616d706c6503636f6d00000600010c61737369737465642d6b65790000fa00ff00000000003d0b686d61632d7368613235360000006553f100012c002045d62b6f710c46b825a04d53803414804717ad1e9d29bd2ae92975569dfe266e123400000000"))
+	})
+
+	It("rejects an unknown TSIG algorithm", func() {
+		provider.TSIGAlgorithm = "hmac-md5"
+		_, err := provider.CreateRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).To(MatchError(ContainSubstring("unsupported TSIG algorithm")))
+		Expect(server.received()).To(BeEmpty())
+	})
+
+	It("adds a record", func() {
+		_, err := provider.CreateRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		requests := server.received()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Header.OpCode).To(Equal(opCodeUpdate))
+		Expect(requests[0].Questions).To(Equal([]dnsmessage.Question{{Name: name("example.com."), Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET}}))
+		Expect(requests[0].Authorities).To(HaveLen(1))
+		Expect(requests[0].Authorities[0].Header.Name).To(Equal(name("api.test.example.com.")))
+		Expect(requests[0].Authorities[0].Header.Class).To(Equal(dnsmessage.ClassINET))
+		Expect(requests[0].Authorities[0].Header.TTL).To(BeEquivalentTo(60))
+		Expect(requests[0].Authorities[0].Body).To(Equal(&dnsmessage.AResource{A: [4]byte{192, 168, 126, 5}}))
+		Expect(requests[0].Additionals).To(HaveLen(1))
+		Expect(requests[0].Additionals[0].Header.Name).To(Equal(name("assisted-key.")))
+		Expect(requests[0].Additionals[0].Header.Type).To(Equal(typeTSIG))
+	})
+
+	It("replaces the records of a name", func() {
+		provider.RecordSet.RecordSetType = "AAAA"
+		provider.TSIGKeyName = ""
+		_, err := provider.UpdateRecordSet("*.apps.test.example.com", "2001:db8::6")
+		Expect(err).ToNot(HaveOccurred())
+		requests := server.received()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Additionals).To(BeEmpty())
+		Expect(requests[0].Authorities).To(HaveLen(2))
+		Expect(requests[0].Authorities[0].Header.Class).To(Equal(dnsmessage.ClassANY))
+		Expect(requests[0].Authorities[0].Header.Type).To(Equal(dnsmessage.TypeAAAA))
+		Expect(requests[0].Authorities[1].Header.Name).To(Equal(name("*.apps.test.example.com.")))
+		Expect(requests[0].Authorities[1].Body).To(Equal(&dnsmessage.AAAAResource{AAAA: [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 6}}))
+	})
+
+	It("deletes a record", func() {
+		_, err := provider.DeleteRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).ToNot(HaveOccurred())
+		requests := server.received()
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Authorities).To(HaveLen(1))
+		Expect(requests[0].Authorities[0].Header.Class).To(Equal(classNone))
+		Expect(requests[0].Authorities[0].Header.TTL).To(BeZero())
+	})
+
+	It("fails when the server refuses the update", func() {
+		rcode = dnsmessage.RCodeRefused
+		_, err := provider.CreateRecordSet("api.test.example.com", "192.168.126.5")
+		Expect(err).To(MatchError(ContainSubstring("RCodeRefused")))
+	})
+
+	It("rejects an address of the other family", func() {
+		_, err := provider.CreateRecordSet("api.test.example.com", "2001:db8::5")
+		Expect(err).To(HaveOccurred())
+		Expect(server.received()).To(BeEmpty())
+	})
+
+	It("gets the addresses of a name", func() {
+		answers = []dnsmessage.Resource{
+			{
+				Header: dnsmessage.ResourceHeader{Name: name("api.test.example.com."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET},
+				Body:   &dnsmessage.AResource{A: [4]byte{192, 168, 126, 5}},
+			},
+			{
+				Header: dnsmessage.ResourceHeader{Name: name("api.test.example.com."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET},
+				Body:   &dnsmessage.AResource{A: [4]byte{192, 168, 126, 7}},
+			},
+		}
+		Expect(provider.GetRecordSet("api.test.example.com")).To(Equal("192.168.126.5,192.168.126.7"))
+		Expect(server.received()[0].Questions[0].Type).To(Equal(dnsmessage.TypeA))
+	})
+
+	It("gets no address of a name that doesn't exist", func() {
+		rcode = dnsmessage.RCodeNameError
+		Expect(provider.GetRecordSet("api.test.example.com")).To(BeEmpty())
+	})
+
+	It("gets the zone that the server is authoritative for", func() {
+		answers = []dnsmessage.Resource{{
+			Header: dnsmessage.ResourceHeader{Name: name("example.com."), Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET},
+			Body:   &dnsmessage.SOAResource{NS: name("ns.example.com."), MBox: name("admin.example.com.")},
+		}}
+		Expect(provider.GetDomainName()).To(Equal("example.com"))
+	})
+
+	It("fails to get a zone that the server isn't authoritative for", func() {
+		_, err := provider.GetDomainName()
+		Expect(err).To(MatchError(ContainSubstring("isn't authoritative")))
+	})
+})
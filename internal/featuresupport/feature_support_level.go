@@ -44,12 +44,13 @@ var featuresList = map[models.FeatureSupportLevelID]SupportLevelFeature{
 	models.FeatureSupportLevelIDOPENSHIFTAI:          (&OpenShiftAIFeature{}).New(),
 
 	// Platform features
-	models.FeatureSupportLevelIDNUTANIXINTEGRATION:  (&NutanixIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDVSPHEREINTEGRATION:  (&VsphereIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDEXTERNALPLATFORMOCI: (&OciIntegrationFeature{}).New(),
-	models.FeatureSupportLevelIDBAREMETALPLATFORM:   (&BaremetalPlatformFeature{}).New(),
-	models.FeatureSupportLevelIDNONEPLATFORM:        (&NonePlatformFeature{}).New(),
-	models.FeatureSupportLevelIDEXTERNALPLATFORM:    (&ExternalPlatformFeature{}).New(),
+	models.FeatureSupportLevelIDNUTANIXINTEGRATION:   (&NutanixIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDVSPHEREINTEGRATION:   (&VsphereIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDOPENSTACKINTEGRATION: (&OpenstackIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORMOCI:  (&OciIntegrationFeature{}).New(),
+	models.FeatureSupportLevelIDBAREMETALPLATFORM:    (&BaremetalPlatformFeature{}).New(),
+	models.FeatureSupportLevelIDNONEPLATFORM:         (&NonePlatformFeature{}).New(),
+	models.FeatureSupportLevelIDEXTERNALPLATFORM:     (&ExternalPlatformFeature{}).New(),
 }
 
 func GetFeatureByID(featureID models.FeatureSupportLevelID) SupportLevelFeature {
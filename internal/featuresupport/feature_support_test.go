@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/platformplugin"
 	"github.com/sirupsen/logrus"
 )
 
@@ -17,6 +18,7 @@ func getPlatformFilters() []SupportLevelFilters {
 	return []SupportLevelFilters{
 		{PlatformType: models.PlatformTypeVsphere.Pointer()},
 		{PlatformType: models.PlatformTypeNutanix.Pointer()},
+		{PlatformType: models.PlatformTypeOpenstack.Pointer()},
 		{PlatformType: models.PlatformTypeBaremetal.Pointer()},
 		{PlatformType: models.PlatformTypeNone.Pointer()},
 		{PlatformType: models.PlatformTypeExternal.Pointer()},
@@ -227,6 +229,12 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 				false,
 			),
 
+			Entry(
+				"openstack platform",
+				[]SupportLevelFeature{&OpenstackIntegrationFeature{}},
+				false,
+			),
+
 			Entry(
 				"none platform",
 				[]SupportLevelFeature{&NonePlatformFeature{}},
@@ -368,6 +376,67 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 		)
 	})
 
+	Context("Test platform plugin support", func() {
+		var featureID models.FeatureSupportLevelID
+
+		BeforeEach(func() {
+			RegisterExternalPlatformPlugin(&platformplugin.PlatformInfo{
+				PlatformName:         "example-cloud",
+				MinOpenshiftVersion:  "4.15",
+				SupportLevel:         models.SupportLevelTechPreview,
+				CPUArchitectures:     []string{models.ClusterCPUArchitectureX8664},
+				IncompatibleFeatures: []models.FeatureSupportLevelID{models.FeatureSupportLevelIDLVM},
+			})
+			featureID = ExternalPlatformPluginFeatureID("example-cloud")
+		})
+
+		AfterEach(func() {
+			delete(featuresList, featureID)
+		})
+
+		It("derives the feature ID from the platform name", func() {
+			Expect(featureID).To(BeEquivalentTo("EXTERNAL_PLATFORM_EXAMPLE_CLOUD"))
+			Expect(GetFeatureByID(featureID).GetName()).To(Equal("example-cloud external platform"))
+		})
+
+		DescribeTable(
+			"Validation pass",
+			func(openshiftVersion, cpuArchitecture string, expectedSupportLevel models.SupportLevel) {
+				filters := SupportLevelFilters{
+					OpenshiftVersion: openshiftVersion,
+					CPUArchitecture:  swag.String(cpuArchitecture),
+				}
+				supportLevel := GetSupportLevel(featureID, filters)
+				Expect(supportLevel).To(Equal(expectedSupportLevel))
+			},
+			Entry("unavailable with Openshift 4.14", "4.14", models.ClusterCPUArchitectureX8664, models.SupportLevelUnavailable),
+			Entry("tech-preview with Openshift 4.15", "4.15", models.ClusterCPUArchitectureX8664, models.SupportLevelTechPreview),
+			Entry("unavailable on arm64", "4.15", models.ClusterCPUArchitectureArm64, models.SupportLevelUnavailable),
+		)
+
+		It("is listed with the features", func() {
+			list := GetFeatureSupportList("4.16", nil, nil, nil)
+			Expect(list).To(HaveKeyWithValue(string(featureID), models.SupportLevelTechPreview))
+		})
+
+		It("is active on the clusters of the platform", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				Platform: &models.Platform{
+					Type:     common.PlatformTypePtr(models.PlatformTypeExternal),
+					External: &models.PlatformExternal{PlatformName: swag.String("example-cloud")},
+				},
+			}}
+			Expect(GetFeatureByID(featureID).getFeatureActiveLevel(cluster, nil, nil, nil)).To(Equal(activeLevelActive))
+			cluster.Platform.External.PlatformName = swag.String("other")
+			Expect(GetFeatureByID(featureID).getFeatureActiveLevel(cluster, nil, nil, nil)).To(Equal(activeLevelNotActive))
+		})
+
+		It("is incompatible with the features declared by the plugin", func() {
+			Expect(*GetFeatureByID(featureID).getIncompatibleFeatures("4.16")).To(ContainElements(
+				models.FeatureSupportLevelIDLVM, models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING))
+		})
+	})
+
 	Context("GetSupportList", func() {
 
 		for _, filters := range getPlatformFilters() {
@@ -382,12 +451,12 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 
 		It("GetFeatureSupportList 4.12", func() {
 			list := GetFeatureSupportList("4.12", nil, nil, nil)
-			Expect(len(list)).To(Equal(32))
+			Expect(len(list)).To(Equal(33))
 		})
 
 		It("GetFeatureSupportList 4.13", func() {
 			list := GetFeatureSupportList("4.13", nil, nil, nil)
-			Expect(len(list)).To(Equal(32))
+			Expect(len(list)).To(Equal(33))
 		})
 
 		It("GetCpuArchitectureSupportList 4.12", func() {
@@ -623,6 +692,42 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 			err = ValidateIncompatibleFeatures(log, models.ClusterCPUArchitectureX8664, &cluster, nil, nil)
 			Expect(err).To(HaveOccurred())
 		})
+		It("OpenStack with incompatible features - fail", func() {
+			cluster := common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion: "4.15",
+				CPUArchitecture:  models.ClusterCPUArchitectureX8664,
+				Platform:         &models.Platform{Type: common.PlatformTypePtr(models.PlatformTypeOpenstack)},
+				MonitoredOperators: []*models.MonitoredOperator{
+					{
+						Name:             "cnv",
+						Namespace:        "openshift-cnv",
+						OperatorType:     models.OperatorTypeOlm,
+						SubscriptionName: "hco-operatorhub",
+						TimeoutSeconds:   60 * 60,
+					},
+				},
+			}}
+			err := ValidateIncompatibleFeatures(log, models.ClusterCPUArchitectureX8664, &cluster, nil, nil)
+			Expect(err).To(HaveOccurred())
+
+			cluster.MonitoredOperators = nil
+			Expect(ValidateIncompatibleFeatures(log, models.ClusterCPUArchitectureX8664, &cluster, nil, nil)).To(Succeed())
+
+			cluster.CPUArchitecture = models.ClusterCPUArchitectureS390x
+			Expect(ValidateIncompatibleFeatures(log, models.ClusterCPUArchitectureS390x, &cluster, nil, nil)).ToNot(Succeed())
+		})
+		It("OpenStack support level depends on the OpenShift version", func() {
+			feature := GetFeatureByID(models.FeatureSupportLevelIDOPENSTACKINTEGRATION)
+			filters := SupportLevelFilters{CPUArchitecture: swag.String(models.ClusterCPUArchitectureX8664)}
+			for version, expected := range map[string]models.SupportLevel{
+				"4.13": models.SupportLevelUnavailable,
+				"4.14": models.SupportLevelTechPreview,
+				"4.15": models.SupportLevelSupported,
+			} {
+				filters.OpenshiftVersion = version
+				Expect(feature.getSupportLevel(filters)).To(Equal(expected), version)
+			}
+		})
 		It("VSphere with incompatible features - fail", func() {
 			operatorsCNV := []*models.MonitoredOperator{
 				{
@@ -329,6 +329,7 @@ func (feature *PlatformManagedNetworkingFeature) getIncompatibleFeatures(string)
 		models.FeatureSupportLevelIDBAREMETALPLATFORM,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 	}
 }
 
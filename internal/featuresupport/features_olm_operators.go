@@ -1,6 +1,8 @@
 package featuresupport
 
 import (
+	"fmt"
+
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
@@ -37,6 +39,54 @@ func isOperatorActivated(operator string, cluster *common.Cluster, updateParams
 	return (operatorActivated && (updateParams == nil || updateParams.OlmOperators == nil)) || operatorActivated && operatorUpdated || operatorUpdated
 }
 
+var platformFeatureIdMap = map[models.PlatformType]models.FeatureSupportLevelID{
+	models.PlatformTypeBaremetal: models.FeatureSupportLevelIDBAREMETALPLATFORM,
+	models.PlatformTypeNone:      models.FeatureSupportLevelIDNONEPLATFORM,
+	models.PlatformTypeNutanix:   models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+	models.PlatformTypeVsphere:   models.FeatureSupportLevelIDVSPHEREINTEGRATION,
+	models.PlatformTypeExternal:  models.FeatureSupportLevelIDEXTERNALPLATFORM,
+	models.PlatformTypeOpenstack: models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
+}
+
+// ValidateOperatorFeatures verifies up front that the features of a set of operators, such as a bundle, are available
+// on the OpenShift version, CPU architecture and platform, and that they are compatible with each other and with the
+// platform. The platform type is optional.
+func ValidateOperatorFeatures(featureIds []models.FeatureSupportLevelID, openshiftVersion, cpuArchitecture string, platformType *models.PlatformType) error {
+	if cpuArchitecture == "" {
+		cpuArchitecture = common.DefaultCPUArchitecture
+	}
+	filters := SupportLevelFilters{
+		OpenshiftVersion: openshiftVersion,
+		CPUArchitecture:  swag.String(cpuArchitecture),
+		PlatformType:     platformType,
+	}
+
+	var features []SupportLevelFeature
+	for _, featureId := range featureIds {
+		feature, ok := featuresList[featureId]
+		if !ok {
+			continue
+		}
+		if !isFeatureCompatibleWithArchitecture(feature, openshiftVersion, cpuArchitecture) ||
+			feature.getSupportLevel(filters) == models.SupportLevelUnavailable {
+			message := fmt.Sprintf("cannot use %s because it's not available on version %s of OpenShift with the %s architecture",
+				feature.GetName(), openshiftVersion, cpuArchitecture)
+			if platformType != nil {
+				message = fmt.Sprintf("%s and the %s platform", message, *platformType)
+			}
+			return fmt.Errorf("%s", message)
+		}
+		features = append(features, feature)
+	}
+
+	if platformType != nil {
+		if platformFeature, ok := featuresList[platformFeatureIdMap[*platformType]]; ok {
+			features = append(features, platformFeature)
+		}
+	}
+	return isFeaturesCompatibleWithFeatures(openshiftVersion, features)
+}
+
 // LvmFeature
 type LvmFeature struct{}
 
@@ -61,7 +111,7 @@ func (feature *LvmFeature) getSupportLevel(filters SupportLevelFilters) models.S
 		return models.SupportLevelUnavailable
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable
 	}
 
@@ -82,6 +132,7 @@ func (feature *LvmFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *mod
 func (feature *LvmFeature) getIncompatibleFeatures(OCPVersion string) *[]models.FeatureSupportLevelID {
 	incompatibleFeatures := []models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 		models.FeatureSupportLevelIDODF,
 	}
@@ -165,7 +216,7 @@ func (feature *CnvFeature) getSupportLevel(filters SupportLevelFilters) models.S
 		return models.SupportLevelUnavailable
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable
 	}
 
@@ -179,6 +230,7 @@ func (feature *CnvFeature) getSupportLevel(filters SupportLevelFilters) models.S
 func (feature *CnvFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 	}
 }
@@ -311,7 +363,7 @@ func (feature *MtvFeature) getSupportLevel(filters SupportLevelFilters) models.S
 		return models.SupportLevelUnavailable
 	}
 
-	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix) {
+	if filters.PlatformType != nil && (*filters.PlatformType == models.PlatformTypeVsphere || *filters.PlatformType == models.PlatformTypeNutanix || *filters.PlatformType == models.PlatformTypeOpenstack) {
 		return models.SupportLevelUnavailable
 	}
 
@@ -334,6 +386,7 @@ func (feature *MtvFeature) getIncompatibleArchitectures(_ *string) *[]models.Arc
 func (feature *MtvFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
 	return &[]models.FeatureSupportLevelID{
 		models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+		models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 		models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 	}
 }
@@ -112,6 +112,7 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 
 			incompatibleFeatures["4.11"] = &[]models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 				models.FeatureSupportLevelIDVIPAUTOALLOC,
@@ -120,6 +121,7 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 
 			incompatibleFeatures["4.12"] = &[]models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 				models.FeatureSupportLevelIDVIPAUTOALLOC,
@@ -128,11 +130,13 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 
 			incompatibleFeatures["4.15"] = &[]models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 			}
 			incompatibleFeatures["4.16.0-rc0"] = &[]models.FeatureSupportLevelID{
 				models.FeatureSupportLevelIDNUTANIXINTEGRATION,
+				models.FeatureSupportLevelIDOPENSTACKINTEGRATION,
 				models.FeatureSupportLevelIDVSPHEREINTEGRATION,
 				models.FeatureSupportLevelIDODF,
 			}
@@ -270,3 +274,23 @@ var _ = Describe("V2ListFeatureSupportLevels API", func() {
 		)
 	})
 })
+
+var _ = Describe("ValidateOperatorFeatures", func() {
+	virtualization := []models.FeatureSupportLevelID{models.FeatureSupportLevelIDCNV, models.FeatureSupportLevelIDMTV}
+
+	It("accepts operators that are available together", func() {
+		Expect(ValidateOperatorFeatures(virtualization, "4.16", models.ClusterCPUArchitectureX8664, nil)).To(Succeed())
+		Expect(ValidateOperatorFeatures(virtualization, "4.16", "", models.PlatformTypeBaremetal.Pointer())).To(Succeed())
+	})
+
+	It("rejects operators that aren't available on the CPU architecture", func() {
+		err := ValidateOperatorFeatures(virtualization, "4.16", models.ClusterCPUArchitectureS390x, nil)
+		Expect(err).To(MatchError(ContainSubstring("cannot use OpenShift Virtualization because it's not available on version 4.16 of OpenShift with the s390x architecture")))
+	})
+
+	It("rejects operators that aren't available on the platform", func() {
+		platformType := models.PlatformTypeNutanix
+		err := ValidateOperatorFeatures(virtualization, "4.16", models.ClusterCPUArchitectureX8664, &platformType)
+		Expect(err).To(MatchError(ContainSubstring("and the nutanix platform")))
+	})
+})
@@ -1,9 +1,14 @@
 package featuresupport
 
 import (
+	"strings"
+	"unicode"
+
 	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/platformplugin"
+	"github.com/thoas/go-funk"
 )
 
 // isPlatformActive return true if the cluster Platform is set with the given platform or not
@@ -196,6 +201,66 @@ func (feature *NutanixIntegrationFeature) getIncompatibleArchitectures(_ *string
 	}
 }
 
+// OpenstackIntegrationFeature
+type OpenstackIntegrationFeature struct{}
+
+func (feature *OpenstackIntegrationFeature) New() SupportLevelFeature {
+	return &OpenstackIntegrationFeature{}
+}
+
+func (feature *OpenstackIntegrationFeature) getId() models.FeatureSupportLevelID {
+	return models.FeatureSupportLevelIDOPENSTACKINTEGRATION
+}
+
+func (feature *OpenstackIntegrationFeature) GetName() string {
+	return "OpenStack Platform Integration"
+}
+
+func (feature *OpenstackIntegrationFeature) getSupportLevel(filters SupportLevelFilters) models.SupportLevel {
+	if isPlatformSet(filters) {
+		return ""
+	}
+
+	if !isFeatureCompatibleWithArchitecture(feature, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable
+	}
+
+	if isNotSupported, err := common.BaseVersionLessThan("4.14", filters.OpenshiftVersion); isNotSupported || err != nil {
+		return models.SupportLevelUnavailable
+	}
+
+	if isEqual, _ := common.BaseVersionEqual("4.14", filters.OpenshiftVersion); isEqual {
+		return models.SupportLevelTechPreview
+	}
+	return models.SupportLevelSupported
+}
+
+func (feature *OpenstackIntegrationFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isPlatformActive(cluster, clusterUpdateParams, models.PlatformTypeOpenstack) {
+		return activeLevelActive
+	}
+
+	return activeLevelNotActive
+}
+
+func (feature *OpenstackIntegrationFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
+	return &[]models.FeatureSupportLevelID{
+		models.FeatureSupportLevelIDSNO,
+		models.FeatureSupportLevelIDLVM,
+		models.FeatureSupportLevelIDCNV,
+		models.FeatureSupportLevelIDPLATFORMMANAGEDNETWORKING,
+		models.FeatureSupportLevelIDMTV,
+		models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE,
+	}
+}
+
+func (feature *OpenstackIntegrationFeature) getIncompatibleArchitectures(_ *string) *[]models.ArchitectureSupportLevelID {
+	return &[]models.ArchitectureSupportLevelID{
+		models.ArchitectureSupportLevelIDS390XARCHITECTURE,
+		models.ArchitectureSupportLevelIDPPC64LEARCHITECTURE,
+	}
+}
+
 // VsphereIntegrationFeature
 type VsphereIntegrationFeature struct{}
 
@@ -357,3 +422,98 @@ func (feature *ExternalPlatformFeature) getFeatureActiveLevel(cluster *common.Cl
 
 	return activeLevelNotActive
 }
+
+// ExternalPlatformPluginFeature is the feature of an external platform provided by a platform plugin
+type ExternalPlatformPluginFeature struct {
+	platform *platformplugin.PlatformInfo
+}
+
+func (feature *ExternalPlatformPluginFeature) New() SupportLevelFeature {
+	return &ExternalPlatformPluginFeature{platform: feature.platform}
+}
+
+func (feature *ExternalPlatformPluginFeature) getId() models.FeatureSupportLevelID {
+	return ExternalPlatformPluginFeatureID(feature.platform.PlatformName)
+}
+
+func (feature *ExternalPlatformPluginFeature) GetName() string {
+	if feature.platform.DisplayName != "" {
+		return feature.platform.DisplayName
+	}
+	return feature.platform.PlatformName + " external platform"
+}
+
+func (feature *ExternalPlatformPluginFeature) getSupportLevel(filters SupportLevelFilters) models.SupportLevel {
+	if isPlatformSet(filters) {
+		return ""
+	}
+
+	if !isFeatureCompatibleWithArchitecture(feature, filters.OpenshiftVersion, swag.StringValue(filters.CPUArchitecture)) {
+		return models.SupportLevelUnavailable
+	}
+
+	if feature.platform.MinOpenshiftVersion != "" {
+		if isNotSupported, err := common.BaseVersionLessThan(feature.platform.MinOpenshiftVersion, filters.OpenshiftVersion); isNotSupported || err != nil {
+			return models.SupportLevelUnavailable
+		}
+	}
+
+	if feature.platform.SupportLevel != "" {
+		return feature.platform.SupportLevel
+	}
+	return models.SupportLevelSupported
+}
+
+func (feature *ExternalPlatformPluginFeature) getIncompatibleFeatures(string) *[]models.FeatureSupportLevelID {
+	incompatibleFeatures := []models.FeatureSupportLevelID{
+		models.FeatureSupportLevelIDCLUSTERMANAGEDNETWORKING,
+		models.FeatureSupportLevelIDVIPAUTOALLOC,
+		models.FeatureSupportLevelIDNONSTANDARDHACONTROLPLANE,
+	}
+	for _, featureID := range feature.platform.IncompatibleFeatures {
+		if !funk.Contains(incompatibleFeatures, featureID) {
+			incompatibleFeatures = append(incompatibleFeatures, featureID)
+		}
+	}
+	return &incompatibleFeatures
+}
+
+func (feature *ExternalPlatformPluginFeature) getIncompatibleArchitectures(_ *string) *[]models.ArchitectureSupportLevelID {
+	if len(feature.platform.CPUArchitectures) == 0 {
+		return nil
+	}
+	var incompatibleArchitectures []models.ArchitectureSupportLevelID
+	for cpuArchitecture, architectureID := range cpuArchitectureFeatureIdMap {
+		if !funk.ContainsString(feature.platform.CPUArchitectures, cpuArchitecture) {
+			incompatibleArchitectures = append(incompatibleArchitectures, architectureID)
+		}
+	}
+	return &incompatibleArchitectures
+}
+
+func (feature *ExternalPlatformPluginFeature) getFeatureActiveLevel(cluster *common.Cluster, _ *models.InfraEnv, clusterUpdateParams *models.V2ClusterUpdateParams, _ *models.InfraEnvUpdateParams) featureActiveLevel {
+	if isPlatformActive(cluster, clusterUpdateParams, models.PlatformTypeExternal) && isExternalIntegrationActive(cluster, clusterUpdateParams, feature.platform.PlatformName) {
+		return activeLevelActive
+	}
+
+	return activeLevelNotActive
+}
+
+// ExternalPlatformPluginFeatureID returns the ID of the feature of the external platform of a platform plugin, for
+// example EXTERNAL_PLATFORM_EXAMPLE_CLOUD for the example-cloud platform
+func ExternalPlatformPluginFeatureID(platformName string) models.FeatureSupportLevelID {
+	id := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, platformName)
+	return models.FeatureSupportLevelID("EXTERNAL_PLATFORM_" + id)
+}
+
+// RegisterExternalPlatformPlugin adds the feature of the external platform of a platform plugin to the features of
+// the service. It must be called when the service starts, before the features are used.
+func RegisterExternalPlatformPlugin(platform *platformplugin.PlatformInfo) {
+	feature := &ExternalPlatformPluginFeature{platform: platform}
+	featuresList[feature.getId()] = feature
+}
@@ -1,6 +1,8 @@
 package gencrypto
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net/url"
 	"os"
 	"time"
@@ -48,6 +50,32 @@ func LocalJWTForKey(id string, private_key_pem string, keyType LocalJWTKeyType)
 	return tokenString, nil
 }
 
+// DigestJWT returns a token signed with the local EC private key that holds the hex encoded
+// SHA-256 digest of the given payload in its "sha256" claim. It is used to make documents
+// produced by the service verifiable without storing the signature separately from them.
+func DigestJWT(payload []byte) (string, error) {
+	key, ok := os.LookupEnv("EC_PRIVATE_KEY_PEM")
+	if !ok || key == "" {
+		return "", errors.Errorf("EC_PRIVATE_KEY_PEM not found")
+	}
+	return DigestJWTForKey(payload, key)
+}
+
+func DigestJWTForKey(payload []byte, private_key_pem string) (string, error) {
+	priv, err := jwt.ParseECPrivateKeyFromPEM([]byte(private_key_pem))
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(payload)
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"sha256": hex.EncodeToString(digest[:]),
+		"iat":    time.Now().Unix(),
+	})
+
+	return token.SignedString(priv)
+}
+
 func SignURL(urlString string, id string, keyType LocalJWTKeyType) (string, error) {
 	tok, err := LocalJWT(id, keyType)
 	if err != nil {
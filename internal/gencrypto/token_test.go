@@ -2,6 +2,8 @@ package gencrypto
 
 import (
 	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"os"
@@ -89,6 +91,28 @@ var _ = Context("with an ECDSA key pair", func() {
 		})
 	})
 
+	It("DigestJWTForKey creates a valid token holding the payload digest", func() {
+		payload := []byte(`{"id":"report"}`)
+		tokenString, err := DigestJWTForKey(payload, privateKeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+
+		parser := &jwt.Parser{ValidMethods: []string{jwt.SigningMethodES256.Alg()}}
+		parsed, err := parser.Parse(tokenString, func(t *jwt.Token) (interface{}, error) { return publicKey, nil })
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.Valid).To(BeTrue())
+
+		claims, ok := parsed.Claims.(jwt.MapClaims)
+		Expect(ok).To(BeTrue())
+		digest := sha256.Sum256(payload)
+		Expect(claims["sha256"]).To(Equal(hex.EncodeToString(digest[:])))
+	})
+
+	It("DigestJWT fails when EC_PRIVATE_KEY_PEM is unset", func() {
+		os.Unsetenv("EC_PRIVATE_KEY_PEM")
+		_, err := DigestJWT([]byte("payload"))
+		Expect(err).To(HaveOccurred())
+	})
+
 	It("LocalJWTForKey creates a valid token", func() {
 		id := uuid.New().String()
 		tokenString, err := LocalJWTForKey(id, privateKeyPEM, InfraEnvKey)
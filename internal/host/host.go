@@ -79,6 +79,7 @@ type API interface {
 	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateApiVipConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
 	UpdateTangConnectivityReport(ctx context.Context, h *models.Host, connectivityReport string) error
+	UpdateLoadBalancerConnectivityReport(ctx context.Context, h *models.Host, loadBalancerConnectivityReport string) error
 	HostMonitoring()
 	CancelInstallation(ctx context.Context, h *models.Host, reason string, db *gorm.DB) *common.ApiErrorResponse
 	IsRequireUserActionReset(h *models.Host) bool
@@ -108,6 +109,7 @@ type API interface {
 	UpdateNTP(ctx context.Context, h *models.Host, ntpSources []*models.NtpSource, db *gorm.DB) error
 	UpdateMachineConfigPoolName(ctx context.Context, db *gorm.DB, h *models.Host, machineConfigPoolName string) error
 	UpdateIgnitionEndpointToken(ctx context.Context, db *gorm.DB, h *models.Host, token string) error
+	UpdateBMC(ctx context.Context, db *gorm.DB, h *models.Host, bmc *models.HostBmc, password *string) error
 	UpdateIgnitionEndpointHTTPHeaders(ctx context.Context, h *models.Host, nodeLabelsStr string, db *gorm.DB) error
 	UpdateNodeLabels(ctx context.Context, h *models.Host, nodeLabelsStr string, db *gorm.DB) error
 	UpdateNodeSkipDiskFormatting(ctx context.Context, h *models.Host, skipDiskFormatting string, db *gorm.DB) error
@@ -756,6 +758,17 @@ func (m *Manager) UpdateTangConnectivityReport(ctx context.Context, h *models.Ho
 	return nil
 }
 
+func (m *Manager) UpdateLoadBalancerConnectivityReport(ctx context.Context, h *models.Host, loadBalancerConnectivityReport string) error {
+	if h.LoadBalancerConnectivity != loadBalancerConnectivityReport {
+		updates := map[string]interface{}{"load_balancer_connectivity": loadBalancerConnectivityReport}
+
+		if err := m.updateHost(ctx, m.db, h, updates).Error; err != nil {
+			return errors.Wrapf(err, "failed to set load_balancer_connectivity to host %s", h.ID.String())
+		}
+	}
+	return nil
+}
+
 func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role models.HostRole, db *gorm.DB) error {
 	cdb := m.db
 	if db != nil {
@@ -810,6 +823,40 @@ func (m *Manager) UpdateIgnitionEndpointToken(ctx context.Context, db *gorm.DB,
 	return m.updateHost(ctx, cdb, h, updates).Error
 }
 
+func (m *Manager) UpdateBMC(ctx context.Context, db *gorm.DB, h *models.Host, bmc *models.HostBmc, password *string) error {
+	hostStatus := swag.StringValue(h.Status)
+	if !funk.ContainsString(hostStatusesBeforeInstallationOrUnbound[:], hostStatus) {
+		return common.NewApiError(http.StatusBadRequest,
+			errors.Errorf("Host is in %s state, host BMC can be set only in one of %s states",
+				hostStatus, hostStatusesBeforeInstallation[:]))
+	}
+
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+
+	updates := map[string]interface{}{"trigger_monitor_timestamp": time.Now()}
+	if bmc != nil {
+		// An empty address removes the BMC of the host
+		updates["bmc_address"] = bmc.Address
+		if bmc.Address == "" {
+			updates["bmc_username"] = ""
+			updates["bmc_disable_certificate_verification"] = false
+			updates["bmc_password"] = ""
+			updates["bmc_password_set"] = false
+		} else {
+			updates["bmc_username"] = bmc.Username
+			updates["bmc_disable_certificate_verification"] = bmc.DisableCertificateVerification
+		}
+	}
+	if password != nil && (bmc == nil || bmc.Address != "") {
+		updates["bmc_password"] = *password
+		updates["bmc_password_set"] = *password != ""
+	}
+	return m.updateHost(ctx, cdb, h, updates).Error
+}
+
 func (m *Manager) UpdateIgnitionEndpointHTTPHeaders(ctx context.Context, h *models.Host, nodeLabelsStr string, db *gorm.DB) error {
 	hostStatus := swag.StringValue(h.Status)
 	if !funk.ContainsString(hostStatusesBeforeInstallationOrUnbound[:], hostStatus) {
@@ -45,6 +45,7 @@ func convertInterfacesToConnectivityCheckHost(hostId *strfmt.UUID, interfaces []
 		var ipAddresses []string
 		connectivityNic.Mac = strfmt.MAC(hostInterface.MacAddress)
 		connectivityNic.Name = hostInterface.Name
+		connectivityNic.Mtu = hostInterface.Mtu
 
 		for _, ip := range hostInterface.IPV4Addresses {
 			ipAddresses = append(ipAddresses, strings.Split(ip, "/")[0])
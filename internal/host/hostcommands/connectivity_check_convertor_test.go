@@ -45,7 +45,7 @@ var _ = Describe("connectivitycheckconvertor", func() {
 				IPV6Addresses: []string{"2001:db8::4/120", "2001:db8::a"},
 			},
 			{
-				Name: "eth1", MacAddress: "45:85:00:80:12:a4",
+				Name: "eth1", MacAddress: "45:85:00:80:12:a4", Mtu: 9000,
 				IPV4Addresses: []string{"10.0.0.4", "10.0.0.5/24", "10.0.0.6", "10.0.0.7/24"},
 				IPV6Addresses: []string{"fe80:5054::1f", "fe80:5054::5/120", "fe80:5054::ff"},
 			},
@@ -58,6 +58,8 @@ var _ = Describe("connectivitycheckconvertor", func() {
 		Expect(connectivityParamsHost.Nics).To(HaveLen(2))
 		Expect(connectivityParamsHost.Nics[0].IPAddresses).To(HaveLen(5))
 		Expect(connectivityParamsHost.Nics[1].IPAddresses).To(HaveLen(7))
+		Expect(connectivityParamsHost.Nics[0].Mtu).To(BeZero())
+		Expect(connectivityParamsHost.Nics[1].Mtu).To(Equal(int64(9000)))
 	})
 
 	It("convertHostsToConnectivityParamsHosts_success", func() {
@@ -0,0 +1,92 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type diskEraseCmd struct {
+	baseCmd
+	db             *gorm.DB
+	timeoutSeconds float64
+}
+
+func NewDiskEraseCmd(log logrus.FieldLogger, db *gorm.DB, timeoutSeconds float64) *diskEraseCmd {
+	return &diskEraseCmd{
+		baseCmd:        baseCmd{log: log},
+		db:             db,
+		timeoutSeconds: timeoutSeconds,
+	}
+}
+
+func (c *diskEraseCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	diskIDs := common.GetDisksToEraseIdentifiers(host)
+	if len(diskIDs) == 0 {
+		return nil, nil
+	}
+
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	request := models.DiskEraseRequest{
+		Disks: make([]*models.DiskEraseTarget, 0, len(diskIDs)),
+	}
+	for _, diskID := range diskIDs {
+		disk := common.GetDiskByIdentifier(inventory, diskID)
+		if disk == nil {
+			return nil, fmt.Errorf("disk %s of host %s to erase was not found in its inventory", diskID, host.ID.String())
+		}
+		request.Disks = append(request.Disks, &models.DiskEraseTarget{
+			ID:   diskID,
+			Path: disk.Path,
+		})
+	}
+
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal DiskEraseRequest: %w", err)
+	}
+
+	// An erasure may take hours, it is only sent again when the agent didn't reply before its timeout
+	dispatched, err := c.markDispatched(host)
+	if err != nil {
+		return nil, err
+	}
+	if !dispatched {
+		c.log.Debugf("Erasure of the disks of host %s is in progress", host.ID.String())
+		return nil, nil
+	}
+
+	step := &models.Step{
+		StepType: models.StepTypeDiskErase,
+		Args: []string{
+			string(requestBytes),
+			fmt.Sprintf("%.2f", c.timeoutSeconds),
+		},
+	}
+	return []*models.Step{step}, nil
+}
+
+// markDispatched records the time the erasure is sent to the agent, unless a previous erasure of the host is still
+// running. The update is conditional so that concurrent polls never send the erasure twice.
+func (c *diskEraseCmd) markDispatched(host *models.Host) (bool, error) {
+	now := time.Now()
+	expired := now.Add(-time.Duration(c.timeoutSeconds * float64(time.Second)))
+	result := c.db.Model(&common.Host{}).
+		Where("id = ? and infra_env_id = ? and (disk_erase_started_at is null or disk_erase_started_at < ?)",
+			host.ID.String(), host.InfraEnvID.String(), expired).
+		Update("disk_erase_started_at", now)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to mark the erasure of the disks of host %s as started: %w", host.ID.String(), result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
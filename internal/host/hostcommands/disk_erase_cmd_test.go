@@ -0,0 +1,95 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("disk_erase_cmd.GetSteps", func() {
+	var (
+		ctx          = context.Background()
+		host         models.Host
+		diskEraseCmd *diskEraseCmd
+		db           *gorm.DB
+		dbName       string
+	)
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		diskEraseCmd = NewDiskEraseCmd(common.GetTestLog(), db, 60)
+
+		id := strfmt.UUID(uuid.New().String())
+		infraEnvId := strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHostWithInfraEnv(id, infraEnvId, models.HostStatusKnownUnbound, models.HostRoleAutoAssign)
+		inventory := models.Inventory{
+			Disks: []*models.Disk{
+				{ID: "/dev/disk/by-id/wwn-0x1111", Path: "/dev/sda"},
+				{ID: "/dev/disk/by-id/wwn-0x2222", Path: "/dev/nvme0n1"},
+			},
+		}
+		inventoryBytes, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		host.Inventory = string(inventoryBytes)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("returns no step when no disk is pending erasure", func() {
+		steps, err := diskEraseCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeNil())
+	})
+
+	It("returns a request with the disks to erase", func() {
+		host.DisksToErase = "/dev/disk/by-id/wwn-0x2222"
+		steps, err := diskEraseCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeDiskErase))
+
+		var request models.DiskEraseRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		Expect(request.Disks).To(HaveLen(1))
+		Expect(request.Disks[0].ID).To(Equal("/dev/disk/by-id/wwn-0x2222"))
+		Expect(request.Disks[0].Path).To(Equal("/dev/nvme0n1"))
+		Expect(steps[0].Args[1]).To(Equal("60.00"))
+	})
+
+	It("doesn't send an erasure again while it is in progress", func() {
+		host.DisksToErase = "/dev/disk/by-id/wwn-0x2222"
+		steps, err := diskEraseCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		steps, err = diskEraseCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeNil())
+	})
+
+	It("sends an erasure again once its timeout passed", func() {
+		host.DisksToErase = "/dev/disk/by-id/wwn-0x2222"
+		Expect(db.Model(&common.Host{}).Where("id = ?", host.ID.String()).
+			Update("disk_erase_started_at", time.Now().Add(-2*time.Minute)).Error).ShouldNot(HaveOccurred())
+		steps, err := diskEraseCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+	})
+
+	It("fails when a disk to erase is missing from the inventory", func() {
+		host.DisksToErase = "/dev/disk/by-id/wwn-0x3333"
+		_, err := diskEraseCmd.GetSteps(ctx, &host)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"net"
 
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/models"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 const (
@@ -17,6 +19,7 @@ const (
 
 type freeAddressesCmd struct {
 	baseCmd
+	db             *gorm.DB
 	kubeApiEnabled bool
 }
 
@@ -39,17 +42,66 @@ func getAllSmallV4Cidrs(host *models.Host, log logrus.FieldLogger) ([]string, er
 	return ret, nil
 }
 
-func getFreeAddressesNetworks(host *models.Host, log logrus.FieldLogger) ([]string, error) {
-	cidrs, err := getAllSmallV4Cidrs(host, log)
+// getIPv6VipCandidates returns the IPv6 VIPs of the cluster of the host that are in the IPv6 networks of the host, as
+// /128 networks. The IPv6 networks are too large to be scanned, so the agent probes these addresses directly with NDP
+// neighbor solicitations.
+func (f *freeAddressesCmd) getIPv6VipCandidates(host *models.Host) ([]string, error) {
+	if host.ClusterID == nil || *host.ClusterID == "" {
+		return nil, nil
+	}
+	networksByFamily, err := network.GetInventoryNetworksByFamily([]*models.Host{host}, f.log)
+	if err != nil {
+		return nil, err
+	}
+	if len(networksByFamily[network.IPv6]) == 0 {
+		return nil, nil
+	}
+	cluster, err := common.GetClusterFromDBWithVips(f.db, *host.ClusterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get cluster %s", host.ClusterID.String())
+	}
+	var vips []string
+	for _, vip := range cluster.APIVips {
+		vips = append(vips, string(vip.IP))
+	}
+	for _, vip := range cluster.IngressVips {
+		vips = append(vips, string(vip.IP))
+	}
+	var ret []string
+	for _, vip := range vips {
+		ip := net.ParseIP(vip)
+		if ip == nil || ip.To4() != nil {
+			continue
+		}
+		for _, cidr := range networksByFamily[network.IPv6] {
+			if _, ipnet, err := net.ParseCIDR(cidr); err == nil && ipnet.Contains(ip) {
+				ret = append(ret, ip.String()+"/128")
+				break
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (f *freeAddressesCmd) getFreeAddressesNetworks(host *models.Host) ([]string, error) {
+	cidrs, err := getAllSmallV4Cidrs(host, f.log)
+	if err != nil {
+		return nil, err
+	}
+	vipCandidates, err := f.getIPv6VipCandidates(host)
 	if err != nil {
 		return nil, err
 	}
-	return cidrs, nil
+	return append(cidrs, vipCandidates...), nil
 }
 
-func newFreeAddressesCmd(log logrus.FieldLogger, kubeApiEnabled bool) CommandGetter {
+func newFreeAddressesCmd(log logrus.FieldLogger, db *gorm.DB, kubeApiEnabled bool) CommandGetter {
 	return &freeAddressesCmd{
 		baseCmd:        baseCmd{log: log},
+		db:             db,
 		kubeApiEnabled: kubeApiEnabled,
 	}
 }
@@ -64,7 +116,7 @@ func (f *freeAddressesCmd) prepareParam(host *models.Host) (string, error) {
 		f.log.WithError(err).Warn("Inventory parse")
 		return "", err
 	}
-	networks, err := getFreeAddressesNetworks(host, f.log)
+	networks, err := f.getFreeAddressesNetworks(host)
 	if err != nil {
 		f.log.WithError(err).Errorf("find if validate with free addresses")
 		return "", err
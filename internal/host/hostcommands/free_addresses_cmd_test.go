@@ -26,7 +26,7 @@ var _ = Describe("free_addresses", func() {
 	var dbName string
 	BeforeEach(func() {
 		db, dbName = common.PrepareTestDB()
-		fCmd = newFreeAddressesCmd(common.GetTestLog(), false)
+		fCmd = newFreeAddressesCmd(common.GetTestLog(), db, false)
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
@@ -113,8 +113,23 @@ var _ = Describe("free_addresses", func() {
 		Expect(stepErr).ShouldNot(HaveOccurred())
 	})
 
+	It("IPv6 VIPs", func() {
+		Expect(db.Create(&common.Cluster{Cluster: models.Cluster{
+			ID:          &clusterId,
+			APIVips:     common.TestIPv6Networking.APIVips,
+			IngressVips: append(common.TestIPv6Networking.IngressVips, &models.IngressVip{IP: "2001:db8::65"}),
+		}}).Error).ShouldNot(HaveOccurred())
+		host.Inventory = common.GenerateTestIPv6Inventory()
+		stepReply, stepErr = fCmd.GetSteps(ctx, &host)
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(HaveLen(1))
+		stepReplyDecoded := models.FreeAddressesRequest{}
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[0]), &stepReplyDecoded)).To(Succeed())
+		Expect(stepReplyDecoded).To(Equal(models.FreeAddressesRequest{"1001:db8::64/128", "1001:db8::65/128"}))
+	})
+
 	It("with kube API", func() {
-		fCmd = newFreeAddressesCmd(common.GetTestLog(), true)
+		fCmd = newFreeAddressesCmd(common.GetTestLog(), db, true)
 		stepReply, stepErr = fCmd.GetSteps(ctx, &host)
 		Expect(stepReply).To(BeNil())
 		Expect(stepErr).ShouldNot(HaveOccurred())
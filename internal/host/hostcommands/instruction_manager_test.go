@@ -123,6 +123,15 @@ var _ = Describe("instruction_manager", func() {
 					models.StepTypeDomainResolution, models.StepTypeVerifyVips,
 				})
 			})
+			It("known with user managed networking", func() {
+				Expect(db.Model(&common.Cluster{}).Where("id = ?", clusterId.String()).
+					Update("user_managed_networking", true).Error).ToNot(HaveOccurred())
+				checkStep(models.HostStatusKnown, []models.StepType{
+					models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses,
+					models.StepTypeInventory, models.StepTypeNtpSynchronizer,
+					models.StepTypeDomainResolution, models.StepTypeLoadBalancerCheck,
+				})
+			})
 			It("disconnected", func() {
 				checkStep(models.HostStatusDisconnected, []models.StepType{
 					models.StepTypeInventory,
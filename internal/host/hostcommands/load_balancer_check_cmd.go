@@ -0,0 +1,64 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type loadBalancerCheckCmd struct {
+	baseCmd
+	db *gorm.DB
+}
+
+func newLoadBalancerCheckCmd(log logrus.FieldLogger, db *gorm.DB) CommandGetter {
+	return &loadBalancerCheckCmd{
+		baseCmd: baseCmd{log: log},
+		db:      db,
+	}
+}
+
+func (f *loadBalancerCheckCmd) prepareParam(host *models.Host) (string, error) {
+	if host.ClusterID == nil || *host.ClusterID == "" {
+		f.log.Warnf("Missing cluster id for host %s infra-env %s", host.ID.String(), host.InfraEnvID.String())
+		return "", nil
+	}
+	cluster, err := common.GetClusterFromDB(f.db, *host.ClusterID, common.SkipEagerLoading)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get cluster %s", host.ClusterID.String())
+	}
+	if !network.IsLoadBalancerCheckRequired(cluster) {
+		return "", nil
+	}
+	request := models.LoadBalancerCheckRequest{
+		Endpoints: network.GetLoadBalancerEndpoints(cluster),
+	}
+	b, err := json.Marshal(&request)
+	if err != nil {
+		f.log.WithError(err).Warn("Json marshal")
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (f *loadBalancerCheckCmd) GetSteps(ctx context.Context, host *models.Host) ([]*models.Step, error) {
+	param, err := f.prepareParam(host)
+	if param == "" || err != nil {
+		return nil, err
+	}
+
+	step := &models.Step{
+		StepType: models.StepTypeLoadBalancerCheck,
+		Args: []string{
+			param,
+		},
+	}
+
+	return []*models.Step{step}, nil
+}
@@ -0,0 +1,90 @@
+package hostcommands
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/models"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("load_balancer_check", func() {
+	ctx := context.Background()
+	var host models.Host
+	var cluster common.Cluster
+	var db *gorm.DB
+	var lbCmd CommandGetter
+	var id, clusterId, infraEnvId strfmt.UUID
+	var dbName string
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		lbCmd = newLoadBalancerCheckCmd(common.GetTestLog(), db)
+
+		id = strfmt.UUID(uuid.New().String())
+		clusterId = strfmt.UUID(uuid.New().String())
+		infraEnvId = strfmt.UUID(uuid.New().String())
+		host = hostutil.GenerateTestHost(id, infraEnvId, clusterId, models.HostStatusInsufficient)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		cluster = hostutil.GenerateTestCluster(clusterId)
+		cluster.Name = "test-cluster"
+		cluster.BaseDNSDomain = "example.com"
+		cluster.UserManagedNetworking = swag.Bool(true)
+	})
+
+	AfterEach(func() {
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("probes the api, the machine config and the ingress endpoints", func() {
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		steps, err := lbCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(HaveLen(1))
+		Expect(steps[0].StepType).To(Equal(models.StepTypeLoadBalancerCheck))
+		Expect(steps[0].Args).To(HaveLen(1))
+		var request models.LoadBalancerCheckRequest
+		Expect(json.Unmarshal([]byte(steps[0].Args[0]), &request)).To(Succeed())
+		var endpoints []string
+		for _, e := range request.Endpoints {
+			endpoints = append(endpoints, swag.StringValue(e.Name)+" "+network.FormatLoadBalancerEndpoint(e))
+		}
+		Expect(endpoints).To(Equal([]string{
+			"api api.test-cluster.example.com:6443",
+			"api-int api-int.test-cluster.example.com:22623",
+			"ingress console-openshift-console.apps.test-cluster.example.com:443",
+			"ingress console-openshift-console.apps.test-cluster.example.com:80",
+		}))
+	})
+
+	It("skips clusters with cluster managed networking", func() {
+		cluster.UserManagedNetworking = swag.Bool(false)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		steps, err := lbCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeNil())
+	})
+
+	It("skips single node clusters", func() {
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		steps, err := lbCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeNil())
+	})
+
+	It("skips clusters without a base domain", func() {
+		cluster.BaseDNSDomain = ""
+		Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+		steps, err := lbCmd.GetSteps(ctx, &host)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(steps).To(BeNil())
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
 	"github.com/sirupsen/logrus"
 )
@@ -25,6 +26,10 @@ func (c *rebootForReclaimCmd) GetSteps(ctx context.Context, host *models.Host) (
 	request := models.RebootForReclaimRequest{
 		HostFsMountDir: &c.HostFSMountDir,
 	}
+	if host.WipeDisksOnReclaim {
+		request.WipeDisks = true
+		request.SkipWipeDisks = common.GetSkippedFormattingDiskIdentifiers(host)
+	}
 	requestBytes, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal RebootForReclaimRequest: %w", err)
@@ -2,6 +2,7 @@ package hostcommands
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -37,6 +38,24 @@ var _ = Describe("reboot_for_reclaim_cmd.GetSteps", func() {
 		stepReply, stepErr = rebootForReclaimCmd.GetSteps(ctx, &host)
 		Expect(stepReply[0].StepType).To(Equal(models.StepTypeRebootForReclaim))
 		Expect(stepErr).To(BeNil())
+
+		var request models.RebootForReclaimRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[0]), &request)).To(Succeed())
+		Expect(*request.HostFsMountDir).To(Equal(hostFSMountDir))
+		Expect(request.WipeDisks).To(BeFalse())
+		Expect(request.SkipWipeDisks).To(BeEmpty())
+	})
+
+	It("requests to wipe the disks of a decommissioned host", func() {
+		host.WipeDisksOnReclaim = true
+		host.SkipFormattingDisks = "/dev/disk/by-id/wwn-0x1111,/dev/disk/by-id/wwn-0x2222"
+		stepReply, stepErr = rebootForReclaimCmd.GetSteps(ctx, &host)
+		Expect(stepErr).To(BeNil())
+
+		var request models.RebootForReclaimRequest
+		Expect(json.Unmarshal([]byte(stepReply[0].Args[0]), &request)).To(Succeed())
+		Expect(request.WipeDisks).To(BeTrue())
+		Expect(request.SkipWipeDisks).To(ConsistOf("/dev/disk/by-id/wwn-0x1111", "/dev/disk/by-id/wwn-0x2222"))
 	})
 
 	AfterEach(func() {
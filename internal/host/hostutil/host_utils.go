@@ -245,6 +245,21 @@ func IsDay2Host(h *models.Host) bool {
 	return swag.StringValue(h.Kind) == models.HostKindAddToExistingClusterHost
 }
 
+// IsDay2ControlPlaneHostJoining returns true for a day2 control plane host that started its installation but
+// has not become a healthy etcd member yet. Control plane hosts must join etcd one at a time.
+func IsDay2ControlPlaneHostJoining(h *models.Host) bool {
+	if !IsDay2Host(h) || common.GetEffectiveRole(h) != models.HostRoleMaster {
+		return false
+	}
+	switch swag.StringValue(h.Status) {
+	case models.HostStatusInstalling, models.HostStatusInstallingInProgress:
+		return true
+	case models.HostStatusAddedToExistingCluster:
+		return h.Progress != nil && h.Progress.CurrentStage == models.HostStageJoined
+	}
+	return false
+}
+
 func IsUnboundHost(h *models.Host) bool {
 	return h.ClusterID == nil
 }
@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
@@ -200,6 +202,30 @@ var _ = Describe("Validations", func() {
 	})
 })
 
+var _ = Describe("IsDay2ControlPlaneHostJoining", func() {
+	newHost := func(kind string, role models.HostRole, status string, stage models.HostStage) *models.Host {
+		return &models.Host{
+			Kind:     swag.String(kind),
+			Role:     role,
+			Status:   swag.String(status),
+			Progress: &models.HostProgressInfo{CurrentStage: stage},
+		}
+	}
+
+	DescribeTable("control plane join state",
+		func(h *models.Host, expected bool) {
+			Expect(IsDay2ControlPlaneHostJoining(h)).To(Equal(expected))
+		},
+		Entry("day2 master installing", newHost(models.HostKindAddToExistingClusterHost, models.HostRoleMaster, models.HostStatusInstalling, ""), true),
+		Entry("day2 master writing image", newHost(models.HostKindAddToExistingClusterHost, models.HostRoleMaster, models.HostStatusInstallingInProgress, models.HostStageWritingImageToDisk), true),
+		Entry("day2 master waiting for etcd", newHost(models.HostKindAddToExistingClusterHost, models.HostRoleMaster, models.HostStatusAddedToExistingCluster, models.HostStageJoined), true),
+		Entry("day2 master done", newHost(models.HostKindAddToExistingClusterHost, models.HostRoleMaster, models.HostStatusAddedToExistingCluster, models.HostStageDone), false),
+		Entry("day2 master not installing yet", newHost(models.HostKindAddToExistingClusterHost, models.HostRoleMaster, models.HostStatusKnown, ""), false),
+		Entry("day2 worker installing", newHost(models.HostKindAddToExistingClusterHost, models.HostRoleWorker, models.HostStatusInstalling, ""), false),
+		Entry("day1 master installing", newHost(models.HostKindHost, models.HostRoleMaster, models.HostStatusInstalling, ""), false),
+	)
+})
+
 func TestHostUtil(t *testing.T) {
 	RegisterFailHandler(Fail)
 	common.InitializeDBTest()
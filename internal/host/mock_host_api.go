@@ -551,6 +551,20 @@ func (mr *MockAPIMockRecorder) UpdateApiVipConnectivityReport(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApiVipConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateApiVipConnectivityReport), arg0, arg1, arg2)
 }
 
+// UpdateBMC mocks base method.
+func (m *MockAPI) UpdateBMC(arg0 context.Context, arg1 *gorm.DB, arg2 *models.Host, arg3 *models.HostBmc, arg4 *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBMC", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBMC indicates an expected call of UpdateBMC.
+func (mr *MockAPIMockRecorder) UpdateBMC(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBMC", reflect.TypeOf((*MockAPI)(nil).UpdateBMC), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UpdateConnectivityReport mocks base method.
 func (m *MockAPI) UpdateConnectivityReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -691,6 +705,20 @@ func (mr *MockAPIMockRecorder) UpdateKubeKeyNS(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKubeKeyNS", reflect.TypeOf((*MockAPI)(nil).UpdateKubeKeyNS), arg0, arg1, arg2)
 }
 
+// UpdateLoadBalancerConnectivityReport mocks base method.
+func (m *MockAPI) UpdateLoadBalancerConnectivityReport(arg0 context.Context, arg1 *models.Host, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerConnectivityReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLoadBalancerConnectivityReport indicates an expected call of UpdateLoadBalancerConnectivityReport.
+func (mr *MockAPIMockRecorder) UpdateLoadBalancerConnectivityReport(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateLoadBalancerConnectivityReport), arg0, arg1, arg2)
+}
+
 // UpdateLogsProgress mocks base method.
 func (m *MockAPI) UpdateLogsProgress(arg0 context.Context, arg1 *models.Host, arg2 string) error {
 	m.ctrl.T.Helper()
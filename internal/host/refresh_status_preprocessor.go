@@ -314,6 +314,18 @@ func newValidations(v *validator) []validation {
 			id:        NoIscsiNicBelongsToMachineCidr,
 			condition: v.noIscsiNicBelongsToMachineCidr,
 		},
+		{
+			id:        IsMtuValid,
+			condition: v.isMtuValid,
+		},
+		{
+			id:        AreSecondaryNetworksValid,
+			condition: v.areSecondaryNetworksValid,
+		},
+		{
+			id:        IsLoadBalancerReachable,
+			condition: v.isLoadBalancerReachable,
+		},
 	}
 }
 
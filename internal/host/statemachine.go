@@ -955,12 +955,16 @@ func NewHostStateMachine(sm stateswitch.StateMachine, th TransitionHandler) stat
 		If(NoSkipMissingDisk),
 		If(NoIPCollisionsInNetwork),
 		If(NoIscsiNicBelongsToMachineCidr),
+		If(IsMtuValid),
+		If(AreSecondaryNetworksValid),
+		If(IsLoadBalancerReachable),
 		If(AreNodeFeatureDiscoveryRequirementsSatisfied),
 		If(AreNvidiaGPURequirementsSatisfied),
 		If(ArePipelinesRequirementsSatisfied),
 		If(AreServiceMeshRequirementsSatisfied),
 		If(AreServerLessRequirementsSatisfied),
 		If(AreOpenShiftAIRequirementsSatisfied),
+		If(AreCustomOperatorsRequirementsSatisfied),
 		/*
 					 * MGMT-15213: The release domain is not resolved correctly when there is a mirror or proxy.  In this case
 					 * validation might fail, but the installation may succeed.
@@ -109,7 +109,8 @@ func (th *transitionHandler) PostRegisterHost(sw stateswitch.StateSwitch, args s
 		// also log info is not current and should be resetted, and progress should be cleared.
 		// In addition, due to late binding the kind should be set to the hostParam value,
 		// because in day2 it may be changed during re-registration
-		extra := append(resetFields[:], "discovery_agent_version", params.discoveryAgentVersion, "ntp_sources", "", "kind", hostParam.Kind)
+		extra := append(resetFields[:], "discovery_agent_version", params.discoveryAgentVersion, "ntp_sources", "", "kind", hostParam.Kind,
+			"wipe_disks_on_reclaim", false)
 		extra = append(extra, resetLogsField...)
 		extra = append(extra, resetProgressFields...)
 		var dbHost *common.Host
@@ -407,7 +408,7 @@ func (th *transitionHandler) PostBindHost(sw stateswitch.StateSwitch, args state
 		return errors.New("PostBindHost invalid argument")
 	}
 
-	extra := append(resetFields[:], "cluster_id", &params.clusterID)
+	extra := append(resetFields[:], "cluster_id", &params.clusterID, "wipe_disks_on_reclaim", false)
 	return th.updateTransitionHost(params.ctx, logutil.FromContext(params.ctx, th.log), params.db, sHost, statusInfoBinding,
 		extra...)
 }
@@ -442,6 +443,9 @@ func (th *transitionHandler) PostUnbindHost(sw stateswitch.StateSwitch, args sta
 func (th *transitionHandler) updateHostForUnbind(ctx context.Context, db *gorm.DB, h *stateHost) error {
 	extra := append(resetFields[:], resetLogsField...)
 	extra = append(extra, restFieldsOnUnbind...)
+	// A decommissioned host is marked for disk wipe before it is reclaimed, the mark is kept until it reboots
+	extra = append(extra, "wipe_disks_on_reclaim",
+		h.host.WipeDisksOnReclaim && swag.StringValue(h.host.Status) == models.HostStatusReclaiming)
 	return th.updateTransitionHost(ctx, logutil.FromContext(ctx, th.log), db, h, statusInfoUnbinding,
 		extra...)
 }
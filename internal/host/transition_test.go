@@ -1171,6 +1171,7 @@ var _ = Describe("Unbind", func() {
 			host.LogsCollectedAt = strfmt.DateTime(time.Now())
 			host.StageStartedAt = strfmt.DateTime(time.Now())
 			host.StageUpdatedAt = strfmt.DateTime(time.Now())
+			host.WipeDisksOnReclaim = true
 
 			dstState := t.dstState
 
@@ -1190,6 +1191,10 @@ var _ = Describe("Unbind", func() {
 				validationState = t.srcState
 			}
 			validation(hapi.UnbindHost(ctx, &host, db, t.reclaim), validationState)
+			if t.success {
+				h := hostutil.GetHostFromDB(hostId, infraEnvId, db)
+				Expect(h.WipeDisksOnReclaim).Should(Equal(dstState == models.HostStatusReclaiming))
+			}
 		})
 	}
 })
@@ -6556,12 +6561,16 @@ var allValidationIDs = []validationID{
 	NoIPCollisionsInNetwork,
 	IsReleaseDomainNameResolvedCorrectly,
 	NoIscsiNicBelongsToMachineCidr,
+	IsMtuValid,
+	AreSecondaryNetworksValid,
+	IsLoadBalancerReachable,
 	AreNodeFeatureDiscoveryRequirementsSatisfied,
 	AreNvidiaGPURequirementsSatisfied,
 	ArePipelinesRequirementsSatisfied,
 	AreServiceMeshRequirementsSatisfied,
 	AreServerLessRequirementsSatisfied,
 	AreOpenShiftAIRequirementsSatisfied,
+	AreCustomOperatorsRequirementsSatisfied,
 }
 
 var allConditions = []conditionId{
@@ -6727,6 +6736,32 @@ var _ = Describe("State machine test - refresh transition", func() {
 			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
 			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
 		})
+
+		It("Moves from known to insufficient when mtu-valid validation fails", func() {
+
+			refreshHostArgs.conditions[string(IsMtuValid)] = false
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusInsufficient))
+
+			refreshHostArgs.conditions[string(IsMtuValid)] = true
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
+		})
+
+		It("Moves from known to insufficient when secondary-networks-valid validation fails", func() {
+
+			refreshHostArgs.conditions[string(AreSecondaryNetworksValid)] = false
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusInsufficient))
+
+			refreshHostArgs.conditions[string(AreSecondaryNetworksValid)] = true
+
+			Expect(stateMachine.Run(TransitionTypeRefresh, testState, &refreshHostArgs)).To(Succeed())
+			Expect(string(testState.State())).To(Equal(models.HostStatusKnown))
+		})
 	})
 
 })
@@ -53,12 +53,16 @@ const (
 	NoSkipMissingDisk                              = validationID(models.HostValidationIDNoSkipMissingDisk)
 	NoIPCollisionsInNetwork                        = validationID(models.HostValidationIDNoIPCollisionsInNetwork)
 	NoIscsiNicBelongsToMachineCidr                 = validationID(models.HostValidationIDNoIscsiNicBelongsToMachineCidr)
+	IsMtuValid                                     = validationID(models.HostValidationIDMtuValid)
+	AreSecondaryNetworksValid                      = validationID(models.HostValidationIDSecondaryNetworksValid)
+	IsLoadBalancerReachable                        = validationID(models.HostValidationIDLoadBalancerReachable)
 	AreNodeFeatureDiscoveryRequirementsSatisfied   = validationID(models.HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied)
 	AreNvidiaGPURequirementsSatisfied              = validationID(models.HostValidationIDNvidiaGpuRequirementsSatisfied)
 	ArePipelinesRequirementsSatisfied              = validationID(models.HostValidationIDPipelinesRequirementsSatisfied)
 	AreServiceMeshRequirementsSatisfied            = validationID(models.HostValidationIDServicemeshRequirementsSatisfied)
 	AreServerLessRequirementsSatisfied             = validationID(models.HostValidationIDServerlessRequirementsSatisfied)
 	AreOpenShiftAIRequirementsSatisfied            = validationID(models.HostValidationIDOpenshiftAiRequirementsSatisfied)
+	AreCustomOperatorsRequirementsSatisfied        = validationID(models.HostValidationIDCustomOperatorsRequirementsSatisfied)
 )
 
 func (v validationID) category() (string, error) {
@@ -83,7 +87,10 @@ func (v validationID) category() (string, error) {
 		NonOverlappingSubnets,
 		IsReleaseDomainNameResolvedCorrectly,
 		NoIPCollisionsInNetwork,
-		NoIscsiNicBelongsToMachineCidr:
+		NoIscsiNicBelongsToMachineCidr,
+		IsMtuValid,
+		AreSecondaryNetworksValid,
+		IsLoadBalancerReachable:
 		return "network", nil
 	case HasInventory,
 		HasMinCPUCores,
@@ -112,7 +119,8 @@ func (v validationID) category() (string, error) {
 		ArePipelinesRequirementsSatisfied,
 		AreServiceMeshRequirementsSatisfied,
 		AreServerLessRequirementsSatisfied,
-		AreOpenShiftAIRequirementsSatisfied:
+		AreOpenShiftAIRequirementsSatisfied,
+		AreCustomOperatorsRequirementsSatisfied:
 		return "operators", nil
 	}
 	return "", common.NewApiError(http.StatusInternalServerError, errors.Errorf("Unexpected validation id %s", string(v)))
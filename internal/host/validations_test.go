@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/assisted-service/internal/hardware"
 	"github.com/openshift/assisted-service/internal/host/hostutil"
 	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/network"
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/provider/registry"
@@ -2605,4 +2606,176 @@ var _ = Describe("Validations test", func() {
 			Entry("is imported", &models.Cluster{ID: &clusterID, Imported: swag.Bool(true)}),
 		)
 	})
+	Context("MTU valid", func() {
+		var (
+			cluster    common.Cluster
+			host       models.Host
+			remoteHost models.Host
+		)
+		BeforeEach(func() {
+			cluster = hostutil.GenerateTestCluster(clusterID)
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			mockProviderRegistry.EXPECT().IsHostSupported(commontesting.EqPlatformType(models.PlatformTypeVsphere), gomock.Any()).Return(false, nil).AnyTimes()
+
+			infraEnvId := strfmt.UUID(uuid.New().String())
+			remoteHost = hostutil.GenerateTestHostByKind(strfmt.UUID(uuid.New().String()), infraEnvId, &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+			remoteHost.Inventory = hostutil.GenerateMasterInventoryWithHostname("remote")
+			Expect(db.Create(&remoteHost).Error).ShouldNot(HaveOccurred())
+			host = hostutil.GenerateTestHostByKind(strfmt.UUID(uuid.New().String()), infraEnvId, &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+			host.Inventory = hostutil.GenerateMasterInventory()
+		})
+
+		refreshMtuValidation := func(mtuReports ...*models.MtuReport) (ValidationStatus, string, bool) {
+			report := models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{
+				{HostID: *remoteHost.ID, MtuReport: mtuReports},
+			}}
+			b, err := json.Marshal(&report)
+			Expect(err).ToNot(HaveOccurred())
+			host.Connectivity = string(b)
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockAndRefreshStatus(&host)
+			refreshedHost := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+			return getValidationResult(refreshedHost.ValidationsInfo, IsMtuValid)
+		}
+
+		It("MTU path is not reported", func() {
+			_, _, ok := refreshMtuValidation()
+			Expect(ok).To(BeFalse())
+		})
+		It("MTU path succeeded", func() {
+			status, message, ok := refreshMtuValidation(&models.MtuReport{OutgoingNic: "eth0", RemoteIPAddress: "1.2.3.10", MtuSuccessful: true, Mtu: 9000})
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("MTU is valid on the paths to the other hosts in the cluster"))
+		})
+		It("MTU path failed in the machine network", func() {
+			status, message, ok := refreshMtuValidation(
+				&models.MtuReport{OutgoingNic: "eth0", RemoteIPAddress: "1.2.3.10", MtuSuccessful: false, Mtu: 9000},
+				&models.MtuReport{OutgoingNic: "eth1", RemoteIPAddress: "10.0.0.10", MtuSuccessful: false, Mtu: 9000},
+			)
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(HavePrefix("Packets of the interface MTU size are dropped on the way to other hosts in the cluster: eth0 to 1.2.3.10 (MTU 9000)."))
+		})
+		It("MTU path failed outside of the machine network", func() {
+			_, _, ok := refreshMtuValidation(&models.MtuReport{OutgoingNic: "eth1", RemoteIPAddress: "10.0.0.10", MtuSuccessful: false})
+			Expect(ok).To(BeFalse())
+		})
+	})
+	Context("Secondary networks valid", func() {
+		var (
+			cluster common.Cluster
+			host    models.Host
+		)
+		BeforeEach(func() {
+			cluster = hostutil.GenerateTestCluster(clusterID)
+			mockProviderRegistry.EXPECT().IsHostSupported(commontesting.EqPlatformType(models.PlatformTypeVsphere), gomock.Any()).Return(false, nil).AnyTimes()
+			host = hostutil.GenerateTestHostByKind(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+			host.Inventory = hostutil.GenerateMasterInventory()
+		})
+
+		refreshSecondaryNetworksValidation := func(desiredStates ...string) (ValidationStatus, string, bool) {
+			policies := models.SecondaryNetworkPolicies{}
+			for i, desiredState := range desiredStates {
+				policies = append(policies, &models.SecondaryNetworkPolicy{
+					Name:         swag.String(fmt.Sprintf("policy-%d", i)),
+					Role:         swag.String(models.SecondaryNetworkPolicyRoleAll),
+					DesiredState: swag.String(desiredState),
+				})
+			}
+			if len(policies) > 0 {
+				b, err := json.Marshal(policies)
+				Expect(err).ToNot(HaveOccurred())
+				cluster.SecondaryNetworks = string(b)
+			}
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockAndRefreshStatus(&host)
+			refreshedHost := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+			return getValidationResult(refreshedHost.ValidationsInfo, AreSecondaryNetworksValid)
+		}
+
+		It("No secondary networks", func() {
+			_, _, ok := refreshSecondaryNetworksValidation()
+			Expect(ok).To(BeFalse())
+		})
+		It("Secondary network on top of the host interfaces", func() {
+			status, message, ok := refreshSecondaryNetworksValidation("interfaces:\n- name: eth0.100\n  type: vlan\n  vlan:\n    base-iface: eth0\n    id: 100\n")
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The secondary network policies can be applied to the host"))
+		})
+		It("Secondary network references missing interfaces", func() {
+			status, message, ok := refreshSecondaryNetworksValidation("interfaces:\n- name: bond0\n  type: bond\n  link-aggregation:\n    port:\n    - eth1\n    - eth2\n")
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The secondary network policies can't be applied to the host: secondary network policy policy-0 references interfaces that the host doesn't have: eth1, eth2."))
+		})
+		It("Secondary network reconfigures the interface of the machine network", func() {
+			status, message, ok := refreshSecondaryNetworksValidation("interfaces:\n- name: eth0\n  type: ethernet\n  mtu: 9000\n")
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(ContainSubstring("secondary network policy policy-0 reconfigures interface eth0 of the machine network"))
+		})
+	})
+	Context("Load balancer reachable", func() {
+		var (
+			cluster common.Cluster
+			host    models.Host
+		)
+		BeforeEach(func() {
+			cluster = hostutil.GenerateTestCluster(clusterID)
+			cluster.UserManagedNetworking = swag.Bool(true)
+			mockProviderRegistry.EXPECT().IsHostSupported(commontesting.EqPlatformType(models.PlatformTypeVsphere), gomock.Any()).Return(false, nil).AnyTimes()
+			host = hostutil.GenerateTestHostByKind(strfmt.UUID(uuid.New().String()), strfmt.UUID(uuid.New().String()), &clusterID, models.HostStatusKnown, models.HostKindHost, models.HostRoleMaster)
+			host.Inventory = hostutil.GenerateMasterInventory()
+		})
+
+		refreshLoadBalancerValidation := func(unreachable ...int) (ValidationStatus, string, bool) {
+			report := models.LoadBalancerCheckResponse{}
+			for i, endpoint := range network.GetLoadBalancerEndpoints(&cluster) {
+				report.Endpoints = append(report.Endpoints, &models.LoadBalancerEndpointResult{
+					Endpoint:  endpoint,
+					Reachable: swag.Bool(!lo.Contains(unreachable, i)),
+				})
+			}
+			b, err := json.Marshal(&report)
+			Expect(err).ToNot(HaveOccurred())
+			host.LoadBalancerConnectivity = string(b)
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockAndRefreshStatus(&host)
+			refreshedHost := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+			return getValidationResult(refreshedHost.ValidationsInfo, IsLoadBalancerReachable)
+		}
+
+		It("Load balancer endpoints are not reported", func() {
+			Expect(db.Create(&cluster).Error).ToNot(HaveOccurred())
+			Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+			mockAndRefreshStatus(&host)
+			refreshedHost := hostutil.GetHostFromDB(*host.ID, host.InfraEnvID, db).Host
+			_, _, ok := getValidationResult(refreshedHost.ValidationsInfo, IsLoadBalancerReachable)
+			Expect(ok).To(BeFalse())
+			Expect(refreshedHost.Status).ToNot(Equal(swag.String(models.HostStatusInsufficient)))
+		})
+		It("Load balancer endpoints are reachable", func() {
+			status, message, ok := refreshLoadBalancerValidation()
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationSuccess))
+			Expect(message).To(Equal("The host reached the load balancer on all the API and ingress endpoints"))
+		})
+		It("Load balancer endpoints are unreachable", func() {
+			status, message, ok := refreshLoadBalancerValidation(1, 3)
+			Expect(ok).To(BeTrue())
+			Expect(status).To(Equal(ValidationFailure))
+			Expect(message).To(Equal("The host can't reach the load balancer on: " +
+				"api-int.test-cluster.example.com:22623, console-openshift-console.apps.test-cluster.example.com:80. " +
+				"Configure the load balancer to listen on the API and ingress endpoints of the cluster."))
+		})
+		It("Cluster without an external load balancer", func() {
+			cluster.UserManagedNetworking = swag.Bool(false)
+			_, _, ok := refreshLoadBalancerValidation(1)
+			Expect(ok).To(BeFalse())
+		})
+	})
 })
@@ -1881,3 +1881,106 @@ func (v *validator) noIscsiNicBelongsToMachineCidr(c *validationContext) (Valida
 
 	return ValidationSuccess, "Network interface connected to iSCSI disk does not belong to machine network CIDRs"
 }
+
+func (v *validator) isMtuValid(c *validationContext) (ValidationStatus, string) {
+	if c.infraEnv != nil || c.cluster == nil || hostutil.IsDay2Host(c.host) || common.IsSingleNodeCluster(c.cluster) {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if c.host.Connectivity == "" {
+		// The MTU path is verified by the connectivity check, the connectivity validations wait for it
+		return ValidationSuccessSuppressOutput, ""
+	}
+	report, err := hostutil.UnmarshalConnectivityReport(c.host.Connectivity)
+	if err != nil {
+		v.log.WithError(err).Warnf("Unable to unmarshal the connectivity report of host %s", c.host.ID)
+		return ValidationError, "Parse error for the connectivity report"
+	}
+
+	clusterHostIDs := make(map[strfmt.UUID]bool)
+	for _, h := range c.cluster.Hosts {
+		clusterHostIDs[*h.ID] = true
+	}
+	checkMachineNetwork := network.IsMachineCidrAvailable(c.cluster)
+	verified := false
+	var failures []string
+	for _, remoteHost := range report.RemoteHosts {
+		if !clusterHostIDs[remoteHost.HostID] {
+			continue
+		}
+		for _, mtuReport := range remoteHost.MtuReport {
+			if checkMachineNetwork && !network.IsIPInMachineNetworks(c.cluster, mtuReport.RemoteIPAddress) {
+				continue
+			}
+			verified = true
+			if mtuReport.MtuSuccessful {
+				continue
+			}
+			failure := fmt.Sprintf("%s to %s", mtuReport.OutgoingNic, mtuReport.RemoteIPAddress)
+			if mtuReport.Mtu > 0 {
+				failure += fmt.Sprintf(" (MTU %d)", mtuReport.Mtu)
+			}
+			failures = append(failures, failure)
+		}
+	}
+	if !verified {
+		// Agents that don't probe the MTU path don't report it
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if len(failures) > 0 {
+		return ValidationFailure, fmt.Sprintf("Packets of the interface MTU size are dropped on the way to other hosts in the cluster: %s. "+
+			"Make sure that the hosts and the switches between them use the same MTU on the machine network.", strings.Join(failures, ", "))
+	}
+	return ValidationSuccess, "MTU is valid on the paths to the other hosts in the cluster"
+}
+
+func (v *validator) isLoadBalancerReachable(c *validationContext) (ValidationStatus, string) {
+	if c.infraEnv != nil || c.cluster == nil || hostutil.IsDay2Host(c.host) || !network.IsLoadBalancerCheckRequired(c.cluster) {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if c.host.LoadBalancerConnectivity == "" {
+		// Agents that don't probe the load balancer endpoints don't report them
+		return ValidationSuccessSuppressOutput, ""
+	}
+	var report models.LoadBalancerCheckResponse
+	if err := json.Unmarshal([]byte(c.host.LoadBalancerConnectivity), &report); err != nil {
+		v.log.WithError(err).Warnf("Unable to unmarshal the load balancer connectivity report of host %s", c.host.ID)
+		return ValidationSuccessSuppressOutput, ""
+	}
+	var unreachable []string
+	for _, result := range report.Endpoints {
+		if result == nil || result.Endpoint == nil || swag.BoolValue(result.Reachable) {
+			continue
+		}
+		unreachable = append(unreachable, network.FormatLoadBalancerEndpoint(result.Endpoint))
+	}
+	if len(unreachable) > 0 {
+		return ValidationFailure, fmt.Sprintf("The host can't reach the load balancer on: %s. "+
+			"Configure the load balancer to listen on the API and ingress endpoints of the cluster.", strings.Join(unreachable, ", "))
+	}
+	if len(report.Endpoints) == 0 {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	return ValidationSuccess, "The host reached the load balancer on all the API and ingress endpoints"
+}
+
+func (v *validator) areSecondaryNetworksValid(c *validationContext) (ValidationStatus, string) {
+	if c.infraEnv != nil || c.cluster == nil || c.cluster.SecondaryNetworks == "" {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	policies, err := network.ParseSecondaryNetworks(c.cluster.SecondaryNetworks)
+	if err != nil {
+		v.log.WithError(err).Warnf("Unable to parse the secondary network policies of cluster %s", c.cluster.ID)
+		return ValidationError, "Parse error for the secondary network policies"
+	}
+	if len(policies) == 0 {
+		return ValidationSuccessSuppressOutput, ""
+	}
+	if c.inventory == nil {
+		return ValidationPending, "Missing inventory"
+	}
+	problems := network.ValidateSecondaryNetworksForHost(v.log, c.cluster, c.host, c.inventory, policies)
+	if len(problems) > 0 {
+		return ValidationFailure, fmt.Sprintf("The secondary network policies can't be applied to the host: %s.", strings.Join(problems, "; "))
+	}
+	return ValidationSuccess, "The secondary network policies can be applied to the host"
+}
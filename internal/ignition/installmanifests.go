@@ -315,8 +315,13 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte)
 		return err
 	}
 	// We want to save install-config.yaml
-	// Installer deletes it so we need to write it one more time
-	err = os.WriteFile(installConfigPath, installConfig, 0600)
+	// Installer deletes it so we need to write it one more time, without the BMC passwords as it can be downloaded
+	savedInstallConfig, err := removeBMCPasswords(installConfig)
+	if err != nil {
+		log.WithError(err).Error("Failed to remove the BMC passwords from the install config")
+		return err
+	}
+	err = os.WriteFile(installConfigPath, savedInstallConfig, 0600)
 	if err != nil {
 		log.Errorf("Failed to write file %s", installConfigPath)
 		return err
@@ -329,6 +334,30 @@ func (g *installerGenerator) Generate(ctx context.Context, installConfig []byte)
 	return nil
 }
 
+// removeBMCPasswords returns the install config without the passwords of the BMCs of the baremetal hosts
+func removeBMCPasswords(installConfig []byte) ([]byte, error) {
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(installConfig, &cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the install config")
+	}
+	platform, _ := cfg["platform"].(map[string]interface{})
+	baremetal, _ := platform["baremetal"].(map[string]interface{})
+	hosts, _ := baremetal["hosts"].([]interface{})
+	removed := false
+	for _, h := range hosts {
+		host, _ := h.(map[string]interface{})
+		bmc, _ := host["bmc"].(map[string]interface{})
+		if _, ok := bmc["password"]; ok {
+			delete(bmc, "password")
+			removed = true
+		}
+	}
+	if !removed {
+		return installConfig, nil
+	}
+	return json.Marshal(cfg)
+}
+
 func (g *installerGenerator) addBootstrapKubeletIpIfRequired(log logrus.FieldLogger, envVars []string) ([]string, error) {
 	// setting bootstrap kubelet node ip
 	log.Debugf("Adding bootstrap ip to env vars")
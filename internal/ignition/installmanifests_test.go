@@ -898,6 +898,31 @@ var _ = Describe("ExtractClusterID", func() {
 	})
 })
 
+var _ = Describe("removeBMCPasswords", func() {
+	It("removes the passwords of the BMCs", func() {
+		installConfig := []byte(`{"platform":{"baremetal":{"hosts":[` +
+			`{"name":"host-1","bmc":{"address":"redfish://192.168.111.1","username":"admin","password":"secret"}},` +
+			`{"name":"host-2","bmc":{"address":"","username":"","password":""}}]}}}`)
+		result, err := removeBMCPasswords(installConfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(result)).ToNot(ContainSubstring("password"))
+		Expect(string(result)).ToNot(ContainSubstring("secret"))
+		Expect(string(result)).To(ContainSubstring(`"username":"admin"`))
+	})
+
+	It("keeps the install config without BMCs", func() {
+		installConfig := []byte(`{"platform":{"none":{}},"pullSecret":"{\"auths\":{}}"}`)
+		result, err := removeBMCPasswords(installConfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(installConfig))
+	})
+
+	It("fails on an invalid install config", func() {
+		_, err := removeBMCPasswords([]byte("{"))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 var _ = Describe("Generator UploadToS3", func() {
 	var (
 		ctx          = context.Background()
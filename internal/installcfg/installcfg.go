@@ -12,6 +12,7 @@ type Platform struct {
 	Vsphere   *VsphereInstallConfigPlatform   `json:"vsphere,omitempty"`
 	Nutanix   *NutanixInstallConfigPlatform   `json:"nutanix,omitempty"`
 	External  *ExternalInstallConfigPlatform  `json:"external,omitempty"`
+	Openstack *OpenstackInstallConfigPlatform `json:"openstack,omitempty"`
 }
 
 type BMC struct {
@@ -39,6 +40,7 @@ type BareMetalInstallConfigPlatform struct {
 	Hosts                        []Host   `json:"hosts"`
 	ClusterOSImage               string   `json:"clusterOSImage,omitempty"`
 	ClusterProvisioningIP        string   `json:"clusterProvisioningIP,omitempty"`
+	BootstrapProvisioningIP      string   `json:"bootstrapProvisioningIP,omitempty"`
 	ProvisioningNetworkInterface string   `json:"provisioningNetworkInterface,omitempty"`
 	ProvisioningNetworkCIDR      *string  `json:"provisioningNetworkCIDR,omitempty"`
 	ProvisioningDHCPRange        string   `json:"provisioningDHCPRange,omitempty"`
@@ -153,6 +155,15 @@ const (
 	CloudControllerManagerTypeNone = ""
 )
 
+type OpenstackInstallConfigPlatform struct {
+	Cloud                string   `json:"cloud"`
+	APIVIPs              []string `json:"apiVIPs,omitempty"`
+	DeprecatedAPIVIP     string   `json:"apiVIP,omitempty"`
+	IngressVIPs          []string `json:"ingressVIPs,omitempty"`
+	DeprecatedIngressVIP string   `json:"ingressVIP,omitempty"`
+	ExternalNetwork      string   `json:"externalNetwork,omitempty"`
+}
+
 type ExternalInstallConfigPlatform struct {
 	// PlatformName holds the arbitrary string representing the infrastructure provider name, expected to be set at the installation time.
 	PlatformName string `yaml:"platformName"`
@@ -0,0 +1,109 @@
+package ipam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// infobloxMAC is the MAC address of the fixed addresses that reserve the addresses, they aren't served by DHCP
+const infobloxMAC = "00:00:00:00:00:00"
+
+// infobloxBackend allocates the addresses of the Infoblox networks whose comment is the name of the pool
+type infobloxBackend struct {
+	url         string
+	networkView string
+	username    string
+	password    string
+	client      *http.Client
+}
+
+func NewInfobloxBackend(baseURL, wapiVersion, networkView, username, password string, client *http.Client) Backend {
+	return &infobloxBackend{
+		url:         fmt.Sprintf("%s/wapi/%s", strings.TrimSuffix(baseURL, "/"), wapiVersion),
+		networkView: networkView,
+		username:    username,
+		password:    password,
+		client:      client,
+	}
+}
+
+func (b *infobloxBackend) do(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, b.url+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(b.username, b.password)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the request to Infoblox")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("Infoblox responded to %s %s with status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if result == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(result), "failed to decode the response of Infoblox")
+}
+
+func (b *infobloxBackend) Allocate(ctx context.Context, pool, description string, _ map[string]bool) (*Address, error) {
+	var networks []struct {
+		Network string `json:"network"`
+	}
+	query := url.Values{}
+	query.Set("comment", pool)
+	query.Set("network_view", b.networkView)
+	query.Set("_return_fields", "network")
+	if err := b.do(ctx, http.MethodGet, "/network?"+query.Encode(), nil, &networks); err != nil {
+		return nil, err
+	}
+	if len(networks) != 1 {
+		return nil, errors.Errorf("expected one Infoblox network with comment %s, found %d", pool, len(networks))
+	}
+	_, subnet, err := net.ParseCIDR(networks[0].Network)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Infoblox network %s is invalid", networks[0].Network)
+	}
+	request := map[string]string{
+		"ipv4addr":     fmt.Sprintf("func:nextavailableip:%s,%s", networks[0].Network, b.networkView),
+		"mac":          infobloxMAC,
+		"network_view": b.networkView,
+		"comment":      description,
+	}
+	var allocated struct {
+		Ref      string `json:"_ref"`
+		IPv4Addr string `json:"ipv4addr"`
+	}
+	if err = b.do(ctx, http.MethodPost, "/fixedaddress?_return_fields=ipv4addr", request, &allocated); err != nil {
+		return nil, err
+	}
+	prefixLength, _ := subnet.Mask.Size()
+	return &Address{IP: allocated.IPv4Addr, PrefixLength: prefixLength, ExternalID: allocated.Ref}, nil
+}
+
+func (b *infobloxBackend) Release(ctx context.Context, reservation *common.IPAMReservation) error {
+	return b.do(ctx, http.MethodDelete, "/"+reservation.ExternalID, nil, nil)
+}
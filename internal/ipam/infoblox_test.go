@@ -0,0 +1,96 @@
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+// mockInfoblox serves the WAPI of an Infoblox with a single network in the default network view
+type mockInfoblox struct {
+	fixedAddresses map[string]string
+}
+
+func (m *mockInfoblox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != "admin" || password != "infoblox" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/wapi/v2.12/network":
+		networks := []map[string]string{}
+		if r.URL.Query().Get("comment") == "lab" && r.URL.Query().Get("network_view") == "default" {
+			networks = append(networks, map[string]string{"_ref": "network/ZG5z:10.0.0.0/24/default", "network": "10.0.0.0/24"})
+		}
+		_ = json.NewEncoder(w).Encode(networks)
+	case r.Method == http.MethodPost && r.URL.Path == "/wapi/v2.12/fixedaddress":
+		var request map[string]string
+		Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+		if request["ipv4addr"] != "func:nextavailableip:10.0.0.0/24,default" || request["mac"] != infobloxMAC {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		address := fmt.Sprintf("10.0.0.%d", len(m.fixedAddresses)+10)
+		ref := fmt.Sprintf("fixedaddress/ZG5z:%s/default", address)
+		m.fixedAddresses[ref] = request["comment"]
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]string{"_ref": ref, "ipv4addr": address})
+	case r.Method == http.MethodDelete:
+		ref := strings.TrimPrefix(r.URL.Path, "/wapi/v2.12/")
+		if _, ok := m.fixedAddresses[ref]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(m.fixedAddresses, ref)
+		_ = json.NewEncoder(w).Encode(ref)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("Infoblox backend", func() {
+	var (
+		ctx    = context.Background()
+		mock   *mockInfoblox
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		mock = &mockInfoblox{fixedAddresses: map[string]string{}}
+		server = httptest.NewServer(mock)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("allocates and releases fixed addresses of the network", func() {
+		backend := NewInfobloxBackend(server.URL, "v2.12", "default", "admin", "infoblox", server.Client())
+		address, err := backend.Allocate(ctx, "lab", "ingress-vip cluster", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*address).To(Equal(Address{IP: "10.0.0.10", PrefixLength: 24, ExternalID: "fixedaddress/ZG5z:10.0.0.10/default"}))
+		Expect(mock.fixedAddresses).To(HaveKeyWithValue(address.ExternalID, "ingress-vip cluster"))
+
+		Expect(backend.Release(ctx, &common.IPAMReservation{ExternalID: address.ExternalID})).To(Succeed())
+		Expect(mock.fixedAddresses).To(BeEmpty())
+	})
+
+	It("fails without a network for the pool", func() {
+		backend := NewInfobloxBackend(server.URL, "v2.12", "other-view", "admin", "infoblox", server.Client())
+		_, err := backend.Allocate(ctx, "lab", "api-vip cluster", nil)
+		Expect(err).To(MatchError("expected one Infoblox network with comment lab, found 0"))
+	})
+
+	It("fails with invalid credentials", func() {
+		backend := NewInfobloxBackend(server.URL, "v2.12", "default", "admin", "invalid", server.Client())
+		_, err := backend.Allocate(ctx, "lab", "api-vip cluster", nil)
+		Expect(err).To(MatchError(ContainSubstring("with status 401")))
+	})
+})
@@ -0,0 +1,207 @@
+package ipam
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	ProviderNone     = ""
+	ProviderPostgres = "postgres"
+	ProviderNetBox   = "netbox"
+	ProviderInfoblox = "infoblox"
+
+	PurposeAPIVip     = "api-vip"
+	PurposeIngressVip = "ingress-vip"
+
+	// reserveAttempts is the number of times that a free address is allocated when another reservation took it
+	reserveAttempts = 5
+)
+
+// ErrDisabled is returned when an address is reserved while the service has no IP address management system
+var ErrDisabled = errors.New("the IP address management isn't configured")
+
+type Config struct {
+	// Provider is the IP address management system: postgres, netbox or infoblox. It is disabled when empty.
+	Provider string `envconfig:"IPAM_PROVIDER" default:""`
+	// Pools are the pools of the postgres provider, in JSON
+	Pools string `envconfig:"IPAM_POOLS" default:""`
+
+	NetBoxURL   string `envconfig:"IPAM_NETBOX_URL" default:""`
+	NetBoxToken string `envconfig:"IPAM_NETBOX_TOKEN" default:""`
+
+	InfobloxURL         string `envconfig:"IPAM_INFOBLOX_URL" default:""`
+	InfobloxUsername    string `envconfig:"IPAM_INFOBLOX_USERNAME" default:""`
+	InfobloxPassword    string `envconfig:"IPAM_INFOBLOX_PASSWORD" default:""`
+	InfobloxWAPIVersion string `envconfig:"IPAM_INFOBLOX_WAPI_VERSION" default:"v2.12"`
+	InfobloxNetworkView string `envconfig:"IPAM_INFOBLOX_NETWORK_VIEW" default:"default"`
+
+	// Insecure skips the verification of the certificate of NetBox and Infoblox
+	Insecure bool          `envconfig:"IPAM_INSECURE" default:"false"`
+	Timeout  time.Duration `envconfig:"IPAM_TIMEOUT" default:"30s"`
+}
+
+// Address is an address allocated by a backend
+type Address struct {
+	IP           string
+	PrefixLength int
+	// ExternalID is the reference of the address in the external IP address management system
+	ExternalID string
+}
+
+// Backend allocates and releases the addresses of the pools of an IP address management system
+type Backend interface {
+	// Allocate allocates a free address of the pool. The addresses that are reserved in the database are passed to
+	// the backends that don't keep track of the allocated addresses.
+	Allocate(ctx context.Context, pool, description string, reserved map[string]bool) (*Address, error)
+	// Release releases an address that was allocated by the backend
+	Release(ctx context.Context, reservation *common.IPAMReservation) error
+}
+
+//go:generate mockgen --build_flags=--mod=mod -package=ipam -destination=mock_ipam.go . API
+type API interface {
+	// Enabled tells whether the service has an IP address management system
+	Enabled() bool
+	// Reserve reserves a free address of the pool for the cluster or the infra-env
+	Reserve(ctx context.Context, ownerID strfmt.UUID, pool, purpose string) (*common.IPAMReservation, error)
+	// Release releases all the addresses of the cluster or the infra-env
+	Release(ctx context.Context, ownerID strfmt.UUID) error
+	// ReleaseUnused releases the addresses of the cluster or the infra-env that aren't in the given used addresses
+	ReleaseUnused(ctx context.Context, ownerID strfmt.UUID, used []string) error
+}
+
+type Manager struct {
+	log     logrus.FieldLogger
+	db      *gorm.DB
+	backend Backend
+}
+
+// NewManager creates the IP address management of the configured provider
+func NewManager(log logrus.FieldLogger, db *gorm.DB, cfg Config) (*Manager, error) {
+	backend, err := newBackend(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{log: log, db: db, backend: backend}, nil
+}
+
+func newBackend(cfg Config) (Backend, error) {
+	switch cfg.Provider {
+	case ProviderNone:
+		return nil, nil
+	case ProviderPostgres:
+		return NewPostgresBackend(cfg.Pools)
+	case ProviderNetBox:
+		if cfg.NetBoxURL == "" || cfg.NetBoxToken == "" {
+			return nil, errors.New("the URL and the token of NetBox must be set")
+		}
+		return NewNetBoxBackend(cfg.NetBoxURL, cfg.NetBoxToken, newHTTPClient(cfg)), nil
+	case ProviderInfoblox:
+		if cfg.InfobloxURL == "" || cfg.InfobloxUsername == "" {
+			return nil, errors.New("the URL and the username of Infoblox must be set")
+		}
+		return NewInfobloxBackend(cfg.InfobloxURL, cfg.InfobloxWAPIVersion, cfg.InfobloxNetworkView,
+			cfg.InfobloxUsername, cfg.InfobloxPassword, newHTTPClient(cfg)), nil
+	default:
+		return nil, errors.Errorf("unknown IPAM provider %s, expected one of %s, %s, %s", cfg.Provider,
+			ProviderPostgres, ProviderNetBox, ProviderInfoblox)
+	}
+}
+
+func newHTTPClient(cfg Config) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: cfg.Insecure} // #nosec G402 only when the service is configured to skip the verification
+	return &http.Client{Transport: transport, Timeout: cfg.Timeout}
+}
+
+func (m *Manager) Enabled() bool {
+	return m.backend != nil
+}
+
+func (m *Manager) Reserve(ctx context.Context, ownerID strfmt.UUID, pool, purpose string) (*common.IPAMReservation, error) {
+	if !m.Enabled() {
+		return nil, ErrDisabled
+	}
+	description := fmt.Sprintf("%s %s", purpose, ownerID)
+	for attempt := 0; attempt < reserveAttempts; attempt++ {
+		reserved, err := m.reservedAddresses(pool)
+		if err != nil {
+			return nil, err
+		}
+		address, err := m.backend.Allocate(ctx, pool, description, reserved)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to allocate an address of pool %s", pool)
+		}
+		reservation := &common.IPAMReservation{
+			OwnerID:      ownerID,
+			Pool:         pool,
+			Address:      address.IP,
+			PrefixLength: address.PrefixLength,
+			Purpose:      purpose,
+			ExternalID:   address.ExternalID,
+		}
+		if err = m.db.Create(reservation).Error; err == nil {
+			m.log.Infof("Reserved address %s of pool %s for %s", address.IP, pool, description)
+			return reservation, nil
+		}
+		if address.ExternalID != "" {
+			// The external system allocated the address, it must not stay allocated without a reservation
+			if releaseErr := m.backend.Release(ctx, reservation); releaseErr != nil {
+				m.log.WithError(releaseErr).Warnf("failed to release address %s of pool %s", address.IP, pool)
+			}
+			return nil, errors.Wrapf(err, "failed to store the reservation of address %s of pool %s", address.IP, pool)
+		}
+		// Another reservation took the address since it was allocated
+		m.log.WithError(err).Debugf("address %s of pool %s was reserved concurrently, allocating another one", address.IP, pool)
+	}
+	return nil, errors.Errorf("failed to reserve an address of pool %s after %d attempts", pool, reserveAttempts)
+}
+
+func (m *Manager) reservedAddresses(pool string) (map[string]bool, error) {
+	var addresses []string
+	if err := m.db.Model(&common.IPAMReservation{}).Where("pool = ?", pool).Pluck("address", &addresses).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get the reserved addresses of pool %s", pool)
+	}
+	reserved := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		reserved[address] = true
+	}
+	return reserved, nil
+}
+
+func (m *Manager) Release(ctx context.Context, ownerID strfmt.UUID) error {
+	return m.ReleaseUnused(ctx, ownerID, nil)
+}
+
+func (m *Manager) ReleaseUnused(ctx context.Context, ownerID strfmt.UUID, used []string) error {
+	query := m.db.Where("owner_id = ?", ownerID.String())
+	if len(used) > 0 {
+		query = query.Where("address not in ?", used)
+	}
+	var reservations []*common.IPAMReservation
+	if err := query.Find(&reservations).Error; err != nil {
+		return errors.Wrapf(err, "failed to get the IPAM reservations of %s", ownerID)
+	}
+	if len(reservations) > 0 && !m.Enabled() {
+		return ErrDisabled
+	}
+	for _, reservation := range reservations {
+		if err := m.backend.Release(ctx, reservation); err != nil {
+			return errors.Wrapf(err, "failed to release address %s of pool %s", reservation.Address, reservation.Pool)
+		}
+		if err := m.db.Delete(reservation).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete the reservation of address %s of pool %s", reservation.Address, reservation.Pool)
+		}
+		m.log.Infof("Released address %s of pool %s of %s", reservation.Address, reservation.Pool, ownerID)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package ipam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// netBoxBackend allocates the addresses of the NetBox prefixes whose description is the name of the pool
+type netBoxBackend struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewNetBoxBackend(baseURL, token string, client *http.Client) Backend {
+	return &netBoxBackend{url: strings.TrimSuffix(baseURL, "/"), token: token, client: client}
+}
+
+type netBoxPrefix struct {
+	ID     int    `json:"id"`
+	Prefix string `json:"prefix"`
+}
+
+type netBoxIPAddress struct {
+	ID      int    `json:"id"`
+	Address string `json:"address"`
+}
+
+func (b *netBoxBackend) do(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, b.url+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token "+b.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send the request to NetBox")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("NetBox responded to %s %s with status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	if result == nil {
+		return nil
+	}
+	return errors.Wrap(json.NewDecoder(resp.Body).Decode(result), "failed to decode the response of NetBox")
+}
+
+func (b *netBoxBackend) Allocate(ctx context.Context, pool, description string, _ map[string]bool) (*Address, error) {
+	var prefixes struct {
+		Results []netBoxPrefix `json:"results"`
+	}
+	if err := b.do(ctx, http.MethodGet, "/api/ipam/prefixes/?description="+url.QueryEscape(pool), nil, &prefixes); err != nil {
+		return nil, err
+	}
+	if len(prefixes.Results) != 1 {
+		return nil, errors.Errorf("expected one NetBox prefix with description %s, found %d", pool, len(prefixes.Results))
+	}
+	var allocated netBoxIPAddress
+	path := fmt.Sprintf("/api/ipam/prefixes/%d/available-ips/", prefixes.Results[0].ID)
+	if err := b.do(ctx, http.MethodPost, path, map[string]string{"description": description}, &allocated); err != nil {
+		return nil, err
+	}
+	ip, subnet, err := net.ParseCIDR(allocated.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "NetBox allocated an invalid address %s", allocated.Address)
+	}
+	prefixLength, _ := subnet.Mask.Size()
+	return &Address{IP: ip.String(), PrefixLength: prefixLength, ExternalID: strconv.Itoa(allocated.ID)}, nil
+}
+
+func (b *netBoxBackend) Release(ctx context.Context, reservation *common.IPAMReservation) error {
+	return b.do(ctx, http.MethodDelete, fmt.Sprintf("/api/ipam/ip-addresses/%s/", reservation.ExternalID), nil, nil)
+}
@@ -0,0 +1,91 @@
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+// mockNetBox serves the prefixes and the available addresses of a NetBox with a single prefix
+type mockNetBox struct {
+	allocated map[int]string
+	next      int
+}
+
+func (m *mockNetBox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Token secret" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/ipam/prefixes/":
+		results := []netBoxPrefix{}
+		if r.URL.Query().Get("description") == "lab" {
+			results = append(results, netBoxPrefix{ID: 7, Prefix: "10.0.0.0/24"})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"count": len(results), "results": results})
+	case r.Method == http.MethodPost && r.URL.Path == "/api/ipam/prefixes/7/available-ips/":
+		var request map[string]string
+		Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+		m.next++
+		m.allocated[m.next] = request["description"]
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(netBoxIPAddress{ID: m.next, Address: fmt.Sprintf("10.0.0.%d/24", m.next+1)})
+	case r.Method == http.MethodDelete:
+		var id int
+		if _, err := fmt.Sscanf(r.URL.Path, "/api/ipam/ip-addresses/%d/", &id); err != nil || m.allocated[id] == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(m.allocated, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("NetBox backend", func() {
+	var (
+		ctx    = context.Background()
+		mock   *mockNetBox
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		mock = &mockNetBox{allocated: map[int]string{}}
+		server = httptest.NewServer(mock)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("allocates and releases addresses of the prefix", func() {
+		backend := NewNetBoxBackend(server.URL+"/", "secret", server.Client())
+		address, err := backend.Allocate(ctx, "lab", "api-vip cluster", nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*address).To(Equal(Address{IP: "10.0.0.2", PrefixLength: 24, ExternalID: "1"}))
+		Expect(mock.allocated).To(Equal(map[int]string{1: "api-vip cluster"}))
+
+		Expect(backend.Release(ctx, &common.IPAMReservation{ExternalID: address.ExternalID})).To(Succeed())
+		Expect(mock.allocated).To(BeEmpty())
+	})
+
+	It("fails without a prefix for the pool", func() {
+		backend := NewNetBoxBackend(server.URL, "secret", server.Client())
+		_, err := backend.Allocate(ctx, "other", "api-vip cluster", nil)
+		Expect(err).To(MatchError("expected one NetBox prefix with description other, found 0"))
+	})
+
+	It("fails with an invalid token", func() {
+		backend := NewNetBoxBackend(server.URL, "invalid", server.Client())
+		_, err := backend.Allocate(ctx, "lab", "api-vip cluster", nil)
+		Expect(err).To(MatchError(ContainSubstring("with status 403")))
+	})
+})
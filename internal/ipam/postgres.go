@@ -0,0 +1,127 @@
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/pkg/errors"
+)
+
+// Pool is a pool of addresses of the postgres provider
+type Pool struct {
+	Name string `json:"name"`
+	CIDR string `json:"cidr"`
+	// Start and End are the first and the last address of the pool, the whole subnet is used when they are empty
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+	// Exclude are addresses of the range that are never allocated, such as the gateway
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// postgresBackend allocates the addresses of static pools, the reservations of the database are the only record of
+// the allocated addresses
+type postgresBackend struct {
+	pools map[string]*Pool
+}
+
+func NewPostgresBackend(poolsJSON string) (Backend, error) {
+	var pools []*Pool
+	if poolsJSON != "" {
+		if err := json.Unmarshal([]byte(poolsJSON), &pools); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the IPAM pools")
+		}
+	}
+	backend := &postgresBackend{pools: make(map[string]*Pool, len(pools))}
+	for _, pool := range pools {
+		if err := validatePool(pool); err != nil {
+			return nil, err
+		}
+		if _, ok := backend.pools[pool.Name]; ok {
+			return nil, errors.Errorf("IPAM pool %s is defined more than once", pool.Name)
+		}
+		backend.pools[pool.Name] = pool
+	}
+	return backend, nil
+}
+
+func validatePool(pool *Pool) error {
+	if pool.Name == "" {
+		return errors.New("IPAM pools must have a name")
+	}
+	_, subnet, err := net.ParseCIDR(pool.CIDR)
+	if err != nil {
+		return errors.Wrapf(err, "IPAM pool %s has an invalid CIDR", pool.Name)
+	}
+	for _, address := range append([]string{pool.Start, pool.End}, pool.Exclude...) {
+		if address == "" {
+			continue
+		}
+		ip := net.ParseIP(address)
+		if ip == nil || !subnet.Contains(ip) {
+			return errors.Errorf("address %s of IPAM pool %s isn't in %s", address, pool.Name, pool.CIDR)
+		}
+	}
+	return nil
+}
+
+func (b *postgresBackend) Allocate(_ context.Context, poolName, _ string, reserved map[string]bool) (*Address, error) {
+	pool, ok := b.pools[poolName]
+	if !ok {
+		return nil, errors.Errorf("IPAM pool %s doesn't exist", poolName)
+	}
+	return nextFreeAddress(pool, reserved)
+}
+
+func (b *postgresBackend) Release(context.Context, *common.IPAMReservation) error {
+	// Deleting the reservation frees the address
+	return nil
+}
+
+// nextFreeAddress returns the lowest address of the range of the pool that is neither reserved nor excluded. The
+// network and the broadcast addresses of IPv4 subnets aren't allocated.
+func nextFreeAddress(pool *Pool, reserved map[string]bool) (*Address, error) {
+	_, subnet, err := net.ParseCIDR(pool.CIDR)
+	if err != nil {
+		return nil, errors.Wrapf(err, "IPAM pool %s has an invalid CIDR", pool.Name)
+	}
+	prefixLength, bits := subnet.Mask.Size()
+	first := toInt(subnet.IP)
+	last := new(big.Int).Sub(new(big.Int).Add(first, new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))), big.NewInt(1))
+	if bits == 32 && prefixLength < 31 {
+		first.Add(first, big.NewInt(1))
+		last.Sub(last, big.NewInt(1))
+	}
+	if pool.Start != "" {
+		first = toInt(net.ParseIP(pool.Start))
+	}
+	if pool.End != "" {
+		last = toInt(net.ParseIP(pool.End))
+	}
+	excluded := make(map[string]bool, len(pool.Exclude))
+	for _, address := range pool.Exclude {
+		excluded[net.ParseIP(address).String()] = true
+	}
+	for current := first; current.Cmp(last) <= 0; current.Add(current, big.NewInt(1)) {
+		ip := toIP(current, bits).String()
+		if !reserved[ip] && !excluded[ip] {
+			return &Address{IP: ip, PrefixLength: prefixLength}, nil
+		}
+	}
+	return nil, errors.Errorf("IPAM pool %s has no free address", pool.Name)
+}
+
+func toInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		return new(big.Int).SetBytes(v4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func toIP(value *big.Int, bits int) net.IP {
+	ip := make(net.IP, bits/8)
+	value.FillBytes(ip)
+	return ip
+}
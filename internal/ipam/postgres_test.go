@@ -0,0 +1,58 @@
+package ipam
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("postgres backend", func() {
+	It("allocates the lowest free address of the subnet", func() {
+		address, err := nextFreeAddress(&Pool{Name: "pool", CIDR: "192.168.10.0/24", Exclude: []string{"192.168.10.1"}},
+			map[string]bool{"192.168.10.2": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*address).To(Equal(Address{IP: "192.168.10.3", PrefixLength: 24}))
+	})
+
+	It("allocates the addresses of the range", func() {
+		pool := &Pool{Name: "pool", CIDR: "192.168.10.0/24", Start: "192.168.10.100", End: "192.168.10.101"}
+		address, err := nextFreeAddress(pool, map[string]bool{"192.168.10.100": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(address.IP).To(Equal("192.168.10.101"))
+
+		_, err = nextFreeAddress(pool, map[string]bool{"192.168.10.100": true, "192.168.10.101": true})
+		Expect(err).To(MatchError("IPAM pool pool has no free address"))
+	})
+
+	It("doesn't allocate the broadcast address", func() {
+		_, err := nextFreeAddress(&Pool{Name: "pool", CIDR: "10.0.0.0/30"}, map[string]bool{"10.0.0.1": true, "10.0.0.2": true})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("allocates IPv6 addresses", func() {
+		address, err := nextFreeAddress(&Pool{Name: "pool", CIDR: "fd00::/64", Start: "fd00::10"}, map[string]bool{"fd00::10": true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*address).To(Equal(Address{IP: "fd00::11", PrefixLength: 64}))
+	})
+
+	It("allocates from the configured pools", func() {
+		backend, err := NewPostgresBackend(`[{"name": "lab", "cidr": "10.0.0.0/24", "start": "10.0.0.50"}]`)
+		Expect(err).ToNot(HaveOccurred())
+		address, err := backend.Allocate(context.Background(), "lab", "api-vip", map[string]bool{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(address.IP).To(Equal("10.0.0.50"))
+
+		_, err = backend.Allocate(context.Background(), "other", "api-vip", map[string]bool{})
+		Expect(err).To(MatchError("IPAM pool other doesn't exist"))
+	})
+
+	It("rejects invalid pools", func() {
+		_, err := NewPostgresBackend(`[{"name": "lab", "cidr": "10.0.0.0/24", "exclude": ["10.0.1.1"]}]`)
+		Expect(err).To(MatchError("address 10.0.1.1 of IPAM pool lab isn't in 10.0.0.0/24"))
+		_, err = NewPostgresBackend(`[{"name": "lab", "cidr": "10.0.0.0/24"}, {"name": "lab", "cidr": "10.0.1.0/24"}]`)
+		Expect(err).To(MatchError("IPAM pool lab is defined more than once"))
+		_, err = NewPostgresBackend(`[{"name": "lab", "cidr": "10.0.0.0"}]`)
+		Expect(err).To(HaveOccurred())
+	})
+})
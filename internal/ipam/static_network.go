@@ -0,0 +1,98 @@
+package ipam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// RenderStaticNetworkConfig reserves an address of the pool for every interface of the static network config that
+// enables IPv4 or IPv6 without DHCP, autoconf or addresses, and sets the reserved address in the interface
+func RenderStaticNetworkConfig(ctx context.Context, api API, ownerID strfmt.UUID, pool string, configs []*models.HostStaticNetworkConfig) error {
+	for _, config := range configs {
+		if config == nil || config.NetworkYaml == "" {
+			continue
+		}
+		state := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(config.NetworkYaml), &state); err != nil {
+			return errors.Wrap(err, "failed to parse the static network config")
+		}
+		interfaces, _ := state["interfaces"].([]interface{})
+		rendered := false
+		for _, item := range interfaces {
+			iface, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, family := range []string{"ipv4", "ipv6"} {
+				ip, ok := iface[family].(map[string]interface{})
+				if !ok || !needsAddress(ip) {
+					continue
+				}
+				reservation, err := api.Reserve(ctx, ownerID, pool, fmt.Sprintf("%s %s", iface["name"], family))
+				if err != nil {
+					return err
+				}
+				ip["address"] = []interface{}{
+					map[string]interface{}{"ip": reservation.Address, "prefix-length": reservation.PrefixLength},
+				}
+				rendered = true
+			}
+		}
+		if !rendered {
+			continue
+		}
+		data, err := yaml.Marshal(state)
+		if err != nil {
+			return errors.Wrap(err, "failed to render the static network config")
+		}
+		config.NetworkYaml = string(data)
+	}
+	return nil
+}
+
+// StaticNetworkConfigAddresses returns the addresses of the interfaces of the static network config
+func StaticNetworkConfigAddresses(configs []*models.HostStaticNetworkConfig) ([]string, error) {
+	var addresses []string
+	for _, config := range configs {
+		if config == nil || config.NetworkYaml == "" {
+			continue
+		}
+		state := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(config.NetworkYaml), &state); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the static network config")
+		}
+		interfaces, _ := state["interfaces"].([]interface{})
+		for _, item := range interfaces {
+			iface, _ := item.(map[string]interface{})
+			for _, family := range []string{"ipv4", "ipv6"} {
+				ip, _ := iface[family].(map[string]interface{})
+				items, _ := ip["address"].([]interface{})
+				for _, item := range items {
+					address, _ := item.(map[string]interface{})
+					if value, ok := address["ip"].(string); ok {
+						addresses = append(addresses, value)
+					}
+				}
+			}
+		}
+	}
+	return addresses, nil
+}
+
+func needsAddress(ip map[string]interface{}) bool {
+	if enabled, _ := ip["enabled"].(bool); !enabled {
+		return false
+	}
+	for _, dynamic := range []string{"dhcp", "autoconf"} {
+		if value, _ := ip[dynamic].(bool); value {
+			return false
+		}
+	}
+	addresses, _ := ip["address"].([]interface{})
+	return len(addresses) == 0
+}
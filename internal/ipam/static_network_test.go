@@ -0,0 +1,121 @@
+package ipam
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("RenderStaticNetworkConfig", func() {
+	var (
+		ctx     = context.Background()
+		ctrl    *gomock.Controller
+		mockAPI *MockAPI
+		ownerID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockAPI = NewMockAPI(ctrl)
+		ownerID = strfmt.UUID(uuid.New().String())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("sets the reserved addresses of the static interfaces", func() {
+		configs := []*models.HostStaticNetworkConfig{{NetworkYaml: `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+  ipv6:
+    enabled: true
+    autoconf: true
+- name: eth1
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.168.1.5
+      prefix-length: 24
+`}}
+		mockAPI.EXPECT().Reserve(ctx, ownerID, "lab", "eth0 ipv4").
+			Return(&common.IPAMReservation{Address: "10.0.0.7", PrefixLength: 24}, nil)
+
+		Expect(RenderStaticNetworkConfig(ctx, mockAPI, ownerID, "lab", configs)).To(Succeed())
+		Expect(configs[0].NetworkYaml).To(MatchYAML(`interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: false
+    address:
+    - ip: 10.0.0.7
+      prefix-length: 24
+  ipv6:
+    enabled: true
+    autoconf: true
+- name: eth1
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.168.1.5
+      prefix-length: 24
+`))
+	})
+
+	It("keeps the config without static interfaces", func() {
+		networkYaml := "interfaces:\n- name: eth0\n  ipv4:\n    enabled: true\n    dhcp: true\n"
+		configs := []*models.HostStaticNetworkConfig{{NetworkYaml: networkYaml}}
+		Expect(RenderStaticNetworkConfig(ctx, mockAPI, ownerID, "lab", configs)).To(Succeed())
+		Expect(configs[0].NetworkYaml).To(Equal(networkYaml))
+	})
+
+	It("fails when the pool has no free address", func() {
+		configs := []*models.HostStaticNetworkConfig{{NetworkYaml: "interfaces:\n- name: eth0\n  ipv4:\n    enabled: true\n"}}
+		mockAPI.EXPECT().Reserve(ctx, ownerID, "lab", "eth0 ipv4").Return(nil, ErrDisabled)
+		Expect(RenderStaticNetworkConfig(ctx, mockAPI, ownerID, "lab", configs)).To(MatchError(ErrDisabled))
+	})
+})
+
+var _ = Describe("StaticNetworkConfigAddresses", func() {
+	It("returns the addresses of the interfaces", func() {
+		configs := []*models.HostStaticNetworkConfig{
+			{NetworkYaml: `interfaces:
+- name: eth0
+  ipv4:
+    enabled: true
+    address:
+    - ip: 10.0.0.7
+      prefix-length: 24
+  ipv6:
+    enabled: true
+    address:
+    - ip: fd00::7
+      prefix-length: 64
+`},
+			{NetworkYaml: "interfaces:\n- name: eth0\n  ipv4:\n    enabled: true\n    dhcp: true\n"},
+			{NetworkYaml: "interfaces:\n- name: eth0\n  ipv4:\n    address:\n    - ip: 10.0.0.8\n      prefix-length: 24\n"},
+		}
+		Expect(StaticNetworkConfigAddresses(configs)).To(Equal([]string{"10.0.0.7", "fd00::7", "10.0.0.8"}))
+	})
+
+	It("fails on an invalid static network config", func() {
+		_, err := StaticNetworkConfigAddresses([]*models.HostStaticNetworkConfig{{NetworkYaml: "interfaces: ["}})
+		Expect(err).To(HaveOccurred())
+	})
+})
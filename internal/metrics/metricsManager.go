@@ -39,6 +39,7 @@ const (
 	counterFilesystemUsagePercentage              = "assisted_installer_filesystem_usage_percentage"
 	counterMonitoredHosts                         = "assisted_installer_monitored_hosts"
 	counterMonitoredClusters                      = "assisted_installer_monitored_clusters"
+	counterDegradedOperators                      = "assisted_installer_degraded_operators"
 )
 
 const (
@@ -61,6 +62,7 @@ const (
 	counterDescriptionFilesystemUsagePercentage              = "The percentage of the filesystem usage by the service"
 	counterDescriptionMonitoredHosts                         = "Number of hosts monitored by host monitor"
 	counterDescriptionMonitoredClusters                      = "Number of clusters monitored by cluster monitor"
+	counterDescriptionDegradedOperators                      = "Number of installed clusters whose operator is degraded, by operator"
 )
 
 const (
@@ -77,6 +79,7 @@ const (
 	imageLabel                 = "imageName"
 	hosts                      = "hosts"
 	clusters                   = "clusters"
+	operatorNameLabel          = "operatorName"
 )
 
 type API interface {
@@ -94,6 +97,7 @@ type API interface {
 	FileSystemUsage(usageInPercentage float64)
 	MonitoredHostsCount(monitoredHosts int64)
 	MonitoredClusterCount(monitoredClusters int64)
+	DegradedOperatorsCount(operatorName string, degradedOperators int64)
 }
 
 type MetricsManager struct {
@@ -119,6 +123,7 @@ type MetricsManager struct {
 	serviceLogicFilesystemUsagePercentage              *prometheus.GaugeVec
 	serviceLogicMonitoredHosts                         *prometheus.GaugeVec
 	serviceLogicMonitoredClusters                      *prometheus.GaugeVec
+	serviceLogicDegradedOperators                      *prometheus.GaugeVec
 }
 
 var _ API = &MetricsManager{}
@@ -280,6 +285,13 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 			Name:      counterMonitoredClusters,
 			Help:      counterDescriptionMonitoredClusters,
 		}, []string{hosts}),
+
+		serviceLogicDegradedOperators: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      counterDegradedOperators,
+			Help:      counterDescriptionDegradedOperators,
+		}, []string{operatorNameLabel}),
 	}
 
 	registry.MustRegister(
@@ -302,6 +314,7 @@ func NewMetricsManager(registry prometheus.Registerer, eventsHandler eventsapi.H
 		m.serviceLogicFilesystemUsagePercentage,
 		m.serviceLogicMonitoredHosts,
 		m.serviceLogicMonitoredClusters,
+		m.serviceLogicDegradedOperators,
 	)
 	return m
 }
@@ -468,6 +481,10 @@ func (m *MetricsManager) MonitoredClusterCount(monitoredClusters int64) {
 	m.serviceLogicMonitoredClusters.WithLabelValues(clusters).Set(float64(monitoredClusters))
 }
 
+func (m *MetricsManager) DegradedOperatorsCount(operatorName string, degradedOperators int64) {
+	m.serviceLogicDegradedOperators.WithLabelValues(operatorName).Set(float64(degradedOperators))
+}
+
 func bytesToGib(bytes int64) int64 {
 	return bytes / int64(units.GiB)
 }
@@ -85,6 +85,18 @@ func (mr *MockAPIMockRecorder) ClusterValidationFailed(clusterValidationType int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterValidationFailed", reflect.TypeOf((*MockAPI)(nil).ClusterValidationFailed), clusterValidationType)
 }
 
+// DegradedOperatorsCount mocks base method.
+func (m *MockAPI) DegradedOperatorsCount(operatorName string, degradedOperators int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DegradedOperatorsCount", operatorName, degradedOperators)
+}
+
+// DegradedOperatorsCount indicates an expected call of DegradedOperatorsCount.
+func (mr *MockAPIMockRecorder) DegradedOperatorsCount(operatorName, degradedOperators interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DegradedOperatorsCount", reflect.TypeOf((*MockAPI)(nil).DegradedOperatorsCount), operatorName, degradedOperators)
+}
+
 // DiskSyncDuration mocks base method.
 func (m *MockAPI) DiskSyncDuration(syncDuration int64) {
 	m.ctrl.T.Helper()
@@ -164,6 +164,11 @@ func isMachineNetworkCidrBigEnough(hosts []*models.Host, machineNetworkCidr stri
 		return networkPrefix <= bits-MinSNOMachineMaskDelta
 	}
 
+	// Networks of 2^62 addresses and more, such as the usual IPv6 /64, can't be too small and would overflow the count
+	if bits-networkPrefix >= 62 {
+		return true
+	}
+
 	//possible hosts in the range is the width of the range minus 2 network addresses minus 2 addresses for vips
 	var availableAddresses int64 = (int64)(uint64(1)<<(bits-networkPrefix)) - int64(numOfHosts) - int64(4)
 	return availableAddresses >= 0
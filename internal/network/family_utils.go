@@ -22,3 +22,22 @@ func IsIPv6CIDR(cidr string) bool {
 	_, _, e := net.ParseCIDR(cidr)
 	return strings.Contains(cidr, ":") && e == nil
 }
+
+// NormalizeIP returns the canonical form of the address, so that the different forms of the same IPv6 address are
+// equal. Invalid addresses are returned as they are.
+func NormalizeIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	return parsed.String()
+}
+
+// NormalizeCIDR returns the canonical form of the network of the CIDR. Invalid CIDRs are returned as they are.
+func NormalizeCIDR(cidr string) string {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return ipnet.String()
+}
@@ -0,0 +1,53 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/constants"
+	"github.com/openshift/assisted-service/models"
+)
+
+const (
+	LoadBalancerAPIPort           = 6443
+	LoadBalancerMachineConfigPort = 22623
+	LoadBalancerHTTPSIngressPort  = 443
+	LoadBalancerHTTPIngressPort   = 80
+)
+
+// IsLoadBalancerCheckRequired returns true if the API and the ingress of the cluster are served by an external load
+// balancer that the hosts can probe before the installation, which is the case of the multi node clusters with user
+// managed networking
+func IsLoadBalancerCheckRequired(cluster *common.Cluster) bool {
+	return swag.BoolValue(cluster.UserManagedNetworking) &&
+		!common.IsSingleNodeCluster(cluster) &&
+		!common.IsDay2Cluster(cluster) &&
+		cluster.Name != "" &&
+		cluster.BaseDNSDomain != ""
+}
+
+// GetLoadBalancerEndpoints returns the endpoints that the external load balancer of the cluster has to listen on
+func GetLoadBalancerEndpoints(cluster *common.Cluster) []*models.LoadBalancerEndpoint {
+	endpoint := func(name, subdomain string, port int64) *models.LoadBalancerEndpoint {
+		return &models.LoadBalancerEndpoint{
+			Name: swag.String(name),
+			Host: swag.String(fmt.Sprintf("%s.%s.%s", subdomain, cluster.Name, cluster.BaseDNSDomain)),
+			Port: swag.Int64(port),
+		}
+	}
+	appsSubdomain := constants.AppsSubDomainNameHostDNSValidation + ".apps"
+	return []*models.LoadBalancerEndpoint{
+		endpoint(models.LoadBalancerEndpointNameAPI, constants.APIClusterSubdomain, LoadBalancerAPIPort),
+		endpoint(models.LoadBalancerEndpointNameAPIInt, constants.InternalAPIClusterSubdomain, LoadBalancerMachineConfigPort),
+		endpoint(models.LoadBalancerEndpointNameIngress, appsSubdomain, LoadBalancerHTTPSIngressPort),
+		endpoint(models.LoadBalancerEndpointNameIngress, appsSubdomain, LoadBalancerHTTPIngressPort),
+	}
+}
+
+// FormatLoadBalancerEndpoint returns the host:port form of the endpoint
+func FormatLoadBalancerEndpoint(endpoint *models.LoadBalancerEndpoint) string {
+	return net.JoinHostPort(swag.StringValue(endpoint.Host), strconv.FormatInt(swag.Int64Value(endpoint.Port), 10))
+}
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/IBM/netaddr"
-	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/go-multierror"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/models"
@@ -103,6 +102,34 @@ func ipIsBroadcast(ipStr, cidrStr string) bool {
 	return ip.Equal(broadcastAddress)
 }
 
+// findHostUsingIP returns the name of the host that has the address on one of its interfaces, for both IPv4 and IPv6
+func findHostUsingIP(hosts []*models.Host, ipStr string) string {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return ""
+	}
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			continue
+		}
+		for _, intf := range inventory.Interfaces {
+			for _, addr := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+				if hostIP, _, err := net.ParseCIDR(addr); err == nil && hostIP.Equal(ip) {
+					if h.RequestedHostname != "" {
+						return h.RequestedHostname
+					}
+					return inventory.Hostname
+				}
+			}
+		}
+	}
+	return ""
+}
+
 func VerifyVipFree(hosts []*models.Host, vip string, machineNetworkCidr string, verification *models.VipVerification, log logrus.FieldLogger) models.VipVerification {
 	if verification != nil {
 		switch *verification {
@@ -126,6 +153,9 @@ func VerifyVip(hosts []*models.Host, machineNetworkCidr string, vip string, vipN
 	if ipIsBroadcast(vip, machineNetworkCidr) {
 		return models.VipVerificationFailed, errors.Errorf("%s <%s> is the broadcast address of machine-network-cidr <%s>", vipName, vip, machineNetworkCidr)
 	}
+	if hostname := findHostUsingIP(hosts, vip); hostname != "" {
+		return models.VipVerificationFailed, errors.Errorf("%s <%s> is already assigned to host %s", vipName, vip, hostname)
+	}
 	var msg string
 	ret := VerifyVipFree(hosts, vip, machineNetworkCidr, verification, log)
 	switch ret {
@@ -152,7 +182,7 @@ func ValidateNoVIPAddressesDuplicates(apiVips []*models.APIVip, ingressVips []*m
 		seenIngressVipAddresses = make(map[string]bool)
 	)
 	for i := range apiVips {
-		ipAddress := string(apiVips[i].IP)
+		ipAddress := NormalizeIP(string(apiVips[i].IP))
 		if ipAddress == "" {
 			continue
 		}
@@ -166,7 +196,7 @@ func ValidateNoVIPAddressesDuplicates(apiVips []*models.APIVip, ingressVips []*m
 	}
 
 	for i := range ingressVips {
-		ipAddress := string(ingressVips[i].IP)
+		ipAddress := NormalizeIP(string(ingressVips[i].IP))
 		if ipAddress == "" {
 			continue
 		}
@@ -576,10 +606,16 @@ func forEachMachineNetwork(log logrus.FieldLogger, cluster *common.Cluster, chec
 	return lo.Reduce(machineNetwork, checkFunc, false)
 }
 
-type IPSet map[strfmt.IPv4]struct{}
+// IPSet is a set of addresses in their normalized form
+type IPSet map[string]struct{}
+
+func (s IPSet) Add(str string) {
+	s[NormalizeIP(str)] = struct{}{}
+}
 
-func (s IPSet) Add(str strfmt.IPv4) {
-	s[str] = struct{}{}
+func (s IPSet) Contains(str string) bool {
+	_, ok := s[NormalizeIP(str)]
+	return ok
 }
 
 func (s IPSet) Intersect(other IPSet) IPSet {
@@ -598,12 +634,15 @@ func freeAddressesUnmarshal(network, freeAddressesStr string, prefix *string) (I
 	if err != nil {
 		return nil, err
 	}
+	if IsIPv6CIDR(network) {
+		return freeIPv6AddressesSet(network, unmarshaled, prefix)
+	}
 	for _, f := range unmarshaled {
-		if f.Network == network {
+		if NormalizeCIDR(f.Network) == NormalizeCIDR(network) {
 			ret := make(IPSet)
 			for _, a := range f.FreeAddresses {
 				if prefix == nil || strings.HasPrefix(a.String(), *prefix) {
-					ret.Add(a)
+					ret.Add(a.String())
 				}
 			}
 			return ret, nil
@@ -612,6 +651,34 @@ func freeAddressesUnmarshal(network, freeAddressesStr string, prefix *string) (I
 	return nil, errors.Errorf("No network %s found", network)
 }
 
+// freeIPv6AddressesSet returns the free addresses of the networks that are in the IPv6 network. The IPv6 networks are
+// usually too large to be scanned, so the agent probes the VIPs as /128 networks instead.
+func freeIPv6AddressesSet(network string, unmarshaled models.FreeNetworksAddresses, prefix *string) (IPSet, error) {
+	_, ipnet, err := net.ParseCIDR(network)
+	if err != nil {
+		return nil, err
+	}
+	var ret IPSet
+	for _, f := range unmarshaled {
+		probed, _, err := net.ParseCIDR(f.Network)
+		if err != nil || !ipnet.Contains(probed) {
+			continue
+		}
+		if ret == nil {
+			ret = make(IPSet)
+		}
+		for _, a := range f.FreeIPV6Addresses {
+			if prefix == nil || strings.HasPrefix(a.String(), *prefix) {
+				ret.Add(a.String())
+			}
+		}
+	}
+	if ret == nil {
+		return nil, errors.Errorf("No network %s found", network)
+	}
+	return ret, nil
+}
+
 func MakeFreeAddressesSet(hosts []*models.Host, network string, prefix *string, log logrus.FieldLogger) IPSet {
 	var (
 		availableFreeAddresses []string
@@ -652,8 +719,7 @@ func MakeFreeAddressesSet(hosts []*models.Host, network string, prefix *string,
 func IpInFreeList(hosts []*models.Host, vipIPStr, network string, log logrus.FieldLogger) models.VipVerification {
 	freeSet := MakeFreeAddressesSet(hosts, network, nil, log)
 	if len(freeSet) > 0 {
-		_, isFree := freeSet[strfmt.IPv4(vipIPStr)]
-		if isFree {
+		if freeSet.Contains(vipIPStr) {
 			return models.VipVerificationSucceeded
 		}
 		return models.VipVerificationFailed
@@ -218,6 +218,56 @@ var _ = Describe("inventory", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("The machine network range is too small for the cluster"))
 		})
+		It("IPv6 free", func() {
+			hosts := []*models.Host{
+				{
+					FreeAddresses: "[{\"network\":\"1001:db8::64/128\",\"free_ipv6_addresses\":[\"1001:db8::64\"]}," +
+						"{\"network\":\"1001:db8:0:0::65/128\",\"free_ipv6_addresses\":[\"1001:db8:0:0::65\"]}]",
+				},
+			}
+			Expect(VerifyVips(hosts, "1001:db8:0::/120", "1001:db8::64", "1001:db8::65", log)).To(Succeed())
+		})
+		It("IPv6 not free", func() {
+			hosts := []*models.Host{
+				{
+					FreeAddresses: "[{\"network\":\"1001:db8::64/128\",\"free_ipv6_addresses\":[]}," +
+						"{\"network\":\"1001:db8::65/128\",\"free_ipv6_addresses\":[\"1001:db8::65\"]}]",
+				},
+			}
+			err := VerifyVips(hosts, "1001:db8::/120", "1001:db8::64", "1001:db8::65", log)
+			Expect(err).To(MatchError("api-vip <1001:db8::64> is already in use in cidr 1001:db8::/120"))
+		})
+		It("IPv6 same vips in different forms", func() {
+			err := VerifyVips(nil, "1001:db8::/64", "1001:db8::64", "1001:db8:0:0::64", log)
+			Expect(err).To(MatchError(ContainSubstring("The IP address \"1001:db8::64\" appears both in apiVIPs and ingressVIPs")))
+		})
+		It("vip assigned to a host", func() {
+			hosts := []*models.Host{{
+				RequestedHostname: "master-0",
+				Inventory:         createInventory(addIPv6Addresses(createInterface("1.2.5.7/23"), "1001:db8::64/64")),
+			}}
+			err := VerifyVips(hosts, "1001:db8::/64", "1001:db8::64", "1001:db8::65", log)
+			Expect(err).To(MatchError("api-vip <1001:db8::64> is already assigned to host master-0"))
+			err = VerifyVips(hosts, primaryMachineCidr, "1.2.5.6", "1.2.5.7", log)
+			Expect(err).To(MatchError("ingress-vip <1.2.5.7> is already assigned to host master-0"))
+		})
+		It("large IPv6 machine network isn't too small", func() {
+			Expect(isMachineNetworkCidrBigEnough(make([]*models.Host, 5), "1001:db8::/64", log)).To(BeTrue())
+		})
+	})
+
+	Context("GetMachineCidrForVip", func() {
+		It("returns the machine network of the family of the vip", func() {
+			cluster := &common.Cluster{Cluster: models.Cluster{MachineNetworks: []*models.MachineNetwork{
+				{Cidr: "1001:db8::/64"},
+				{Cidr: "1.2.4.0/23"},
+			}}}
+			Expect(GetMachineCidrForVip(cluster, 0, "1001:db8::64")).To(Equal("1001:db8::/64"))
+			Expect(GetMachineCidrForVip(cluster, 1, "1.2.5.6")).To(Equal("1.2.4.0/23"))
+			Expect(GetMachineCidrForVip(cluster, 0, "1.2.5.6")).To(Equal("1.2.4.0/23"))
+			Expect(GetMachineCidrForVip(cluster, 1, "1001:db8::64")).To(Equal("1001:db8::/64"))
+			Expect(GetMachineCidrForVip(cluster, 2, "1.2.5.6")).To(BeEmpty())
+		})
 	})
 
 	Context("GetInventoryNetworks", func() {
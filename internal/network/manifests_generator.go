@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -32,6 +33,7 @@ type ManifestsGeneratorAPI interface {
 	AddSchedulableMastersManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddDiskEncryptionManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	AddNicReapply(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
+	AddSecondaryNetworksManifests(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error
 	IsSNODNSMasqEnabled() bool
 }
 
@@ -603,6 +605,33 @@ func (m *ManifestsGenerator) AddNicReapply(ctx context.Context, log logrus.Field
 	return nil
 }
 
+func (m *ManifestsGenerator) AddSecondaryNetworksManifests(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
+	policies, err := ParseSecondaryNetworks(c.SecondaryNetworks)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse secondary network policies")
+		return err
+	}
+	for _, policy := range policies {
+		manifests, err := renderSecondaryNetworkPolicy(policy, log)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to render secondary network policy %s", swag.StringValue(policy.Name))
+			return err
+		}
+		filenames := make([]string, 0, len(manifests))
+		for filename := range manifests {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+		for _, filename := range filenames {
+			if err := m.createManifests(ctx, c, filename, manifests[filename]); err != nil {
+				log.WithError(err).Errorf("Failed to create secondary network manifest %s", filename)
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // NewConfig returns network config if env vars can be parsed
 func NewConfig() (*Config, error) {
 	networkCfg := Config{}
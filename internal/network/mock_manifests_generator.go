@@ -106,6 +106,20 @@ func (mr *MockManifestsGeneratorAPIMockRecorder) AddSchedulableMastersManifest(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSchedulableMastersManifest", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).AddSchedulableMastersManifest), ctx, log, c)
 }
 
+// AddSecondaryNetworksManifests mocks base method.
+func (m *MockManifestsGeneratorAPI) AddSecondaryNetworksManifests(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecondaryNetworksManifests", ctx, log, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecondaryNetworksManifests indicates an expected call of AddSecondaryNetworksManifests.
+func (mr *MockManifestsGeneratorAPIMockRecorder) AddSecondaryNetworksManifests(ctx, log, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecondaryNetworksManifests", reflect.TypeOf((*MockManifestsGeneratorAPI)(nil).AddSecondaryNetworksManifests), ctx, log, c)
+}
+
 // AddTelemeterManifest mocks base method.
 func (m *MockManifestsGeneratorAPI) AddTelemeterManifest(ctx context.Context, log logrus.FieldLogger, c *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,38 @@
+package network
+
+import (
+	"net"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// GetMachineNetworkInterface returns the interface of the inventory that has an address in one of the machine
+// networks of the cluster, or nil if there is no such interface
+func GetMachineNetworkInterface(log logrus.FieldLogger, cluster *common.Cluster, inventory *models.Inventory) *models.Interface {
+	if inventory == nil {
+		return nil
+	}
+	for _, intf := range inventory.Interfaces {
+		if IsInterfaceInPrimaryMachineNetCidr(log, cluster, intf) {
+			return intf
+		}
+	}
+	return nil
+}
+
+// IsIPInMachineNetworks returns true if the IP address is in one of the machine networks of the cluster
+func IsIPInMachineNetworks(cluster *common.Cluster, ipAddress string) bool {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return false
+	}
+	for _, machineNetwork := range cluster.MachineNetworks {
+		_, ipNet, err := net.ParseCIDR(string(machineNetwork.Cidr))
+		if err == nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
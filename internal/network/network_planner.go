@@ -0,0 +1,352 @@
+package network
+
+import (
+	"encoding/json"
+	"math/big"
+	"net"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// maxPlanCandidates bounds the number of subnets of a size that are tried in a private range
+const maxPlanCandidates = 4096
+
+// PlanDefaults are the networks that are suggested first, when they don't overlap the avoided networks
+type PlanDefaults struct {
+	ClusterNetworkCidr           string
+	ClusterNetworkHostPrefix     int64
+	ClusterNetworkCidrIPv6       string
+	ClusterNetworkHostPrefixIPv6 int64
+	ServiceNetworkCidr           string
+	ServiceNetworkCidrIPv6       string
+}
+
+type clusterNetworkSize struct {
+	prefixLength int
+	hostPrefix   int64
+}
+
+var (
+	// The IPv4 private ranges, 100.64.0.0/10 isn't used as OVN-Kubernetes uses some of its subnets internally
+	ipv4PrivateRanges = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+	ipv4ServiceRanges = []string{"172.16.0.0/12", "10.0.0.0/8", "192.168.0.0/16"}
+	ipv6PrivateRanges = []string{"fd00::/8"}
+
+	ipv4ClusterNetworkSizes = []clusterNetworkSize{{14, 23}, {16, 23}, {18, 24}, {20, 25}}
+	ipv6ClusterNetworkSizes = []clusterNetworkSize{{48, 64}, {56, 64}}
+	ipv4ServiceNetworkSizes = []int{16, 18, 20}
+	ipv6ServiceNetworkSizes = []int{112, 116}
+)
+
+// GetHostAvoidedNetworks returns the networks of the addresses and the destinations of the routes of the hosts. The
+// routes of the inventory have no prefix length, their destination is avoided as a single address.
+func GetHostAvoidedNetworks(hosts []*models.Host, log logrus.FieldLogger) []*models.AvoidedNetwork {
+	ret := make([]*models.AvoidedNetwork, 0)
+	for _, cidr := range sortedStrings(GetInventoryNetworks(hosts, log)) {
+		ret = append(ret, &models.AvoidedNetwork{Cidr: models.Subnet(cidr), Source: models.AvoidedNetworkSourceHostAddress})
+	}
+	routes := make(map[string]bool)
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		var inventory models.Inventory
+		if err := json.Unmarshal([]byte(h.Inventory), &inventory); err != nil {
+			log.WithError(err).Warnf("Unmarshal inventory %s", h.Inventory)
+			continue
+		}
+		for _, route := range inventory.Routes {
+			if cidr := routeDestinationNetwork(route); cidr != "" {
+				routes[cidr] = true
+			}
+		}
+	}
+	for _, cidr := range sortedStrings(keys(routes)) {
+		ret = append(ret, &models.AvoidedNetwork{Cidr: models.Subnet(cidr), Source: models.AvoidedNetworkSourceHostRoute})
+	}
+	return ret
+}
+
+func routeDestinationNetwork(route *models.Route) string {
+	if isDefault, err := IsDefaultRoute(route); err != nil || isDefault {
+		return ""
+	}
+	if _, ipnet, err := net.ParseCIDR(route.Destination); err == nil {
+		return ipnet.String()
+	}
+	ip := net.ParseIP(route.Destination)
+	if ip == nil || ip.IsUnspecified() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsMulticast() {
+		return ""
+	}
+	if ip.To4() != nil {
+		return (&net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}).String()
+	}
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}).String()
+}
+
+// GetHostsCommonMachineNetworks returns, for each address family, the first network that all the hosts have an
+// address in
+func GetHostsCommonMachineNetworks(hosts []*models.Host, log logrus.FieldLogger) []*models.MachineNetwork {
+	var commonNetworks map[string]bool
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		hostNetworks := GetInventoryNetworks([]*models.Host{h}, log)
+		if commonNetworks == nil {
+			commonNetworks = make(map[string]bool)
+			for _, n := range hostNetworks {
+				commonNetworks[n] = true
+			}
+			continue
+		}
+		shared := make(map[string]bool)
+		for _, n := range hostNetworks {
+			if commonNetworks[n] {
+				shared[n] = true
+			}
+		}
+		commonNetworks = shared
+	}
+	ret := make([]*models.MachineNetwork, 0)
+	var v4net, v6net string
+	for _, n := range sortedStrings(keys(commonNetworks)) {
+		if IsIPV4CIDR(n) && v4net == "" {
+			v4net = n
+			ret = append(ret, &models.MachineNetwork{Cidr: models.Subnet(n)})
+		}
+		if IsIPv6CIDR(n) && v6net == "" {
+			v6net = n
+			ret = append(ret, &models.MachineNetwork{Cidr: models.Subnet(n)})
+		}
+	}
+	return ret
+}
+
+// GetClusterAvoidedNetworks returns the machine, cluster and service networks of the clusters
+func GetClusterAvoidedNetworks(clusters []*common.Cluster) []*models.AvoidedNetwork {
+	ret := make([]*models.AvoidedNetwork, 0)
+	for _, cluster := range clusters {
+		cidrs := append(append(GetMachineNetworkCidrs(cluster), GetClusterNetworkCidrs(cluster)...), GetServiceNetworkCidrs(cluster)...)
+		for _, cidr := range cidrs {
+			ret = append(ret, &models.AvoidedNetwork{
+				Cidr:      models.Subnet(cidr),
+				Source:    models.AvoidedNetworkSourceCluster,
+				ClusterID: clusterID(cluster),
+			})
+		}
+	}
+	return ret
+}
+
+func clusterID(cluster *common.Cluster) strfmt.UUID {
+	if cluster.ID == nil {
+		return ""
+	}
+	return *cluster.ID
+}
+
+// SuggestNetworks suggests a cluster and a service network for each address family of the stack type. The default
+// networks are suggested when they don't overlap the machine networks and the avoided networks, otherwise the first
+// free subnet of the private ranges, trying smaller subnets when there is no free subnet of the default size.
+func SuggestNetworks(stackType string, hostCount int, machineNetworks []*models.MachineNetwork, avoided []*models.AvoidedNetwork,
+	defaults PlanDefaults) (*models.NetworksSuggestion, error) {
+	taken := make([]*net.IPNet, 0, len(avoided)+len(machineNetworks))
+	for _, cidr := range append(machineNetworkCidrs(machineNetworks), avoidedCidrs(avoided)...) {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid network %s", cidr)
+		}
+		taken = append(taken, ipnet)
+	}
+
+	suggestion := &models.NetworksSuggestion{
+		StackType:       stackType,
+		MachineNetworks: machineNetworks,
+		ClusterNetworks: []*models.ClusterNetwork{},
+		ServiceNetworks: []*models.ServiceNetwork{},
+		AvoidedNetworks: avoided,
+	}
+	type familyPlan struct {
+		defaultCluster    string
+		defaultHostPrefix int64
+		clusterSizes      []clusterNetworkSize
+		clusterRanges     []string
+		defaultService    string
+		serviceSizes      []int
+		serviceRanges     []string
+	}
+	var families []familyPlan
+	ipv4 := familyPlan{defaults.ClusterNetworkCidr, defaults.ClusterNetworkHostPrefix, ipv4ClusterNetworkSizes, ipv4PrivateRanges,
+		defaults.ServiceNetworkCidr, ipv4ServiceNetworkSizes, ipv4ServiceRanges}
+	ipv6 := familyPlan{defaults.ClusterNetworkCidrIPv6, defaults.ClusterNetworkHostPrefixIPv6, ipv6ClusterNetworkSizes, ipv6PrivateRanges,
+		defaults.ServiceNetworkCidrIPv6, ipv6ServiceNetworkSizes, ipv6PrivateRanges}
+	switch stackType {
+	case models.NetworksSuggestionStackTypeIPV4:
+		families = []familyPlan{ipv4}
+	case models.NetworksSuggestionStackTypeIPV6:
+		families = []familyPlan{ipv6}
+	case models.NetworksSuggestionStackTypeDualStack:
+		families = []familyPlan{ipv4, ipv6}
+	default:
+		return nil, errors.Errorf("unknown stack type %s", stackType)
+	}
+
+	for _, family := range families {
+		clusterNetwork, hostPrefix, err := suggestClusterNetwork(family.defaultCluster, family.defaultHostPrefix, family.clusterSizes,
+			family.clusterRanges, hostCount, taken)
+		if err != nil {
+			return nil, err
+		}
+		taken = append(taken, clusterNetwork)
+		suggestion.ClusterNetworks = append(suggestion.ClusterNetworks,
+			&models.ClusterNetwork{Cidr: models.Subnet(clusterNetwork.String()), HostPrefix: hostPrefix})
+
+		serviceSizes := make([]clusterNetworkSize, 0, len(family.serviceSizes))
+		for _, size := range family.serviceSizes {
+			serviceSizes = append(serviceSizes, clusterNetworkSize{prefixLength: size})
+		}
+		serviceNetwork, err := suggestNetwork(family.defaultService, serviceSizes, family.serviceRanges, taken, func(*net.IPNet, int64) bool { return true })
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to suggest a service network")
+		}
+		taken = append(taken, serviceNetwork)
+		suggestion.ServiceNetworks = append(suggestion.ServiceNetworks, &models.ServiceNetwork{Cidr: models.Subnet(serviceNetwork.String())})
+	}
+	return suggestion, nil
+}
+
+func suggestClusterNetwork(defaultCidr string, defaultHostPrefix int64, sizes []clusterNetworkSize, ranges []string, hostCount int,
+	taken []*net.IPNet) (*net.IPNet, int64, error) {
+	bigEnough := func(cidr *net.IPNet, hostPrefix int64) bool {
+		return VerifyClusterCidrSize(int(hostPrefix), cidr.String(), hostCount) == nil
+	}
+	if _, defaultNetwork, err := net.ParseCIDR(defaultCidr); err == nil && !overlapsAny(defaultNetwork, taken) && bigEnough(defaultNetwork, defaultHostPrefix) {
+		return defaultNetwork, defaultHostPrefix, nil
+	}
+	for _, size := range sizes {
+		candidate, err := suggestNetwork("", []clusterNetworkSize{size}, ranges, taken, bigEnough)
+		if err == nil {
+			return candidate, size.hostPrefix, nil
+		}
+	}
+	return nil, 0, errors.Errorf("failed to suggest a cluster network for %d hosts, the private ranges %v are used", hostCount, ranges)
+}
+
+// suggestNetwork returns the default network when it's free, or the first free subnet of the sizes in the ranges
+func suggestNetwork(defaultCidr string, sizes []clusterNetworkSize, ranges []string, taken []*net.IPNet,
+	accept func(*net.IPNet, int64) bool) (*net.IPNet, error) {
+	if _, defaultNetwork, err := net.ParseCIDR(defaultCidr); err == nil && !overlapsAny(defaultNetwork, taken) {
+		return defaultNetwork, nil
+	}
+	for _, size := range sizes {
+		for _, r := range ranges {
+			_, privateRange, err := net.ParseCIDR(r)
+			if err != nil {
+				return nil, err
+			}
+			rangePrefix, bits := privateRange.Mask.Size()
+			if size.prefixLength < rangePrefix {
+				continue
+			}
+			step := new(big.Int).Lsh(big.NewInt(1), uint(bits-size.prefixLength))
+			current := ipToInt(privateRange.IP)
+			for i := 0; i < maxPlanCandidates; i++ {
+				candidate := &net.IPNet{IP: intToIP(current, bits), Mask: net.CIDRMask(size.prefixLength, bits)}
+				if !privateRange.Contains(candidate.IP) {
+					break
+				}
+				overlapping := overlappingNetwork(candidate, taken)
+				if overlapping == nil && accept(candidate, size.hostPrefix) {
+					return candidate, nil
+				}
+				current.Add(current, step)
+				if overlapping != nil {
+					// Skip the subnets of the overlapping network, it can be much larger than the candidates
+					next := networkEnd(overlapping)
+					next.Add(next, big.NewInt(1))
+					roundUp(next, step)
+					if next.Cmp(current) > 0 {
+						current = next
+					}
+				}
+			}
+		}
+	}
+	return nil, errors.Errorf("no free network in %v", ranges)
+}
+
+func overlapsAny(candidate *net.IPNet, taken []*net.IPNet) bool {
+	return overlappingNetwork(candidate, taken) != nil
+}
+
+func overlappingNetwork(candidate *net.IPNet, taken []*net.IPNet) *net.IPNet {
+	for _, t := range taken {
+		if t.Contains(candidate.IP) || candidate.Contains(t.IP) {
+			return t
+		}
+	}
+	return nil
+}
+
+// networkEnd returns the last address of the network
+func networkEnd(ipnet *net.IPNet) *big.Int {
+	ones, bits := ipnet.Mask.Size()
+	size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	return size.Add(size, ipToInt(ipnet.IP)).Sub(size, big.NewInt(1))
+}
+
+// roundUp rounds the value up to a multiple of the step
+func roundUp(value, step *big.Int) {
+	remainder := new(big.Int).Mod(value, step)
+	if remainder.Sign() != 0 {
+		value.Add(value, step).Sub(value, remainder)
+	}
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		return new(big.Int).SetBytes(v4)
+	}
+	return new(big.Int).SetBytes(ip.To16())
+}
+
+func intToIP(value *big.Int, bits int) net.IP {
+	ip := make(net.IP, bits/8)
+	value.FillBytes(ip)
+	return ip
+}
+
+func machineNetworkCidrs(machineNetworks []*models.MachineNetwork) []string {
+	ret := make([]string, 0, len(machineNetworks))
+	for _, n := range machineNetworks {
+		ret = append(ret, string(n.Cidr))
+	}
+	return ret
+}
+
+func avoidedCidrs(avoided []*models.AvoidedNetwork) []string {
+	ret := make([]string, 0, len(avoided))
+	for _, a := range avoided {
+		ret = append(ret, string(a.Cidr))
+	}
+	return ret
+}
+
+func keys(m map[string]bool) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	return ret
+}
+
+func sortedStrings(s []string) []string {
+	sort.Strings(s)
+	return s
+}
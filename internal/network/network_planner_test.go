@@ -0,0 +1,159 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("network planner", func() {
+	var (
+		log      = logrus.New()
+		defaults = PlanDefaults{
+			ClusterNetworkCidr:           "10.128.0.0/14",
+			ClusterNetworkHostPrefix:     23,
+			ClusterNetworkCidrIPv6:       "fd01::/48",
+			ClusterNetworkHostPrefixIPv6: 64,
+			ServiceNetworkCidr:           "172.30.0.0/16",
+			ServiceNetworkCidrIPv6:       "fd02::/112",
+		}
+	)
+
+	avoid := func(source string, cidrs ...string) []*models.AvoidedNetwork {
+		ret := make([]*models.AvoidedNetwork, 0)
+		for _, cidr := range cidrs {
+			ret = append(ret, &models.AvoidedNetwork{Cidr: models.Subnet(cidr), Source: source})
+		}
+		return ret
+	}
+
+	clusterNetworks := func(suggestion *models.NetworksSuggestion) []string {
+		ret := make([]string, 0)
+		for _, n := range suggestion.ClusterNetworks {
+			ret = append(ret, string(n.Cidr))
+		}
+		return ret
+	}
+
+	serviceNetworks := func(suggestion *models.NetworksSuggestion) []string {
+		ret := make([]string, 0)
+		for _, n := range suggestion.ServiceNetworks {
+			ret = append(ret, string(n.Cidr))
+		}
+		return ret
+	}
+
+	Context("SuggestNetworks", func() {
+		It("suggests the default networks when they are free", func() {
+			suggestion, err := SuggestNetworks(models.NetworksSuggestionStackTypeIPV4, 3,
+				CreateMachineNetworksArray("192.168.127.0/24"), avoid(models.AvoidedNetworkSourceHostAddress, "192.168.127.0/24"), defaults)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(suggestion.StackType).To(Equal(models.NetworksSuggestionStackTypeIPV4))
+			Expect(suggestion.ClusterNetworks).To(Equal([]*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}}))
+			Expect(serviceNetworks(suggestion)).To(Equal([]string{"172.30.0.0/16"}))
+			Expect(suggestion.MachineNetworks).To(HaveLen(1))
+			Expect(suggestion.AvoidedNetworks).To(HaveLen(1))
+		})
+
+		It("avoids the networks that overlap the defaults", func() {
+			avoided := append(avoid(models.AvoidedNetworkSourceHostAddress, "10.130.4.0/24", "10.2.0.0/16"),
+				avoid(models.AvoidedNetworkSourceHostRoute, "172.30.10.1/32")...)
+			suggestion, err := SuggestNetworks(models.NetworksSuggestionStackTypeIPV4, 3, nil, avoided, defaults)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clusterNetworks(suggestion)).To(Equal([]string{"10.4.0.0/14"}))
+			Expect(serviceNetworks(suggestion)).To(Equal([]string{"172.16.0.0/16"}))
+		})
+
+		It("uses the other private ranges when the reserved networks take 10.0.0.0/8", func() {
+			avoided := avoid(models.AvoidedNetworkSourceReserved, "10.0.0.0/8", "172.16.0.0/16")
+			suggestion, err := SuggestNetworks(models.NetworksSuggestionStackTypeIPV4, 3, nil, avoided, defaults)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clusterNetworks(suggestion)).To(Equal([]string{"172.20.0.0/14"}))
+			Expect(serviceNetworks(suggestion)).To(Equal([]string{"172.30.0.0/16"}))
+		})
+
+		It("suggests smaller cluster networks when there is no free /14", func() {
+			avoided := avoid(models.AvoidedNetworkSourceCluster, "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/17")
+			suggestion, err := SuggestNetworks(models.NetworksSuggestionStackTypeIPV4, 3, nil, avoided, defaults)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(suggestion.ClusterNetworks).To(Equal([]*models.ClusterNetwork{{Cidr: "192.168.128.0/18", HostPrefix: 24}}))
+			Expect(serviceNetworks(suggestion)).To(Equal([]string{"192.168.192.0/18"}))
+		})
+
+		It("fails when the private ranges are used", func() {
+			avoided := avoid(models.AvoidedNetworkSourceReserved, "10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16")
+			_, err := SuggestNetworks(models.NetworksSuggestionStackTypeIPV4, 3, nil, avoided, defaults)
+			Expect(err).To(MatchError(ContainSubstring("failed to suggest a cluster network for 3 hosts")))
+		})
+
+		It("suggests networks for both families of dual-stack", func() {
+			avoided := avoid(models.AvoidedNetworkSourceCluster, "fd01::/48", "fd02::/112")
+			suggestion, err := SuggestNetworks(models.NetworksSuggestionStackTypeDualStack, 5, nil, avoided, defaults)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(suggestion.ClusterNetworks).To(Equal([]*models.ClusterNetwork{
+				{Cidr: "10.128.0.0/14", HostPrefix: 23},
+				{Cidr: "fd00::/48", HostPrefix: 64},
+			}))
+			Expect(serviceNetworks(suggestion)).To(Equal([]string{"172.30.0.0/16", "fd00:0:1::/112"}))
+		})
+
+		It("rejects invalid networks", func() {
+			_, err := SuggestNetworks(models.NetworksSuggestionStackTypeIPV4, 3, nil, avoid(models.AvoidedNetworkSourceReserved, "10.0.0.0"), defaults)
+			Expect(err).To(HaveOccurred())
+			_, err = SuggestNetworks("ipv5", 3, nil, nil, defaults)
+			Expect(err).To(MatchError("unknown stack type ipv5"))
+		})
+	})
+
+	Context("avoided networks", func() {
+		It("returns the networks of the addresses and the routes of the hosts", func() {
+			inventory := models.Inventory{
+				Interfaces: []*models.Interface{addIPv6Addresses(createInterface("10.128.1.5/24"), "fd00:10::5/64")},
+				Routes: []*models.Route{
+					{Destination: "0.0.0.0", Gateway: "10.128.1.1", Family: 2},
+					{Destination: "172.30.5.0", Gateway: "10.128.1.254", Family: 2},
+					{Destination: "fe80::", Family: 10},
+				},
+			}
+			data, err := json.Marshal(&inventory)
+			Expect(err).ToNot(HaveOccurred())
+			avoided := GetHostAvoidedNetworks(createHosts(string(data), createInventory(createInterface("10.128.1.6/24"))), log)
+			Expect(avoided).To(Equal([]*models.AvoidedNetwork{
+				{Cidr: "10.128.1.0/24", Source: models.AvoidedNetworkSourceHostAddress},
+				{Cidr: "fd00:10::/64", Source: models.AvoidedNetworkSourceHostAddress},
+				{Cidr: "172.30.5.0/32", Source: models.AvoidedNetworkSourceHostRoute},
+			}))
+		})
+
+		It("returns the networks of the clusters", func() {
+			id := strfmt.UUID("8d8e2b8c-7a4b-4f6a-9d8f-3a1f3c2b1a00")
+			cluster := &common.Cluster{Cluster: models.Cluster{
+				ID:              &id,
+				MachineNetworks: CreateMachineNetworksArray("192.168.1.0/24"),
+				ClusterNetworks: []*models.ClusterNetwork{{Cidr: "10.128.0.0/14", HostPrefix: 23}},
+				ServiceNetworks: []*models.ServiceNetwork{{Cidr: "172.30.0.0/16"}},
+			}}
+			Expect(GetClusterAvoidedNetworks([]*common.Cluster{cluster})).To(Equal([]*models.AvoidedNetwork{
+				{Cidr: "192.168.1.0/24", Source: models.AvoidedNetworkSourceCluster, ClusterID: id},
+				{Cidr: "10.128.0.0/14", Source: models.AvoidedNetworkSourceCluster, ClusterID: id},
+				{Cidr: "172.30.0.0/16", Source: models.AvoidedNetworkSourceCluster, ClusterID: id},
+			}))
+		})
+
+		It("returns the networks that all the hosts are connected to", func() {
+			hosts := createHosts(
+				createInventory(createInterface("10.0.0.5/24"), addIPv6Addresses(createInterface("192.168.1.5/24"), "fd00::5/64")),
+				createInventory(addIPv6Addresses(createInterface("192.168.1.6/24"), "fd00::6/64")),
+			)
+			Expect(GetHostsCommonMachineNetworks(hosts, log)).To(Equal([]*models.MachineNetwork{
+				{Cidr: "192.168.1.0/24"},
+				{Cidr: "fd00::/64"},
+			}))
+		})
+	})
+})
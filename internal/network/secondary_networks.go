@@ -0,0 +1,236 @@
+package network
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// nmstateInterface is the part of an NMState interface that references other interfaces
+type nmstateInterface struct {
+	Name            string `json:"name"`
+	Type            string `json:"type"`
+	LinkAggregation *struct {
+		Port   []string `json:"port"`
+		Slaves []string `json:"slaves"`
+	} `json:"link-aggregation"`
+	Vlan *struct {
+		BaseIface string `json:"base-iface"`
+	} `json:"vlan"`
+	Bridge *struct {
+		Port []struct {
+			Name string `json:"name"`
+		} `json:"port"`
+	} `json:"bridge"`
+}
+
+type nmstateState struct {
+	Interfaces []nmstateInterface `json:"interfaces"`
+}
+
+// ParseSecondaryNetworks returns the secondary network policies stored in the cluster
+func ParseSecondaryNetworks(secondaryNetworks string) (models.SecondaryNetworkPolicies, error) {
+	policies := models.SecondaryNetworkPolicies{}
+	if secondaryNetworks == "" {
+		return policies, nil
+	}
+	if err := json.Unmarshal([]byte(secondaryNetworks), &policies); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the secondary network policies")
+	}
+	return policies, nil
+}
+
+// SecondaryNetworkPolicyRole returns the role of the hosts that the policy applies to
+func SecondaryNetworkPolicyRole(policy *models.SecondaryNetworkPolicy) string {
+	if swag.StringValue(policy.Role) == "" {
+		return models.SecondaryNetworkPolicyRoleAll
+	}
+	return swag.StringValue(policy.Role)
+}
+
+func parseDesiredState(policy *models.SecondaryNetworkPolicy) (*nmstateState, error) {
+	var state nmstateState
+	if err := yaml.Unmarshal([]byte(swag.StringValue(policy.DesiredState)), &state); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the desired state of secondary network policy %s", swag.StringValue(policy.Name))
+	}
+	if len(state.Interfaces) == 0 {
+		return nil, errors.Errorf("the desired state of secondary network policy %s has no interfaces", swag.StringValue(policy.Name))
+	}
+	for _, intf := range state.Interfaces {
+		if intf.Name == "" {
+			return nil, errors.Errorf("the desired state of secondary network policy %s has an interface without a name", swag.StringValue(policy.Name))
+		}
+	}
+	return &state, nil
+}
+
+// ValidateSecondaryNetworkPolicies verifies that the names of the policies are unique and that their desired states
+// are valid NMState configurations with interfaces
+func ValidateSecondaryNetworkPolicies(policies models.SecondaryNetworkPolicies) error {
+	names := make(map[string]bool)
+	for _, policy := range policies {
+		if policy == nil {
+			return errors.New("secondary network policies can't be null")
+		}
+		name := swag.StringValue(policy.Name)
+		if names[name] {
+			return errors.Errorf("secondary network policy %s is defined more than once", name)
+		}
+		names[name] = true
+		if _, err := parseDesiredState(policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SecondaryNetworkPolicySelectsHost returns true if the policy applies to the host, according to its role
+func SecondaryNetworkPolicySelectsHost(policy *models.SecondaryNetworkPolicy, host *models.Host) bool {
+	role := SecondaryNetworkPolicyRole(policy)
+	if role == models.SecondaryNetworkPolicyRoleAll {
+		return true
+	}
+	hostRole := common.GetEffectiveRole(host)
+	if hostRole == models.HostRoleBootstrap {
+		hostRole = models.HostRoleMaster
+	}
+	return string(hostRole) == role
+}
+
+// ValidateSecondaryNetworksForHost verifies that the interfaces that the policies applied to the host reference
+// exist in its inventory, and that they don't reconfigure the interface of the machine network. It returns the
+// problems that were found.
+func ValidateSecondaryNetworksForHost(log logrus.FieldLogger, cluster *common.Cluster, host *models.Host, inventory *models.Inventory,
+	policies models.SecondaryNetworkPolicies) []string {
+	hostInterfaces := make(map[string]bool)
+	for _, intf := range inventory.Interfaces {
+		hostInterfaces[intf.Name] = true
+	}
+	machineNetworkInterface := ""
+	if nic := GetMachineNetworkInterface(log, cluster, inventory); nic != nil {
+		machineNetworkInterface = nic.Name
+	}
+
+	var problems []string
+	for _, policy := range policies {
+		if !SecondaryNetworkPolicySelectsHost(policy, host) {
+			continue
+		}
+		name := swag.StringValue(policy.Name)
+		state, err := parseDesiredState(policy)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		defined := make(map[string]bool)
+		for _, intf := range state.Interfaces {
+			defined[intf.Name] = true
+		}
+		var missing []string
+		usesMachineNetwork := false
+		for _, intf := range state.Interfaces {
+			var referenced []string
+			if intf.Type == "ethernet" {
+				referenced = append(referenced, intf.Name)
+			}
+			if intf.LinkAggregation != nil {
+				referenced = append(referenced, intf.LinkAggregation.Port...)
+				referenced = append(referenced, intf.LinkAggregation.Slaves...)
+			}
+			if intf.Bridge != nil {
+				for _, port := range intf.Bridge.Port {
+					referenced = append(referenced, port.Name)
+				}
+			}
+			for _, ref := range referenced {
+				if ref == machineNetworkInterface {
+					usesMachineNetwork = true
+				}
+			}
+			// A VLAN on top of the interface of the machine network doesn't reconfigure it
+			if intf.Vlan != nil && intf.Vlan.BaseIface != "" {
+				referenced = append(referenced, intf.Vlan.BaseIface)
+			}
+			for _, ref := range referenced {
+				if !hostInterfaces[ref] && !(defined[ref] && ref != intf.Name) {
+					missing = append(missing, ref)
+				}
+			}
+		}
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("secondary network policy %s references interfaces that the host doesn't have: %s",
+				name, strings.Join(uniqueSorted(missing), ", ")))
+		}
+		if usesMachineNetwork {
+			problems = append(problems, fmt.Sprintf("secondary network policy %s reconfigures interface %s of the machine network, configure it with the static network config of the infra-env instead",
+				name, machineNetworkInterface))
+		}
+	}
+	return problems
+}
+
+func uniqueSorted(values []string) []string {
+	set := make(map[string]bool)
+	ret := make([]string, 0, len(values))
+	for _, v := range values {
+		if !set[v] {
+			set[v] = true
+			ret = append(ret, v)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+const secondaryNetworkMachineConfigManifest = `
+apiVersion: machineconfiguration.openshift.io/v1
+kind: MachineConfig
+metadata:
+  labels:
+    machineconfiguration.openshift.io/role: {{.ROLE}}
+  name: 50-{{.ROLE}}s-secondary-network-{{.NAME}}
+spec:
+  config:
+    ignition:
+      version: 3.2.0
+    storage:
+      files:
+      - contents:
+          source: data:text/plain;charset=utf-8;base64,{{.DESIRED_STATE}}
+        mode: 384
+        path: /etc/nmstate/{{.NAME}}.yml
+        overwrite: true
+`
+
+// renderSecondaryNetworkPolicy renders the manifests of the policy by file name: a MachineConfig per role that writes
+// the desired state to /etc/nmstate, where nmstate.service applies it on boot. The policies are applied without the
+// Kubernetes NMState operator, which the service doesn't install.
+func renderSecondaryNetworkPolicy(policy *models.SecondaryNetworkPolicy, log logrus.FieldLogger) (map[string][]byte, error) {
+	name := swag.StringValue(policy.Name)
+	roles := []string{SecondaryNetworkPolicyRole(policy)}
+	if roles[0] == models.SecondaryNetworkPolicyRoleAll {
+		roles = []string{models.SecondaryNetworkPolicyRoleMaster, models.SecondaryNetworkPolicyRoleWorker}
+	}
+	ret := make(map[string][]byte)
+	for _, role := range roles {
+		content, err := fillTemplate(map[string]interface{}{
+			"ROLE":          role,
+			"NAME":          name,
+			"DESIRED_STATE": base64.StdEncoding.EncodeToString([]byte(swag.StringValue(policy.DesiredState))),
+		}, secondaryNetworkMachineConfigManifest, log)
+		if err != nil {
+			return nil, err
+		}
+		ret[fmt.Sprintf("50-%ss-secondary-network-%s.yaml", role, name)] = content
+	}
+	return ret, nil
+}
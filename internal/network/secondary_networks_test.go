@@ -0,0 +1,215 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
+	"github.com/openshift/assisted-service/models"
+	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const bondDesiredState = `interfaces:
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: active-backup
+    port:
+    - eth1
+    - eth2
+- name: bond0.100
+  type: vlan
+  state: up
+  vlan:
+    base-iface: bond0
+    id: 100
+`
+
+var _ = Describe("secondary networks", func() {
+	var (
+		log       = logrus.New()
+		clusterID = strfmt.UUID("11111111-1111-1111-1111-111111111111")
+		cluster   *common.Cluster
+		host      *models.Host
+		inventory *models.Inventory
+	)
+
+	policy := func(name, role, desiredState string) *models.SecondaryNetworkPolicy {
+		return &models.SecondaryNetworkPolicy{
+			Name:         swag.String(name),
+			Role:         swag.String(role),
+			DesiredState: swag.String(desiredState),
+		}
+	}
+
+	BeforeEach(func() {
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			ID:              &clusterID,
+			MachineNetworks: []*models.MachineNetwork{{Cidr: "192.168.1.0/24"}},
+		}}
+		inventory = &models.Inventory{Interfaces: []*models.Interface{
+			{Name: "eth0", IPV4Addresses: []string{"192.168.1.10/24"}},
+			{Name: "eth1"},
+			{Name: "eth2"},
+		}}
+		host = &models.Host{Role: models.HostRoleWorker, NodeLabels: `{"storage":"true"}`}
+	})
+
+	Context("ParseSecondaryNetworks", func() {
+		It("returns no policies for an empty value", func() {
+			policies, err := ParseSecondaryNetworks("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies).To(BeEmpty())
+		})
+
+		It("fails on an invalid value", func() {
+			_, err := ParseSecondaryNetworks("[")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateSecondaryNetworkPolicies", func() {
+		It("accepts valid policies", func() {
+			Expect(ValidateSecondaryNetworkPolicies(models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleWorker, bondDesiredState),
+			})).To(Succeed())
+		})
+
+		It("rejects policies with the same name", func() {
+			err := ValidateSecondaryNetworkPolicies(models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleWorker, bondDesiredState),
+				policy("storage", models.SecondaryNetworkPolicyRoleMaster, bondDesiredState),
+			})
+			Expect(err).To(MatchError("secondary network policy storage is defined more than once"))
+		})
+
+		It("rejects a desired state that isn't YAML", func() {
+			err := ValidateSecondaryNetworkPolicies(models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleAll, "interfaces: ["),
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects a desired state without interfaces", func() {
+			err := ValidateSecondaryNetworkPolicies(models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleAll, "routes: {}"),
+			})
+			Expect(err).To(MatchError("the desired state of secondary network policy storage has no interfaces"))
+		})
+	})
+
+	Context("SecondaryNetworkPolicySelectsHost", func() {
+		It("selects by role", func() {
+			Expect(SecondaryNetworkPolicySelectsHost(policy("p", models.SecondaryNetworkPolicyRoleWorker, bondDesiredState), host)).To(BeTrue())
+			Expect(SecondaryNetworkPolicySelectsHost(policy("p", models.SecondaryNetworkPolicyRoleMaster, bondDesiredState), host)).To(BeFalse())
+			Expect(SecondaryNetworkPolicySelectsHost(policy("p", models.SecondaryNetworkPolicyRoleAll, bondDesiredState), host)).To(BeTrue())
+		})
+
+		It("treats the bootstrap host as a master", func() {
+			host.Role = models.HostRoleBootstrap
+			Expect(SecondaryNetworkPolicySelectsHost(policy("p", models.SecondaryNetworkPolicyRoleMaster, bondDesiredState), host)).To(BeTrue())
+		})
+	})
+
+	Context("ValidateSecondaryNetworksForHost", func() {
+		It("succeeds when the referenced interfaces exist", func() {
+			Expect(ValidateSecondaryNetworksForHost(log, cluster, host, inventory, models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleAll, bondDesiredState),
+			})).To(BeEmpty())
+		})
+
+		It("ignores the policies that don't apply to the host", func() {
+			inventory.Interfaces = inventory.Interfaces[:1]
+			Expect(ValidateSecondaryNetworksForHost(log, cluster, host, inventory, models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleMaster, bondDesiredState),
+			})).To(BeEmpty())
+		})
+
+		It("reports missing interfaces", func() {
+			inventory.Interfaces = inventory.Interfaces[:2]
+			Expect(ValidateSecondaryNetworksForHost(log, cluster, host, inventory, models.SecondaryNetworkPolicies{
+				policy("storage", models.SecondaryNetworkPolicyRoleAll, bondDesiredState),
+			})).To(Equal([]string{"secondary network policy storage references interfaces that the host doesn't have: eth2"}))
+		})
+
+		It("reports a missing SR-IOV physical function", func() {
+			Expect(ValidateSecondaryNetworksForHost(log, cluster, host, inventory, models.SecondaryNetworkPolicies{
+				policy("sriov", models.SecondaryNetworkPolicyRoleAll, "interfaces:\n- name: ens5f0\n  type: ethernet\n  ethernet:\n    sr-iov:\n      total-vfs: 4\n"),
+			})).To(Equal([]string{"secondary network policy sriov references interfaces that the host doesn't have: ens5f0"}))
+		})
+
+		It("allows a VLAN on the interface of the machine network", func() {
+			Expect(ValidateSecondaryNetworksForHost(log, cluster, host, inventory, models.SecondaryNetworkPolicies{
+				policy("vlan", models.SecondaryNetworkPolicyRoleAll, "interfaces:\n- name: eth0.200\n  type: vlan\n  vlan:\n    base-iface: eth0\n    id: 200\n"),
+			})).To(BeEmpty())
+		})
+
+		It("reports policies that reconfigure the interface of the machine network", func() {
+			Expect(ValidateSecondaryNetworksForHost(log, cluster, host, inventory, models.SecondaryNetworkPolicies{
+				policy("bridge", models.SecondaryNetworkPolicyRoleAll, "interfaces:\n- name: br1\n  type: linux-bridge\n  bridge:\n    port:\n    - name: eth0\n"),
+			})).To(Equal([]string{"secondary network policy bridge reconfigures interface eth0 of the machine network, configure it with the static network config of the infra-env instead"}))
+		})
+	})
+
+	Context("AddSecondaryNetworksManifests", func() {
+		var (
+			ctx                   = context.Background()
+			ctrl                  *gomock.Controller
+			manifestsApi          *manifestsapi.MockManifestsAPI
+			manifestsGeneratorApi ManifestsGeneratorAPI
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			manifestsApi = manifestsapi.NewMockManifestsAPI(ctrl)
+			manifestsGeneratorApi = NewManifestsGenerator(manifestsApi, Config{}, nil)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		setPolicies := func(policies ...*models.SecondaryNetworkPolicy) {
+			b, err := json.Marshal(policies)
+			Expect(err).ToNot(HaveOccurred())
+			cluster.SecondaryNetworks = string(b)
+		}
+
+		It("doesn't add manifests without policies", func() {
+			Expect(manifestsGeneratorApi.AddSecondaryNetworksManifests(ctx, log, cluster)).To(Succeed())
+		})
+
+		It("adds a machine config per role", func() {
+			setPolicies(policy("storage", models.SecondaryNetworkPolicyRoleAll, bondDesiredState))
+			var filenames []string
+			manifestsApi.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any(), false).DoAndReturn(
+				func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+					filenames = append(filenames, *params.CreateManifestParams.FileName)
+					content, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(content)).To(ContainSubstring("kind: MachineConfig"))
+					Expect(string(content)).To(ContainSubstring("path: /etc/nmstate/storage.yml"))
+					Expect(string(content)).To(ContainSubstring(base64.StdEncoding.EncodeToString([]byte(bondDesiredState))))
+					return &models.Manifest{}, nil
+				}).Times(2)
+			Expect(manifestsGeneratorApi.AddSecondaryNetworksManifests(ctx, log, cluster)).To(Succeed())
+			Expect(filenames).To(Equal([]string{"50-masters-secondary-network-storage.yaml", "50-workers-secondary-network-storage.yaml"}))
+		})
+
+		It("fails when the manifest can't be created", func() {
+			setPolicies(policy("storage", models.SecondaryNetworkPolicyRoleMaster, bondDesiredState))
+			manifestsApi.EXPECT().CreateClusterManifestInternal(ctx, gomock.Any(), false).Return(nil, errors.New("failed"))
+			Expect(manifestsGeneratorApi.AddSecondaryNetworksManifests(ctx, log, cluster)).ToNot(Succeed())
+		})
+	})
+})
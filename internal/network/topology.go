@@ -0,0 +1,270 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+// topologyEndpoint identifies a NIC of a host in the topology
+type topologyEndpoint struct {
+	hostID strfmt.UUID
+	nic    string
+}
+
+// BuildTopology builds the network topology of the hosts from their inventories and connectivity reports. The links
+// are the results of the L2 and L3 connectivity checks of every host, and the L2 segments group the NICs that reached
+// each other with successful L2 checks.
+func BuildTopology(hosts []*models.Host, log logrus.FieldLogger) *models.NetworkTopology {
+	topology := &models.NetworkTopology{
+		Hosts:      make([]*models.NetworkTopologyHost, 0, len(hosts)),
+		Links:      make([]*models.NetworkTopologyLink, 0),
+		L2Segments: make([]*models.NetworkTopologySegment, 0),
+	}
+	nicsByIP := make(map[strfmt.UUID]map[string]string)
+	for _, h := range hosts {
+		topologyHost := &models.NetworkTopologyHost{
+			HostID: *h.ID,
+			Role:   common.GetEffectiveRole(h),
+			Status: swag.StringValue(h.Status),
+			Nics:   make([]*models.NetworkTopologyNic, 0),
+		}
+		topologyHost.Hostname = h.RequestedHostname
+		nicsByIP[*h.ID] = make(map[string]string)
+		if h.Inventory != "" {
+			inventory, err := common.UnmarshalInventory(h.Inventory)
+			if err != nil {
+				log.WithError(err).Warnf("Failed to parse the inventory of host %s", h.ID)
+			} else {
+				if topologyHost.Hostname == "" {
+					topologyHost.Hostname = inventory.Hostname
+				}
+				for _, intf := range inventory.Interfaces {
+					topologyHost.Nics = append(topologyHost.Nics, topologyNic(intf))
+					for _, address := range append(append([]string{}, intf.IPV4Addresses...), intf.IPV6Addresses...) {
+						if ip, _, err := net.ParseCIDR(address); err == nil {
+							nicsByIP[*h.ID][ip.String()] = intf.Name
+						}
+					}
+				}
+			}
+		}
+		topology.Hosts = append(topology.Hosts, topologyHost)
+	}
+
+	segments := newEndpointSets()
+	for _, h := range hosts {
+		if h.Connectivity == "" {
+			continue
+		}
+		var report models.ConnectivityReport
+		if err := json.Unmarshal([]byte(h.Connectivity), &report); err != nil {
+			log.WithError(err).Warnf("Failed to parse the connectivity report of host %s", h.ID)
+			continue
+		}
+		for _, remoteHost := range report.RemoteHosts {
+			remoteNics, ok := nicsByIP[remoteHost.HostID]
+			if !ok {
+				// The remote host isn't part of the topology anymore
+				continue
+			}
+			for _, l2 := range remoteHost.L2Connectivity {
+				link := &models.NetworkTopologyLink{
+					Type:              models.NetworkTopologyLinkTypeL2,
+					SourceHostID:      *h.ID,
+					SourceNic:         l2.OutgoingNic,
+					DestinationHostID: remoteHost.HostID,
+					DestinationNic:    remoteNics[normalizeIP(l2.RemoteIPAddress)],
+					RemoteIPAddress:   l2.RemoteIPAddress,
+					RemoteMac:         l2.RemoteMac,
+					Successful:        l2.Successful,
+				}
+				topology.Links = append(topology.Links, link)
+				if link.Successful && link.SourceNic != "" && link.DestinationNic != "" {
+					segments.union(topologyEndpoint{hostID: link.SourceHostID, nic: link.SourceNic},
+						topologyEndpoint{hostID: link.DestinationHostID, nic: link.DestinationNic})
+				}
+			}
+			for _, l3 := range remoteHost.L3Connectivity {
+				topology.Links = append(topology.Links, &models.NetworkTopologyLink{
+					Type:                 models.NetworkTopologyLinkTypeL3,
+					SourceHostID:         *h.ID,
+					SourceNic:            l3.OutgoingNic,
+					DestinationHostID:    remoteHost.HostID,
+					DestinationNic:       remoteNics[normalizeIP(l3.RemoteIPAddress)],
+					RemoteIPAddress:      l3.RemoteIPAddress,
+					Successful:           l3.Successful,
+					AverageRTTMs:         l3.AverageRTTMs,
+					PacketLossPercentage: l3.PacketLossPercentage,
+				})
+			}
+		}
+	}
+
+	hostOrder := make(map[strfmt.UUID]int)
+	for i, h := range hosts {
+		hostOrder[*h.ID] = i
+	}
+	for i, members := range segments.groups(func(a, b topologyEndpoint) bool {
+		if a.hostID != b.hostID {
+			return hostOrder[a.hostID] < hostOrder[b.hostID]
+		}
+		return a.nic < b.nic
+	}) {
+		segment := &models.NetworkTopologySegment{ID: int64(i + 1)}
+		for _, member := range members {
+			segment.Members = append(segment.Members, &models.NetworkTopologyEndpoint{HostID: member.hostID, Nic: member.nic})
+		}
+		topology.L2Segments = append(topology.L2Segments, segment)
+	}
+	return topology
+}
+
+func topologyNic(intf *models.Interface) *models.NetworkTopologyNic {
+	nic := &models.NetworkTopologyNic{
+		Name:          intf.Name,
+		MacAddress:    intf.MacAddress,
+		Mtu:           intf.Mtu,
+		IPAddresses:   make([]string, 0),
+		LldpNeighbors: intf.LldpNeighbors,
+	}
+	nic.IPAddresses = append(nic.IPAddresses, intf.IPV4Addresses...)
+	nic.IPAddresses = append(nic.IPAddresses, intf.IPV6Addresses...)
+	return nic
+}
+
+func normalizeIP(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+	return address
+}
+
+// endpointSets is a disjoint set of the endpoints that share an L2 segment
+type endpointSets struct {
+	parent map[topologyEndpoint]topologyEndpoint
+}
+
+func newEndpointSets() *endpointSets {
+	return &endpointSets{parent: make(map[topologyEndpoint]topologyEndpoint)}
+}
+
+func (s *endpointSets) find(e topologyEndpoint) topologyEndpoint {
+	parent, ok := s.parent[e]
+	if !ok {
+		s.parent[e] = e
+		return e
+	}
+	if parent == e {
+		return e
+	}
+	root := s.find(parent)
+	s.parent[e] = root
+	return root
+}
+
+func (s *endpointSets) union(a, b topologyEndpoint) {
+	rootA, rootB := s.find(a), s.find(b)
+	if rootA != rootB {
+		s.parent[rootB] = rootA
+	}
+}
+
+// groups returns the sets sorted by their first member, with their members sorted by less
+func (s *endpointSets) groups(less func(a, b topologyEndpoint) bool) [][]topologyEndpoint {
+	byRoot := make(map[topologyEndpoint][]topologyEndpoint)
+	for e := range s.parent {
+		root := s.find(e)
+		byRoot[root] = append(byRoot[root], e)
+	}
+	ret := make([][]topologyEndpoint, 0, len(byRoot))
+	for _, members := range byRoot {
+		sort.Slice(members, func(i, j int) bool { return less(members[i], members[j]) })
+		ret = append(ret, members)
+	}
+	sort.Slice(ret, func(i, j int) bool { return less(ret[i][0], ret[j][0]) })
+	return ret
+}
+
+// TopologyToDot renders the topology as a Graphviz DOT graph. Every host is a subgraph with a node per NIC, the links
+// are edges between the NICs, green when the check succeeded and red otherwise, dashed for L2 links, and the LLDP
+// neighbors are dotted edges to a node per switch port.
+func TopologyToDot(name string, topology *models.NetworkTopology) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(name))
+	b.WriteString("  node [shape=box];\n")
+	lldpPorts := make(map[string]bool)
+	for _, h := range topology.Hosts {
+		label := h.Hostname
+		if label == "" {
+			label = h.HostID.String()
+		}
+		if h.Role != "" {
+			label = fmt.Sprintf("%s (%s)", label, h.Role)
+		}
+		fmt.Fprintf(&b, "  subgraph %s {\n", dotQuote("cluster_"+h.HostID.String()))
+		fmt.Fprintf(&b, "    label=%s;\n", dotQuote(label))
+		for _, nic := range h.Nics {
+			nicLabel := strings.Join(append([]string{nic.Name}, nic.IPAddresses...), "\n")
+			if nic.Mtu > 0 {
+				nicLabel += fmt.Sprintf("\nMTU %d", nic.Mtu)
+			}
+			fmt.Fprintf(&b, "    %s [label=%s];\n", dotQuote(dotNicID(h.HostID, nic.Name)), dotQuote(nicLabel))
+		}
+		b.WriteString("  }\n")
+		for _, nic := range h.Nics {
+			for _, neighbor := range nic.LldpNeighbors {
+				portID := fmt.Sprintf("lldp/%s/%s", neighbor.ChassisID, neighbor.PortID)
+				if !lldpPorts[portID] {
+					lldpPorts[portID] = true
+					system := neighbor.SystemName
+					if system == "" {
+						system = neighbor.ChassisID
+					}
+					fmt.Fprintf(&b, "  %s [shape=ellipse, label=%s];\n", dotQuote(portID), dotQuote(system+"\n"+neighbor.PortID))
+				}
+				fmt.Fprintf(&b, "  %s -> %s [dir=none, style=dotted];\n", dotQuote(dotNicID(h.HostID, nic.Name)), dotQuote(portID))
+			}
+		}
+	}
+	for _, link := range topology.Links {
+		destination := link.DestinationNic
+		if destination == "" {
+			destination = link.RemoteIPAddress
+		}
+		color := "red"
+		if link.Successful {
+			color = "green"
+		}
+		attributes := []string{fmt.Sprintf("color=%s", color)}
+		if link.Type == models.NetworkTopologyLinkTypeL2 {
+			attributes = append(attributes, "style=dashed", fmt.Sprintf("label=%s", dotQuote("L2")))
+		} else {
+			attributes = append(attributes, fmt.Sprintf("label=%s",
+				dotQuote(fmt.Sprintf("L3 %.2f ms %.1f%%", link.AverageRTTMs, link.PacketLossPercentage))))
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", dotQuote(dotNicID(link.SourceHostID, link.SourceNic)),
+			dotQuote(dotNicID(link.DestinationHostID, destination)), strings.Join(attributes, ", "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotNicID(hostID strfmt.UUID, nic string) string {
+	return fmt.Sprintf("%s/%s", hostID, nic)
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,137 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("network topology", func() {
+	var (
+		log     = logrus.New()
+		hostIDs = []strfmt.UUID{
+			"11111111-1111-1111-1111-111111111111",
+			"22222222-2222-2222-2222-222222222222",
+			"33333333-3333-3333-3333-333333333333",
+		}
+		hosts []*models.Host
+	)
+
+	nic := func(name, address string) *models.Interface {
+		return &models.Interface{Name: name, IPV4Addresses: []string{address}, Mtu: 1500}
+	}
+
+	connectivity := func(remoteHosts ...*models.ConnectivityRemoteHost) string {
+		b, err := json.Marshal(&models.ConnectivityReport{RemoteHosts: remoteHosts})
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	BeforeEach(func() {
+		hosts = nil
+		for i, inventory := range []string{
+			createInventory(nic("eth0", "192.168.1.10/24"), nic("eth1", "10.0.0.10/24")),
+			createInventory(nic("eth0", "192.168.1.11/24"), nic("eth1", "10.0.0.11/24")),
+			createInventory(nic("ens3", "192.168.1.12/24")),
+		} {
+			hosts = append(hosts, &models.Host{
+				ID:        &hostIDs[i],
+				Inventory: inventory,
+				Role:      models.HostRoleMaster,
+				Status:    swag.String(models.HostStatusKnown),
+			})
+		}
+		hosts[0].Connectivity = connectivity(
+			&models.ConnectivityRemoteHost{
+				HostID: hostIDs[1],
+				L2Connectivity: []*models.L2Connectivity{
+					{OutgoingNic: "eth0", RemoteIPAddress: "192.168.1.11", Successful: true},
+					{OutgoingNic: "eth1", RemoteIPAddress: "10.0.0.11", Successful: true},
+				},
+				L3Connectivity: []*models.L3Connectivity{
+					{OutgoingNic: "eth0", RemoteIPAddress: "192.168.1.11", Successful: true, AverageRTTMs: 0.5},
+				},
+			},
+			&models.ConnectivityRemoteHost{
+				HostID: hostIDs[2],
+				L2Connectivity: []*models.L2Connectivity{
+					{OutgoingNic: "eth0", RemoteIPAddress: "192.168.1.12", Successful: false},
+				},
+			},
+		)
+		hosts[2].Connectivity = connectivity(&models.ConnectivityRemoteHost{
+			HostID: hostIDs[1],
+			L2Connectivity: []*models.L2Connectivity{
+				{OutgoingNic: "ens3", RemoteIPAddress: "192.168.1.11", Successful: true},
+			},
+		})
+	})
+
+	It("builds the hosts, the links and the L2 segments", func() {
+		topology := BuildTopology(hosts, log)
+		Expect(topology.Hosts).To(HaveLen(3))
+		Expect(topology.Hosts[0].Nics).To(HaveLen(2))
+		Expect(topology.Hosts[0].Nics[0].IPAddresses).To(Equal([]string{"192.168.1.10/24"}))
+		Expect(topology.Hosts[0].Role).To(Equal(models.HostRoleMaster))
+		Expect(topology.Links).To(HaveLen(5))
+		Expect(topology.Links[0]).To(Equal(&models.NetworkTopologyLink{
+			Type:              models.NetworkTopologyLinkTypeL2,
+			SourceHostID:      hostIDs[0],
+			SourceNic:         "eth0",
+			DestinationHostID: hostIDs[1],
+			DestinationNic:    "eth0",
+			RemoteIPAddress:   "192.168.1.11",
+			Successful:        true,
+		}))
+		Expect(topology.Links[2].AverageRTTMs).To(Equal(0.5))
+		Expect(topology.Links[3].DestinationNic).To(Equal("ens3"))
+		Expect(topology.L2Segments).To(Equal([]*models.NetworkTopologySegment{
+			{ID: 1, Members: []*models.NetworkTopologyEndpoint{
+				{HostID: hostIDs[0], Nic: "eth0"},
+				{HostID: hostIDs[1], Nic: "eth0"},
+				{HostID: hostIDs[2], Nic: "ens3"},
+			}},
+			{ID: 2, Members: []*models.NetworkTopologyEndpoint{
+				{HostID: hostIDs[0], Nic: "eth1"},
+				{HostID: hostIDs[1], Nic: "eth1"},
+			}},
+		}))
+	})
+
+	It("ignores the connectivity to hosts outside of the topology", func() {
+		topology := BuildTopology(hosts[:2], log)
+		Expect(topology.Links).To(HaveLen(3))
+		Expect(topology.L2Segments).To(HaveLen(2))
+	})
+
+	It("ignores invalid inventories and connectivity reports", func() {
+		hosts[1].Inventory = "{"
+		hosts[2].Connectivity = "{"
+		topology := BuildTopology(hosts, log)
+		Expect(topology.Hosts[1].Nics).To(BeEmpty())
+		Expect(topology.Links).To(HaveLen(4))
+		Expect(topology.Links[0].DestinationNic).To(BeEmpty())
+		Expect(topology.L2Segments).To(BeEmpty())
+	})
+
+	It("renders the topology as DOT", func() {
+		inventory := models.Inventory{Hostname: "master-0", Interfaces: []*models.Interface{nic("eth0", "192.168.1.10/24")}}
+		inventory.Interfaces[0].LldpNeighbors = []*models.LldpNeighbor{{ChassisID: "aa:bb", PortID: "Eth1/1", SystemName: "tor-1"}}
+		b, err := json.Marshal(&inventory)
+		Expect(err).ToNot(HaveOccurred())
+		hosts[0].Inventory = string(b)
+		dot := TopologyToDot("cluster", BuildTopology(hosts, log))
+		Expect(dot).To(HavePrefix("digraph \"cluster\" {\n"))
+		Expect(dot).To(ContainSubstring("    label=\"master-0 (master)\";\n"))
+		Expect(dot).To(ContainSubstring(`"11111111-1111-1111-1111-111111111111/eth0" [label="eth0\n192.168.1.10/24\nMTU 1500"];`))
+		Expect(dot).To(ContainSubstring(`"lldp/aa:bb/Eth1/1" [shape=ellipse, label="tor-1\nEth1/1"];`))
+		Expect(dot).To(ContainSubstring(`"11111111-1111-1111-1111-111111111111/eth0" -> "22222222-2222-2222-2222-222222222222/eth0" [color=green, label="L3 0.50 ms 0.0%"];`))
+		Expect(dot).To(ContainSubstring(`"11111111-1111-1111-1111-111111111111/eth0" -> "33333333-3333-3333-3333-333333333333/ens3" [color=red, style=dashed, label="L2"];`))
+		Expect(dot).To(HaveSuffix("}\n"))
+	})
+})
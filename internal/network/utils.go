@@ -181,6 +181,22 @@ func GetMachineCidrById(cluster *common.Cluster, index int) string {
 	}
 }
 
+// GetMachineCidrForVip returns the machine network of the VIP at the index. It is the machine network at the same
+// index, unless it is of the other IP family, as can happen in dual-stack clusters, in which case it is the first
+// machine network of the family of the VIP.
+func GetMachineCidrForVip(cluster *common.Cluster, index int, vip string) string {
+	cidr := GetMachineCidrById(cluster, index)
+	if cidr == "" || IsIPv6Addr(vip) == IsIPv6CIDR(cidr) {
+		return cidr
+	}
+	for _, machineNetwork := range cluster.MachineNetworks {
+		if IsIPv6Addr(vip) == IsIPv6CIDR(string(machineNetwork.Cidr)) {
+			return string(machineNetwork.Cidr)
+		}
+	}
+	return cidr
+}
+
 func DerefMachineNetworks(obj interface{}) []*models.MachineNetwork {
 	switch v := obj.(type) {
 	case []*models.MachineNetwork:
@@ -49,6 +49,9 @@ type Operator interface {
 	GetHostValidationID() string
 	// GetProperties provides description of operator properties
 	GetProperties() models.OperatorProperties
+	// GetPropertiesSchema returns the JSON schema of the operator properties, the properties that all the operators
+	// accept are added by the operators manager
+	GetPropertiesSchema() string
 	// GetMonitoredOperator returns MonitoredOperator corresponding to the Operator implementation
 	GetMonitoredOperator() *models.MonitoredOperator
 	// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
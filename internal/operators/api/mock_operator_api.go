@@ -195,6 +195,20 @@ func (mr *MockOperatorMockRecorder) GetProperties() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProperties", reflect.TypeOf((*MockOperator)(nil).GetProperties))
 }
 
+// GetPropertiesSchema mocks base method.
+func (m *MockOperator) GetPropertiesSchema() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPropertiesSchema")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetPropertiesSchema indicates an expected call of GetPropertiesSchema.
+func (mr *MockOperatorMockRecorder) GetPropertiesSchema() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPropertiesSchema", reflect.TypeOf((*MockOperator)(nil).GetPropertiesSchema))
+}
+
 // ValidateCluster mocks base method.
 func (m *MockOperator) ValidateCluster(arg0 context.Context, arg1 *common.Cluster) (ValidationResult, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	manifestsapi "github.com/openshift/assisted-service/internal/manifests/api"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/generic"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/mce"
@@ -15,7 +16,9 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/pipelines"
 	"github.com/openshift/assisted-service/internal/operators/serverless"
 	"github.com/openshift/assisted-service/internal/operators/servicemesh"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	"github.com/sirupsen/logrus"
 )
@@ -35,12 +38,20 @@ var OperatorConsole = models.MonitoredOperator{
 type Options struct {
 	CheckClusterVersion bool
 	CNVConfig           cnv.Config
+	// GenericOperatorsDir is the directory of the definitions of the generic OLM operators, for example where a
+	// ConfigMap holding them is mounted
+	GenericOperatorsDir string `envconfig:"OLM_OPERATOR_DEFINITIONS_DIR" default:""`
+	// MirrorRegistriesBuilder provides the mirror registries of the service that the catalog sources of the operators
+	// are validated against, the configuration of the service is used when not set
+	MirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder `ignored:"true"`
+	// SpokeK8sClientFactory creates the clients of the installed clusters that operators are enabled on after the
+	// installation, a factory reading the kubeconfig of the clusters from the storage is used when not set
+	SpokeK8sClientFactory spoke_k8s_client.SpokeK8sClientFactory `ignored:"true"`
 }
 
 // NewManager creates new instance of an Operator Manager
 func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, options Options, objectHandler s3wrapper.API) *Manager {
-	return NewManagerWithOperators(
-		log, manifestAPI, options, objectHandler,
+	olmOperators := []api.Operator{
 		lso.NewLSOperator(),
 		odf.NewOcsOperator(log),
 		odf.NewOdfOperator(log),
@@ -54,9 +65,40 @@ func NewManager(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, o
 		servicemesh.NewServiceMeshOperator(log),
 		serverless.NewServerLessOperator(log),
 		openshiftai.NewOpenShiftAIOperator(log),
+	}
+	return NewManagerWithOperators(
+		log, manifestAPI, options, objectHandler,
+		append(olmOperators, newGenericOperators(log, options.GenericOperatorsDir, olmOperators)...)...,
 	)
 }
 
+// newGenericOperators creates the generic OLM operators of the definitions in the directory. Invalid definitions are
+// fatal, so that a typo doesn't silently remove an operator from the service.
+func newGenericOperators(log logrus.FieldLogger, dir string, builtinOperators []api.Operator) []api.Operator {
+	if dir == "" {
+		return nil
+	}
+	definitions, err := generic.LoadDefinitions(dir)
+	if err != nil {
+		log.WithError(err).Fatalf("failed to load the OLM operator definitions from %s", dir)
+	}
+	ret := make([]api.Operator, 0, len(definitions))
+	for _, definition := range definitions {
+		for _, builtinOperator := range builtinOperators {
+			if builtinOperator.GetName() == definition.Name {
+				log.Fatalf("OLM operator %s is already supported and can't be defined in %s", definition.Name, dir)
+			}
+		}
+		operator, err := generic.NewGenericOperator(log, definition)
+		if err != nil {
+			log.WithError(err).Fatalf("failed to create OLM operator %s", definition.Name)
+		}
+		log.Infof("Loaded OLM operator %s from its definition", definition.Name)
+		ret = append(ret, operator)
+	}
+	return ret
+}
+
 // NewManagerWithOperators creates new instance of an Operator Manager and configures it with given operators
 func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.ManifestsAPI, options Options, objectHandler s3wrapper.API, olmOperators ...api.Operator) *Manager {
 	nameToOperator := make(map[string]api.Operator)
@@ -77,11 +119,22 @@ func NewManagerWithOperators(log logrus.FieldLogger, manifestAPI manifestsapi.Ma
 		monitoredOperators[olmOperator.GetName()] = olmOperator.GetMonitoredOperator()
 	}
 
+	mirrorRegistriesBuilder := options.MirrorRegistriesBuilder
+	if mirrorRegistriesBuilder == nil {
+		mirrorRegistriesBuilder = mirrorregistries.New()
+	}
+	spokeK8sClientFactory := options.SpokeK8sClientFactory
+	if spokeK8sClientFactory == nil {
+		spokeK8sClientFactory = spoke_k8s_client.NewSpokeK8sClientFactory(log)
+	}
+
 	return &Manager{
-		log:                log,
-		olmOperators:       nameToOperator,
-		monitoredOperators: monitoredOperators,
-		manifestsAPI:       manifestAPI,
-		objectHandler:      objectHandler,
+		log:                     log,
+		olmOperators:            nameToOperator,
+		monitoredOperators:      monitoredOperators,
+		manifestsAPI:            manifestAPI,
+		objectHandler:           objectHandler,
+		mirrorRegistriesBuilder: mirrorRegistriesBuilder,
+		spokeK8sClientFactory:   spokeK8sClientFactory,
 	}
 }
@@ -0,0 +1,207 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/mtv"
+	"github.com/openshift/assisted-service/internal/operators/openshiftai"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// bundles are the sets of operators that users can install together instead of selecting them one by one. The
+// dependencies of the operators are resolved like the dependencies of operators selected one by one.
+var bundles = []models.Bundle{
+	{
+		ID:          swag.String(models.BundleIDVirtualization),
+		Title:       swag.String("Virtualization"),
+		Description: "Run virtual machines alongside containers and migrate virtual machines from other platforms",
+		Operators: []*models.OperatorCreateParams{
+			{Name: cnv.Operator.Name},
+			{Name: mtv.Operator.Name},
+		},
+	},
+	{
+		ID:          swag.String(models.BundleIDOpenshiftAi),
+		Title:       swag.String("OpenShift AI"),
+		Description: "Train, serve, monitor and manage AI and machine learning models and applications",
+		Operators: []*models.OperatorCreateParams{
+			{Name: openshiftai.Operator.Name},
+		},
+	},
+}
+
+func findBundle(bundleID string) (*models.Bundle, error) {
+	for i := range bundles {
+		if swag.StringValue(bundles[i].ID) == bundleID {
+			return copyBundle(&bundles[i]), nil
+		}
+	}
+	return nil, common.NewApiError(http.StatusNotFound, errors.Errorf("bundle %s is not supported", bundleID))
+}
+
+func copyBundle(bundle *models.Bundle) *models.Bundle {
+	ret := *bundle
+	ret.Operators = make([]*models.OperatorCreateParams, 0, len(bundle.Operators))
+	for _, operator := range bundle.Operators {
+		operatorCopy := *operator
+		ret.Operators = append(ret.Operators, &operatorCopy)
+	}
+	return &ret
+}
+
+// getBundleOperators returns the operators of the bundle and their dependencies as monitored operators
+func (mgr *Manager) getBundleOperators(cluster *common.Cluster, bundle *models.Bundle) ([]*models.MonitoredOperator, error) {
+	operators := make([]*models.MonitoredOperator, 0, len(bundle.Operators))
+	for _, bundleOperator := range bundle.Operators {
+		operator, err := mgr.GetOperatorByName(bundleOperator.Name)
+		if err != nil {
+			return nil, err
+		}
+		operator.Properties = bundleOperator.Properties
+		operators = append(operators, operator)
+	}
+	return mgr.ResolveDependencies(cluster, operators)
+}
+
+// validateBundle verifies that the operators of the bundle and their dependencies are supported on the OpenShift
+// version, the CPU architecture and the platform of the cluster
+func (mgr *Manager) validateBundle(cluster *common.Cluster, bundle *models.Bundle) error {
+	operators, err := mgr.getBundleOperators(cluster, bundle)
+	if err != nil {
+		return err
+	}
+	var featureIds []models.FeatureSupportLevelID
+	for _, operator := range operators {
+		olmOperator := mgr.olmOperators[operator.Name]
+		if checker, ok := olmOperator.(architectureChecker); ok &&
+			!checker.IsCompatibleWithArchitecture(cluster.OpenshiftVersion, cluster.CPUArchitecture) {
+			return errors.Errorf("cannot use %s because it's not compatible with the %s architecture",
+				olmOperator.GetFullName(), cluster.CPUArchitecture)
+		}
+		if featureId := olmOperator.GetFeatureSupportID(); featureId != "" {
+			featureIds = append(featureIds, featureId)
+		}
+	}
+	var platformType *models.PlatformType
+	if cluster.Platform != nil {
+		platformType = cluster.Platform.Type
+	}
+	if err = featuresupport.ValidateOperatorFeatures(featureIds, cluster.OpenshiftVersion, cluster.CPUArchitecture, platformType); err != nil {
+		return errors.Wrapf(err, "bundle %s is not supported", swag.StringValue(bundle.ID))
+	}
+	return nil
+}
+
+func addHostRequirements(total *models.HostTypeHardwareRequirements, operatorName string, requirements *models.HostTypeHardwareRequirements) {
+	if requirements == nil {
+		return
+	}
+	for _, qualitative := range requirements.Qualitative {
+		total.Qualitative = append(total.Qualitative, fmt.Sprintf("%s: %s", operatorName, qualitative))
+	}
+	if requirements.Quantitative == nil {
+		return
+	}
+	total.Quantitative.CPUCores += requirements.Quantitative.CPUCores
+	total.Quantitative.RAMMib += requirements.Quantitative.RAMMib
+	total.Quantitative.DiskSizeGb += requirements.Quantitative.DiskSizeGb
+}
+
+// getBundleRequirements aggregates the preflight hardware requirements of the operators of the bundle and of their
+// dependencies
+func (mgr *Manager) getBundleRequirements(ctx context.Context, cluster *common.Cluster, bundle *models.Bundle) (*models.HostTypeHardwareRequirementsWrapper, error) {
+	operators, err := mgr.getBundleOperators(cluster, bundle)
+	if err != nil {
+		return nil, err
+	}
+	bundleCluster := *cluster
+	bundleCluster.MonitoredOperators = operators
+
+	ret := &models.HostTypeHardwareRequirementsWrapper{
+		Master: &models.HostTypeHardwareRequirements{Qualitative: []string{}, Quantitative: &models.ClusterHostRequirementsDetails{}},
+		Worker: &models.HostTypeHardwareRequirements{Qualitative: []string{}, Quantitative: &models.ClusterHostRequirementsDetails{}},
+	}
+	for _, operator := range operators {
+		requirements, err := mgr.olmOperators[operator.Name].GetPreflightRequirements(ctx, &bundleCluster)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the preflight requirements of operator %s", operator.Name)
+		}
+		if requirements.Requirements == nil {
+			continue
+		}
+		addHostRequirements(ret.Master, operator.Name, requirements.Requirements.Master)
+		addHostRequirements(ret.Worker, operator.Name, requirements.Requirements.Worker)
+	}
+	return ret, nil
+}
+
+// GetBundle returns the bundle of operators with the aggregated preflight requirements of its operators for the
+// cluster
+func (mgr *Manager) GetBundle(ctx context.Context, cluster *common.Cluster, bundleID string) (*models.Bundle, error) {
+	bundle, err := findBundle(bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.Requirements, err = mgr.getBundleRequirements(ctx, cluster, bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// ListBundles returns the bundles of operators with the aggregated preflight requirements of their operators for the
+// cluster. When the OpenShift version of the cluster is set, the bundles that the cluster doesn't support are omitted.
+func (mgr *Manager) ListBundles(ctx context.Context, cluster *common.Cluster) ([]*models.Bundle, error) {
+	ret := make([]*models.Bundle, 0, len(bundles))
+	for i := range bundles {
+		bundle := copyBundle(&bundles[i])
+		if cluster.OpenshiftVersion != "" {
+			if err := mgr.validateBundle(cluster, bundle); err != nil {
+				mgr.log.WithError(err).Debugf("Omitting bundle %s", swag.StringValue(bundle.ID))
+				continue
+			}
+		}
+		var err error
+		if bundle.Requirements, err = mgr.getBundleRequirements(ctx, cluster, bundle); err != nil {
+			return nil, err
+		}
+		ret = append(ret, bundle)
+	}
+	return ret, nil
+}
+
+// ExpandBundles validates the bundles against the cluster and adds their operators to the given operators. The
+// properties of operators that are already given take precedence over the properties set by the bundles.
+func (mgr *Manager) ExpandBundles(cluster *common.Cluster, bundleIDs []string, operators []*models.OperatorCreateParams) ([]*models.OperatorCreateParams, error) {
+	ret := append([]*models.OperatorCreateParams{}, operators...)
+	for _, bundleID := range bundleIDs {
+		bundle, err := findBundle(bundleID)
+		if err != nil {
+			return nil, err
+		}
+		if err = mgr.validateBundle(cluster, bundle); err != nil {
+			return nil, err
+		}
+		for _, bundleOperator := range bundle.Operators {
+			if !hasOperatorCreateParams(ret, bundleOperator.Name) {
+				ret = append(ret, bundleOperator)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func hasOperatorCreateParams(operators []*models.OperatorCreateParams, name string) bool {
+	for _, operator := range operators {
+		if operator.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package operators_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/lso"
+	"github.com/openshift/assisted-service/internal/operators/mtv"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("Operator bundles", func() {
+	bundleIDs := func(bundles []*models.Bundle) []string {
+		ret := make([]string, 0, len(bundles))
+		for _, bundle := range bundles {
+			ret = append(ret, swag.StringValue(bundle.ID))
+		}
+		return ret
+	}
+
+	BeforeEach(func() {
+		cluster.OpenshiftVersion = "4.16.0"
+		cluster.CPUArchitecture = models.ClusterCPUArchitectureX8664
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeFull)
+	})
+
+	It("lists all the bundles without an OpenShift version", func() {
+		cluster.OpenshiftVersion = ""
+		bundles, err := manager.ListBundles(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundleIDs(bundles)).To(ConsistOf(models.BundleIDVirtualization, models.BundleIDOpenshiftAi))
+	})
+
+	It("omits the bundles that the CPU architecture doesn't support", func() {
+		cluster.CPUArchitecture = models.ClusterCPUArchitectureArm64
+		bundles, err := manager.ListBundles(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundleIDs(bundles)).ToNot(ContainElement(models.BundleIDOpenshiftAi))
+	})
+
+	It("omits the bundles that the platform doesn't support", func() {
+		cluster.Platform = &models.Platform{Type: models.PlatformTypeNutanix.Pointer()}
+		bundles, err := manager.ListBundles(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundleIDs(bundles)).ToNot(ContainElement(models.BundleIDVirtualization))
+	})
+
+	It("aggregates the preflight requirements of the operators of a bundle and of their dependencies", func() {
+		bundle, err := manager.GetBundle(context.TODO(), cluster, models.BundleIDVirtualization)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bundle.Operators).To(HaveLen(2))
+
+		var expectedCPUCores, expectedRAMMib int64
+		preflight, err := manager.GetPreflightRequirementsBreakdownForCluster(context.TODO(), cluster)
+		Expect(err).ToNot(HaveOccurred())
+		for _, requirements := range preflight {
+			switch requirements.OperatorName {
+			case cnv.Operator.Name, mtv.Operator.Name, lso.Operator.Name:
+				expectedCPUCores += requirements.Requirements.Master.Quantitative.CPUCores
+				expectedRAMMib += requirements.Requirements.Master.Quantitative.RAMMib
+			}
+		}
+		Expect(bundle.Requirements.Master.Quantitative.CPUCores).To(Equal(expectedCPUCores))
+		Expect(bundle.Requirements.Master.Quantitative.RAMMib).To(Equal(expectedRAMMib))
+		Expect(bundle.Requirements.Master.Quantitative.CPUCores).To(BeNumerically(">", 0))
+	})
+
+	It("fails to get a bundle that doesn't exist", func() {
+		_, err := manager.GetBundle(context.TODO(), cluster, "unknown")
+		Expect(err).To(HaveOccurred())
+		apiErr, ok := err.(*common.ApiErrorResponse)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+	})
+
+	Context("ExpandBundles", func() {
+		It("adds the operators of the bundles", func() {
+			operators, err := manager.ExpandBundles(cluster, []string{models.BundleIDVirtualization}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operators).To(ConsistOf(
+				&models.OperatorCreateParams{Name: cnv.Operator.Name},
+				&models.OperatorCreateParams{Name: mtv.Operator.Name},
+			))
+		})
+
+		It("keeps the properties of the operators that are already given", func() {
+			given := &models.OperatorCreateParams{Name: cnv.Operator.Name, Properties: `{"channel": "candidate"}`}
+			operators, err := manager.ExpandBundles(cluster, []string{models.BundleIDVirtualization}, []*models.OperatorCreateParams{given})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(operators).To(ConsistOf(given, &models.OperatorCreateParams{Name: mtv.Operator.Name}))
+		})
+
+		It("rejects a bundle that the cluster doesn't support", func() {
+			cluster.CPUArchitecture = models.ClusterCPUArchitectureS390x
+			_, err := manager.ExpandBundles(cluster, []string{models.BundleIDVirtualization}, nil)
+			Expect(err).To(MatchError(ContainSubstring("bundle virtualization is not supported")))
+		})
+
+		It("rejects an unknown bundle", func() {
+			_, err := manager.ExpandBundles(cluster, []string{"unknown"}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/hardware/virt"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/models"
@@ -121,8 +122,8 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{"CPU does not have virtualization support"}}, nil
 	}
 
-	if shouldInstallHPP(o.config, cluster) {
-		if err = validDiscoverableSNODisk(inventory.Disks, host.InstallationDiskID, o.config.SNOPoolSizeRequestHPPGib); err != nil {
+	if config := o.clusterConfig(cluster); shouldInstallHPP(config, cluster) {
+		if err = validDiscoverableSNODisk(inventory.Disks, host.InstallationDiskID, config.SNOPoolSizeRequestHPPGib); err != nil {
 			return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{err.Error()}}, nil
 		}
 	}
@@ -158,12 +159,17 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 
 // GenerateManifests generates manifests for the operator
 func (o *operator) GenerateManifests(c *common.Cluster) (map[string][]byte, []byte, error) {
-	return Manifests(o.config, c)
+	return Manifests(o.clusterConfig(c), c)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(o.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties
+func (o *operator) GetPropertiesSchema() string {
+	return propertiesSchema
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the CNV Operator
@@ -225,8 +231,8 @@ func (o *operator) GetPreflightRequirements(_ context.Context, cluster *common.C
 		"Additional 1GiB of RAM per each supported SR-IOV NIC",
 		"CPU has virtualization flag (vmx or svm)",
 	}
-	if shouldInstallHPP(o.config, cluster) {
-		qualitativeRequirements = append(qualitativeRequirements, fmt.Sprintf("Additional disk with %d Gi", o.config.SNOPoolSizeRequestHPPGib))
+	if config := o.clusterConfig(cluster); shouldInstallHPP(config, cluster) {
+		qualitativeRequirements = append(qualitativeRequirements, fmt.Sprintf("Additional disk with %d Gi", config.SNOPoolSizeRequestHPPGib))
 	}
 
 	cnvODependencies, err := o.GetDependencies(cluster)
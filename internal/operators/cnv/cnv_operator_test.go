@@ -338,6 +338,25 @@ var _ = Describe("CNV operator", func() {
 		}}),
 	)
 
+	It("GetPreflightRequirements, should use the hostpath provisioner properties", func() {
+		cnvOperator := cnv.NewCNVOperator(log, cnv.Config{SNOPoolSizeRequestHPPGib: 50, SNOInstallHPP: false})
+		cluster := common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:     "4.10",
+			HighAvailabilityMode: &noneHaMode,
+			MonitoredOperators: []*models.MonitoredOperator{{
+				Name:       cnv.Operator.Name,
+				Properties: `{"installHostPathProvisioner": true, "hostPathProvisionerPoolSizeGib": 80}`,
+			}},
+		}}
+		requirements, err := cnvOperator.GetPreflightRequirements(context.TODO(), &cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requirements.Requirements.Master.Qualitative).To(ContainElement("Additional disk with 80 Gi"))
+
+		openshiftManifests, _, err := cnvOperator.GenerateManifests(&cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(openshiftManifests).To(HaveKey("50_openshift-cnv_hpp_sc.yaml"))
+	})
+
 	DescribeTable("Validate Cluster", func(ocpVersion []string, cpuArch string, expectedApiStatus api.ValidationStatus, errorMessage string) {
 		cluster := common.Cluster{}
 		cluster.CPUArchitecture = cpuArch
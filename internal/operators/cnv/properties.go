@@ -0,0 +1,47 @@
+package cnv
+
+import (
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+)
+
+const propertiesSchema = `{
+  "type": "object",
+  "properties": {
+    "installHostPathProvisioner": {
+      "type": "boolean",
+      "description": "Install the hostpath provisioner on single node clusters where it's supported"
+    },
+    "hostPathProvisionerPoolSizeGib": {
+      "type": "integer",
+      "minimum": 1,
+      "description": "Size in GiB of the storage pool of the hostpath provisioner, an additional disk of this size is required"
+    }
+  },
+  "additionalProperties": false
+}`
+
+// Properties are the settings of the operator that can be set when it's enabled, they override the configuration
+// of the service for the cluster
+type Properties struct {
+	InstallHostPathProvisioner     *bool  `json:"installHostPathProvisioner,omitempty"`
+	HostPathProvisionerPoolSizeGib *int64 `json:"hostPathProvisionerPoolSizeGib,omitempty"`
+}
+
+// clusterConfig returns the configuration of the operator for the cluster, the properties of the operator override the
+// configuration of the service. The properties are validated before the installation, invalid ones are ignored.
+func (o *operator) clusterConfig(cluster *common.Cluster) Config {
+	config := o.config
+	properties := Properties{}
+	if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, Operator.Name, &properties); err != nil {
+		o.log.WithError(err).Warnf("Ignoring the properties of operator %s in cluster %s", Operator.Name, cluster.ID)
+		return config
+	}
+	if properties.InstallHostPathProvisioner != nil {
+		config.SNOInstallHPP = *properties.InstallHostPathProvisioner
+	}
+	if properties.HostPathProvisionerPoolSizeGib != nil {
+		config.SNOPoolSizeRequestHPPGib = *properties.HostPathProvisionerPoolSizeGib
+	}
+	return config
+}
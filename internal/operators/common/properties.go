@@ -0,0 +1,210 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// commonPropertiesSchema describes the properties that all the OLM operators accept, they customize the subscription
+// of the operator
+const commonPropertiesSchema = `{
+  "channel": {
+    "type": "string",
+    "minLength": 1,
+    "description": "Channel of the operator subscription, overrides the default channel of the operator"
+  },
+  "startingCSV": {
+    "type": "string",
+    "minLength": 1,
+    "description": "ClusterServiceVersion that the subscription starts from, pins the version of the operator"
+  },
+  "installPlanApproval": {
+    "type": "string",
+    "enum": ["Automatic", "Manual"],
+    "description": "Approval of the install plans of the subscription, the installation waits for Manual install plans to be approved"
+  },
+  "catalogSource": {
+    "type": "object",
+    "description": "CatalogSource that the operator is installed from, created by the installation when its image is set",
+    "required": ["name"],
+    "properties": {
+      "name": {"type": "string", "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"},
+      "namespace": {"type": "string", "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"},
+      "image": {"type": "string", "minLength": 1}
+    },
+    "additionalProperties": false
+  }
+}`
+
+var commonProperties = func() spec.SchemaProperties {
+	properties := spec.SchemaProperties{}
+	if err := json.Unmarshal([]byte(commonPropertiesSchema), &properties); err != nil {
+		panic(err)
+	}
+	return properties
+}()
+
+// PropertiesSchema parses the JSON schema of the properties of an operator and adds the properties that all the
+// operators accept. An empty schema means that the operator accepts only these common properties.
+func PropertiesSchema(schemaJSON string) (*spec.Schema, error) {
+	schema := &spec.Schema{}
+	if strings.TrimSpace(schemaJSON) != "" {
+		if err := json.Unmarshal([]byte(schemaJSON), schema); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the properties schema")
+		}
+	}
+	if len(schema.Type) == 0 {
+		schema.Type = spec.StringOrArray{"object"}
+	}
+	if !schema.Type.Contains("object") {
+		return nil, errors.Errorf("the properties schema must describe an object")
+	}
+	if schema.AdditionalProperties == nil {
+		schema.AdditionalProperties = &spec.SchemaOrBool{Allows: false}
+	}
+	if schema.Properties == nil {
+		schema.Properties = spec.SchemaProperties{}
+	}
+	for name, property := range commonProperties {
+		if _, ok := schema.Properties[name]; ok {
+			return nil, errors.Errorf("the properties schema can't redefine the common property %s", name)
+		}
+		schema.Properties[name] = property
+	}
+	return schema, nil
+}
+
+func unmarshalProperties(properties string) (interface{}, error) {
+	var data interface{} = map[string]interface{}{}
+	if strings.TrimSpace(properties) == "" {
+		return data, nil
+	}
+	if err := json.Unmarshal([]byte(properties), &data); err != nil {
+		return nil, errors.Wrap(err, "properties are not valid JSON")
+	}
+	return data, nil
+}
+
+// ValidateProperties verifies that the properties of an operator, a JSON object, match the schema of its properties
+func ValidateProperties(schema *spec.Schema, properties string) error {
+	data, err := unmarshalProperties(properties)
+	if err != nil {
+		return err
+	}
+	return validateAgainstSchema(schema, data)
+}
+
+func validateAgainstSchema(schema *spec.Schema, data interface{}) error {
+	if err := validate.AgainstSchema(schema, data, strfmt.Default); err != nil {
+		return errors.New(strings.ReplaceAll(err.Error(), " in body", ""))
+	}
+	return nil
+}
+
+// isPropertiesObject tells if the properties of an operator are a JSON object. The properties were free-form before
+// they had a schema, so clusters created back then may store anything else.
+func isPropertiesObject(properties string) bool {
+	var data map[string]interface{}
+	return json.Unmarshal([]byte(properties), &data) == nil && data != nil
+}
+
+// ValidateStoredProperties verifies the properties of an operator that a cluster already stores. Unlike
+// ValidateProperties, it ignores the properties that aren't a JSON object and the unknown keys, since clusters created
+// before the properties had a schema may have them.
+func ValidateStoredProperties(schema *spec.Schema, properties string) error {
+	if strings.TrimSpace(properties) == "" || !isPropertiesObject(properties) {
+		return nil
+	}
+	data, err := unmarshalProperties(properties)
+	if err != nil {
+		return err
+	}
+	lenient := *schema
+	lenient.AdditionalProperties = &spec.SchemaOrBool{Allows: true}
+	return validateAgainstSchema(&lenient, data)
+}
+
+// UnmarshalProperties unmarshals the properties of the operator in the given list into target. Properties of an
+// operator that isn't in the list, or that aren't a JSON object, are empty.
+func UnmarshalProperties(operators []*models.MonitoredOperator, operatorName string, target interface{}) error {
+	for _, operator := range operators {
+		if operator.Name != operatorName || !isPropertiesObject(operator.Properties) {
+			continue
+		}
+		if err := json.Unmarshal([]byte(operator.Properties), target); err != nil {
+			return errors.Wrapf(err, "failed to parse the properties of operator %s", operatorName)
+		}
+	}
+	return nil
+}
+
+var propertyDataTypes = map[string]string{
+	"boolean": models.OperatorPropertyDataTypeBoolean,
+	"string":  models.OperatorPropertyDataTypeString,
+	"integer": models.OperatorPropertyDataTypeInteger,
+	"number":  models.OperatorPropertyDataTypeFloat,
+	"object":  models.OperatorPropertyDataTypeObject,
+	"array":   models.OperatorPropertyDataTypeArray,
+}
+
+func formatPropertyValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
+
+// PropertiesFromSchema describes the top level properties of a properties schema
+func PropertiesFromSchema(schema *spec.Schema) models.OperatorProperties {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret := models.OperatorProperties{}
+	for _, name := range names {
+		property := schema.Properties[name]
+		described := &models.OperatorProperty{
+			Name:        name,
+			Description: property.Description,
+		}
+		if len(property.Type) > 0 {
+			described.DataType = propertyDataTypes[property.Type[0]]
+		}
+		for _, required := range schema.Required {
+			if required == name {
+				described.Mandatory = true
+			}
+		}
+		for _, option := range property.Enum {
+			described.Options = append(described.Options, formatPropertyValue(option))
+		}
+		if property.Default != nil {
+			described.DefaultValue = formatPropertyValue(property.Default)
+		}
+		ret = append(ret, described)
+	}
+	return ret
+}
+
+// DescribeProperties describes the properties of an operator from the JSON schema that it declares
+func DescribeProperties(schemaJSON string) models.OperatorProperties {
+	schema, err := PropertiesSchema(schemaJSON)
+	if err != nil {
+		return models.OperatorProperties{}
+	}
+	return PropertiesFromSchema(schema)
+}
@@ -0,0 +1,178 @@
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"sigs.k8s.io/yaml"
+)
+
+const testPropertiesSchema = `{
+  "type": "object",
+  "required": ["size"],
+  "properties": {
+    "size": {"type": "integer", "minimum": 1, "description": "Size of the pool"},
+    "mode": {"type": "string", "enum": ["fast", "safe"], "default": "safe"},
+    "paths": {"type": "array", "items": {"type": "string"}}
+  }
+}`
+
+var _ = Describe("Operator properties", func() {
+	DescribeTable("ValidateProperties",
+		func(schemaJSON, properties, expectedError string) {
+			schema, err := common.PropertiesSchema(schemaJSON)
+			Expect(err).ToNot(HaveOccurred())
+			err = common.ValidateProperties(schema, properties)
+			if expectedError == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedError))
+			}
+		},
+		Entry("no properties without schema", "", "", ""),
+		Entry("channel without schema", "", `{"channel": "stable-4.16"}`, ""),
+		Entry("unknown property without schema", "", `{"size": 1}`, "size is a forbidden property"),
+		Entry("empty channel", "", `{"channel": ""}`, "channel should be at least 1 chars long"),
+		Entry("valid properties", testPropertiesSchema, `{"size": 5, "mode": "fast", "paths": ["/dev/sdb"], "channel": "stable"}`, ""),
+		Entry("missing required property", testPropertiesSchema, `{"mode": "fast"}`, "size is required"),
+		Entry("wrong type", testPropertiesSchema, `{"size": "5"}`, "size must be of type integer"),
+		Entry("enum", testPropertiesSchema, `{"size": 5, "mode": "slow"}`, "mode should be one of"),
+		Entry("not JSON", testPropertiesSchema, `size: 5`, "properties are not valid JSON"),
+		Entry("subscription properties", "", `{"startingCSV": "op.v1.2.3", "installPlanApproval": "Manual", "catalogSource": {"name": "mirror", "image": "mirror.example.com/index:v4.16"}}`, ""),
+		Entry("invalid install plan approval", "", `{"installPlanApproval": "manual"}`, "installPlanApproval should be one of"),
+		Entry("catalog source without name", "", `{"catalogSource": {"image": "mirror.example.com/index:v4.16"}}`, "catalogSource.name is required"),
+		Entry("invalid catalog source name", "", `{"catalogSource": {"name": "Mirror"}}`, "catalogSource.name should match"),
+	)
+
+	DescribeTable("ValidateStoredProperties",
+		func(properties, expectedError string) {
+			schema, err := common.PropertiesSchema(testPropertiesSchema)
+			Expect(err).ToNot(HaveOccurred())
+			err = common.ValidateStoredProperties(schema, properties)
+			if expectedError == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedError))
+			}
+		},
+		Entry("no properties", "", ""),
+		Entry("unknown property", `{"size": 5, "legacy": true}`, ""),
+		Entry("not JSON", `size: 5`, ""),
+		Entry("not an object", `[5]`, ""),
+		Entry("wrong type", `{"size": "5", "legacy": true}`, "size must be of type integer"),
+	)
+
+	It("rejects a schema that isn't an object", func() {
+		_, err := common.PropertiesSchema(`{"type": "string"}`)
+		Expect(err).To(HaveOccurred())
+		_, err = common.PropertiesSchema(`{`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects a schema that redefines a common property", func() {
+		_, err := common.PropertiesSchema(`{"properties": {"channel": {"type": "integer"}}}`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("describes the properties", func() {
+		properties := common.DescribeProperties(testPropertiesSchema)
+		names := make([]string, 0, len(properties))
+		for _, property := range properties {
+			names = append(names, property.Name)
+		}
+		Expect(names).To(Equal([]string{"catalogSource", "channel", "installPlanApproval", "mode", "paths", "size", "startingCSV"}))
+		Expect(properties).To(ContainElements(models.OperatorProperties{
+			{
+				Name:        "channel",
+				DataType:    models.OperatorPropertyDataTypeString,
+				Description: "Channel of the operator subscription, overrides the default channel of the operator",
+			},
+			{
+				Name:        "installPlanApproval",
+				DataType:    models.OperatorPropertyDataTypeString,
+				Options:     []string{"Automatic", "Manual"},
+				Description: "Approval of the install plans of the subscription, the installation waits for Manual install plans to be approved",
+			},
+			{Name: "mode", DataType: models.OperatorPropertyDataTypeString, Options: []string{"fast", "safe"}, DefaultValue: "safe"},
+			{Name: "paths", DataType: models.OperatorPropertyDataTypeArray},
+			{Name: "size", DataType: models.OperatorPropertyDataTypeInteger, Mandatory: true, Description: "Size of the pool"},
+		}))
+	})
+
+	It("unmarshals the properties of an operator", func() {
+		operators := []*models.MonitoredOperator{
+			{Name: "first", Properties: `{"size": 5}`},
+			{Name: "second"},
+			{Name: "legacy", Properties: "size=5"},
+		}
+		properties := struct {
+			Size int `json:"size"`
+		}{}
+		Expect(common.UnmarshalProperties(operators, "first", &properties)).To(Succeed())
+		Expect(properties.Size).To(Equal(5))
+
+		properties.Size = 0
+		Expect(common.UnmarshalProperties(operators, "second", &properties)).To(Succeed())
+		Expect(common.UnmarshalProperties(operators, "third", &properties)).To(Succeed())
+		Expect(common.UnmarshalProperties(operators, "legacy", &properties)).To(Succeed())
+		Expect(properties.Size).To(BeZero())
+	})
+
+	It("overrides the subscriptions", func() {
+		manifests := map[string][]byte{
+			"subscription.yaml": []byte("apiVersion: operators.coreos.com/v1alpha1\nkind: Subscription\nmetadata:\n  name: test\nspec:\n  name: test\n  channel: stable\n  source: redhat-operators\n  sourceNamespace: openshift-marketplace\n"),
+			"namespace.yaml":    []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: test\n"),
+		}
+		Expect(common.OverrideSubscription(manifests, &common.SubscriptionProperties{
+			Channel:             "fast",
+			StartingCSV:         "test.v1.2.3",
+			InstallPlanApproval: common.InstallPlanApprovalManual,
+			CatalogSource:       &common.CatalogSource{Name: "mirror", Namespace: "catalogs"},
+		})).To(Succeed())
+
+		subscription := map[string]interface{}{}
+		Expect(yaml.Unmarshal(manifests["subscription.yaml"], &subscription)).To(Succeed())
+		Expect(subscription["spec"]).To(Equal(map[string]interface{}{
+			"name":                "test",
+			"channel":             "fast",
+			"startingCSV":         "test.v1.2.3",
+			"installPlanApproval": "Manual",
+			"source":              "mirror",
+			"sourceNamespace":     "catalogs",
+		}))
+		Expect(string(manifests["namespace.yaml"])).To(Equal("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: test\n"))
+	})
+
+	It("leaves the subscriptions unchanged without subscription properties", func() {
+		content := []byte("apiVersion: operators.coreos.com/v1alpha1\nkind: Subscription\nmetadata:\n  name: test\nspec:\n  name: test\n")
+		manifests := map[string][]byte{"subscription.yaml": content}
+		Expect(common.OverrideSubscription(manifests, &common.SubscriptionProperties{})).To(Succeed())
+		Expect(manifests["subscription.yaml"]).To(Equal(content))
+	})
+
+	It("generates the manifest of a catalog source", func() {
+		catalogSource := &common.CatalogSource{Name: "mirror", Image: "mirror.example.com/index:v4.16"}
+		Expect(common.CatalogSourceManifestName(catalogSource)).To(Equal("50_catalogsource_openshift-marketplace_mirror.yaml"))
+
+		manifest := map[string]interface{}{}
+		Expect(yaml.Unmarshal(common.CatalogSourceManifest(catalogSource), &manifest)).To(Succeed())
+		Expect(manifest["kind"]).To(Equal("CatalogSource"))
+		Expect(manifest["metadata"]).To(Equal(map[string]interface{}{"name": "mirror", "namespace": "openshift-marketplace"}))
+		Expect(manifest["spec"]).To(HaveKeyWithValue("image", "mirror.example.com/index:v4.16"))
+		Expect(manifest["spec"]).To(HaveKeyWithValue("sourceType", "grpc"))
+	})
+
+	DescribeTable("IsImageMirrored",
+		func(image string, expected bool) {
+			Expect(common.IsImageMirrored(image, []string{"registry.redhat.io/redhat", "mirror.example.com:5000/"})).To(Equal(expected))
+		},
+		Entry("source repository", "registry.redhat.io/redhat/redhat-operator-index:v4.16", true),
+		Entry("mirror registry", "mirror.example.com:5000/olm/index:v4.16", true),
+		Entry("other registry", "quay.io/redhat/index:v4.16", false),
+		Entry("registry with the same prefix", "registry.redhat.io/redhat-other/index:v4.16", false),
+	)
+})
@@ -0,0 +1,134 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultCatalogSourceNamespace is the namespace of the catalog sources when the properties don't set it
+	DefaultCatalogSourceNamespace = "openshift-marketplace"
+
+	// InstallPlanApprovalManual makes the installation of the operator wait for its install plan to be approved
+	InstallPlanApprovalManual = "Manual"
+)
+
+// CatalogSource is the catalog that an operator is installed from instead of its default catalog
+type CatalogSource struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	// Image is the index image of the catalog, a CatalogSource is created when it is set
+	Image string `json:"image,omitempty"`
+}
+
+// GetNamespace returns the namespace of the catalog source
+func (c *CatalogSource) GetNamespace() string {
+	if c.Namespace == "" {
+		return DefaultCatalogSourceNamespace
+	}
+	return c.Namespace
+}
+
+// SubscriptionProperties are the common properties of the OLM operators that customize their subscription
+type SubscriptionProperties struct {
+	Channel             string         `json:"channel,omitempty"`
+	StartingCSV         string         `json:"startingCSV,omitempty"`
+	InstallPlanApproval string         `json:"installPlanApproval,omitempty"`
+	CatalogSource       *CatalogSource `json:"catalogSource,omitempty"`
+}
+
+// IsSet returns true if the properties customize the subscription
+func (s *SubscriptionProperties) IsSet() bool {
+	return s.Channel != "" || s.StartingCSV != "" || s.InstallPlanApproval != "" || s.CatalogSource != nil
+}
+
+// GetSubscriptionProperties returns the subscription properties of the given operator
+func GetSubscriptionProperties(operator *models.MonitoredOperator) (*SubscriptionProperties, error) {
+	properties := &SubscriptionProperties{}
+	if err := UnmarshalProperties([]*models.MonitoredOperator{operator}, operator.Name, properties); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
+// OverrideSubscription sets the channel, the starting CSV, the install plan approval and the catalog source of the
+// subscriptions in the given manifests according to the properties
+func OverrideSubscription(manifests map[string][]byte, properties *SubscriptionProperties) error {
+	if !properties.IsSet() {
+		return nil
+	}
+	for name, content := range manifests {
+		manifest := map[string]interface{}{}
+		if err := yaml.Unmarshal(content, &manifest); err != nil || manifest["kind"] != "Subscription" {
+			continue
+		}
+		subscriptionSpec, ok := manifest["spec"].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("subscription manifest %s has no spec", name)
+		}
+		if properties.Channel != "" {
+			subscriptionSpec["channel"] = properties.Channel
+		}
+		if properties.StartingCSV != "" {
+			subscriptionSpec["startingCSV"] = properties.StartingCSV
+		}
+		if properties.InstallPlanApproval != "" {
+			subscriptionSpec["installPlanApproval"] = properties.InstallPlanApproval
+		}
+		if properties.CatalogSource != nil {
+			subscriptionSpec["source"] = properties.CatalogSource.Name
+			subscriptionSpec["sourceNamespace"] = properties.CatalogSource.GetNamespace()
+		}
+		updated, err := yaml.Marshal(manifest)
+		if err != nil {
+			return errors.Wrapf(err, "failed to override subscription manifest %s", name)
+		}
+		manifests[name] = updated
+	}
+	return nil
+}
+
+const catalogSourceManifestTemplate = `apiVersion: operators.coreos.com/v1alpha1
+kind: CatalogSource
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  sourceType: grpc
+  image: %s
+  displayName: %s
+`
+
+// CatalogSourceManifestName returns the name of the manifest of the catalog source
+func CatalogSourceManifestName(catalogSource *CatalogSource) string {
+	return fmt.Sprintf("50_catalogsource_%s_%s.yaml", catalogSource.GetNamespace(), catalogSource.Name)
+}
+
+// CatalogSourceManifest generates the manifest of a catalog source that serves the index image
+func CatalogSourceManifest(catalogSource *CatalogSource) []byte {
+	return []byte(fmt.Sprintf(catalogSourceManifestTemplate, catalogSource.Name, catalogSource.GetNamespace(),
+		catalogSource.Image, catalogSource.Name))
+}
+
+// IsImageMirrored returns true if the image is pulled from one of the registry locations or one of their mirrors
+func IsImageMirrored(image string, locations []string) bool {
+	for _, location := range locations {
+		location = strings.TrimSuffix(location, "/")
+		if location == "" {
+			continue
+		}
+		if image == location {
+			return true
+		}
+		for _, separator := range []string{"/", ":", "@"} {
+			if strings.HasPrefix(image, location+separator) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,270 @@
+package operators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	masterNodeRoleLabel       = "node-role.kubernetes.io/master"
+	controlPlaneNodeRoleLabel = "node-role.kubernetes.io/control-plane"
+
+	// OperatorManifestsAppliedStatusInfo is the status info of the operators whose manifests were all applied to the
+	// installed cluster
+	OperatorManifestsAppliedStatusInfo = "The manifests of the operator were applied to the cluster"
+	// OperatorCustomResourcesPendingStatusInfo is the status info of the operators whose custom resources couldn't be
+	// created because the operator isn't installed yet
+	OperatorCustomResourcesPendingStatusInfo = "The operator is being installed, its custom resources will be " +
+		"created once it is installed"
+)
+
+// spokeNode is a node of an installed cluster with the resources that aren't requested by its pods
+type spokeNode struct {
+	name              string
+	role              models.HostRole
+	freeCPUMillicores int64
+	freeRAMMib        int64
+}
+
+// EnableOperators enables OLM operators on an installed cluster. It verifies that the nodes of the cluster have
+// enough free resources for the operators that the cluster doesn't have yet, and creates the objects of the manifests
+// of the operators in the cluster. Objects that already exist are left untouched, so that enabling an operator again
+// creates the custom resources that couldn't be created while the operator was being installed. The status info of
+// the operators is set according to the outcome.
+func (mgr *Manager) EnableOperators(ctx context.Context, cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	log := logutil.FromContext(ctx, mgr.log)
+
+	enabledCluster := *cluster
+	enabledCluster.MonitoredOperators = make([]*models.MonitoredOperator, 0, len(cluster.MonitoredOperators)+len(operators))
+	newOperators := make([]*models.MonitoredOperator, 0, len(operators))
+	for _, clusterOperator := range cluster.MonitoredOperators {
+		if !operatorscommon.HasOperator(operators, clusterOperator.Name) {
+			enabledCluster.MonitoredOperators = append(enabledCluster.MonitoredOperators, clusterOperator)
+		}
+	}
+	for _, operator := range operators {
+		olmOperator, ok := mgr.olmOperators[operator.Name]
+		if !ok {
+			return common.NewApiError(http.StatusBadRequest, errors.Errorf("operator %s isn't an OLM operator", operator.Name))
+		}
+		if err := validateOperatorProperties(olmOperator, operator.Properties); err != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid properties of operator %s", operator.Name))
+		}
+		if !operatorscommon.HasOperator(cluster.MonitoredOperators, operator.Name) {
+			newOperators = append(newOperators, operator)
+		}
+		enabledCluster.MonitoredOperators = append(enabledCluster.MonitoredOperators, operator)
+	}
+
+	if err := mgr.EnsureOperatorPrerequisite(&enabledCluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, enabledCluster.MonitoredOperators); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+	catalogImages := make(map[string]string)
+	for _, clusterOperator := range enabledCluster.MonitoredOperators {
+		if clusterOperator.OperatorType != models.OperatorTypeOlm {
+			continue
+		}
+		if err := mgr.validateCatalogSource(&enabledCluster, clusterOperator, catalogImages); err != nil {
+			return common.NewApiError(http.StatusBadRequest, errors.Wrapf(err, "invalid catalog source of operator %s", clusterOperator.Name))
+		}
+	}
+
+	spokeClient, err := mgr.spokeK8sClientFactory.CreateFromStorageKubeconfig(ctx, cluster.ID, mgr.objectHandler)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create the client of cluster %s", cluster.ID)
+		return common.NewApiError(http.StatusConflict, errors.Wrapf(err, "failed to connect to cluster %s", cluster.ID))
+	}
+	if err = mgr.validateSpokeNodes(ctx, spokeClient, &enabledCluster, newOperators); err != nil {
+		return common.NewApiError(http.StatusBadRequest, err)
+	}
+
+	catalogSources := make(map[string]bool)
+	for _, operator := range operators {
+		openshiftManifests, customManifest, err := mgr.olmOperators[operator.Name].GenerateManifests(&enabledCluster)
+		if err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to generate the manifests of operator %s", operator.Name))
+		}
+		if err = overrideSubscription(operator, openshiftManifests, catalogSources); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, err)
+		}
+		if err = applySpokeManifests(ctx, spokeClient, openshiftManifests); err != nil {
+			return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to apply the manifests of operator %s", operator.Name))
+		}
+		operator.StatusInfo = OperatorManifestsAppliedStatusInfo
+		if err = applySpokeManifest(ctx, spokeClient, customManifest); err != nil {
+			if !meta.IsNoMatchError(err) {
+				return common.NewApiError(http.StatusInternalServerError, errors.Wrapf(err, "failed to create the custom resources of operator %s", operator.Name))
+			}
+			log.WithError(err).Infof("Custom resources of operator %s can't be created before the operator is installed", operator.Name)
+			operator.StatusInfo = OperatorCustomResourcesPendingStatusInfo
+		}
+		log.Infof("Applied the manifests of operator %s to cluster %s", operator.Name, cluster.ID)
+	}
+	return nil
+}
+
+// CreatePendingCustomResources creates the custom resources of an operator enabled on an installed cluster that
+// couldn't be created while the operator was being installed. It returns false when the resources of the operator
+// still aren't known to the cluster.
+func (mgr *Manager) CreatePendingCustomResources(ctx context.Context, cluster *common.Cluster, spokeClient client.Client, operator *models.MonitoredOperator) (bool, error) {
+	olmOperator, ok := mgr.olmOperators[operator.Name]
+	if !ok {
+		return false, errors.Errorf("operator %s isn't an OLM operator", operator.Name)
+	}
+	_, customManifest, err := olmOperator.GenerateManifests(cluster)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to generate the manifests of operator %s", operator.Name)
+	}
+	if err = applySpokeManifest(ctx, spokeClient, customManifest); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to create the custom resources of operator %s", operator.Name)
+	}
+	return true, nil
+}
+
+// validateSpokeNodes verifies that the resources of the nodes of the installed cluster that aren't requested by pods
+// satisfy the host requirements of the operators
+func (mgr *Manager) validateSpokeNodes(ctx context.Context, spokeClient client.Client, cluster *common.Cluster, operators []*models.MonitoredOperator) error {
+	if len(operators) == 0 {
+		return nil
+	}
+	nodes, err := getSpokeNodes(ctx, spokeClient)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return errors.Errorf("cluster %s has no nodes", cluster.ID)
+	}
+	var failures []string
+	for _, node := range nodes {
+		host := &models.Host{Role: node.role, RequestedHostname: node.name}
+		var cpuCores, ramMib int64
+		for _, operator := range operators {
+			requirements, err := mgr.olmOperators[operator.Name].GetHostRequirements(ctx, cluster, host)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get the requirements of operator %s for node %s", operator.Name, node.name)
+			}
+			if requirements == nil {
+				continue
+			}
+			cpuCores += requirements.CPUCores
+			ramMib += requirements.RAMMib
+		}
+		if cpuCores*1000 > node.freeCPUMillicores || ramMib > node.freeRAMMib {
+			failures = append(failures, fmt.Sprintf("node %s has %d free CPU millicores and %d MiB of free memory, "+
+				"but the operators require %d CPU cores and %d MiB of memory", node.name, node.freeCPUMillicores,
+				node.freeRAMMib, cpuCores, ramMib))
+		}
+	}
+	if len(failures) > 0 {
+		return errors.Errorf("the nodes of the cluster don't have enough resources: %s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// getSpokeNodes returns the nodes of the installed cluster with the allocatable resources that aren't requested by
+// the pods running on them
+func getSpokeNodes(ctx context.Context, spokeClient client.Client) ([]*spokeNode, error) {
+	nodeList := &corev1.NodeList{}
+	if err := spokeClient.List(ctx, nodeList); err != nil {
+		return nil, errors.Wrap(err, "failed to list the nodes of the cluster")
+	}
+	podList := &corev1.PodList{}
+	if err := spokeClient.List(ctx, podList); err != nil {
+		return nil, errors.Wrap(err, "failed to list the pods of the cluster")
+	}
+	requestedCPUMillicores := make(map[string]int64)
+	requestedRAMBytes := make(map[string]int64)
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			requestedCPUMillicores[pod.Spec.NodeName] += container.Resources.Requests.Cpu().MilliValue()
+			requestedRAMBytes[pod.Spec.NodeName] += container.Resources.Requests.Memory().Value()
+		}
+	}
+
+	nodes := make([]*spokeNode, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		role := models.HostRoleWorker
+		if _, ok := node.Labels[masterNodeRoleLabel]; ok {
+			role = models.HostRoleMaster
+		} else if _, ok := node.Labels[controlPlaneNodeRoleLabel]; ok {
+			role = models.HostRoleMaster
+		}
+		nodes = append(nodes, &spokeNode{
+			name:              node.Name,
+			role:              role,
+			freeCPUMillicores: node.Status.Allocatable.Cpu().MilliValue() - requestedCPUMillicores[node.Name],
+			freeRAMMib:        (node.Status.Allocatable.Memory().Value() - requestedRAMBytes[node.Name]) / (1024 * 1024),
+		})
+	}
+	return nodes, nil
+}
+
+// applySpokeManifests creates the objects of the manifests in the installed cluster. The namespaces are created
+// first, then the other manifests are applied in the order of their names, like the installer does.
+func applySpokeManifests(ctx context.Context, spokeClient client.Client, manifests map[string][]byte) error {
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	isNamespace := func(name string) bool {
+		manifest := map[string]interface{}{}
+		return yaml.Unmarshal(manifests[name], &manifest) == nil && manifest["kind"] == "Namespace"
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		if isNamespace(names[i]) != isNamespace(names[j]) {
+			return isNamespace(names[i])
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		if err := applySpokeManifest(ctx, spokeClient, manifests[name]); err != nil {
+			return errors.Wrapf(err, "failed to apply manifest %s", name)
+		}
+	}
+	return nil
+}
+
+// applySpokeManifest creates the objects of the documents of the manifest in the installed cluster, objects that
+// already exist are left untouched
+func applySpokeManifest(ctx context.Context, spokeClient client.Client, content []byte) error {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(content), 4096)
+	for {
+		object := &unstructured.Unstructured{}
+		if err := decoder.Decode(&object.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "failed to decode manifest")
+		}
+		if len(object.Object) == 0 {
+			continue
+		}
+		if err := spokeClient.Create(ctx, object); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+}
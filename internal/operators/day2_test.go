@@ -0,0 +1,230 @@
+package operators_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/lvm"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeSpokeK8sClient struct {
+	client.Client
+}
+
+func (c fakeSpokeK8sClient) ListCsrs(ctx context.Context) (*certificatesv1.CertificateSigningRequestList, error) {
+	return nil, nil
+}
+
+func (c fakeSpokeK8sClient) ApproveCsr(ctx context.Context, csr *certificatesv1.CertificateSigningRequest) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
+	return nil, nil
+}
+
+func (c fakeSpokeK8sClient) PatchNodeLabels(ctx context.Context, name, labels string) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) PatchMachineConfigPoolPaused(ctx context.Context, pause bool, mcpName string) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) DeleteNode(ctx context.Context, name string) error {
+	return nil
+}
+
+// noMatchSpokeK8sClient fails to create the objects of a kind that the cluster doesn't serve yet
+type noMatchSpokeK8sClient struct {
+	fakeSpokeK8sClient
+	kind string
+}
+
+func (c noMatchSpokeK8sClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == c.kind {
+		return &meta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+	}
+	return c.fakeSpokeK8sClient.Create(ctx, obj, opts...)
+}
+
+var _ = Describe("Enable operators on installed clusters", func() {
+	var (
+		spokeFactory *spoke_k8s_client.MockSpokeK8sClientFactory
+		spokeClient  fakeSpokeK8sClient
+	)
+
+	addNode := func(name, cpu, memory string) {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"node-role.kubernetes.io/master": ""},
+			},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse(memory),
+				},
+			},
+		}
+		Expect(spokeClient.Create(ctx, node)).To(Succeed())
+	}
+
+	listObjects := func(kind string) []unstructured.Unstructured {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: kind + "List"})
+		Expect(spokeClient.List(ctx, list)).To(Succeed())
+		return list.Items
+	}
+
+	newLvmOperator := func() *models.MonitoredOperator {
+		operator := lvm.Operator
+		return &operator
+	}
+
+	BeforeEach(func() {
+		cluster.Status = swag.String(models.ClusterStatusInstalled)
+		cluster.HighAvailabilityMode = swag.String(models.ClusterHighAvailabilityModeNone)
+		cluster.SchedulableMastersForcedTrue = swag.Bool(true)
+		cluster.CPUArchitecture = models.ClusterCPUArchitectureX8664
+
+		spokeClient = fakeSpokeK8sClient{Client: fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()}
+		spokeFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		manager = operators.NewManager(log, manifestsAPI, operators.Options{SpokeK8sClientFactory: spokeFactory}, mockS3Api)
+	})
+
+	It("applies the manifests and the custom resources of the operator", func() {
+		addNode("master-0", "8", "32Gi")
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), cluster.ID, mockS3Api).Return(spokeClient, nil)
+		operator := newLvmOperator()
+
+		Expect(manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{operator})).To(Succeed())
+
+		namespace := &corev1.Namespace{}
+		Expect(spokeClient.Get(ctx, client.ObjectKey{Name: lvm.Operator.Namespace}, namespace)).To(Succeed())
+		Expect(listObjects("Subscription")).To(HaveLen(1))
+		lvmClusters := &unstructured.UnstructuredList{}
+		lvmClusters.SetGroupVersionKind(schema.GroupVersionKind{Group: "lvm.topolvm.io", Version: "v1alpha1", Kind: "LVMClusterList"})
+		Expect(spokeClient.List(ctx, lvmClusters)).To(Succeed())
+		Expect(lvmClusters.Items).To(HaveLen(1))
+		Expect(operator.StatusInfo).To(Equal(operators.OperatorManifestsAppliedStatusInfo))
+	})
+
+	It("overrides the subscription according to the properties of the operator", func() {
+		addNode("master-0", "8", "32Gi")
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), cluster.ID, mockS3Api).Return(spokeClient, nil)
+		operator := newLvmOperator()
+		operator.Properties = `{"channel": "candidate"}`
+
+		Expect(manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{operator})).To(Succeed())
+
+		subscriptions := listObjects("Subscription")
+		Expect(subscriptions).To(HaveLen(1))
+		channel, _, err := unstructured.NestedString(subscriptions[0].Object, "spec", "channel")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(channel).To(Equal("candidate"))
+	})
+
+	It("waits for the operator to be installed to create its custom resources", func() {
+		addNode("master-0", "8", "32Gi")
+		noMatchClient := noMatchSpokeK8sClient{fakeSpokeK8sClient: spokeClient, kind: "LVMCluster"}
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), cluster.ID, mockS3Api).Return(noMatchClient, nil)
+		operator := newLvmOperator()
+
+		Expect(manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{operator})).To(Succeed())
+
+		Expect(listObjects("Subscription")).To(HaveLen(1))
+		Expect(operator.StatusInfo).To(Equal(operators.OperatorCustomResourcesPendingStatusInfo))
+	})
+
+	It("creates the pending custom resources once the operator is installed", func() {
+		noMatchClient := noMatchSpokeK8sClient{fakeSpokeK8sClient: spokeClient, kind: "LVMCluster"}
+		operator := newLvmOperator()
+		cluster.MonitoredOperators = []*models.MonitoredOperator{operator}
+
+		created, err := manager.CreatePendingCustomResources(ctx, cluster, noMatchClient, operator)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeFalse())
+
+		created, err = manager.CreatePendingCustomResources(ctx, cluster, spokeClient, operator)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeTrue())
+		lvmClusters := &unstructured.UnstructuredList{}
+		lvmClusters.SetGroupVersionKind(schema.GroupVersionKind{Group: "lvm.topolvm.io", Version: "v1alpha1", Kind: "LVMClusterList"})
+		Expect(spokeClient.List(ctx, lvmClusters)).To(Succeed())
+		Expect(lvmClusters.Items).To(HaveLen(1))
+	})
+
+	It("rejects the operator when the nodes don't have enough free resources", func() {
+		addNode("master-0", "8", "32Gi")
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "busy", Namespace: "default"},
+			Spec: corev1.PodSpec{
+				NodeName: "master-0",
+				Containers: []corev1.Container{{
+					Name: "busy",
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("7500m"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					}},
+				}},
+			},
+		}
+		Expect(spokeClient.Create(ctx, pod)).To(Succeed())
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), cluster.ID, mockS3Api).Return(spokeClient, nil)
+
+		err := manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{newLvmOperator()})
+
+		Expect(err).To(MatchError(ContainSubstring("node master-0 has 500 free CPU millicores")))
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+		Expect(listObjects("Subscription")).To(BeEmpty())
+	})
+
+	It("doesn't validate the resources of the nodes for operators that are already enabled", func() {
+		addNode("master-0", "100m", "128Mi")
+		cluster.MonitoredOperators = []*models.MonitoredOperator{newLvmOperator()}
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), cluster.ID, mockS3Api).Return(spokeClient, nil)
+
+		Expect(manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{newLvmOperator()})).To(Succeed())
+		Expect(listObjects("Subscription")).To(HaveLen(1))
+	})
+
+	It("rejects invalid properties", func() {
+		operator := newLvmOperator()
+		operator.Properties = `{"channel": ""}`
+
+		err := manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{operator})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+	})
+
+	It("fails when the cluster can't be reached", func() {
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), cluster.ID, mockS3Api).Return(nil, errors.New("no kubeconfig"))
+
+		err := manager.EnableOperators(ctx, cluster, []*models.MonitoredOperator{newLvmOperator()})
+
+		Expect(err).To(MatchError(ContainSubstring("no kubeconfig")))
+		Expect(err.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+	})
+})
@@ -0,0 +1,237 @@
+package generic
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultSourceNamespace     = "openshift-marketplace"
+	defaultTimeoutSeconds      = 60 * 60
+	defaultInstallPlanApproval = "Automatic"
+)
+
+var nameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// HostRequirements are the additional resources that the operator needs on each host of a role
+type HostRequirements struct {
+	CPUCores   int64 `json:"cpuCores,omitempty"`
+	RAMMib     int64 `json:"ramMib,omitempty"`
+	DiskSizeGb int64 `json:"diskSizeGb,omitempty"`
+}
+
+// Requirements are the additional resources that the operator needs on the hosts of the cluster. Master requirements
+// also apply to single node clusters.
+type Requirements struct {
+	Master HostRequirements `json:"master,omitempty"`
+	Worker HostRequirements `json:"worker,omitempty"`
+}
+
+// ManifestTemplate is a Go template of a manifest, executed with the definition, the monitored operator, the cluster
+// and the properties of the operator as data
+type ManifestTemplate struct {
+	// FileName is the name of the manifest, required for OpenShift manifests
+	FileName string `json:"fileName,omitempty"`
+	Template string `json:"template"`
+}
+
+// Manifests are the manifests that are created in addition to the namespace, the operator group and the subscription
+// of the operator
+type Manifests struct {
+	// OpenShift manifests are created during the installation
+	OpenShift []ManifestTemplate `json:"openshift,omitempty"`
+	// Custom manifests are applied by the installer controller once the operator is installed, they typically hold
+	// the custom resources that configure the operator
+	Custom []ManifestTemplate `json:"custom,omitempty"`
+}
+
+// Definition describes an OLM operator that is installed by the generic operator implementation
+type Definition struct {
+	Name             string `json:"name"`
+	FullName         string `json:"fullName,omitempty"`
+	Namespace        string `json:"namespace"`
+	SubscriptionName string `json:"subscriptionName"`
+	// PackageName is the name of the operator in the catalog, it defaults to the subscription name
+	PackageName     string `json:"packageName,omitempty"`
+	Channel         string `json:"channel"`
+	Source          string `json:"source"`
+	SourceNamespace string `json:"sourceNamespace,omitempty"`
+	// AllNamespaces makes the operator group of the operator target all the namespaces
+	AllNamespaces  bool     `json:"allNamespaces,omitempty"`
+	TimeoutSeconds int64    `json:"timeoutSeconds,omitempty"`
+	Dependencies   []string `json:"dependencies,omitempty"`
+	// SupportedArchitectures are the CPU architectures of the clusters the operator can be installed on, all the
+	// architectures are supported when empty
+	SupportedArchitectures []string `json:"supportedArchitectures,omitempty"`
+	// MinOpenshiftVersion and MaxOpenshiftVersion are the inclusive range of OpenShift versions the operator can be
+	// installed on, the maximum is compared to the major and minor version of the cluster
+	MinOpenshiftVersion string       `json:"minOpenshiftVersion,omitempty"`
+	MaxOpenshiftVersion string       `json:"maxOpenshiftVersion,omitempty"`
+	Requirements        Requirements `json:"requirements,omitempty"`
+	// PropertiesSchema is the JSON schema of the properties of the operator, the properties are available to the
+	// manifest templates
+	PropertiesSchema json.RawMessage `json:"propertiesSchema,omitempty"`
+	Manifests        Manifests       `json:"manifests,omitempty"`
+}
+
+// ParseDefinition parses a YAML or JSON operator definition and sets the defaults of the optional fields
+func ParseDefinition(data []byte) (*Definition, error) {
+	definition := &Definition{}
+	if err := yaml.UnmarshalStrict(data, definition); err != nil {
+		return nil, errors.Wrap(err, "failed to parse operator definition")
+	}
+	if definition.FullName == "" {
+		definition.FullName = definition.Name
+	}
+	if definition.PackageName == "" {
+		definition.PackageName = definition.SubscriptionName
+	}
+	if definition.SourceNamespace == "" {
+		definition.SourceNamespace = defaultSourceNamespace
+	}
+	if definition.TimeoutSeconds == 0 {
+		definition.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	for i, arch := range definition.SupportedArchitectures {
+		definition.SupportedArchitectures[i] = common.NormalizeCPUArchitecture(arch)
+	}
+	if err := definition.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid definition of operator %s", definition.Name)
+	}
+	return definition, nil
+}
+
+// Validate verifies that the definition is complete and that its manifest templates can be parsed
+func (d *Definition) Validate() error {
+	if !nameRegex.MatchString(d.Name) {
+		return errors.Errorf("name %q must consist of lower case alphanumeric characters or '-'", d.Name)
+	}
+	for field, value := range map[string]string{
+		"namespace":        d.Namespace,
+		"subscriptionName": d.SubscriptionName,
+		"channel":          d.Channel,
+		"source":           d.Source,
+	} {
+		if value == "" {
+			return errors.Errorf("%s is required", field)
+		}
+	}
+	if !nameRegex.MatchString(d.Namespace) {
+		return errors.Errorf("namespace %q must consist of lower case alphanumeric characters or '-'", d.Namespace)
+	}
+	if d.TimeoutSeconds < 0 {
+		return errors.Errorf("timeoutSeconds must not be negative")
+	}
+	for _, dependency := range d.Dependencies {
+		if dependency == d.Name {
+			return errors.Errorf("operator can't depend on itself")
+		}
+	}
+	for _, version := range []string{d.MinOpenshiftVersion, d.MaxOpenshiftVersion} {
+		if version == "" {
+			continue
+		}
+		if _, err := common.GetMajorMinorVersion(version); err != nil {
+			return errors.Wrapf(err, "invalid OpenShift version %s", version)
+		}
+	}
+	if d.MinOpenshiftVersion != "" && d.MaxOpenshiftVersion != "" {
+		if lower, _ := common.BaseVersionLessThan(d.MinOpenshiftVersion, d.MaxOpenshiftVersion); lower {
+			return errors.Errorf("maxOpenshiftVersion %s is lower than minOpenshiftVersion %s", d.MaxOpenshiftVersion, d.MinOpenshiftVersion)
+		}
+	}
+	for _, requirements := range []HostRequirements{d.Requirements.Master, d.Requirements.Worker} {
+		if requirements.CPUCores < 0 || requirements.RAMMib < 0 || requirements.DiskSizeGb < 0 {
+			return errors.Errorf("requirements must not be negative")
+		}
+	}
+	fileNames := make(map[string]bool)
+	for _, manifest := range d.Manifests.OpenShift {
+		if manifest.FileName == "" {
+			return errors.Errorf("fileName is required for OpenShift manifests")
+		}
+		if fileNames[manifest.FileName] {
+			return errors.Errorf("manifest %s is defined more than once", manifest.FileName)
+		}
+		fileNames[manifest.FileName] = true
+	}
+	if _, err := operatorscommon.PropertiesSchema(string(d.PropertiesSchema)); err != nil {
+		return errors.Wrap(err, "invalid propertiesSchema")
+	}
+	_, err := d.parseTemplates()
+	return err
+}
+
+func (d *Definition) templateName(folder string, index int, manifest ManifestTemplate) string {
+	if manifest.FileName != "" {
+		return fmt.Sprintf("%s/%s", folder, manifest.FileName)
+	}
+	return fmt.Sprintf("%s/%d", folder, index)
+}
+
+func (d *Definition) parseTemplates() (*template.Template, error) {
+	templates := template.New(d.Name)
+	for folder, manifests := range map[string][]ManifestTemplate{
+		"openshift": d.Manifests.OpenShift,
+		"custom":    d.Manifests.Custom,
+	} {
+		for i, manifest := range manifests {
+			name := d.templateName(folder, i, manifest)
+			if _, err := templates.New(name).Parse(manifest.Template); err != nil {
+				return nil, errors.Wrapf(err, "failed to parse manifest template %s", name)
+			}
+		}
+	}
+	return templates, nil
+}
+
+// LoadDefinitions loads the operator definitions from the YAML and JSON files of the directory, for example the
+// directory where a ConfigMap holding the definitions is mounted
+func LoadDefinitions(dir string) ([]*Definition, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read operator definitions directory %s", dir)
+	}
+	var definitions []*Definition
+	names := make(map[string]string)
+	for _, entry := range entries {
+		// Mounted ConfigMaps contain hidden directories with the actual files
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read operator definition %s", path)
+		}
+		definition, err := ParseDefinition(data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load operator definition %s", path)
+		}
+		if other, ok := names[definition.Name]; ok {
+			return nil, errors.Errorf("operator %s is defined in both %s and %s", definition.Name, other, path)
+		}
+		names[definition.Name] = path
+		definitions = append(definitions, definition)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Name < definitions[j].Name
+	})
+	return definitions, nil
+}
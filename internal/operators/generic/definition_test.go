@@ -0,0 +1,132 @@
+package generic
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+const sriovDefinition = `
+name: sriov
+fullName: SR-IOV Network Operator
+namespace: openshift-sriov-network-operator
+subscriptionName: sriov-network-operator-subscription
+packageName: sriov-network-operator
+channel: stable
+source: redhat-operators
+supportedArchitectures: [amd64]
+minOpenshiftVersion: "4.12"
+maxOpenshiftVersion: "4.16"
+requirements:
+  master:
+    cpuCores: 1
+    ramMib: 512
+  worker:
+    ramMib: 256
+manifests:
+  openshift:
+  - fileName: 50_sriov_operatorconfig.yaml
+    template: |
+      namespace: {{ .Definition.Namespace }}
+  custom:
+  - template: |
+      operator: {{ .Operator.Name }}
+`
+
+var _ = Describe("Definition", func() {
+	It("sets the defaults", func() {
+		definition, err := ParseDefinition([]byte("{name: test, namespace: test-ns, subscriptionName: test-sub, channel: stable, source: redhat-operators}"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definition.FullName).To(Equal("test"))
+		Expect(definition.PackageName).To(Equal("test-sub"))
+		Expect(definition.SourceNamespace).To(Equal(defaultSourceNamespace))
+		Expect(definition.TimeoutSeconds).To(BeEquivalentTo(defaultTimeoutSeconds))
+	})
+
+	It("parses a complete definition", func() {
+		definition, err := ParseDefinition([]byte(sriovDefinition))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definition.FullName).To(Equal("SR-IOV Network Operator"))
+		Expect(definition.PackageName).To(Equal("sriov-network-operator"))
+		Expect(definition.SupportedArchitectures).To(Equal([]string{"x86_64"}))
+		Expect(definition.Requirements.Master).To(Equal(HostRequirements{CPUCores: 1, RAMMib: 512}))
+		Expect(definition.Manifests.OpenShift).To(HaveLen(1))
+		Expect(definition.Manifests.Custom).To(HaveLen(1))
+	})
+
+	DescribeTable("rejects an invalid definition",
+		func(data, expectedError string) {
+			_, err := ParseDefinition([]byte(data))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedError))
+		},
+		Entry("unknown field", "{name: test, nmespace: test}", "unknown field"),
+		Entry("invalid name", "{name: Test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators}",
+			"must consist of lower case alphanumeric characters"),
+		Entry("missing channel", "{name: test, namespace: test, subscriptionName: test, source: redhat-operators}", "channel is required"),
+		Entry("self dependency", "{name: test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators, dependencies: [test]}",
+			"operator can't depend on itself"),
+		Entry("version range", "{name: test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators, minOpenshiftVersion: '4.14', maxOpenshiftVersion: '4.12'}",
+			"maxOpenshiftVersion 4.12 is lower than minOpenshiftVersion 4.14"),
+		Entry("negative requirements", "{name: test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators, requirements: {worker: {cpuCores: -1}}}",
+			"requirements must not be negative"),
+		Entry("missing file name", "{name: test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators, manifests: {openshift: [{template: a}]}}",
+			"fileName is required"),
+		Entry("properties schema", "{name: test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators, propertiesSchema: {type: string}}",
+			"invalid propertiesSchema"),
+		Entry("invalid template", "{name: test, namespace: test, subscriptionName: test, channel: stable, source: redhat-operators, manifests: {custom: [{template: '{{ .Definition'}]}}",
+			"failed to parse manifest template custom/0"),
+	)
+
+	Context("LoadDefinitions", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "operator-definitions")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		writeFile := func(name, content string) {
+			Expect(os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)).To(Succeed())
+		}
+
+		It("loads the definitions sorted by name", func() {
+			writeFile("sriov.yaml", sriovDefinition)
+			writeFile("other.json", `{"name": "other", "namespace": "other", "subscriptionName": "other", "channel": "stable", "source": "redhat-operators"}`)
+			writeFile("README.md", "not a definition")
+			writeFile("..data/sriov.yaml", "hidden")
+
+			definitions, err := LoadDefinitions(dir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(definitions).To(HaveLen(2))
+			Expect(definitions[0].Name).To(Equal("other"))
+			Expect(definitions[1].Name).To(Equal("sriov"))
+		})
+
+		It("rejects duplicate names", func() {
+			writeFile("a.yaml", sriovDefinition)
+			writeFile("b.yaml", sriovDefinition)
+
+			_, err := LoadDefinitions(dir)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("operator sriov is defined in both"))
+		})
+
+		It("reports the invalid file", func() {
+			writeFile("invalid.yaml", "name: [")
+
+			_, err := LoadDefinitions(dir)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid.yaml"))
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package generic
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+const namespaceManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: {{ .Definition.Namespace }}
+  annotations:
+    workload.openshift.io/allowed: management
+`
+
+const operatorGroupManifest = `apiVersion: operators.coreos.com/v1
+kind: OperatorGroup
+metadata:
+  name: {{ .Definition.Name }}-operator-group
+  namespace: {{ .Definition.Namespace }}
+spec:
+{{- if .Definition.AllNamespaces }}
+  {}
+{{- else }}
+  targetNamespaces:
+  - {{ .Definition.Namespace }}
+{{- end }}
+`
+
+const subscriptionManifest = `apiVersion: operators.coreos.com/v1alpha1
+kind: Subscription
+metadata:
+  name: {{ .Definition.SubscriptionName }}
+  namespace: {{ .Definition.Namespace }}
+spec:
+  name: {{ .Definition.PackageName }}
+  channel: {{ .Definition.Channel }}
+  source: {{ .Definition.Source }}
+  sourceNamespace: {{ .Definition.SourceNamespace }}
+  installPlanApproval: {{ .InstallPlanApproval }}
+`
+
+var builtinTemplates = template.Must(template.New("builtin").Parse(""))
+
+func init() {
+	template.Must(builtinTemplates.New("namespace").Parse(namespaceManifest))
+	template.Must(builtinTemplates.New("operatorgroup").Parse(operatorGroupManifest))
+	template.Must(builtinTemplates.New("subscription").Parse(subscriptionManifest))
+}
+
+// templateData is the data that the manifest templates are executed with
+type templateData struct {
+	Definition          *Definition
+	Operator            *models.MonitoredOperator
+	Cluster             *common.Cluster
+	Properties          map[string]interface{}
+	InstallPlanApproval string
+}
+
+func executeTemplate(templates *template.Template, name string, data *templateData) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	if err := templates.ExecuteTemplate(buffer, name, data); err != nil {
+		return nil, errors.Wrapf(err, "failed to execute manifest template %s", name)
+	}
+	return buffer.Bytes(), nil
+}
+
+// GenerateManifests generates the namespace, the operator group and the subscription of the operator and the
+// additional manifests of its definition
+func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	properties := map[string]interface{}{}
+	if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, o.GetName(), &properties); err != nil {
+		return nil, nil, err
+	}
+	data := &templateData{
+		Definition:          o.definition,
+		Operator:            o.GetMonitoredOperator(),
+		Cluster:             cluster,
+		Properties:          properties,
+		InstallPlanApproval: defaultInstallPlanApproval,
+	}
+
+	openshiftManifests := make(map[string][]byte)
+	for _, builtin := range []string{"namespace", "operatorgroup", "subscription"} {
+		content, err := executeTemplate(builtinTemplates, builtin, data)
+		if err != nil {
+			return nil, nil, err
+		}
+		openshiftManifests["50_"+o.definition.Name+"_"+builtin+".yaml"] = content
+	}
+	for i, manifest := range o.definition.Manifests.OpenShift {
+		content, err := executeTemplate(o.templates, o.definition.templateName("openshift", i, manifest), data)
+		if err != nil {
+			return nil, nil, err
+		}
+		openshiftManifests[manifest.FileName] = content
+	}
+
+	customManifests := &bytes.Buffer{}
+	for i, manifest := range o.definition.Manifests.Custom {
+		content, err := executeTemplate(o.templates, o.definition.templateName("custom", i, manifest), data)
+		if err != nil {
+			return nil, nil, err
+		}
+		customManifests.WriteString("---\n")
+		customManifests.Write(content)
+		customManifests.WriteString("\n")
+	}
+	return openshiftManifests, customManifests.Bytes(), nil
+}
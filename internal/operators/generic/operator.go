@@ -0,0 +1,208 @@
+package generic
+
+import (
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// operator is an OLM operator plugin that is driven by a Definition instead of code. All the generic operators share
+// the same cluster and host validation IDs, the operators manager merges their results.
+type operator struct {
+	log        logrus.FieldLogger
+	definition *Definition
+	templates  *template.Template
+	monitored  models.MonitoredOperator
+}
+
+// NewGenericOperator creates a new operator from its definition
+func NewGenericOperator(log logrus.FieldLogger, definition *Definition) (*operator, error) {
+	templates, err := definition.parseTemplates()
+	if err != nil {
+		return nil, err
+	}
+	return &operator{
+		log:        log,
+		definition: definition,
+		templates:  templates,
+		monitored: models.MonitoredOperator{
+			Name:             definition.Name,
+			Namespace:        definition.Namespace,
+			OperatorType:     models.OperatorTypeOlm,
+			SubscriptionName: definition.SubscriptionName,
+			TimeoutSeconds:   definition.TimeoutSeconds,
+		},
+	}, nil
+}
+
+// GetName reports the name of an operator this Operator manages
+func (o *operator) GetName() string {
+	return o.definition.Name
+}
+
+func (o *operator) GetFullName() string {
+	return o.definition.FullName
+}
+
+// GetDependencies provides a list of dependencies of the Operator
+func (o *operator) GetDependencies(cluster *common.Cluster) ([]string, error) {
+	return append([]string{}, o.definition.Dependencies...), nil
+}
+
+// GetClusterValidationID returns cluster validation ID for the Operator
+func (o *operator) GetClusterValidationID() string {
+	return string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)
+}
+
+// GetHostValidationID returns host validation ID for the Operator
+func (o *operator) GetHostValidationID() string {
+	return string(models.HostValidationIDCustomOperatorsRequirementsSatisfied)
+}
+
+// IsCompatibleWithArchitecture returns true if the operator can be installed on clusters of the CPU architecture
+func (o *operator) IsCompatibleWithArchitecture(_ string, cpuArchitecture string) bool {
+	if len(o.definition.SupportedArchitectures) == 0 {
+		return true
+	}
+	return funk.ContainsString(o.definition.SupportedArchitectures, common.NormalizeCPUArchitecture(cpuArchitecture))
+}
+
+// ValidateCluster verifies whether this operator is valid for given cluster
+func (o *operator) ValidateCluster(_ context.Context, cluster *common.Cluster) (api.ValidationResult, error) {
+	result := api.ValidationResult{Status: api.Success, ValidationId: o.GetClusterValidationID(), Reasons: []string{}}
+
+	if !o.IsCompatibleWithArchitecture(cluster.OpenshiftVersion, cluster.CPUArchitecture) {
+		result.Status = api.Failure
+		result.Reasons = append(result.Reasons, fmt.Sprintf("%s is not supported for %s CPU architecture.", o.GetFullName(), cluster.CPUArchitecture))
+	}
+	if o.definition.MinOpenshiftVersion != "" {
+		if lower, _ := common.BaseVersionLessThan(o.definition.MinOpenshiftVersion, cluster.OpenshiftVersion); lower {
+			result.Status = api.Failure
+			result.Reasons = append(result.Reasons, fmt.Sprintf("%s is only supported for openshift versions %s and above",
+				o.GetFullName(), o.definition.MinOpenshiftVersion))
+		}
+	}
+	if o.definition.MaxOpenshiftVersion != "" {
+		clusterVersion, err := common.GetMajorMinorVersion(cluster.OpenshiftVersion)
+		if err == nil {
+			if higher, _ := common.BaseVersionLessThan(*clusterVersion, o.definition.MaxOpenshiftVersion); higher {
+				result.Status = api.Failure
+				result.Reasons = append(result.Reasons, fmt.Sprintf("%s is only supported for openshift versions up to %s",
+					o.GetFullName(), o.definition.MaxOpenshiftVersion))
+			}
+		}
+	}
+	return result, nil
+}
+
+// ValidateHost returns validationResult based on node type requirements such as memory and cpu
+func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, host *models.Host, _ *models.ClusterHostRequirementsDetails) (api.ValidationResult, error) {
+	if host.Inventory == "" {
+		return api.ValidationResult{Status: api.Pending, ValidationId: o.GetHostValidationID(), Reasons: []string{"Missing Inventory in some of the hosts"}}, nil
+	}
+	inventory, err := common.UnmarshalInventory(host.Inventory)
+	if err != nil {
+		o.log.Errorf("Failed to get inventory from host with id %s", host.ID)
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	requirements, err := o.GetHostRequirements(ctx, cluster, host)
+	if err != nil {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID()}, err
+	}
+
+	if inventory.CPU.Count < requirements.CPUCores {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{fmt.Sprintf(
+			"Insufficient CPU to deploy %s. Required CPU count is %d but found %d ", o.GetFullName(), requirements.CPUCores, inventory.CPU.Count)}}, nil
+	}
+	if inventory.Memory.UsableBytes < conversions.MibToBytes(requirements.RAMMib) {
+		return api.ValidationResult{Status: api.Failure, ValidationId: o.GetHostValidationID(), Reasons: []string{fmt.Sprintf(
+			"Insufficient memory to deploy %s. Required memory is %d MiB but found %d MiB", o.GetFullName(), requirements.RAMMib,
+			conversions.BytesToMib(inventory.Memory.UsableBytes))}}, nil
+	}
+	return api.ValidationResult{Status: api.Success, ValidationId: o.GetHostValidationID(), Reasons: []string{}}, nil
+}
+
+// GenerateManifests is implemented in manifests.go
+
+// GetHostRequirements provides operator's requirements towards the host
+func (o *operator) GetHostRequirements(ctx context.Context, cluster *common.Cluster, host *models.Host) (*models.ClusterHostRequirementsDetails, error) {
+	log := logutil.FromContext(ctx, o.log)
+	preflightRequirements, err := o.GetPreflightRequirements(ctx, cluster)
+	if err != nil {
+		log.WithError(err).Errorf("Cannot retrieve preflight requirements for host %s", host.ID)
+		return nil, err
+	}
+	role := common.GetEffectiveRole(host)
+	if common.IsSingleNodeCluster(cluster) || role == models.HostRoleMaster || role == models.HostRoleBootstrap {
+		return preflightRequirements.Requirements.Master.Quantitative, nil
+	}
+	return preflightRequirements.Requirements.Worker.Quantitative, nil
+}
+
+func hostTypeHardwareRequirements(requirements HostRequirements) *models.HostTypeHardwareRequirements {
+	qualitative := []string{}
+	if requirements.RAMMib > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d MiB of additional RAM", requirements.RAMMib))
+	}
+	if requirements.CPUCores > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d additional CPUs", requirements.CPUCores))
+	}
+	if requirements.DiskSizeGb > 0 {
+		qualitative = append(qualitative, fmt.Sprintf("%d GiB of additional disk space", requirements.DiskSizeGb))
+	}
+	return &models.HostTypeHardwareRequirements{
+		Qualitative: qualitative,
+		Quantitative: &models.ClusterHostRequirementsDetails{
+			CPUCores:   requirements.CPUCores,
+			RAMMib:     requirements.RAMMib,
+			DiskSizeGb: requirements.DiskSizeGb,
+		},
+	}
+}
+
+// GetPreflightRequirements returns operator hardware requirements that can be determined with cluster data only
+func (o *operator) GetPreflightRequirements(context context.Context, cluster *common.Cluster) (*models.OperatorHardwareRequirements, error) {
+	dependencies, err := o.GetDependencies(cluster)
+	if err != nil {
+		return &models.OperatorHardwareRequirements{}, err
+	}
+	return &models.OperatorHardwareRequirements{
+		OperatorName: o.GetName(),
+		Dependencies: dependencies,
+		Requirements: &models.HostTypeHardwareRequirementsWrapper{
+			Master: hostTypeHardwareRequirements(o.definition.Requirements.Master),
+			Worker: hostTypeHardwareRequirements(o.definition.Requirements.Worker),
+		},
+	}, nil
+}
+
+// GetProperties provides description of operator properties
+func (o *operator) GetProperties() models.OperatorProperties {
+	return operatorscommon.DescribeProperties(o.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties declared by its definition
+func (o *operator) GetPropertiesSchema() string {
+	return string(o.definition.PropertiesSchema)
+}
+
+// GetMonitoredOperator returns MonitoredOperator corresponding to the operator definition
+func (o *operator) GetMonitoredOperator() *models.MonitoredOperator {
+	return &o.monitored
+}
+
+// GetFeatureSupportID returns an empty ID, generic operators aren't described by a feature support level and check
+// the CPU architecture of the cluster on their own
+func (o *operator) GetFeatureSupportID() models.FeatureSupportLevelID {
+	return ""
+}
@@ -0,0 +1,188 @@
+package generic
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/operators/api"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Generic operator", func() {
+	var (
+		log      = logrus.New()
+		operator *operator
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		definition, err := ParseDefinition([]byte(sriovDefinition))
+		Expect(err).ToNot(HaveOccurred())
+		operator, err = NewGenericOperator(log, definition)
+		Expect(err).ToNot(HaveOccurred())
+		mode := models.ClusterHighAvailabilityModeFull
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			OpenshiftVersion:     "4.14.3",
+			CPUArchitecture:      common.X86CPUArchitecture,
+			HighAvailabilityMode: &mode,
+		}}
+	})
+
+	It("describes the operator", func() {
+		Expect(operator.GetName()).To(Equal("sriov"))
+		Expect(operator.GetFullName()).To(Equal("SR-IOV Network Operator"))
+		Expect(operator.GetClusterValidationID()).To(Equal(string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)))
+		Expect(operator.GetHostValidationID()).To(Equal(string(models.HostValidationIDCustomOperatorsRequirementsSatisfied)))
+		Expect(operator.GetMonitoredOperator()).To(Equal(&models.MonitoredOperator{
+			Name:             "sriov",
+			Namespace:        "openshift-sriov-network-operator",
+			OperatorType:     models.OperatorTypeOlm,
+			SubscriptionName: "sriov-network-operator-subscription",
+			TimeoutSeconds:   defaultTimeoutSeconds,
+		}))
+	})
+
+	DescribeTable("ValidateCluster",
+		func(openshiftVersion, cpuArchitecture string, expectedReasons []string) {
+			cluster.OpenshiftVersion = openshiftVersion
+			cluster.CPUArchitecture = cpuArchitecture
+			result, err := operator.ValidateCluster(context.TODO(), cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Reasons).To(Equal(expectedReasons))
+			if len(expectedReasons) == 0 {
+				Expect(result.Status).To(Equal(api.Success))
+			} else {
+				Expect(result.Status).To(Equal(api.Failure))
+			}
+		},
+		Entry("supported", "4.14.3", common.X86CPUArchitecture, []string{}),
+		Entry("minimum version", "4.12.0", common.X86CPUArchitecture, []string{}),
+		Entry("maximum version", "4.16.10", common.X86CPUArchitecture, []string{}),
+		Entry("old version", "4.11.5", common.X86CPUArchitecture,
+			[]string{"SR-IOV Network Operator is only supported for openshift versions 4.12 and above"}),
+		Entry("new version", "4.17.0", common.X86CPUArchitecture,
+			[]string{"SR-IOV Network Operator is only supported for openshift versions up to 4.16"}),
+		Entry("architecture", "4.14.3", common.ARM64CPUArchitecture,
+			[]string{"SR-IOV Network Operator is not supported for arm64 CPU architecture."}),
+	)
+
+	Context("host requirements", func() {
+		newHost := func(role models.HostRole, cpuCount, memoryMib int64) *models.Host {
+			inventory, err := common.MarshalInventory(&models.Inventory{
+				CPU:    &models.CPU{Count: cpuCount},
+				Memory: &models.Memory{UsableBytes: conversions.MibToBytes(memoryMib)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return &models.Host{Role: role, Inventory: inventory}
+		}
+
+		It("uses the requirements of the role", func() {
+			requirements, err := operator.GetHostRequirements(context.TODO(), cluster, newHost(models.HostRoleMaster, 1, 1024))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{CPUCores: 1, RAMMib: 512}))
+
+			requirements, err = operator.GetHostRequirements(context.TODO(), cluster, newHost(models.HostRoleWorker, 1, 1024))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements).To(Equal(&models.ClusterHostRequirementsDetails{RAMMib: 256}))
+		})
+
+		It("uses the master requirements for single node clusters", func() {
+			mode := models.ClusterHighAvailabilityModeNone
+			cluster.HighAvailabilityMode = &mode
+			requirements, err := operator.GetHostRequirements(context.TODO(), cluster, newHost(models.HostRoleAutoAssign, 1, 1024))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements.RAMMib).To(BeEquivalentTo(512))
+		})
+
+		It("validates the host", func() {
+			result, err := operator.ValidateHost(context.TODO(), cluster, newHost(models.HostRoleMaster, 1, 1024), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Success))
+
+			result, err = operator.ValidateHost(context.TODO(), cluster, newHost(models.HostRoleMaster, 1, 256), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf(ContainSubstring("Insufficient memory to deploy SR-IOV Network Operator")))
+
+			result, err = operator.ValidateHost(context.TODO(), cluster, &models.Host{Role: models.HostRoleMaster}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(api.Pending))
+		})
+
+		It("describes the preflight requirements", func() {
+			requirements, err := operator.GetPreflightRequirements(context.TODO(), cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requirements.OperatorName).To(Equal("sriov"))
+			Expect(requirements.Requirements.Master.Qualitative).To(ConsistOf("512 MiB of additional RAM", "1 additional CPUs"))
+			Expect(requirements.Requirements.Worker.Qualitative).To(ConsistOf("256 MiB of additional RAM"))
+		})
+	})
+
+	It("generates the manifests", func() {
+		openshiftManifests, customManifests, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(openshiftManifests).To(HaveLen(4))
+		Expect(openshiftManifests).To(HaveKey("50_sriov_namespace.yaml"))
+		Expect(openshiftManifests).To(HaveKey("50_sriov_operatorgroup.yaml"))
+		Expect(string(openshiftManifests["50_sriov_operatorconfig.yaml"])).To(Equal("namespace: openshift-sriov-network-operator\n"))
+		Expect(string(customManifests)).To(Equal("---\noperator: sriov\n\n"))
+
+		subscription := map[string]interface{}{}
+		Expect(yaml.Unmarshal(openshiftManifests["50_sriov_subscription.yaml"], &subscription)).To(Succeed())
+		Expect(subscription["spec"]).To(Equal(map[string]interface{}{
+			"name":                "sriov-network-operator",
+			"channel":             "stable",
+			"source":              "redhat-operators",
+			"sourceNamespace":     "openshift-marketplace",
+			"installPlanApproval": "Automatic",
+		}))
+		for _, manifest := range openshiftManifests {
+			Expect(yaml.Unmarshal(manifest, &map[string]interface{}{})).To(Succeed())
+		}
+	})
+
+	It("executes the templates with the operator properties", func() {
+		definition, err := ParseDefinition([]byte(`
+name: test
+namespace: test
+subscriptionName: test
+channel: stable
+source: redhat-operators
+propertiesSchema:
+  type: object
+  properties:
+    logLevel: {type: integer}
+manifests:
+  custom:
+  - template: "logLevel: {{ with .Properties.logLevel }}{{ . }}{{ else }}0{{ end }}"
+`))
+		Expect(err).ToNot(HaveOccurred())
+		operator, err := NewGenericOperator(log, definition)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operator.GetProperties()).To(ContainElement(&models.OperatorProperty{Name: "logLevel", DataType: models.OperatorPropertyDataTypeInteger}))
+
+		_, customManifests, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(customManifests)).To(Equal("---\nlogLevel: 0\n"))
+
+		cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: "test", Properties: `{"logLevel": 2}`}}
+		_, customManifests, err = operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(customManifests)).To(Equal("---\nlogLevel: 2\n"))
+	})
+
+	It("targets all the namespaces", func() {
+		operator.definition.AllNamespaces = true
+		openshiftManifests, _, err := operator.GenerateManifests(cluster)
+		Expect(err).ToNot(HaveOccurred())
+		operatorGroup := map[string]interface{}{}
+		Expect(yaml.Unmarshal(openshiftManifests["50_sriov_operatorgroup.yaml"], &operatorGroup)).To(Succeed())
+		Expect(operatorGroup["spec"]).To(BeEmpty())
+	})
+})
@@ -7,11 +7,13 @@ import (
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/cluster"
 	"github.com/openshift/assisted-service/internal/common"
 	eventgen "github.com/openshift/assisted-service/internal/common/events"
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/operators"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
@@ -127,3 +129,86 @@ func (h *Handler) UpdateMonitoredOperatorStatus(ctx context.Context, clusterID s
 	eventgen.SendClusterOperatorStatusEvent(ctx, h.eventsHandler, clusterID, operator.Name, string(status), statusInfo)
 	return nil
 }
+
+// EnableClusterOperators enables OLM operators and the dependencies that the cluster doesn't have yet on an installed
+// cluster. The operators are added to the monitored operators of the cluster with the progressing status, so that
+// their rollout is tracked like the rollout of the operators selected before the installation.
+func (h *Handler) EnableClusterOperators(ctx context.Context, clusterID strfmt.UUID, params []*models.OperatorCreateParams) (models.MonitoredOperatorsList, error) {
+	log := logutil.FromContext(ctx, h.log)
+	if len(params) == 0 {
+		return nil, common.NewApiError(http.StatusBadRequest, errors.New("no operators to enable"))
+	}
+	cluster, err := common.GetClusterFromDB(h.db, clusterID, common.UseEagerLoading)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.NewApiError(http.StatusNotFound, err)
+		}
+		return nil, common.NewApiError(http.StatusInternalServerError, err)
+	}
+	if swag.StringValue(cluster.Status) != models.ClusterStatusInstalled && !common.IsDay2Cluster(cluster) {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf(
+			"operators can only be enabled on installed clusters, cluster %s is %s", clusterID, swag.StringValue(cluster.Status)))
+	}
+	// Imported clusters have no kubeconfig in the service, which can't connect to them
+	if swag.BoolValue(cluster.Imported) {
+		return nil, common.NewApiError(http.StatusConflict, errors.Errorf(
+			"operators can't be enabled on imported cluster %s", clusterID))
+	}
+
+	requested := make([]*models.MonitoredOperator, 0, len(params))
+	for _, param := range params {
+		operator, err := h.operatorsAPI.GetOperatorByName(param.Name)
+		if err != nil {
+			return nil, common.NewApiError(http.StatusBadRequest, err)
+		}
+		operator.Properties = param.Properties
+		requested = append(requested, operator)
+	}
+	resolved, err := h.operatorsAPI.ResolveDependencies(cluster, requested)
+	if err != nil {
+		return nil, common.NewApiError(http.StatusBadRequest, err)
+	}
+	operators := make([]*models.MonitoredOperator, 0, len(resolved))
+	for _, operator := range resolved {
+		if operatorscommon.HasOperator(requested, operator.Name) || !operatorscommon.HasOperator(cluster.MonitoredOperators, operator.Name) {
+			operators = append(operators, operator)
+		}
+	}
+
+	if err = h.operatorsAPI.EnableOperators(ctx, cluster, operators); err != nil {
+		log.WithError(err).Errorf("failed to enable operators on cluster %s", clusterID)
+		return nil, err
+	}
+
+	ret := models.MonitoredOperatorsList{}
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		for _, operator := range operators {
+			var err error
+			operator.ClusterID = clusterID
+			if operatorscommon.HasOperator(cluster.MonitoredOperators, operator.Name) {
+				err = tx.Model(&models.MonitoredOperator{}).Where("cluster_id = ? and name = ?", clusterID, operator.Name).
+					Update("properties", operator.Properties).Error
+			} else {
+				err = tx.Create(operator).Error
+			}
+			if err != nil {
+				return common.NewApiError(http.StatusInternalServerError,
+					errors.Wrapf(err, "failed to save operator %s of cluster %s", operator.Name, clusterID))
+			}
+			if err = h.UpdateMonitoredOperatorStatus(ctx, clusterID, operator.Name, "", models.OperatorStatusProgressing, operator.StatusInfo, tx); err != nil {
+				return err
+			}
+			var enabled *models.MonitoredOperator
+			if enabled, err = h.FindMonitoredOperator(ctx, clusterID, operator.Name, tx); err != nil {
+				return err
+			}
+			ret = append(ret, enabled)
+		}
+		return nil
+	})
+	if err != nil {
+		log.WithError(err).Errorf("failed to save the operators enabled on cluster %s", clusterID)
+		return nil, err
+	}
+	return ret, nil
+}
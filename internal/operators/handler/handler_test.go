@@ -17,9 +17,12 @@ import (
 	eventsapi "github.com/openshift/assisted-service/internal/events/api"
 	"github.com/openshift/assisted-service/internal/events/eventstest"
 	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/cnv"
 	operatorsHandler "github.com/openshift/assisted-service/internal/operators/handler"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/models"
+	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -205,6 +208,136 @@ var _ = Describe("Operators manager", func() {
 			}
 		})
 	})
+
+	Context("EnableClusterOperators", func() {
+		BeforeEach(func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", *c.ID).Update("status", models.ClusterStatusInstalled).Error).ToNot(HaveOccurred())
+		})
+
+		expectOperators := func() {
+			mockApi.EXPECT().GetOperatorByName(cnv.Operator.Name).Return(from(cnv.Operator), nil)
+			mockApi.EXPECT().ResolveDependencies(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *common.Cluster, operators []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
+					return append(operators, from(lso.Operator)), nil
+				})
+		}
+
+		It("should enable the operator and track its rollout", func() {
+			expectOperators()
+			mockApi.EXPECT().EnableOperators(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ *common.Cluster, enabled []*models.MonitoredOperator) error {
+					// The dependency is already enabled on the cluster
+					Expect(enabled).To(HaveLen(1))
+					Expect(enabled[0].Name).To(Equal(cnv.Operator.Name))
+					Expect(enabled[0].Properties).To(Equal(`{"channel": "candidate"}`))
+					enabled[0].StatusInfo = operators.OperatorManifestsAppliedStatusInfo
+					return nil
+				})
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+				eventstest.WithClusterIdMatcher(c.ID.String()))).Times(1)
+
+			reply := handler.V2EnableClusterOperators(context.TODO(), restoperators.V2EnableClusterOperatorsParams{
+				ClusterID: *c.ID,
+				Operators: []*models.OperatorCreateParams{{Name: cnv.Operator.Name, Properties: `{"channel": "candidate"}`}},
+			})
+
+			Expect(reply).To(BeAssignableToTypeOf(restoperators.NewV2EnableClusterOperatorsCreated()))
+			payload := reply.(*restoperators.V2EnableClusterOperatorsCreated).Payload
+			Expect(payload).To(HaveLen(1))
+			Expect(payload[0].Name).To(Equal(cnv.Operator.Name))
+			Expect(payload[0].Status).To(Equal(models.OperatorStatusProgressing))
+
+			operatorName := cnv.Operator.Name
+			enabled, err := handler.GetMonitoredOperators(context.TODO(), *c.ID, &operatorName, db)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled[0].ClusterID).To(Equal(*c.ID))
+			Expect(enabled[0].StatusInfo).To(Equal(operators.OperatorManifestsAppliedStatusInfo))
+			Expect(enabled[0].Properties).To(Equal(`{"channel": "candidate"}`))
+		})
+
+		It("should not save the operator when it can't be enabled", func() {
+			expectOperators()
+			mockApi.EXPECT().EnableOperators(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				common.NewApiError(http.StatusBadRequest, errors.New("not enough resources")))
+
+			reply := handler.V2EnableClusterOperators(context.TODO(), restoperators.V2EnableClusterOperatorsParams{
+				ClusterID: *c.ID,
+				Operators: []*models.OperatorCreateParams{{Name: cnv.Operator.Name}},
+			})
+
+			Expect(reply).To(BeAssignableToTypeOf(common.NewApiError(http.StatusBadRequest, nil)))
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusBadRequest))
+			operatorName := cnv.Operator.Name
+			_, err := handler.FindMonitoredOperator(context.TODO(), *c.ID, operatorName, db)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a cluster that isn't installed", func() {
+			reply := handler.V2EnableClusterOperators(context.TODO(), restoperators.V2EnableClusterOperatorsParams{
+				ClusterID: *c2.ID,
+				Operators: []*models.OperatorCreateParams{{Name: cnv.Operator.Name}},
+			})
+
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+		})
+
+		It("should reject an imported cluster", func() {
+			Expect(db.Model(&common.Cluster{}).Where("id = ?", *c.ID).Updates(map[string]interface{}{
+				"status":   models.ClusterStatusAddingHosts,
+				"imported": true,
+				"kind":     models.ClusterKindAddHostsCluster,
+			}).Error).ToNot(HaveOccurred())
+
+			reply := handler.V2EnableClusterOperators(context.TODO(), restoperators.V2EnableClusterOperatorsParams{
+				ClusterID: *c.ID,
+				Operators: []*models.OperatorCreateParams{{Name: cnv.Operator.Name}},
+			})
+
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusConflict))
+		})
+
+		It("should reject an unknown cluster", func() {
+			reply := handler.V2EnableClusterOperators(context.TODO(), restoperators.V2EnableClusterOperatorsParams{
+				ClusterID: strfmt.UUID(uuid.New().String()),
+				Operators: []*models.OperatorCreateParams{{Name: cnv.Operator.Name}},
+			})
+
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+		})
+	})
+
+	Context("Bundles", func() {
+		It("should list the bundles for the given OpenShift version, CPU architecture and platform", func() {
+			bundles := []*models.Bundle{{ID: swag.String(models.BundleIDVirtualization)}}
+			mockApi.EXPECT().ListBundles(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, cluster *common.Cluster) ([]*models.Bundle, error) {
+					Expect(cluster.OpenshiftVersion).To(Equal("4.16"))
+					Expect(cluster.CPUArchitecture).To(Equal(models.ClusterCPUArchitectureArm64))
+					Expect(*cluster.Platform.Type).To(Equal(models.PlatformTypeBaremetal))
+					return bundles, nil
+				})
+
+			reply := handler.V2ListBundles(context.TODO(), restoperators.V2ListBundlesParams{
+				OpenshiftVersion: swag.String("4.16"),
+				CPUArchitecture:  swag.String(models.ClusterCPUArchitectureArm64),
+				PlatformType:     swag.String(string(models.PlatformTypeBaremetal)),
+			})
+
+			Expect(reply).To(BeAssignableToTypeOf(restoperators.NewV2ListBundlesOK()))
+			Expect(reply.(*restoperators.V2ListBundlesOK).Payload).To(Equal(bundles))
+		})
+
+		It("should report a bundle that doesn't exist", func() {
+			mockApi.EXPECT().GetBundle(gomock.Any(), gomock.Any(), "unknown").
+				Return(nil, common.NewApiError(http.StatusNotFound, errors.New("bundle unknown is not supported")))
+
+			reply := handler.V2GetBundle(context.TODO(), restoperators.V2GetBundleParams{BundleID: "unknown"})
+
+			Expect(reply).To(BeAssignableToTypeOf(&common.ApiErrorResponse{}))
+			Expect(reply.(*common.ApiErrorResponse).StatusCode()).To(BeEquivalentTo(http.StatusNotFound))
+		})
+	})
 })
 
 func from(prototype models.MonitoredOperator) *models.MonitoredOperator {
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
 	restoperators "github.com/openshift/assisted-service/restapi/operations/operators"
 )
@@ -18,6 +20,15 @@ func (h *Handler) V2ListOfClusterOperators(ctx context.Context, params restopera
 	return restoperators.NewV2ListOfClusterOperatorsOK().WithPayload(operatorsList)
 }
 
+// V2EnableClusterOperators Enables additional operators on an installed cluster.
+func (h *Handler) V2EnableClusterOperators(ctx context.Context, params restoperators.V2EnableClusterOperatorsParams) middleware.Responder {
+	operatorsList, err := h.EnableClusterOperators(ctx, params.ClusterID, params.Operators)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2EnableClusterOperatorsCreated().WithPayload(operatorsList)
+}
+
 // V2ListOperatorProperties Lists properties for an operator name.
 func (h *Handler) V2ListOperatorProperties(ctx context.Context, params restoperators.V2ListOperatorPropertiesParams) middleware.Responder {
 	log := logutil.FromContext(ctx, h.log)
@@ -36,3 +47,33 @@ func (h *Handler) V2ListSupportedOperators(_ context.Context, _ restoperators.V2
 	return restoperators.NewV2ListSupportedOperatorsOK().
 		WithPayload(h.operatorsAPI.GetSupportedOperators())
 }
+
+// V2ListBundles Lists the bundles of operators.
+func (h *Handler) V2ListBundles(ctx context.Context, params restoperators.V2ListBundlesParams) middleware.Responder {
+	cluster := &common.Cluster{Cluster: models.Cluster{
+		OpenshiftVersion:     swag.StringValue(params.OpenshiftVersion),
+		CPUArchitecture:      swag.StringValue(params.CPUArchitecture),
+		HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+	}}
+	if params.PlatformType != nil {
+		cluster.Platform = &models.Platform{Type: models.NewPlatformType(models.PlatformType(*params.PlatformType))}
+	}
+	bundles, err := h.operatorsAPI.ListBundles(ctx, cluster)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2ListBundlesOK().WithPayload(bundles)
+}
+
+// V2GetBundle Retrieves a bundle of operators.
+func (h *Handler) V2GetBundle(ctx context.Context, params restoperators.V2GetBundleParams) middleware.Responder {
+	cluster := &common.Cluster{Cluster: models.Cluster{
+		CPUArchitecture:      common.DefaultCPUArchitecture,
+		HighAvailabilityMode: swag.String(models.ClusterHighAvailabilityModeFull),
+	}}
+	bundle, err := h.operatorsAPI.GetBundle(ctx, cluster, params.BundleID)
+	if err != nil {
+		return common.GenerateErrorResponder(err)
+	}
+	return restoperators.NewV2GetBundleOK().WithPayload(bundle)
+}
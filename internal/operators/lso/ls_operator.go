@@ -5,6 +5,7 @@ import (
 
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 )
 
@@ -65,9 +66,14 @@ func (l *lsOperator) GenerateManifests(c *common.Cluster) (map[string][]byte, []
 	return Manifests()
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties.
 func (l *lsOperator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(l.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties: only the common properties are accepted
+func (l *lsOperator) GetPropertiesSchema() string {
+	return ""
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the LSO
@@ -137,9 +137,14 @@ func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte
 	return Manifests(cluster)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(o.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties
+func (o *operator) GetPropertiesSchema() string {
+	return propertiesSchema
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the LSO
@@ -23,7 +23,11 @@ func Manifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
 	if err != nil {
 		return nil, nil, err
 	}
-	lvmcluster, err := getLvmCluster()
+	properties, err := getProperties(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	lvmcluster, err := getLvmCluster(properties)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -80,15 +84,16 @@ func getOperatorGroup() ([]byte, error) {
 	return executeTemplate(data, "LvmOperatorGroup", LvmOperatorGroup)
 }
 
-func getLvmCluster() ([]byte, error) {
-	data := map[string]string{
+func getLvmCluster(properties *Properties) ([]byte, error) {
+	data := map[string]interface{}{
 		"OPERATOR_NAMESPACE": Operator.Namespace,
 		"DEVICE_NAME":        defaultDeviceName,
+		"DEVICE_SELECTOR":    properties.DeviceSelector,
 	}
 	return executeTemplate(data, "LvmCluster", LvmCluster)
 }
 
-func executeTemplate(data map[string]string, contentName, content string) ([]byte, error) {
+func executeTemplate(data interface{}, contentName, content string) ([]byte, error) {
 	tmpl, err := template.New(contentName).Parse(content)
 	if err != nil {
 		return nil, err
@@ -138,6 +143,21 @@ spec:
   storage:
     deviceClasses:
     - name: {{.DEVICE_NAME}}
+{{- with .DEVICE_SELECTOR }}
+      deviceSelector:
+{{- with .Paths }}
+        paths:
+{{- range . }}
+        - "{{ . }}"
+{{- end }}
+{{- end }}
+{{- with .OptionalPaths }}
+        optionalPaths:
+{{- range . }}
+        - "{{ . }}"
+{{- end }}
+{{- end }}
+{{- end }}
       thinPoolConfig:
         name: thin-pool-1
         sizePercent: 90
@@ -42,6 +42,36 @@ var _ = Describe("LVM manifest generation", func() {
 			Expect(err).ShouldNot(HaveOccurred(), "yamltojson err: %v", err)
 		})
 	})
+	It("Selects the devices of the LVM cluster from the operator properties", func() {
+		cluster = getCluster("4.15.0")
+		_, manifest, err := operator.GenerateManifests(cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(manifest)).ToNot(ContainSubstring("deviceSelector"))
+
+		cluster.MonitoredOperators = []*models.MonitoredOperator{{
+			Name:       Operator.Name,
+			Properties: `{"deviceSelector": {"paths": ["/dev/sdb"], "optionalPaths": ["/dev/sdc", "/dev/sdd"]}}`,
+		}}
+		_, manifest, err = operator.GenerateManifests(cluster)
+		Expect(err).ShouldNot(HaveOccurred())
+		lvmCluster := struct {
+			Spec struct {
+				Storage struct {
+					DeviceClasses []struct {
+						Name           string         `json:"name"`
+						DeviceSelector DeviceSelector `json:"deviceSelector"`
+					} `json:"deviceClasses"`
+				} `json:"storage"`
+			} `json:"spec"`
+		}{}
+		Expect(yaml.Unmarshal(manifest, &lvmCluster)).To(Succeed())
+		Expect(lvmCluster.Spec.Storage.DeviceClasses).To(HaveLen(1))
+		Expect(lvmCluster.Spec.Storage.DeviceClasses[0].DeviceSelector).To(Equal(DeviceSelector{
+			Paths:         []string{"/dev/sdb"},
+			OptionalPaths: []string{"/dev/sdc", "/dev/sdd"},
+		}))
+	})
+
 	It("Check Subscription information", func() {
 		cluster = getCluster("4.12.0-rc.4")
 		subscriptionInfo, err := getSubscriptionInfo(cluster.OpenshiftVersion)
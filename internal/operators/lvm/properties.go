@@ -0,0 +1,50 @@
+package lvm
+
+import (
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+)
+
+const propertiesSchema = `{
+  "type": "object",
+  "properties": {
+    "deviceSelector": {
+      "type": "object",
+      "description": "Disks of the hosts that the volume group is created on, all the available disks are used when not set",
+      "properties": {
+        "paths": {
+          "type": "array",
+          "description": "Disks that must exist on every host",
+          "items": {"type": "string", "pattern": "^/dev/"}
+        },
+        "optionalPaths": {
+          "type": "array",
+          "description": "Disks that are used when they exist",
+          "items": {"type": "string", "pattern": "^/dev/"}
+        }
+      },
+      "minProperties": 1,
+      "additionalProperties": false
+    }
+  },
+  "additionalProperties": false
+}`
+
+// DeviceSelector selects the disks that the volume group of the LVM cluster is created on
+type DeviceSelector struct {
+	Paths         []string `json:"paths,omitempty"`
+	OptionalPaths []string `json:"optionalPaths,omitempty"`
+}
+
+// Properties are the settings of the operator that can be set when it's enabled
+type Properties struct {
+	DeviceSelector *DeviceSelector `json:"deviceSelector,omitempty"`
+}
+
+func getProperties(cluster *common.Cluster) (*Properties, error) {
+	properties := &Properties{}
+	if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, Operator.Name, properties); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
@@ -18,13 +18,16 @@ import (
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/mce"
 	"github.com/openshift/assisted-service/internal/operators/odf"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
 	"github.com/openshift/assisted-service/models"
 	logutil "github.com/openshift/assisted-service/pkg/log"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/thoas/go-funk"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const controllerManifestFile = "custom_manifests.json"
@@ -46,6 +49,10 @@ type Manager struct {
 	monitoredOperators map[string]*models.MonitoredOperator
 	manifestsAPI       manifestsapi.ManifestsAPI
 	objectHandler      s3wrapper.API
+	// mirrorRegistriesBuilder provides the mirror registries of the service
+	mirrorRegistriesBuilder mirrorregistries.ServiceMirrorRegistriesConfigBuilder
+	// spokeK8sClientFactory creates the clients of the installed clusters that operators are enabled on
+	spokeK8sClientFactory spoke_k8s_client.SpokeK8sClientFactory
 }
 
 // API defines Operator management operation
@@ -79,6 +86,17 @@ type API interface {
 	GetPreflightRequirementsBreakdownForCluster(ctx context.Context, cluster *common.Cluster) ([]*models.OperatorHardwareRequirements, error)
 	// EnsureOperatorPrerequisite Ensure that for the given operators has the base prerequisite for installation
 	EnsureOperatorPrerequisite(cluster *common.Cluster, openshiftVersion string, cpuArchitecture string, operators []*models.MonitoredOperator) error
+	// ListBundles returns the bundles of operators that the cluster supports with their preflight requirements
+	ListBundles(ctx context.Context, cluster *common.Cluster) ([]*models.Bundle, error)
+	// GetBundle returns a bundle of operators with its preflight requirements for the cluster
+	GetBundle(ctx context.Context, cluster *common.Cluster, bundleID string) (*models.Bundle, error)
+	// ExpandBundles validates the bundles against the cluster and adds their operators to the given operators
+	ExpandBundles(cluster *common.Cluster, bundleIDs []string, operators []*models.OperatorCreateParams) ([]*models.OperatorCreateParams, error)
+	// EnableOperators validates the nodes of an installed cluster and applies the manifests of the operators to it
+	EnableOperators(ctx context.Context, cluster *common.Cluster, operators []*models.MonitoredOperator) error
+	// CreatePendingCustomResources creates the custom resources of an operator enabled on an installed cluster once
+	// the operator is installed, it returns false while the operator isn't installed yet
+	CreatePendingCustomResources(ctx context.Context, cluster *common.Cluster, spokeClient client.Client, operator *models.MonitoredOperator) (bool, error)
 }
 
 // GetPreflightRequirementsBreakdownForCluster provides host requirements breakdown for each supported OLM operator
@@ -150,6 +168,7 @@ func hasMCEAndStorage(operators []*models.MonitoredOperator) bool {
 // Returns map assigning manifest content to its desired file name
 func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Cluster) error {
 	var controllerManifests []Manifest
+	catalogSources := make(map[string]bool)
 	// Generate manifests for all the generic operators
 	for _, clusterOperator := range cluster.MonitoredOperators {
 		if clusterOperator.OperatorType != models.OperatorTypeOlm {
@@ -163,6 +182,9 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 				mgr.log.Error(fmt.Sprintf("Cannot generate %s manifests due to ", clusterOperator.Name), err)
 				return err
 			}
+			if err = overrideSubscription(clusterOperator, openshiftManifests, catalogSources); err != nil {
+				return err
+			}
 			for k, v := range openshiftManifests {
 				err = mgr.createInstallManifests(ctx, cluster, k, v, models.ManifestFolderOpenshift)
 				if err != nil {
@@ -201,6 +223,28 @@ func (mgr *Manager) GenerateManifests(ctx context.Context, cluster *common.Clust
 	return nil
 }
 
+// overrideSubscription customizes the subscription of the operator according to its properties, and adds the
+// manifest of the catalog source that it is installed from when the properties set the image of the catalog. Catalog
+// sources shared by several operators are only added once.
+func overrideSubscription(clusterOperator *models.MonitoredOperator, openshiftManifests map[string][]byte, catalogSources map[string]bool) error {
+	properties, err := operatorscommon.GetSubscriptionProperties(clusterOperator)
+	if err != nil {
+		return err
+	}
+	if err = operatorscommon.OverrideSubscription(openshiftManifests, properties); err != nil {
+		return err
+	}
+	if properties.CatalogSource == nil || properties.CatalogSource.Image == "" {
+		return nil
+	}
+	name := operatorscommon.CatalogSourceManifestName(properties.CatalogSource)
+	if !catalogSources[name] {
+		catalogSources[name] = true
+		openshiftManifests[name] = operatorscommon.CatalogSourceManifest(properties.CatalogSource)
+	}
+	return nil
+}
+
 // createControllerManifest create a file called custom_manifests.json, which is later obtained by the
 // assisted-installer-controller, which apply this manifest file after the OLM is deployed,
 // so user can provide here even CRs provisioned by the OLM.
@@ -282,7 +326,7 @@ func (mgr *Manager) ValidateHost(ctx context.Context, cluster *common.Cluster, h
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeValidationResults(results, string(models.HostValidationIDCustomOperatorsRequirementsSatisfied)), nil
 }
 
 // ValidateCluster validates cluster requirements
@@ -294,6 +338,7 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 		pendingOperators[k] = struct{}{}
 	}
 
+	catalogImages := make(map[string]string)
 	for _, clusterOperator := range cluster.MonitoredOperators {
 		if clusterOperator.OperatorType != models.OperatorTypeOlm {
 			continue
@@ -301,6 +346,24 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 
 		operator := mgr.olmOperators[clusterOperator.Name]
 		if operator != nil {
+			if err := validateStoredOperatorProperties(operator, clusterOperator.Properties); err != nil {
+				delete(pendingOperators, clusterOperator.Name)
+				results = append(results, api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: operator.GetClusterValidationID(),
+					Reasons:      []string{fmt.Sprintf("Invalid properties of %s: %s", operator.GetFullName(), err.Error())},
+				})
+				continue
+			}
+			if err := mgr.validateCatalogSource(cluster, clusterOperator, catalogImages); err != nil {
+				delete(pendingOperators, clusterOperator.Name)
+				results = append(results, api.ValidationResult{
+					Status:       api.Failure,
+					ValidationId: operator.GetClusterValidationID(),
+					Reasons:      []string{fmt.Sprintf("Invalid catalog source of %s: %s", operator.GetFullName(), err.Error())},
+				})
+				continue
+			}
 			result, err := operator.ValidateCluster(ctx, cluster)
 			if err != nil {
 				return nil, err
@@ -321,7 +384,48 @@ func (mgr *Manager) ValidateCluster(ctx context.Context, cluster *common.Cluster
 		}
 		results = append(results, result)
 	}
-	return results, nil
+	return mergeValidationResults(results, string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)), nil
+}
+
+var validationStatusSeverity = map[api.ValidationStatus]int{
+	api.Success: 0,
+	api.Pending: 1,
+	api.Failure: 2,
+}
+
+// mergeValidationResults merges the results of the operators that share a validation ID, like the generic operators
+// do, into a single result with the most severe status. The custom operators validation is always reported, even
+// when no generic operator is configured, as the state machines require every validation to be present.
+func mergeValidationResults(results []api.ValidationResult, customValidationID string) []api.ValidationResult {
+	merged := make([]api.ValidationResult, 0, len(results)+1)
+	indexes := make(map[string]int)
+	for _, result := range results {
+		i, ok := indexes[result.ValidationId]
+		if !ok {
+			indexes[result.ValidationId] = len(merged)
+			merged = append(merged, result)
+			continue
+		}
+		current := &merged[i]
+		switch {
+		case validationStatusSeverity[result.Status] > validationStatusSeverity[current.Status]:
+			if current.Status == api.Success {
+				current.Reasons = nil
+			}
+			current.Status = result.Status
+			current.Reasons = append(append([]string{}, current.Reasons...), result.Reasons...)
+		case result.Status == current.Status || result.Status != api.Success:
+			current.Reasons = append(append([]string{}, current.Reasons...), result.Reasons...)
+		}
+	}
+	if _, ok := indexes[customValidationID]; !ok {
+		merged = append(merged, api.ValidationResult{
+			Status:       api.Success,
+			ValidationId: customValidationID,
+			Reasons:      []string{"No custom operators are configured"},
+		})
+	}
+	return merged
 }
 
 // GetSupportedOperators returns a list of OLM operators that are supported
@@ -437,7 +541,93 @@ func (mgr *Manager) GetSupportedOperatorsByType(operatorType models.OperatorType
 	return operators
 }
 
+// validateOperatorProperties verifies that the properties of an operator match the schema that it declares
+func validateOperatorProperties(operator api.Operator, properties string) error {
+	schema, err := operatorscommon.PropertiesSchema(operator.GetPropertiesSchema())
+	if err != nil {
+		return err
+	}
+	return operatorscommon.ValidateProperties(schema, properties)
+}
+
+// validateStoredOperatorProperties verifies the properties that a cluster stores for an operator, leniently so that
+// the clusters created before the properties had a schema keep installing
+func validateStoredOperatorProperties(operator api.Operator, properties string) error {
+	schema, err := operatorscommon.PropertiesSchema(operator.GetPropertiesSchema())
+	if err != nil {
+		return err
+	}
+	return operatorscommon.ValidateStoredProperties(schema, properties)
+}
+
+// validateCatalogSource verifies that the catalog source that the properties of the operator set has the same image as
+// the catalog sources of the same name of the other operators, and that in disconnected installations its image is
+// pulled from a mirrored registry
+func (mgr *Manager) validateCatalogSource(cluster *common.Cluster, clusterOperator *models.MonitoredOperator, catalogImages map[string]string) error {
+	properties, err := operatorscommon.GetSubscriptionProperties(clusterOperator)
+	if err != nil {
+		return err
+	}
+	catalogSource := properties.CatalogSource
+	if catalogSource == nil || catalogSource.Image == "" {
+		return nil
+	}
+	key := catalogSource.GetNamespace() + "/" + catalogSource.Name
+	if image, ok := catalogImages[key]; ok && image != catalogSource.Image {
+		return errors.Errorf("catalog source %s is set with both image %s and image %s", key, image, catalogSource.Image)
+	}
+	catalogImages[key] = catalogSource.Image
+
+	locations, err := mgr.getMirroredLocations(cluster)
+	if err != nil {
+		return err
+	}
+	if locations != nil && !operatorscommon.IsImageMirrored(catalogSource.Image, locations) {
+		return errors.Errorf("image %s is not pulled from any of the mirrored registries %s", catalogSource.Image,
+			strings.Join(locations, ", "))
+	}
+	return nil
+}
+
+// getMirroredLocations returns the registries and their mirrors that are configured for the cluster, or for the
+// service when the cluster has no mirror configuration of its own. It returns nil when no mirror is configured.
+func (mgr *Manager) getMirroredLocations(cluster *common.Cluster) ([]string, error) {
+	configuration, err := cluster.GetMirrorRegistryConfiguration()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the mirror registry configuration of the cluster")
+	}
+	var registries []mirrorregistries.RegistriesConf
+	if common.IsMirrorConfigurationSet(configuration) {
+		registries, err = mirrorregistries.ExtractLocationMirrorDataFromRegistriesFromToml(configuration.RegistriesConf)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse the mirror registry configuration of the cluster")
+		}
+	} else if mgr.mirrorRegistriesBuilder.IsMirrorRegistriesConfigured() {
+		registries, err = mgr.mirrorRegistriesBuilder.ExtractLocationMirrorDataFromRegistries()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get the mirror registries of the service")
+		}
+	} else {
+		return nil, nil
+	}
+	locations := make([]string, 0)
+	for _, registry := range registries {
+		locations = append(locations, registry.Location)
+		locations = append(locations, registry.Mirror...)
+	}
+	return locations, nil
+}
+
+// architectureChecker is implemented by operators that aren't described by a feature support level and check the CPU
+// architecture on their own
+type architectureChecker interface {
+	IsCompatibleWithArchitecture(openshiftVersion, cpuArchitecture string) bool
+}
+
 func isOperatorCompatibleWithArchitecture(cluster *common.Cluster, cpuArchitecture string, operator api.Operator) bool {
+	if checker, ok := operator.(architectureChecker); ok {
+		return checker.IsCompatibleWithArchitecture(cluster.OpenshiftVersion, cpuArchitecture)
+	}
 	featureId := operator.GetFeatureSupportID()
 	return featuresupport.IsFeatureCompatibleWithArchitecture(featureId, cluster.OpenshiftVersion, cpuArchitecture)
 }
@@ -502,6 +692,16 @@ func EnsureLVMAndCNVDoNotClash(cluster *common.Cluster, openshiftVersion string,
 }
 
 func (mgr *Manager) EnsureOperatorPrerequisite(cluster *common.Cluster, openshiftVersion string, cpuArchitecture string, operators []*models.MonitoredOperator) error {
+	for _, operator := range operators {
+		olmOperator, ok := mgr.olmOperators[operator.Name]
+		if !ok {
+			continue
+		}
+		if err := validateOperatorProperties(olmOperator, operator.Properties); err != nil {
+			return errors.Wrapf(err, "invalid properties of operator %s", operator.Name)
+		}
+	}
+
 	err := EnsureLVMAndCNVDoNotClash(cluster, openshiftVersion, operators)
 	if err != nil {
 		return err
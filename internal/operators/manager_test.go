@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -20,12 +21,14 @@ import (
 	"github.com/openshift/assisted-service/internal/operators"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
+	"github.com/openshift/assisted-service/internal/operators/generic"
 	"github.com/openshift/assisted-service/internal/operators/lso"
 	"github.com/openshift/assisted-service/internal/operators/lvm"
 	"github.com/openshift/assisted-service/internal/operators/mce"
 	"github.com/openshift/assisted-service/internal/operators/odf"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
+	"github.com/openshift/assisted-service/pkg/mirrorregistries"
 	"github.com/openshift/assisted-service/pkg/s3wrapper"
 	operations "github.com/openshift/assisted-service/restapi/operations/manifests"
 	"github.com/sirupsen/logrus"
@@ -202,6 +205,57 @@ var _ = Describe("Operators manager", func() {
 			Expect(manager.GenerateManifests(ctx, cluster)).ShouldNot(HaveOccurred())
 		})
 
+		It("should override the channel of the subscription from the operator properties", func() {
+			operator := lso.Operator
+			operator.Properties = `{"channel": "stable-4.16"}`
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&operator}
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			subscriptions := 0
+			manifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).DoAndReturn(
+				func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+					content, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+					Expect(err).ToNot(HaveOccurred())
+					if strings.Contains(string(content), "kind: Subscription") {
+						subscriptions++
+						Expect(string(content)).To(ContainSubstring("channel: stable-4.16"))
+					}
+					return &models.Manifest{}, nil
+				}).Times(3)
+			Expect(manager.GenerateManifests(ctx, cluster)).ShouldNot(HaveOccurred())
+			Expect(subscriptions).To(Equal(1))
+		})
+
+		It("should pin the subscription and create the catalog source from the operator properties", func() {
+			operator := lso.Operator
+			operator.Properties = `{"startingCSV": "local-storage-operator.v4.16.0", "installPlanApproval": "Manual",
+				"catalogSource": {"name": "mirror", "image": "mirror.example.com/olm/index:v4.16"}}`
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&operator}
+			mockS3Api.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			manifests := make(map[string]string)
+			manifestsAPI.EXPECT().CreateClusterManifestInternal(gomock.Any(), gomock.Any(), false).DoAndReturn(
+				func(_ context.Context, params operations.V2CreateClusterManifestParams, _ bool) (*models.Manifest, error) {
+					content, err := base64.StdEncoding.DecodeString(*params.CreateManifestParams.Content)
+					Expect(err).ToNot(HaveOccurred())
+					manifests[*params.CreateManifestParams.FileName] = string(content)
+					return &models.Manifest{}, nil
+				}).Times(4)
+			Expect(manager.GenerateManifests(ctx, cluster)).ShouldNot(HaveOccurred())
+
+			Expect(manifests).To(HaveKey("50_catalogsource_openshift-marketplace_mirror.yaml"))
+			Expect(manifests["50_catalogsource_openshift-marketplace_mirror.yaml"]).To(ContainSubstring("image: mirror.example.com/olm/index:v4.16"))
+			subscriptions := 0
+			for _, content := range manifests {
+				if strings.Contains(content, "kind: Subscription") {
+					subscriptions++
+					Expect(content).To(ContainSubstring("startingCSV: local-storage-operator.v4.16.0"))
+					Expect(content).To(ContainSubstring("installPlanApproval: Manual"))
+					Expect(content).To(ContainSubstring("source: mirror"))
+					Expect(content).To(ContainSubstring("sourceNamespace: openshift-marketplace"))
+				}
+			}
+			Expect(subscriptions).To(Equal(1))
+		})
+
 		It("should create 8 manifests (CNV + LSO) using the manifest API", func() {
 			cluster.MonitoredOperators = []*models.MonitoredOperator{
 				&cnv.Operator,
@@ -447,7 +501,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(12))
+			Expect(results).To(HaveLen(13))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied), Reasons: []string{"odf is disabled"}},
@@ -461,6 +515,7 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied), Reasons: []string{"servicemesh is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied), Reasons: []string{"serverless is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied), Reasons: []string{"openshift-ai is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied), Reasons: []string{"No custom operators are configured"}},
 			))
 		})
 
@@ -473,7 +528,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateCluster(context.TODO(), cluster)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(12))
+			Expect(results).To(HaveLen(13))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDLsoRequirementsSatisfied), Reasons: []string{}},
 				api.ValidationResult{Status: api.Failure, ValidationId: string(models.ClusterValidationIDOdfRequirementsSatisfied),
@@ -488,8 +543,149 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDServicemeshRequirementsSatisfied), Reasons: []string{"servicemesh is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDServerlessRequirementsSatisfied), Reasons: []string{"serverless is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDOpenshiftAiRequirementsSatisfied), Reasons: []string{"openshift-ai is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied), Reasons: []string{"No custom operators are configured"}},
+			))
+		})
+	})
+
+	Context("Operator properties", func() {
+		It("should deem an operator cluster-invalid when its properties don't match its schema", func() {
+			operator := mce.Operator
+			operator.Properties = `{"availabilityConfig": "Large"}`
+			cluster.MonitoredOperators = []*models.MonitoredOperator{&operator}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(ContainElement(HaveField("ValidationId", string(models.ClusterValidationIDMceRequirementsSatisfied))))
+			for _, result := range results {
+				if result.ValidationId == string(models.ClusterValidationIDMceRequirementsSatisfied) {
+					Expect(result.Status).To(Equal(api.Failure))
+					Expect(result.Reasons).To(HaveLen(1))
+					Expect(result.Reasons[0]).To(HavePrefix("Invalid properties of multicluster engine: "))
+					Expect(result.Reasons[0]).To(ContainSubstring("availabilityConfig should be one of"))
+				}
+			}
+		})
+
+		DescribeTable("should accept the properties of a pre-existing cluster that the schema doesn't describe",
+			func(properties string) {
+				operator := mce.Operator
+				operator.Properties = properties
+				cluster.MonitoredOperators = []*models.MonitoredOperator{&operator}
+
+				results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(ContainElement(HaveField("ValidationId", string(models.ClusterValidationIDMceRequirementsSatisfied))))
+				for _, result := range results {
+					Expect(result.Reasons).ToNot(ContainElement(HavePrefix("Invalid properties")))
+				}
+			},
+			Entry("unknown key", `{"availabilityConfig": "Basic", "replicas": 3}`),
+			Entry("not JSON", `replicas=3`),
+			Entry("not an object", `["replicas"]`),
+		)
+
+		It("should reject the unknown properties of an operator when it's enabled", func() {
+			operator := mce.Operator
+			operator.Properties = `{"availabilityConfig": "Basic", "replicas": 3}`
+
+			err := manager.EnsureOperatorPrerequisite(cluster, cluster.OpenshiftVersion, cluster.CPUArchitecture, []*models.MonitoredOperator{&operator})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("invalid properties of operator mce: "))
+			Expect(err.Error()).To(ContainSubstring("replicas is a forbidden property"))
+		})
+	})
+
+	Context("Catalog sources", func() {
+		var mirrorRegistriesBuilder *mirrorregistries.MockServiceMirrorRegistriesConfigBuilder
+
+		BeforeEach(func() {
+			mirrorRegistriesBuilder = mirrorregistries.NewMockServiceMirrorRegistriesConfigBuilder(ctrl)
+			manager = operators.NewManager(log, manifestsAPI, operators.Options{MirrorRegistriesBuilder: mirrorRegistriesBuilder}, mockS3Api)
+		})
+
+		withCatalogSource := func(operator models.MonitoredOperator, name, image string) *models.MonitoredOperator {
+			operator.Properties = fmt.Sprintf(`{"catalogSource": {"name": "%s", "image": "%s"}}`, name, image)
+			return &operator
+		}
+
+		validationResult := func(results []api.ValidationResult, validationID models.ClusterValidationID) api.ValidationResult {
+			for _, result := range results {
+				if result.ValidationId == string(validationID) {
+					return result
+				}
+			}
+			Fail(fmt.Sprintf("validation %s not found", validationID))
+			return api.ValidationResult{}
+		}
+
+		It("should accept a catalog source when no mirror registry is configured", func() {
+			mirrorRegistriesBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false)
+			cluster.MonitoredOperators = []*models.MonitoredOperator{withCatalogSource(lso.Operator, "mirror", "quay.io/example/index:v4.16")}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validationResult(results, models.ClusterValidationIDLsoRequirementsSatisfied).Status).To(Equal(api.Success))
+		})
+
+		It("should validate the catalog image against the mirror registries of the service", func() {
+			mirrorRegistriesBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(true).Times(2)
+			mirrorRegistriesBuilder.EXPECT().ExtractLocationMirrorDataFromRegistries().Return([]mirrorregistries.RegistriesConf{
+				{Location: "registry.redhat.io/redhat", Mirror: []string{"mirror.example.com:5000/redhat"}},
+			}, nil).Times(2)
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				withCatalogSource(lso.Operator, "mirror", "mirror.example.com:5000/redhat/redhat-operator-index:v4.16"),
+				withCatalogSource(mce.Operator, "other", "quay.io/example/index:v4.16"),
+			}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validationResult(results, models.ClusterValidationIDLsoRequirementsSatisfied).Status).To(Equal(api.Success))
+			result := validationResult(results, models.ClusterValidationIDMceRequirementsSatisfied)
+			Expect(result.Status).To(Equal(api.Failure))
+			Expect(result.Reasons).To(ConsistOf(
+				"Invalid catalog source of multicluster engine: image quay.io/example/index:v4.16 is not pulled from any of the " +
+					"mirrored registries registry.redhat.io/redhat, mirror.example.com:5000/redhat",
 			))
 		})
+
+		It("should prefer the mirror registries of the cluster", func() {
+			Expect(cluster.SetMirrorRegistryConfiguration(&common.MirrorRegistryConfiguration{
+				RegistriesConf: "[[registry]]\nlocation = \"registry.redhat.io\"\n\n[[registry.mirror]]\nlocation = \"cluster-mirror.example.com\"\n",
+			})).To(Succeed())
+			cluster.MonitoredOperators = []*models.MonitoredOperator{withCatalogSource(lso.Operator, "mirror", "cluster-mirror.example.com/olm/index:v4.16")}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(validationResult(results, models.ClusterValidationIDLsoRequirementsSatisfied).Status).To(Equal(api.Success))
+		})
+
+		It("should reject a catalog source that is set with different images", func() {
+			mirrorRegistriesBuilder.EXPECT().IsMirrorRegistriesConfigured().Return(false).AnyTimes()
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				withCatalogSource(lso.Operator, "mirror", "quay.io/example/index:v4.16"),
+				withCatalogSource(mce.Operator, "mirror", "quay.io/example/index:v4.17"),
+			}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			failures := 0
+			for _, validationID := range []models.ClusterValidationID{models.ClusterValidationIDLsoRequirementsSatisfied, models.ClusterValidationIDMceRequirementsSatisfied} {
+				result := validationResult(results, validationID)
+				if result.Status == api.Failure {
+					failures++
+					Expect(result.Reasons[0]).To(ContainSubstring("catalog source openshift-marketplace/mirror is set with both image"))
+				}
+			}
+			Expect(failures).To(Equal(1))
+		})
 	})
 
 	Context("ValidateHost", func() {
@@ -499,7 +695,7 @@ var _ = Describe("Operators manager", func() {
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(12))
+			Expect(results).To(HaveLen(13))
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{"lso is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOdfRequirementsSatisfied), Reasons: []string{"odf is disabled"}},
@@ -513,6 +709,7 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDServicemeshRequirementsSatisfied), Reasons: []string{"servicemesh is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDServerlessRequirementsSatisfied), Reasons: []string{"serverless is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOpenshiftAiRequirementsSatisfied), Reasons: []string{"openshift-ai is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDCustomOperatorsRequirementsSatisfied), Reasons: []string{"No custom operators are configured"}},
 			))
 		})
 
@@ -524,7 +721,7 @@ var _ = Describe("Operators manager", func() {
 
 			results, err := manager.ValidateHost(context.TODO(), cluster, clusterHost)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(results).To(HaveLen(12))
+			Expect(results).To(HaveLen(13))
 
 			Expect(results).To(ContainElements(
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDLsoRequirementsSatisfied), Reasons: []string{}},
@@ -539,6 +736,7 @@ var _ = Describe("Operators manager", func() {
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDServicemeshRequirementsSatisfied), Reasons: []string{"servicemesh is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDServerlessRequirementsSatisfied), Reasons: []string{"serverless is disabled"}},
 				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDOpenshiftAiRequirementsSatisfied), Reasons: []string{"openshift-ai is disabled"}},
+				api.ValidationResult{Status: api.Success, ValidationId: string(models.HostValidationIDCustomOperatorsRequirementsSatisfied), Reasons: []string{"No custom operators are configured"}},
 			))
 		})
 
@@ -624,6 +822,61 @@ var _ = Describe("Operators manager", func() {
 		})
 	})
 
+	Context("Generic operators", func() {
+		newGenericOperator := func(definition string) api.Operator {
+			parsed, err := generic.ParseDefinition([]byte(definition))
+			Expect(err).ToNot(HaveOccurred())
+			operator, err := generic.NewGenericOperator(log, parsed)
+			Expect(err).ToNot(HaveOccurred())
+			return operator
+		}
+
+		BeforeEach(func() {
+			manager = operators.NewManagerWithOperators(log, manifestsAPI, operators.Options{}, nil,
+				newGenericOperator("{name: first, namespace: first, subscriptionName: first, channel: stable, source: redhat-operators}"),
+				newGenericOperator("{name: second, namespace: second, subscriptionName: second, channel: stable, source: redhat-operators, minOpenshiftVersion: '4.15'}"),
+			)
+		})
+
+		It("should merge the results of the disabled operators", func() {
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Status).To(Equal(api.Success))
+			Expect(results[0].ValidationId).To(Equal(string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied)))
+			Expect(results[0].Reasons).To(ConsistOf("first is disabled", "second is disabled"))
+		})
+
+		It("should report only the failures of the enabled operators", func() {
+			cluster.MonitoredOperators = []*models.MonitoredOperator{
+				{Name: "first", OperatorType: models.OperatorTypeOlm},
+				{Name: "second", OperatorType: models.OperatorTypeOlm},
+			}
+
+			results, err := manager.ValidateCluster(context.TODO(), cluster)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(ConsistOf(api.ValidationResult{
+				Status:       api.Failure,
+				ValidationId: string(models.ClusterValidationIDCustomOperatorsRequirementsSatisfied),
+				Reasons:      []string{"second is only supported for openshift versions 4.15 and above"},
+			}))
+		})
+
+		It("should check the architecture of the cluster", func() {
+			operator := newGenericOperator("{name: first, namespace: first, subscriptionName: first, channel: stable, source: redhat-operators, supportedArchitectures: [x86_64]}")
+			manager = operators.NewManagerWithOperators(log, manifestsAPI, operators.Options{}, nil, operator)
+			cluster.CPUArchitecture = common.ARM64CPUArchitecture
+
+			err := manager.EnsureOperatorArchCapability(cluster, common.ARM64CPUArchitecture, []*models.MonitoredOperator{operator.GetMonitoredOperator()})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("first is not available when arm64 CPU architecture is selected"))
+
+			Expect(manager.EnsureOperatorArchCapability(cluster, common.X86CPUArchitecture, []*models.MonitoredOperator{operator.GetMonitoredOperator()})).To(Succeed())
+		})
+	})
+
 	DescribeTable("ResolveDependencies, should resolve dependencies", func(input []*models.MonitoredOperator, expected []*models.MonitoredOperator) {
 		cluster.MonitoredOperators = input
 		resolvedDependencies, err := manager.ResolveDependencies(cluster, cluster.MonitoredOperators)
@@ -678,7 +931,21 @@ var _ = Describe("Operators manager", func() {
 			properties, err := manager.GetOperatorProperties("odf")
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(properties).To(BeEquivalentTo(models.OperatorProperties{}))
+			Expect(properties).To(HaveLen(4))
+			Expect(properties).To(ContainElement(HaveField("Name", "channel")))
+			Expect(properties).To(ContainElement(HaveField("Name", "catalogSource")))
+		})
+
+		It("should describe the properties declared by the schema of an operator", func() {
+			properties, err := manager.GetOperatorProperties("mce")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(properties).To(ContainElement(&models.OperatorProperty{
+				Name:        "availabilityConfig",
+				DataType:    models.OperatorPropertyDataTypeString,
+				Options:     []string{"High", "Basic"},
+				Description: "Size of the hub: High runs the components of the engine with multiple replicas, Basic with a single replica",
+			}))
 		})
 	})
 
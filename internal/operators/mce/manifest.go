@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"text/template"
+
+	"github.com/openshift/assisted-service/internal/common"
 )
 
 const (
@@ -19,7 +21,7 @@ var storageSizeGi = map[string]int64{
 }
 
 // Manifests returns manifests needed to deploy MCE.
-func Manifests() (openshiftManifests map[string][]byte, customManifests []byte, err error) {
+func Manifests(cluster *common.Cluster) (openshiftManifests map[string][]byte, customManifests []byte, err error) {
 	// Generate the OpenShift manifests:
 	namespaceManifest, err := getNamespace()
 	if err != nil {
@@ -40,7 +42,11 @@ func Manifests() (openshiftManifests map[string][]byte, customManifests []byte,
 		"50_openshift-mce_operator_subscription.yaml": operatorSubscriptionManifest,
 	}
 
-	mceManifest, err := getMultiClusterEngine()
+	properties, err := getProperties(cluster)
+	if err != nil {
+		return
+	}
+	mceManifest, err := getMultiClusterEngine(properties)
 	if err != nil {
 		return
 	}
@@ -88,9 +94,10 @@ func getOperatorGroup() ([]byte, error) {
 	return executeTemplate(data, operatorGroupManifestTemplate)
 }
 
-func getMultiClusterEngine() ([]byte, error) {
+func getMultiClusterEngine(properties *Properties) ([]byte, error) {
 	data := map[string]string{
-		"OPERATOR_NAMESPACE": Operator.Namespace,
+		"OPERATOR_NAMESPACE":  Operator.Namespace,
+		"AVAILABILITY_CONFIG": properties.AvailabilityConfig,
 	}
 	return executeTemplate(data, clusterEngineManifestTemplate)
 }
@@ -147,6 +154,9 @@ metadata:
   name: mce
 spec:
   targetNamespace: "{{.OPERATOR_NAMESPACE}}"
+{{- if .AVAILABILITY_CONFIG }}
+  availabilityConfig: "{{.AVAILABILITY_CONFIG}}"
+{{- end }}
 `
 
 const agentServiceConfigTemplate = `
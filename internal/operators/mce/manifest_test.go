@@ -38,5 +38,19 @@ var _ = Describe("MCE manifest generation", func() {
 			_, err = yaml.YAMLToJSON(manifest)
 			Expect(err).ShouldNot(HaveOccurred(), "yamltojson err: %v", err)
 		})
+
+		It("Sets the availability of the engine from the operator properties", func() {
+			cluster = getCluster("4.11.0")
+			_, manifest, err := operator.GenerateManifests(cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(manifest)).ToNot(ContainSubstring("availabilityConfig"))
+
+			cluster.MonitoredOperators = []*models.MonitoredOperator{{Name: Operator.Name, Properties: `{"availabilityConfig": "Basic"}`}}
+			_, manifest, err = operator.GenerateManifests(cluster)
+			Expect(err).ShouldNot(HaveOccurred())
+			engine := map[string]interface{}{}
+			Expect(yaml.Unmarshal(manifest, &engine)).To(Succeed())
+			Expect(engine["spec"]).To(HaveKeyWithValue("availabilityConfig", "Basic"))
+		})
 	})
 })
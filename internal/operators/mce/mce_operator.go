@@ -8,6 +8,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -136,13 +137,18 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 }
 
 // GenerateManifests generates manifests for the operator.
-func (o *operator) GenerateManifests(_ *common.Cluster) (map[string][]byte, []byte, error) {
-	return Manifests()
+func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte, []byte, error) {
+	return Manifests(cluster)
 }
 
 // GetProperties provides description of operator properties.
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(o.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties
+func (o *operator) GetPropertiesSchema() string {
+	return propertiesSchema
 }
 
 // GetMonitoredOperator returns the information that describes how to monitor the operator.
@@ -0,0 +1,31 @@
+package mce
+
+import (
+	"github.com/openshift/assisted-service/internal/common"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
+)
+
+const propertiesSchema = `{
+  "type": "object",
+  "properties": {
+    "availabilityConfig": {
+      "type": "string",
+      "enum": ["High", "Basic"],
+      "description": "Size of the hub: High runs the components of the engine with multiple replicas, Basic with a single replica"
+    }
+  },
+  "additionalProperties": false
+}`
+
+// Properties are the settings of the operator that can be set when it's enabled
+type Properties struct {
+	AvailabilityConfig string `json:"availabilityConfig,omitempty"`
+}
+
+func getProperties(cluster *common.Cluster) (*Properties, error) {
+	properties := &Properties{}
+	if err := operatorscommon.UnmarshalProperties(cluster.MonitoredOperators, Operator.Name, properties); err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
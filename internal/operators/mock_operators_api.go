@@ -12,6 +12,7 @@ import (
 	common "github.com/openshift/assisted-service/internal/common"
 	api "github.com/openshift/assisted-service/internal/operators/api"
 	models "github.com/openshift/assisted-service/models"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // MockAPI is a mock of API interface.
@@ -51,6 +52,35 @@ func (mr *MockAPIMockRecorder) AnyOLMOperatorEnabled(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnyOLMOperatorEnabled", reflect.TypeOf((*MockAPI)(nil).AnyOLMOperatorEnabled), arg0)
 }
 
+// CreatePendingCustomResources mocks base method.
+func (m *MockAPI) CreatePendingCustomResources(arg0 context.Context, arg1 *common.Cluster, arg2 client.Client, arg3 *models.MonitoredOperator) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePendingCustomResources", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePendingCustomResources indicates an expected call of CreatePendingCustomResources.
+func (mr *MockAPIMockRecorder) CreatePendingCustomResources(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePendingCustomResources", reflect.TypeOf((*MockAPI)(nil).CreatePendingCustomResources), arg0, arg1, arg2, arg3)
+}
+
+// EnableOperators mocks base method.
+func (m *MockAPI) EnableOperators(arg0 context.Context, arg1 *common.Cluster, arg2 []*models.MonitoredOperator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOperators", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableOperators indicates an expected call of EnableOperators.
+func (mr *MockAPIMockRecorder) EnableOperators(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOperators", reflect.TypeOf((*MockAPI)(nil).EnableOperators), arg0, arg1, arg2)
+}
+
 // EnsureOperatorPrerequisite mocks base method.
 func (m *MockAPI) EnsureOperatorPrerequisite(arg0 *common.Cluster, arg1, arg2 string, arg3 []*models.MonitoredOperator) error {
 	m.ctrl.T.Helper()
@@ -65,6 +95,21 @@ func (mr *MockAPIMockRecorder) EnsureOperatorPrerequisite(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOperatorPrerequisite", reflect.TypeOf((*MockAPI)(nil).EnsureOperatorPrerequisite), arg0, arg1, arg2, arg3)
 }
 
+// ExpandBundles mocks base method.
+func (m *MockAPI) ExpandBundles(arg0 *common.Cluster, arg1 []string, arg2 []*models.OperatorCreateParams) ([]*models.OperatorCreateParams, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpandBundles", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.OperatorCreateParams)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpandBundles indicates an expected call of ExpandBundles.
+func (mr *MockAPIMockRecorder) ExpandBundles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpandBundles", reflect.TypeOf((*MockAPI)(nil).ExpandBundles), arg0, arg1, arg2)
+}
+
 // GenerateManifests mocks base method.
 func (m *MockAPI) GenerateManifests(arg0 context.Context, arg1 *common.Cluster) error {
 	m.ctrl.T.Helper()
@@ -79,6 +124,21 @@ func (mr *MockAPIMockRecorder) GenerateManifests(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateManifests", reflect.TypeOf((*MockAPI)(nil).GenerateManifests), arg0, arg1)
 }
 
+// GetBundle mocks base method.
+func (m *MockAPI) GetBundle(arg0 context.Context, arg1 *common.Cluster, arg2 string) (*models.Bundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBundle", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Bundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBundle indicates an expected call of GetBundle.
+func (mr *MockAPIMockRecorder) GetBundle(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBundle", reflect.TypeOf((*MockAPI)(nil).GetBundle), arg0, arg1, arg2)
+}
+
 // GetMonitoredOperatorsList mocks base method.
 func (m *MockAPI) GetMonitoredOperatorsList() map[string]*models.MonitoredOperator {
 	m.ctrl.T.Helper()
@@ -181,6 +241,21 @@ func (mr *MockAPIMockRecorder) GetSupportedOperatorsByType(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportedOperatorsByType", reflect.TypeOf((*MockAPI)(nil).GetSupportedOperatorsByType), arg0)
 }
 
+// ListBundles mocks base method.
+func (m *MockAPI) ListBundles(arg0 context.Context, arg1 *common.Cluster) ([]*models.Bundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBundles", arg0, arg1)
+	ret0, _ := ret[0].([]*models.Bundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBundles indicates an expected call of ListBundles.
+func (mr *MockAPIMockRecorder) ListBundles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBundles", reflect.TypeOf((*MockAPI)(nil).ListBundles), arg0, arg1)
+}
+
 // ResolveDependencies mocks base method.
 func (m *MockAPI) ResolveDependencies(arg0 *common.Cluster, arg1 []*models.MonitoredOperator) ([]*models.MonitoredOperator, error) {
 	m.ctrl.T.Helper()
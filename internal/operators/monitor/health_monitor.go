@@ -0,0 +1,321 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/requestid"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterVersionName = "version"
+
+	csvPhaseSucceeded = "Succeeded"
+	csvPhaseFailed    = "Failed"
+)
+
+var (
+	subscriptionGVK = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "Subscription"}
+	csvGVK          = schema.GroupVersionKind{Group: "operators.coreos.com", Version: "v1alpha1", Kind: "ClusterServiceVersion"}
+)
+
+type Config struct {
+	// Enabled enables the monitoring of the operators of the installed clusters whose kubeconfig is stored
+	Enabled  bool          `envconfig:"OPERATORS_HEALTH_MONITOR_ENABLED" default:"false"`
+	Interval time.Duration `envconfig:"OPERATORS_HEALTH_MONITOR_INTERVAL" default:"5m"`
+	// ClusterTimeout is the time that reading the status of the operators of a cluster may take
+	ClusterTimeout time.Duration `envconfig:"OPERATORS_HEALTH_MONITOR_CLUSTER_TIMEOUT" default:"1m"`
+}
+
+// StatusUpdater updates the status of the monitored operators of a cluster
+type StatusUpdater interface {
+	UpdateMonitoredOperatorStatus(ctx context.Context, clusterID strfmt.UUID, monitoredOperatorName string,
+		monitoredOperatorVersion string, status models.OperatorStatus, statusInfo string, db *gorm.DB) error
+}
+
+// CustomResourcesCreator creates the custom resources of the operators enabled on installed clusters that couldn't be
+// created while the operators were being installed
+type CustomResourcesCreator interface {
+	CreatePendingCustomResources(ctx context.Context, cluster *common.Cluster, spokeClient client.Client, operator *models.MonitoredOperator) (bool, error)
+}
+
+// operatorHealth is the status of an operator read from an installed cluster
+type operatorHealth struct {
+	status     models.OperatorStatus
+	statusInfo string
+	version    string
+}
+
+// HealthMonitor periodically reads the status of the operators of the installed clusters whose kubeconfig is stored
+// from the clusters themselves. The assisted-installer-controller stops reporting the status of the operators once the
+// installation completes, so operators that finish after the finalizing timeouts, or that degrade later, would
+// otherwise keep the status reported during the installation. It also creates the custom resources of the operators
+// enabled after the installation once the operators are installed.
+type HealthMonitor struct {
+	log                   logrus.FieldLogger
+	db                    *gorm.DB
+	config                Config
+	leaderElector         leader.Leader
+	objectHandler         s3wrapper.API
+	spokeK8sClientFactory spoke_k8s_client.SpokeK8sClientFactory
+	statusUpdater         StatusUpdater
+	crCreator             CustomResourcesCreator
+	eventsHandler         eventsapi.Handler
+	metricAPI             metrics.API
+	// reportedOperators are the names of the operators whose degraded count was reported, so that the count is reset
+	// once none of them is degraded
+	reportedOperators map[string]bool
+}
+
+// NewHealthMonitor creates the monitor of the health of the operators of the installed clusters
+func NewHealthMonitor(log logrus.FieldLogger, db *gorm.DB, config Config, leaderElector leader.Leader, objectHandler s3wrapper.API,
+	spokeK8sClientFactory spoke_k8s_client.SpokeK8sClientFactory, statusUpdater StatusUpdater, crCreator CustomResourcesCreator,
+	eventsHandler eventsapi.Handler, metricAPI metrics.API) *HealthMonitor {
+	return &HealthMonitor{
+		log:                   log,
+		db:                    db,
+		config:                config,
+		leaderElector:         leaderElector,
+		objectHandler:         objectHandler,
+		spokeK8sClientFactory: spokeK8sClientFactory,
+		statusUpdater:         statusUpdater,
+		crCreator:             crCreator,
+		eventsHandler:         eventsHandler,
+		metricAPI:             metricAPI,
+		reportedOperators:     make(map[string]bool),
+	}
+}
+
+// MonitorOperators reads the status of the operators of the installed clusters, updates the monitored operators whose
+// status changed and reports the number of degraded operators
+func (m *HealthMonitor) MonitorOperators() {
+	if !m.leaderElector.IsLeader() {
+		m.log.Debugf("Not a leader, exiting MonitorOperators")
+		return
+	}
+	requestID := requestid.NewID()
+	ctx := requestid.ToContext(context.Background(), requestID)
+	log := requestid.RequestIDLogger(m.log, requestID)
+
+	clusters, err := common.GetClustersFromDBWhere(m.db, common.SkipEagerLoading, common.SkipDeletedRecords,
+		"status = ?", models.ClusterStatusInstalled)
+	if err != nil {
+		log.WithError(err).Error("failed to get the installed clusters")
+		return
+	}
+
+	degraded := make(map[string]int64)
+	for name := range m.reportedOperators {
+		degraded[name] = 0
+	}
+	for _, cluster := range clusters {
+		var clusterOperators []*models.MonitoredOperator
+		if err = m.db.Find(&clusterOperators, "cluster_id = ?", cluster.ID).Error; err != nil {
+			log.WithError(err).Errorf("failed to get the operators of cluster %s", cluster.ID)
+			continue
+		}
+		// The service can only connect to the clusters whose kubeconfig was uploaded at the end of the installation
+		var exists bool
+		if exists, err = m.objectHandler.DoesObjectExist(ctx, fmt.Sprintf("%s/%s", cluster.ID, constants.Kubeconfig)); err != nil || !exists {
+			if err != nil {
+				log.WithError(err).Warnf("failed to find the kubeconfig of cluster %s", cluster.ID)
+			}
+			continue
+		}
+		cluster.MonitoredOperators = clusterOperators
+		if err = m.monitorClusterOperators(ctx, log, cluster, clusterOperators); err != nil {
+			log.WithError(err).Warnf("failed to monitor the operators of cluster %s", cluster.ID)
+		}
+		for _, operator := range clusterOperators {
+			if _, ok := degraded[operator.Name]; !ok {
+				degraded[operator.Name] = 0
+			}
+			if operator.Status == models.OperatorStatusFailed {
+				degraded[operator.Name]++
+			}
+		}
+	}
+	for name, count := range degraded {
+		m.metricAPI.DegradedOperatorsCount(name, count)
+		m.reportedOperators[name] = true
+	}
+}
+
+// monitorClusterOperators reads the status of the operators from the cluster and updates the operators whose status
+// changed. The given operators are updated in place.
+func (m *HealthMonitor) monitorClusterOperators(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster, clusterOperators []*models.MonitoredOperator) error {
+	ctx, cancel := context.WithTimeout(ctx, m.config.ClusterTimeout)
+	defer cancel()
+
+	spokeClient, err := m.spokeK8sClientFactory.CreateFromStorageKubeconfig(ctx, cluster.ID, m.objectHandler)
+	if err != nil {
+		return errors.Wrap(err, "failed to create the client of the cluster")
+	}
+	for _, operator := range clusterOperators {
+		health, err := getOperatorHealth(ctx, spokeClient, operator)
+		if err != nil {
+			log.WithError(err).Warnf("failed to get the status of operator %s of cluster %s", operator.Name, cluster.ID)
+			continue
+		}
+		if health != nil && operator.OperatorType == models.OperatorTypeOlm && operator.StatusInfo == operators.OperatorCustomResourcesPendingStatusInfo {
+			if health.status == models.OperatorStatusProgressing {
+				// The status info keeps the custom resources pending until the operator is installed
+				continue
+			}
+			if health.status == models.OperatorStatusAvailable && !m.createPendingCustomResources(ctx, log, cluster, spokeClient, operator) {
+				continue
+			}
+		}
+		if health == nil || (health.status == operator.Status && (health.statusInfo == "" || health.statusInfo == operator.StatusInfo)) {
+			continue
+		}
+		if err = m.statusUpdater.UpdateMonitoredOperatorStatus(ctx, *cluster.ID, operator.Name, health.version,
+			health.status, health.statusInfo, m.db); err != nil {
+			log.WithError(err).Errorf("failed to update the status of operator %s of cluster %s", operator.Name, cluster.ID)
+			continue
+		}
+		if health.status == models.OperatorStatusFailed && operator.Status != models.OperatorStatusFailed {
+			eventgen.SendClusterOperatorDegradedEvent(ctx, m.eventsHandler, *cluster.ID, operator.Name, health.statusInfo)
+		}
+		operator.Status = health.status
+		if health.statusInfo != "" {
+			operator.StatusInfo = health.statusInfo
+		}
+	}
+	return nil
+}
+
+// createPendingCustomResources creates the custom resources of an operator that was enabled after the installation,
+// it returns false when they couldn't be created yet
+func (m *HealthMonitor) createPendingCustomResources(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster,
+	spokeClient client.Client, operator *models.MonitoredOperator) bool {
+	created, err := m.crCreator.CreatePendingCustomResources(ctx, cluster, spokeClient, operator)
+	if err != nil {
+		log.WithError(err).Warnf("failed to create the custom resources of operator %s of cluster %s", operator.Name, cluster.ID)
+		return false
+	}
+	if !created {
+		log.Infof("Custom resources of operator %s of cluster %s can't be created yet", operator.Name, cluster.ID)
+		return false
+	}
+	log.Infof("Created the custom resources of operator %s of cluster %s", operator.Name, cluster.ID)
+	return true
+}
+
+// getOperatorHealth reads the status of the operator from the cluster. The builtin operators are described by the
+// cluster version and the cluster operators, the OLM operators by the CSV that their subscription installed. It
+// returns nil when the status of the operator can't be read from the cluster.
+func getOperatorHealth(ctx context.Context, spokeClient client.Client, operator *models.MonitoredOperator) (*operatorHealth, error) {
+	switch operator.OperatorType {
+	case models.OperatorTypeBuiltin:
+		if operator.Name == operators.OperatorCVO.Name {
+			return getClusterVersionHealth(ctx, spokeClient)
+		}
+		return getClusterOperatorHealth(ctx, spokeClient, operator.Name)
+	case models.OperatorTypeOlm:
+		if operator.Namespace == "" || operator.SubscriptionName == "" {
+			return nil, nil
+		}
+		return getCSVHealth(ctx, spokeClient, operator)
+	}
+	return nil, nil
+}
+
+func getClusterVersionHealth(ctx context.Context, spokeClient client.Client) (*operatorHealth, error) {
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := spokeClient.Get(ctx, client.ObjectKey{Name: clusterVersionName}, clusterVersion); err != nil {
+		return nil, errors.Wrap(err, "failed to get the cluster version")
+	}
+	health := conditionsHealth(clusterVersion.Status.Conditions, configv1.ClusterStatusConditionType("Failing"))
+	health.version = clusterVersion.Status.Desired.Version
+	return health, nil
+}
+
+func getClusterOperatorHealth(ctx context.Context, spokeClient client.Client, name string) (*operatorHealth, error) {
+	clusterOperator := &configv1.ClusterOperator{}
+	if err := spokeClient.Get(ctx, client.ObjectKey{Name: name}, clusterOperator); err != nil {
+		return nil, errors.Wrapf(err, "failed to get cluster operator %s", name)
+	}
+	health := conditionsHealth(clusterOperator.Status.Conditions, configv1.OperatorDegraded)
+	for _, version := range clusterOperator.Status.Versions {
+		if version.Name == "operator" {
+			health.version = version.Version
+		}
+	}
+	return health, nil
+}
+
+// conditionsHealth returns the health described by the conditions of a cluster operator or of the cluster version,
+// the failure condition type is the one that reports degradations
+func conditionsHealth(conditions []configv1.ClusterOperatorStatusCondition, failureType configv1.ClusterStatusConditionType) *operatorHealth {
+	var available, failure *configv1.ClusterOperatorStatusCondition
+	for i := range conditions {
+		switch conditions[i].Type {
+		case configv1.OperatorAvailable:
+			available = &conditions[i]
+		case failureType:
+			failure = &conditions[i]
+		}
+	}
+	if failure != nil && failure.Status == configv1.ConditionTrue {
+		return &operatorHealth{status: models.OperatorStatusFailed, statusInfo: failure.Message}
+	}
+	if available != nil && available.Status == configv1.ConditionTrue {
+		return &operatorHealth{status: models.OperatorStatusAvailable, statusInfo: available.Message}
+	}
+	if available != nil {
+		return &operatorHealth{status: models.OperatorStatusProgressing, statusInfo: available.Message}
+	}
+	return &operatorHealth{status: models.OperatorStatusProgressing}
+}
+
+func getCSVHealth(ctx context.Context, spokeClient client.Client, operator *models.MonitoredOperator) (*operatorHealth, error) {
+	subscription := &unstructured.Unstructured{}
+	subscription.SetGroupVersionKind(subscriptionGVK)
+	if err := spokeClient.Get(ctx, client.ObjectKey{Namespace: operator.Namespace, Name: operator.SubscriptionName}, subscription); err != nil {
+		return nil, errors.Wrapf(err, "failed to get subscription %s/%s", operator.Namespace, operator.SubscriptionName)
+	}
+	csvName, _, _ := unstructured.NestedString(subscription.Object, "status", "installedCSV")
+	if csvName == "" {
+		return &operatorHealth{status: models.OperatorStatusProgressing, statusInfo: "Waiting for the subscription to install the operator"}, nil
+	}
+
+	csv := &unstructured.Unstructured{}
+	csv.SetGroupVersionKind(csvGVK)
+	if err := spokeClient.Get(ctx, client.ObjectKey{Namespace: operator.Namespace, Name: csvName}, csv); err != nil {
+		return nil, errors.Wrapf(err, "failed to get CSV %s/%s", operator.Namespace, csvName)
+	}
+	phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(csv.Object, "status", "message")
+	version, _, _ := unstructured.NestedString(csv.Object, "spec", "version")
+	health := &operatorHealth{status: models.OperatorStatusProgressing, statusInfo: message, version: version}
+	switch phase {
+	case csvPhaseSucceeded:
+		health.status = models.OperatorStatusAvailable
+	case csvPhaseFailed:
+		health.status = models.OperatorStatusFailed
+	}
+	if health.statusInfo == "" {
+		health.statusInfo = fmt.Sprintf("CSV %s is in phase %s", csvName, phase)
+	}
+	return health, nil
+}
@@ -0,0 +1,325 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/assisted-service/internal/cluster"
+	"github.com/openshift/assisted-service/internal/common"
+	eventgen "github.com/openshift/assisted-service/internal/common/events"
+	"github.com/openshift/assisted-service/internal/constants"
+	eventsapi "github.com/openshift/assisted-service/internal/events/api"
+	"github.com/openshift/assisted-service/internal/events/eventstest"
+	"github.com/openshift/assisted-service/internal/metrics"
+	"github.com/openshift/assisted-service/internal/operators"
+	"github.com/openshift/assisted-service/internal/operators/handler"
+	"github.com/openshift/assisted-service/internal/spoke_k8s_client"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/leader"
+	"github.com/openshift/assisted-service/pkg/s3wrapper"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeSpokeK8sClient struct {
+	client.Client
+}
+
+func (c fakeSpokeK8sClient) ListCsrs(ctx context.Context) (*certificatesv1.CertificateSigningRequestList, error) {
+	return nil, nil
+}
+
+func (c fakeSpokeK8sClient) ApproveCsr(ctx context.Context, csr *certificatesv1.CertificateSigningRequest) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
+	return nil, nil
+}
+
+func (c fakeSpokeK8sClient) PatchNodeLabels(ctx context.Context, name, labels string) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) PatchMachineConfigPoolPaused(ctx context.Context, pause bool, mcpName string) error {
+	return nil
+}
+
+func (c fakeSpokeK8sClient) DeleteNode(ctx context.Context, name string) error {
+	return nil
+}
+
+var _ = Describe("Operators health monitor", func() {
+	const (
+		operatorName     = "example"
+		operatorNS       = "openshift-example"
+		subscriptionName = "example-operator"
+		csvName          = "example-operator.v1.2.3"
+	)
+
+	var (
+		db            *gorm.DB
+		dbName        string
+		ctrl          *gomock.Controller
+		mockEvents    *eventsapi.MockHandler
+		mockMetrics   *metrics.MockAPI
+		mockS3Api     *s3wrapper.MockAPI
+		mockOperators *operators.MockAPI
+		spokeFactory  *spoke_k8s_client.MockSpokeK8sClientFactory
+		spokeClient   fakeSpokeK8sClient
+		healthMonitor *HealthMonitor
+		clusterID     strfmt.UUID
+	)
+
+	createCluster := func(kubeKeyName string, status models.OperatorStatus) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		c := &common.Cluster{
+			KubeKeyName: kubeKeyName,
+			Cluster: models.Cluster{
+				ID:     &id,
+				Status: swag.String(models.ClusterStatusInstalled),
+				MonitoredOperators: []*models.MonitoredOperator{{
+					Name:             operatorName,
+					OperatorType:     models.OperatorTypeOlm,
+					Namespace:        operatorNS,
+					SubscriptionName: subscriptionName,
+					Status:           status,
+					StatusInfo:       "timed out",
+				}},
+			},
+		}
+		Expect(db.Create(c).Error).ToNot(HaveOccurred())
+		return id
+	}
+
+	createCSV := func(phase, message string) {
+		subscription := &unstructured.Unstructured{}
+		subscription.SetGroupVersionKind(subscriptionGVK)
+		subscription.SetNamespace(operatorNS)
+		subscription.SetName(subscriptionName)
+		Expect(unstructured.SetNestedField(subscription.Object, csvName, "status", "installedCSV")).To(Succeed())
+		Expect(spokeClient.Create(context.TODO(), subscription)).To(Succeed())
+
+		csv := &unstructured.Unstructured{}
+		csv.SetGroupVersionKind(csvGVK)
+		csv.SetNamespace(operatorNS)
+		csv.SetName(csvName)
+		Expect(unstructured.SetNestedField(csv.Object, "1.2.3", "spec", "version")).To(Succeed())
+		Expect(unstructured.SetNestedField(csv.Object, phase, "status", "phase")).To(Succeed())
+		Expect(unstructured.SetNestedField(csv.Object, message, "status", "message")).To(Succeed())
+		Expect(spokeClient.Create(context.TODO(), csv)).To(Succeed())
+	}
+
+	expectKubeconfig := func(id strfmt.UUID, exists bool) {
+		mockS3Api.EXPECT().DoesObjectExist(gomock.Any(), fmt.Sprintf("%s/%s", id, constants.Kubeconfig)).Return(exists, nil)
+	}
+
+	getOperator := func(id strfmt.UUID) *models.MonitoredOperator {
+		var operator models.MonitoredOperator
+		Expect(db.First(&operator, "cluster_id = ? and name = ?", id, operatorName).Error).ToNot(HaveOccurred())
+		return &operator
+	}
+
+	BeforeEach(func() {
+		db, dbName = common.PrepareTestDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockEvents = eventsapi.NewMockHandler(ctrl)
+		mockMetrics = metrics.NewMockAPI(ctrl)
+		mockS3Api = s3wrapper.NewMockAPI(ctrl)
+		spokeFactory = spoke_k8s_client.NewMockSpokeK8sClientFactory(ctrl)
+		spokeClient = fakeSpokeK8sClient{Client: fakeclient.NewClientBuilder().WithScheme(spoke_k8s_client.GetKubeClientSchemes()).Build()}
+		log := logrus.New()
+		mockOperators = operators.NewMockAPI(ctrl)
+		operatorsHandler := handler.NewHandler(mockOperators, log, db, mockEvents, cluster.NewMockProgressAPI(ctrl))
+		healthMonitor = NewHealthMonitor(log, db, Config{ClusterTimeout: time.Minute}, &leader.DummyElector{}, mockS3Api,
+			spokeFactory, operatorsHandler, mockOperators, mockEvents, mockMetrics)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		common.DeleteTestDB(db, dbName)
+	})
+
+	It("reports an operator that finished after the installation as available", func() {
+		clusterID = createCluster("cluster-deployment", models.OperatorStatusFailed)
+		createCSV(csvPhaseSucceeded, "install strategy completed with no errors")
+		expectKubeconfig(clusterID, true)
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Api).Return(spokeClient, nil)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String())))
+		mockMetrics.EXPECT().DegradedOperatorsCount(operatorName, int64(0))
+
+		healthMonitor.MonitorOperators()
+
+		operator := getOperator(clusterID)
+		Expect(operator.Status).To(Equal(models.OperatorStatusAvailable))
+		Expect(operator.StatusInfo).To(Equal("install strategy completed with no errors"))
+		Expect(operator.Version).To(Equal("1.2.3"))
+	})
+
+	It("records the degradation of an operator", func() {
+		clusterID = createCluster("cluster-deployment", models.OperatorStatusAvailable)
+		createCSV(csvPhaseFailed, "install strategy failed")
+		expectKubeconfig(clusterID, true)
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Api).Return(spokeClient, nil)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String())))
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterOperatorDegradedEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String())))
+		mockMetrics.EXPECT().DegradedOperatorsCount(operatorName, int64(1))
+
+		healthMonitor.MonitorOperators()
+
+		Expect(getOperator(clusterID).Status).To(Equal(models.OperatorStatusFailed))
+
+		// The degradation is only recorded once
+		expectKubeconfig(clusterID, true)
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Api).Return(spokeClient, nil)
+		mockMetrics.EXPECT().DegradedOperatorsCount(operatorName, int64(1))
+
+		healthMonitor.MonitorOperators()
+	})
+
+	It("monitors the clusters that aren't managed via the kube-API", func() {
+		clusterID = createCluster("", models.OperatorStatusFailed)
+		createCSV(csvPhaseSucceeded, "install strategy completed with no errors")
+		expectKubeconfig(clusterID, true)
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Api).Return(spokeClient, nil)
+		mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+			eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+			eventstest.WithClusterIdMatcher(clusterID.String())))
+		mockMetrics.EXPECT().DegradedOperatorsCount(operatorName, int64(0))
+
+		healthMonitor.MonitorOperators()
+
+		Expect(getOperator(clusterID).Status).To(Equal(models.OperatorStatusAvailable))
+	})
+
+	It("ignores the clusters whose kubeconfig isn't stored", func() {
+		clusterID = createCluster("", models.OperatorStatusFailed)
+		expectKubeconfig(clusterID, false)
+
+		healthMonitor.MonitorOperators()
+
+		Expect(getOperator(clusterID).Status).To(Equal(models.OperatorStatusFailed))
+	})
+
+	It("keeps the status when the cluster can't be reached", func() {
+		clusterID = createCluster("cluster-deployment", models.OperatorStatusFailed)
+		expectKubeconfig(clusterID, true)
+		spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Api).Return(nil, context.DeadlineExceeded)
+		mockMetrics.EXPECT().DegradedOperatorsCount(operatorName, int64(1))
+
+		healthMonitor.MonitorOperators()
+
+		Expect(getOperator(clusterID).Status).To(Equal(models.OperatorStatusFailed))
+	})
+
+	Context("operators enabled after the installation", func() {
+		BeforeEach(func() {
+			clusterID = createCluster("", models.OperatorStatusProgressing)
+			Expect(db.Model(&models.MonitoredOperator{}).Where("cluster_id = ?", clusterID).
+				Update("status_info", operators.OperatorCustomResourcesPendingStatusInfo).Error).ToNot(HaveOccurred())
+			expectKubeconfig(clusterID, true)
+			spokeFactory.EXPECT().CreateFromStorageKubeconfig(gomock.Any(), &clusterID, mockS3Api).Return(spokeClient, nil)
+			mockMetrics.EXPECT().DegradedOperatorsCount(operatorName, int64(0))
+		})
+
+		It("keeps the custom resources pending while the operator is installed", func() {
+			createCSV("Installing", "installing the deployments")
+
+			healthMonitor.MonitorOperators()
+
+			operator := getOperator(clusterID)
+			Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+			Expect(operator.StatusInfo).To(Equal(operators.OperatorCustomResourcesPendingStatusInfo))
+		})
+
+		It("creates the custom resources once the operator is installed", func() {
+			createCSV(csvPhaseSucceeded, "install strategy completed with no errors")
+			mockOperators.EXPECT().CreatePendingCustomResources(gomock.Any(), gomock.Any(), spokeClient, gomock.Any()).DoAndReturn(
+				func(_ context.Context, c *common.Cluster, _ client.Client, operator *models.MonitoredOperator) (bool, error) {
+					Expect(*c.ID).To(Equal(clusterID))
+					Expect(c.MonitoredOperators).To(HaveLen(1))
+					Expect(operator.Name).To(Equal(operatorName))
+					return true, nil
+				})
+			mockEvents.EXPECT().SendClusterEvent(gomock.Any(), eventstest.NewEventMatcher(
+				eventstest.WithNameMatcher(eventgen.ClusterOperatorStatusEventName),
+				eventstest.WithClusterIdMatcher(clusterID.String())))
+
+			healthMonitor.MonitorOperators()
+
+			operator := getOperator(clusterID)
+			Expect(operator.Status).To(Equal(models.OperatorStatusAvailable))
+			Expect(operator.StatusInfo).To(Equal("install strategy completed with no errors"))
+		})
+
+		It("retries the custom resources that the cluster doesn't know yet", func() {
+			createCSV(csvPhaseSucceeded, "install strategy completed with no errors")
+			mockOperators.EXPECT().CreatePendingCustomResources(gomock.Any(), gomock.Any(), spokeClient, gomock.Any()).Return(false, nil)
+
+			healthMonitor.MonitorOperators()
+
+			operator := getOperator(clusterID)
+			Expect(operator.Status).To(Equal(models.OperatorStatusProgressing))
+			Expect(operator.StatusInfo).To(Equal(operators.OperatorCustomResourcesPendingStatusInfo))
+		})
+	})
+
+	Context("builtin operators", func() {
+		It("reads the status of a cluster operator", func() {
+			clusterOperator := &configv1.ClusterOperator{
+				ObjectMeta: metav1.ObjectMeta{Name: operators.OperatorConsole.Name},
+				Status: configv1.ClusterOperatorStatus{
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue},
+						{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue, Message: "route not reachable"},
+					},
+					Versions: []configv1.OperandVersion{{Name: "operator", Version: "4.16.0"}},
+				},
+			}
+			Expect(spokeClient.Create(context.TODO(), clusterOperator)).To(Succeed())
+
+			health, err := getOperatorHealth(context.TODO(), spokeClient, &operators.OperatorConsole)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health).To(Equal(&operatorHealth{status: models.OperatorStatusFailed, statusInfo: "route not reachable", version: "4.16.0"}))
+		})
+
+		It("reads the status of the cluster version", func() {
+			clusterVersion := &configv1.ClusterVersion{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterVersionName},
+				Status: configv1.ClusterVersionStatus{
+					Desired: configv1.Release{Version: "4.16.0"},
+					Conditions: []configv1.ClusterOperatorStatusCondition{
+						{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue, Message: "Done applying 4.16.0"},
+					},
+				},
+			}
+			Expect(spokeClient.Create(context.TODO(), clusterVersion)).To(Succeed())
+
+			health, err := getOperatorHealth(context.TODO(), spokeClient, &operators.OperatorCVO)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health).To(Equal(&operatorHealth{status: models.OperatorStatusAvailable, statusInfo: "Done applying 4.16.0", version: "4.16.0"}))
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/assisted-service/internal/featuresupport"
 	"github.com/openshift/assisted-service/internal/operators/api"
 	"github.com/openshift/assisted-service/internal/operators/cnv"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/models"
 	"github.com/openshift/assisted-service/pkg/conversions"
 	logutil "github.com/openshift/assisted-service/pkg/log"
@@ -159,9 +160,14 @@ func (o *operator) GetPreflightRequirements(context context.Context, cluster *co
 	}, nil
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties.
 func (l *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(l.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties: only the common properties are accepted
+func (l *operator) GetPropertiesSchema() string {
+	return ""
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the MTV
@@ -7,6 +7,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/operators/api"
+	operatorscommon "github.com/openshift/assisted-service/internal/operators/common"
 	"github.com/openshift/assisted-service/internal/operators/nodefeaturediscovery"
 	"github.com/openshift/assisted-service/internal/templating"
 	"github.com/openshift/assisted-service/models"
@@ -96,7 +97,12 @@ func (o *operator) ValidateHost(ctx context.Context, cluster *common.Cluster, ho
 
 // GetProperties provides description of operator properties.
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(o.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties: only the common properties are accepted
+func (o *operator) GetPropertiesSchema() string {
+	return ""
 }
 
 // GetMonitoredOperator returns the information that describes how to monitor the operator.
@@ -187,9 +187,14 @@ func (o *operator) GenerateManifests(cluster *common.Cluster) (map[string][]byte
 	return Manifests(o.config, cluster.OpenshiftVersion)
 }
 
-// GetProperties provides description of operator properties: none required
+// GetProperties provides description of operator properties.
 func (o *operator) GetProperties() models.OperatorProperties {
-	return models.OperatorProperties{}
+	return operatorscommon.DescribeProperties(o.GetPropertiesSchema())
+}
+
+// GetPropertiesSchema returns the JSON schema of the operator properties: only the common properties are accepted
+func (o *operator) GetPropertiesSchema() string {
+	return ""
 }
 
 // GetMonitoredOperator returns MonitoredOperator corresponding to the ODF Operator
@@ -79,6 +79,14 @@ func (p *baremetalProvider) AddPlatformToInstallConfig(
 		if inventory.Boot != nil && inventory.Boot.CurrentBootMode != "uefi" {
 			hosts[yamlHostIdx].BootMode = "legacy"
 		}
+		if host.Bmc != nil && host.Bmc.Address != "" {
+			hosts[yamlHostIdx].BMC = installcfg.BMC{
+				Address:                        host.Bmc.Address,
+				Username:                       host.Bmc.Username,
+				Password:                       cluster.HostBMCPasswords[*host.ID],
+				DisableCertificateVerification: host.Bmc.DisableCertificateVerification,
+			}
+		}
 		yamlHostIdx += 1
 	}
 
@@ -90,6 +98,10 @@ func (p *baremetalProvider) AddPlatformToInstallConfig(
 	if enableMetal3Provisioning {
 		provNetwork = "Disabled"
 	}
+	provisioning := cluster.BaremetalProvisioning
+	if provisioning != nil && provisioning.ProvisioningNetwork != "" {
+		provNetwork = provisioning.ProvisioningNetwork
+	}
 	p.Log.Infof("setting Baremetal.ProvisioningNetwork to %s", provNetwork)
 
 	if featuresupport.IsFeatureAvailable(models.FeatureSupportLevelIDDUALSTACKVIPS, cluster.OpenshiftVersion, swag.String(cluster.CPUArchitecture)) {
@@ -114,6 +126,16 @@ func (p *baremetalProvider) AddPlatformToInstallConfig(
 		}
 	}
 
+	if provisioning != nil && provisioning.ProvisioningNetwork != "" {
+		if provisioning.ProvisioningNetworkCidr != "" {
+			cfg.Platform.Baremetal.ProvisioningNetworkCIDR = swag.String(provisioning.ProvisioningNetworkCidr)
+		}
+		cfg.Platform.Baremetal.ProvisioningNetworkInterface = provisioning.ProvisioningNetworkInterface
+		cfg.Platform.Baremetal.ClusterProvisioningIP = provisioning.ClusterProvisioningIP
+		cfg.Platform.Baremetal.BootstrapProvisioningIP = provisioning.BootstrapProvisioningIP
+		cfg.Platform.Baremetal.ProvisioningDHCPRange = provisioning.ProvisioningDhcpRange
+	}
+
 	// We want to use the NTP sources specified in the cluster, and if that is empty, the ones specified in the
 	// infrastructure environment. Note that in some rare cases there may be multiple infrastructure environments,
 	// so we add the NTP sources of all of them.
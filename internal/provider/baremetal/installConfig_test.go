@@ -1,6 +1,11 @@
 package baremetal
 
 import (
+	"encoding/json"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
@@ -127,3 +132,84 @@ var _ = Describe("Add NTP sources", func() {
 		Expect(cfg.Platform.Baremetal.AdditionalNTPServers).To(ConsistOf("1.1.1.1", "2.2.2.2", "3.3.3.3"))
 	})
 })
+
+var _ = Describe("Provisioning network and BMC", func() {
+	var (
+		cluster *common.Cluster
+		cfg     *installcfg.InstallerConfigBaremetal
+		hostID  strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		hostID = strfmt.UUID(uuid.New().String())
+		inventory, err := json.Marshal(&models.Inventory{
+			Hostname: "master-0",
+			Interfaces: []*models.Interface{{
+				Name:          "eth0",
+				MacAddress:    "52:54:00:00:00:01",
+				IPV4Addresses: []string{"192.168.111.10/24"},
+			}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		cluster = &common.Cluster{
+			Cluster: models.Cluster{
+				OpenshiftVersion: "4.18",
+				MachineNetworks:  []*models.MachineNetwork{{Cidr: "192.168.111.0/24"}},
+				Hosts: []*models.Host{{
+					ID:        &hostID,
+					Role:      models.HostRoleMaster,
+					Inventory: string(inventory),
+					Bmc: &models.HostBmc{
+						Address:                        "redfish-virtualmedia://192.168.111.1:8000/redfish/v1/Systems/1",
+						Username:                       "admin",
+						DisableCertificateVerification: true,
+					},
+				}},
+			},
+			HostBMCPasswords: map[strfmt.UUID]string{hostID: "secret"},
+		}
+		cfg = &installcfg.InstallerConfigBaremetal{}
+		cfg.ControlPlane.Replicas = 1
+		cfg.Compute = make([]struct {
+			Hyperthreading string "json:\"hyperthreading,omitempty\""
+			Name           string "json:\"name\""
+			Replicas       int    "json:\"replicas\""
+		}, 1)
+	})
+
+	It("Disables the provisioning network by default", func() {
+		Expect(NewBaremetalProvider(common.GetTestLog()).AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.Baremetal.ProvisioningNetwork).To(Equal("Disabled"))
+		Expect(cfg.Platform.Baremetal.ProvisioningNetworkCIDR).To(BeNil())
+	})
+
+	It("Sets the provisioning network of the cluster", func() {
+		cluster.BaremetalProvisioning = &models.BaremetalProvisioning{
+			ProvisioningNetwork:          "Managed",
+			ProvisioningNetworkCidr:      "172.22.0.0/24",
+			ProvisioningNetworkInterface: "eth1",
+			ClusterProvisioningIP:        "172.22.0.3",
+			BootstrapProvisioningIP:      "172.22.0.2",
+			ProvisioningDhcpRange:        "172.22.0.10,172.22.0.254",
+		}
+		Expect(NewBaremetalProvider(common.GetTestLog()).AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		platform := cfg.Platform.Baremetal
+		Expect(platform.ProvisioningNetwork).To(Equal("Managed"))
+		Expect(platform.ProvisioningNetworkCIDR).To(Equal(swag.String("172.22.0.0/24")))
+		Expect(platform.ProvisioningNetworkInterface).To(Equal("eth1"))
+		Expect(platform.ClusterProvisioningIP).To(Equal("172.22.0.3"))
+		Expect(platform.BootstrapProvisioningIP).To(Equal("172.22.0.2"))
+		Expect(platform.ProvisioningDHCPRange).To(Equal("172.22.0.10,172.22.0.254"))
+	})
+
+	It("Sets the BMC of the hosts", func() {
+		Expect(NewBaremetalProvider(common.GetTestLog()).AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.Baremetal.Hosts[0].BootMACAddress).To(Equal("52:54:00:00:00:01"))
+		Expect(cfg.Platform.Baremetal.Hosts[0].BMC).To(Equal(installcfg.BMC{
+			Address:                        "redfish-virtualmedia://192.168.111.1:8000/redfish/v1/Systems/1",
+			Username:                       "admin",
+			Password:                       "secret",
+			DisableCertificateVerification: true,
+		}))
+	})
+})
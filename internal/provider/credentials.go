@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/sirupsen/logrus"
+)
+
+// CredentialsResult is the result of the validation of the platform credentials of a cluster against the API of
+// the platform
+type CredentialsResult struct {
+	// Configured is false when the install config overrides of the cluster don't set the credentials of the
+	// platform, the credentials are then set after the installation and aren't validated
+	Configured bool
+	// Failures describes the objects, permissions and hosts that failed the validation
+	Failures []string
+}
+
+// CredentialsValidator connects to the API of the platform with the credentials of the install config overrides of
+// the cluster and verifies the configured objects, the permissions of the user and that the hosts of the cluster are
+// virtual machines of the platform. An error is returned when the validation couldn't run.
+type CredentialsValidator func(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster, insecure bool) (*CredentialsResult, error)
@@ -0,0 +1,194 @@
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/platformplugin"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// PluginsConfig configures the platform plugins, the executables of the plugins directory are started by the service
+// and provide the external platforms that they declare
+type PluginsConfig struct {
+	Dir          string        `envconfig:"PLATFORM_PLUGINS_DIR" default:""`
+	StartTimeout time.Duration `envconfig:"PLATFORM_PLUGINS_START_TIMEOUT" default:"30s"`
+	CallTimeout  time.Duration `envconfig:"PLATFORM_PLUGINS_CALL_TIMEOUT" default:"10m"`
+}
+
+// pluginExternalProvider delegates to a platform plugin running out of the process of the service
+type pluginExternalProvider struct {
+	baseExternalProvider
+	platform platformplugin.Platform
+	info     *platformplugin.PlatformInfo
+}
+
+func NewPluginExternalProvider(log logrus.FieldLogger, platform platformplugin.Platform, info *platformplugin.PlatformInfo) provider.Provider {
+	p := &pluginExternalProvider{
+		baseExternalProvider: baseExternalProvider{
+			Log: log,
+		},
+		platform: platform,
+		info:     info,
+	}
+	p.Provider = p
+	return p
+}
+
+func (p *pluginExternalProvider) IsProviderForPlatform(platform *models.Platform) bool {
+	return common.IsExternalIntegrationEnabled(platform, p.info.PlatformName)
+}
+
+func (p *pluginExternalProvider) AddPlatformToInstallConfig(
+	cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster, infraEnvs []*common.InfraEnv) error {
+	modelsInfraEnvs := make([]*models.InfraEnv, 0, len(infraEnvs))
+	for _, infraEnv := range infraEnvs {
+		modelsInfraEnvs = append(modelsInfraEnvs, &infraEnv.InfraEnv)
+	}
+	platform, err := p.platform.AddPlatformToInstallConfig(&cluster.Cluster, modelsInfraEnvs)
+	if err != nil {
+		return fmt.Errorf("platform plugin %s failed to add the platform to the install config: %w", p.info.PlatformName, err)
+	}
+	if platform == nil {
+		return p.baseExternalProvider.AddPlatformToInstallConfig(cfg, cluster, infraEnvs)
+	}
+
+	data, err := json.Marshal(platform)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the install config platform of platform plugin %s: %w", p.info.PlatformName, err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var cfgPlatform installcfg.Platform
+	if err = decoder.Decode(&cfgPlatform); err != nil {
+		return fmt.Errorf("invalid install config platform of platform plugin %s: %w", p.info.PlatformName, err)
+	}
+	cfg.Platform = cfgPlatform
+
+	provider.ConfigureUserManagedNetworkingInInstallConfig(p.Log, cluster, cfg)
+
+	return nil
+}
+
+func (p *pluginExternalProvider) IsHostSupported(host *models.Host) (bool, error) {
+	return p.platform.IsHostSupported(host)
+}
+
+func (p *pluginExternalProvider) AreHostsSupported(hosts []*models.Host) (bool, error) {
+	for _, h := range hosts {
+		supported, err := p.IsHostSupported(h)
+		if err != nil {
+			return false, fmt.Errorf("error while checking if host is supported, error is: %w", err)
+		}
+		if !supported {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (p *pluginExternalProvider) PreCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	updatedEnvVars, err := p.platform.PreCreateManifestsHook(&cluster.Cluster, *envVars, workDir)
+	if err != nil {
+		return fmt.Errorf("platform plugin %s failed to run the pre creation manifests hook: %w", p.info.PlatformName, err)
+	}
+	*envVars = updatedEnvVars
+	return nil
+}
+
+func (p *pluginExternalProvider) PostCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	updatedEnvVars, err := p.platform.PostCreateManifestsHook(&cluster.Cluster, *envVars, workDir)
+	if err != nil {
+		return fmt.Errorf("platform plugin %s failed to run the post creation manifests hook: %w", p.info.PlatformName, err)
+	}
+	*envVars = updatedEnvVars
+	return nil
+}
+
+func (p *pluginExternalProvider) SetPlatformUsages(usages map[string]models.Usage, usageApi usage.API) error {
+	if err := p.baseExternalProvider.SetPlatformUsages(usages, usageApi); err != nil {
+		return err
+	}
+	updatedUsages, err := p.platform.SetPlatformUsages(usages)
+	if err != nil {
+		return fmt.Errorf("platform plugin %s failed to set the platform usages: %w", p.info.PlatformName, err)
+	}
+	var removedUsages []string
+	for name := range usages {
+		if _, ok := updatedUsages[name]; !ok {
+			removedUsages = append(removedUsages, name)
+		}
+	}
+	for _, name := range removedUsages {
+		usageApi.Remove(usages, name)
+	}
+	for name, updatedUsage := range updatedUsages {
+		data := updatedUsage.Data
+		usageApi.Add(usages, name, &data)
+	}
+	return nil
+}
+
+// LoadPluginProviders starts the platform plugins of the plugins directory and returns their providers and the
+// platforms that they provide
+func LoadPluginProviders(log logrus.FieldLogger, config PluginsConfig) (providers []provider.Provider, platforms []*platformplugin.PlatformInfo, err error) {
+	if config.Dir == "" {
+		return nil, nil, nil
+	}
+	var entries []os.DirEntry
+	entries, err = os.ReadDir(config.Dir)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to read the platform plugins directory %s", config.Dir)
+	}
+
+	var clients []*platformplugin.Client
+	defer func() {
+		// The plugins that were started are stopped when one of the plugins is invalid
+		if err != nil {
+			for _, client := range clients {
+				client.Kill()
+			}
+		}
+	}()
+	platformNames := map[string]string{common.ExternalPlatformNameOci: "the service"}
+	for _, entry := range entries {
+		path := filepath.Join(config.Dir, entry.Name())
+		var fileInfo os.FileInfo
+		if fileInfo, err = os.Stat(path); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to stat platform plugin %s", path)
+		}
+		if !fileInfo.Mode().IsRegular() || fileInfo.Mode().Perm()&0o111 == 0 {
+			log.Debugf("Skipping %s, it isn't an executable file", path)
+			continue
+		}
+
+		client := platformplugin.NewClient(log, path, config.StartTimeout, config.CallTimeout)
+		clients = append(clients, client)
+		var info *platformplugin.PlatformInfo
+		if info, err = client.Info(); err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get the platform of plugin %s", path)
+		}
+		if info.PlatformName == "" {
+			return nil, nil, errors.Errorf("platform plugin %s has no platform name", path)
+		}
+		if owner, ok := platformNames[info.PlatformName]; ok {
+			return nil, nil, errors.Errorf("platform %s of plugin %s is already provided by %s", info.PlatformName, path, owner)
+		}
+		platformNames[info.PlatformName] = path
+
+		log.Infof("Platform plugin %s provides external platform %s", path, info.PlatformName)
+		providers = append(providers, NewPluginExternalProvider(log, client, info))
+		platforms = append(platforms, info)
+	}
+	return providers, platforms, nil
+}
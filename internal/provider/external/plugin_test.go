@@ -0,0 +1,147 @@
+package external
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/internal/usage"
+	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/platformplugin"
+)
+
+type fakePlatform struct {
+	platformplugin.BasePlatform
+	installConfigPlatform map[string]interface{}
+}
+
+func (p *fakePlatform) Info() (*platformplugin.PlatformInfo, error) {
+	return &platformplugin.PlatformInfo{PlatformName: "example"}, nil
+}
+
+func (p *fakePlatform) AddPlatformToInstallConfig(_ *models.Cluster, _ []*models.InfraEnv) (map[string]interface{}, error) {
+	return p.installConfigPlatform, nil
+}
+
+func (p *fakePlatform) IsHostSupported(host *models.Host) (bool, error) {
+	return host.RequestedHostname != "", nil
+}
+
+func (p *fakePlatform) PreCreateManifestsHook(cluster *models.Cluster, envVars []string, _ string) ([]string, error) {
+	return append(envVars, "EXAMPLE_CLUSTER_NAME="+cluster.Name), nil
+}
+
+func (p *fakePlatform) SetPlatformUsages(usages map[string]models.Usage) (map[string]models.Usage, error) {
+	usages["Example feature"] = models.Usage{Name: "Example feature", Data: map[string]interface{}{"enabled": true}}
+	return usages, nil
+}
+
+var _ = Describe("platform plugin", func() {
+	var (
+		log      = common.GetTestLog()
+		platform *fakePlatform
+		p        provider.Provider
+		cluster  *common.Cluster
+	)
+
+	BeforeEach(func() {
+		platform = &fakePlatform{}
+		info, err := platform.Info()
+		Expect(err).ToNot(HaveOccurred())
+		p = NewPluginExternalProvider(log, platform, info)
+		cluster = &common.Cluster{Cluster: models.Cluster{
+			Name: "my-cluster",
+			Platform: &models.Platform{
+				Type: models.PlatformTypeExternal.Pointer(),
+				External: &models.PlatformExternal{
+					PlatformName:           swag.String("example"),
+					CloudControllerManager: swag.String(models.PlatformExternalCloudControllerManagerExternal),
+				},
+			},
+		}}
+	})
+
+	It("is the provider of the platform of the plugin", func() {
+		Expect(p.IsProviderForPlatform(cluster.Platform)).To(BeTrue())
+		Expect(p.IsProviderForPlatform(&models.Platform{
+			Type:     models.PlatformTypeExternal.Pointer(),
+			External: &models.PlatformExternal{PlatformName: swag.String(common.ExternalPlatformNameOci)},
+		})).To(BeFalse())
+		Expect(p.IsProviderForPlatform(&models.Platform{Type: models.PlatformTypeBaremetal.Pointer()})).To(BeFalse())
+	})
+
+	It("sets the external platform when the plugin doesn't return a platform", func() {
+		cfg := &installcfg.InstallerConfigBaremetal{}
+		Expect(p.AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.External).To(Equal(&installcfg.ExternalInstallConfigPlatform{
+			PlatformName:           "example",
+			CloudControllerManager: installcfg.CloudControllerManagerTypeExternal,
+		}))
+	})
+
+	It("sets the platform returned by the plugin", func() {
+		platform.installConfigPlatform = map[string]interface{}{
+			"external": map[string]interface{}{"platformName": "example-cloud"},
+		}
+		cfg := &installcfg.InstallerConfigBaremetal{}
+		Expect(p.AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+		Expect(cfg.Platform.External.PlatformName).To(Equal("example-cloud"))
+	})
+
+	It("rejects an invalid platform returned by the plugin", func() {
+		platform.installConfigPlatform = map[string]interface{}{"example": map[string]interface{}{}}
+		cfg := &installcfg.InstallerConfigBaremetal{}
+		Expect(p.AddPlatformToInstallConfig(cfg, cluster, nil)).To(MatchError(ContainSubstring("invalid install config platform")))
+	})
+
+	It("checks the hosts with the plugin", func() {
+		supported, err := p.AreHostsSupported([]*models.Host{{RequestedHostname: "master-0"}, {RequestedHostname: "master-1"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supported).To(BeTrue())
+
+		supported, err = p.AreHostsSupported([]*models.Host{{RequestedHostname: "master-0"}, {}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supported).To(BeFalse())
+	})
+
+	It("runs the hooks of the plugin", func() {
+		envVars := []string{"A=B"}
+		Expect(p.PreCreateManifestsHook(cluster, &envVars, "/tmp")).To(Succeed())
+		Expect(envVars).To(Equal([]string{"A=B", "EXAMPLE_CLUSTER_NAME=my-cluster"}))
+		Expect(p.PostCreateManifestsHook(cluster, &envVars, "/tmp")).To(Succeed())
+		Expect(envVars).To(Equal([]string{"A=B", "EXAMPLE_CLUSTER_NAME=my-cluster"}))
+	})
+
+	It("sets the usages of the plugin", func() {
+		usages := map[string]models.Usage{}
+		Expect(p.SetPlatformUsages(usages, usage.NewManager(log, nil))).To(Succeed())
+		Expect(usages).To(HaveKey(usage.PlatformSelectionUsage))
+		Expect(usages).To(HaveKey("Example feature"))
+		Expect(usages["Example feature"].ID).To(Equal(usage.UsageNameToID("Example feature")))
+		Expect(usages["Example feature"].Data).To(Equal(map[string]interface{}{"enabled": true}))
+	})
+
+	It("doesn't load plugins without a plugins directory", func() {
+		providers, platforms, err := LoadPluginProviders(log, PluginsConfig{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(BeEmpty())
+		Expect(platforms).To(BeEmpty())
+	})
+
+	It("skips the files of the plugins directory that aren't executable", func() {
+		dir, err := os.MkdirTemp("", "platform-plugins-")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		Expect(os.WriteFile(filepath.Join(dir, "README"), []byte("plugins"), 0600)).To(Succeed())
+
+		providers, platforms, err := LoadPluginProviders(log, PluginsConfig{Dir: dir})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(providers).To(BeEmpty())
+		Expect(platforms).To(BeEmpty())
+	})
+})
@@ -0,0 +1,252 @@
+package nutanix
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ provider.CredentialsValidator = ValidateCredentials
+
+// prismPageLength is the number of entities that are listed by each request to Prism Central
+const prismPageLength = 500
+
+type installConfigOverrides struct {
+	Platform struct {
+		Nutanix *installcfg.NutanixInstallConfigPlatform `json:"nutanix"`
+	} `json:"platform"`
+}
+
+// prismStatusError is returned for the responses of Prism Central that don't have a successful status
+type prismStatusError struct {
+	statusCode int
+}
+
+func (e *prismStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.statusCode)
+}
+
+type prismListRequest struct {
+	Kind   string `json:"kind"`
+	Length int    `json:"length"`
+	Offset int    `json:"offset"`
+}
+
+type prismEntity struct {
+	Metadata struct {
+		UUID string `json:"uuid"`
+	} `json:"metadata"`
+	Spec struct {
+		Name string `json:"name"`
+	} `json:"spec"`
+}
+
+type prismListResponse struct {
+	Entities []prismEntity `json:"entities"`
+	Metadata struct {
+		TotalMatches int `json:"total_matches"`
+	} `json:"metadata"`
+}
+
+// prismClient calls the v3 API of Prism Central
+type prismClient struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+func newPrismClient(prismCentral installcfg.NutanixPrismCentral, insecure bool) *prismClient {
+	port := prismCentral.Endpoint.Port
+	if port == 0 {
+		port = PhPCPort
+	}
+	u := url.URL{
+		Scheme: "https",
+		Host:   net.JoinHostPort(prismCentral.Endpoint.Address, strconv.Itoa(int(port))),
+		Path:   "/api/nutanix/v3",
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure} // #nosec G402 only when the service is configured to skip the verification
+	return &prismClient{
+		baseURL:    u.String(),
+		username:   prismCentral.Username,
+		password:   string(prismCentral.Password),
+		httpClient: &http.Client{Transport: transport},
+	}
+}
+
+func (c *prismClient) do(ctx context.Context, method, path string, body, response interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &prismStatusError{statusCode: resp.StatusCode}
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// list returns all the entities of the kind, page by page
+func (c *prismClient) list(ctx context.Context, kind string) ([]prismEntity, error) {
+	var entities []prismEntity
+	for {
+		var response prismListResponse
+		request := prismListRequest{Kind: kind, Length: prismPageLength, Offset: len(entities)}
+		if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%ss/list", kind), request, &response); err != nil {
+			return nil, err
+		}
+		entities = append(entities, response.Entities...)
+		if len(response.Entities) == 0 || len(entities) >= response.Metadata.TotalMatches {
+			return entities, nil
+		}
+	}
+}
+
+// ValidateCredentials connects to the Prism Central of the install config overrides of the cluster, verifies its
+// Prism Elements and subnets, and verifies that the hosts of the cluster are virtual machines of the Prism Central
+func ValidateCredentials(ctx context.Context, _ logrus.FieldLogger, cluster *common.Cluster, insecure bool) (*provider.CredentialsResult, error) {
+	platform, err := nutanixPlatformFromOverrides(cluster.InstallConfigOverrides)
+	if err != nil {
+		return nil, err
+	}
+	if platform == nil || platform.PrismCentral.Endpoint.Address == "" || platform.PrismCentral.Endpoint.Address == PhPCAddress ||
+		platform.PrismCentral.Username == PhUsername {
+		return &provider.CredentialsResult{}, nil
+	}
+
+	address := platform.PrismCentral.Endpoint.Address
+	client := newPrismClient(platform.PrismCentral, insecure)
+	result := &provider.CredentialsResult{Configured: true}
+
+	clusters, err := client.list(ctx, "cluster")
+	if err != nil {
+		result.Failures = append(result.Failures, prismFailure(address, platform.PrismCentral.Username, "list the clusters", err))
+		// The credentials are invalid or Prism Central can't be reached, the next requests would fail the same way
+		return result, nil
+	}
+	clusterNames := make(map[string]string, len(clusters))
+	for _, c := range clusters {
+		clusterNames[strings.ToLower(c.Metadata.UUID)] = c.Spec.Name
+	}
+	for _, prismElement := range platform.PrismElements {
+		if prismElement.UUID == PhPUUID {
+			continue
+		}
+		name, ok := clusterNames[strings.ToLower(prismElement.UUID.String())]
+		if !ok {
+			result.Failures = append(result.Failures, fmt.Sprintf("Prism Central %s: Prism Element %s not found", address, prismElement.UUID))
+			continue
+		}
+		if prismElement.Name != "" && prismElement.Name != name {
+			result.Failures = append(result.Failures, fmt.Sprintf("Prism Central %s: Prism Element %s is named %s, not %s",
+				address, prismElement.UUID, name, prismElement.Name))
+		}
+	}
+
+	for _, subnetUUID := range platform.SubnetUUIDs {
+		if subnetUUID == PhSubnetUUID {
+			continue
+		}
+		if err = client.do(ctx, http.MethodGet, "/subnets/"+url.PathEscape(subnetUUID.String()), nil, nil); err != nil {
+			result.Failures = append(result.Failures, prismFailure(address, platform.PrismCentral.Username, "get subnet "+subnetUUID.String(), err))
+		}
+	}
+
+	vms, err := client.list(ctx, "vm")
+	if err != nil {
+		result.Failures = append(result.Failures, prismFailure(address, platform.PrismCentral.Username, "list the virtual machines", err))
+		return result, nil
+	}
+	result.Failures = append(result.Failures, validateHosts(address, vms, cluster.Hosts)...)
+	return result, nil
+}
+
+func nutanixPlatformFromOverrides(overrides string) (*installcfg.NutanixInstallConfigPlatform, error) {
+	if overrides == "" {
+		return nil, nil
+	}
+	var config installConfigOverrides
+	if err := json.Unmarshal([]byte(overrides), &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the install config overrides")
+	}
+	return config.Platform.Nutanix, nil
+}
+
+// prismFailure describes the failure of a request to Prism Central
+func prismFailure(address, username, action string, err error) string {
+	var statusErr *prismStatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.statusCode {
+		case http.StatusUnauthorized:
+			return fmt.Sprintf("Prism Central %s: invalid credentials for user %s", address, username)
+		case http.StatusForbidden:
+			return fmt.Sprintf("Prism Central %s: user %s isn't allowed to %s", address, username, action)
+		case http.StatusNotFound:
+			return fmt.Sprintf("Prism Central %s: failed to %s, not found", address, action)
+		}
+	}
+	return fmt.Sprintf("Prism Central %s: failed to %s: %s", address, action, err)
+}
+
+// validateHosts verifies that the serial number of each host is the UUID of a virtual machine, as it is for the
+// virtual machines of AHV
+func validateHosts(address string, vms []prismEntity, hosts []*models.Host) []string {
+	vmUUIDs := make(map[string]bool, len(vms))
+	for _, vm := range vms {
+		vmUUIDs[strings.ToLower(vm.Metadata.UUID)] = true
+	}
+	var failures []string
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil || inventory.SystemVendor == nil {
+			continue
+		}
+		serialNumber := strings.ToLower(strings.TrimSpace(inventory.SystemVendor.SerialNumber))
+		if !vmUUIDs[serialNumber] {
+			failures = append(failures, fmt.Sprintf("Prism Central %s: host %s with serial number %s isn't a virtual machine of the Prism Central",
+				address, hostutil.GetHostnameForMsg(h), inventory.SystemVendor.SerialNumber))
+		}
+	}
+	return failures
+}
@@ -0,0 +1,240 @@
+package nutanix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/models"
+)
+
+// mockPrism serves the v3 API of Prism Central that the validation uses, the pages of the lists have at most 2
+// entities to exercise the pagination
+type mockPrism struct {
+	username  string
+	password  string
+	clusters  map[string]string
+	subnets   map[string]bool
+	vms       []string
+	forbidden map[string]bool
+}
+
+func (m *mockPrism) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	username, password, ok := r.BasicAuth()
+	if !ok || username != m.username || password != m.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/api/nutanix/v3")
+	if m.forbidden[path] {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && (path == "/clusters/list" || path == "/vms/list"):
+		var request prismListRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var entities []prismEntity
+		if path == "/clusters/list" {
+			for id, name := range m.clusters {
+				entity := prismEntity{}
+				entity.Metadata.UUID = id
+				entity.Spec.Name = name
+				entities = append(entities, entity)
+			}
+		} else {
+			for _, id := range m.vms {
+				entity := prismEntity{}
+				entity.Metadata.UUID = id
+				entities = append(entities, entity)
+			}
+		}
+		response := prismListResponse{}
+		response.Metadata.TotalMatches = len(entities)
+		if request.Offset < len(entities) {
+			end := request.Offset + 2
+			if end > len(entities) {
+				end = len(entities)
+			}
+			response.Entities = entities[request.Offset:end]
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/subnets/"):
+		if !m.subnets[strings.TrimPrefix(path, "/subnets/")] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("ValidateCredentials", func() {
+	var (
+		ctx      = context.Background()
+		log      = common.GetTestLog()
+		prism    *mockPrism
+		server   *httptest.Server
+		platform *installcfg.NutanixInstallConfigPlatform
+		cluster  *common.Cluster
+	)
+
+	const (
+		peUUID     = "00061663-9fa0-28ca-185b-ac1f6b6f97e2"
+		subnetUUID = "b2ccd32b-0a63-4c66-a37b-e2a9ed0e9b9a"
+	)
+
+	setOverrides := func() {
+		data, err := json.Marshal(map[string]interface{}{"platform": map[string]interface{}{"nutanix": map[string]interface{}{
+			"prismCentral": map[string]interface{}{
+				"endpoint": map[string]interface{}{"address": platform.PrismCentral.Endpoint.Address, "port": platform.PrismCentral.Endpoint.Port},
+				"username": platform.PrismCentral.Username,
+				"password": platform.PrismCentral.Password,
+			},
+			"prismElements": []map[string]interface{}{{
+				"endpoint": map[string]interface{}{"address": "prism-element.example.com", "port": 9440},
+				"uuid":     platform.PrismElements[0].UUID,
+				"name":     platform.PrismElements[0].Name,
+			}},
+			"subnetUUIDs": platform.SubnetUUIDs,
+		}}})
+		Expect(err).ToNot(HaveOccurred())
+		cluster.InstallConfigOverrides = string(data)
+	}
+
+	addHost := func(serial string) {
+		data, err := json.Marshal(&models.Inventory{
+			Hostname:     fmt.Sprintf("host-%d", len(cluster.Hosts)),
+			SystemVendor: &models.SystemVendor{Manufacturer: NutanixManufacturer, SerialNumber: serial},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		cluster.Hosts = append(cluster.Hosts, &models.Host{ID: &id, Inventory: string(data)})
+	}
+
+	BeforeEach(func() {
+		prism = &mockPrism{
+			username:  "admin",
+			password:  "secret",
+			clusters:  map[string]string{peUUID: "pe-0", uuid.New().String(): "pe-1"},
+			subnets:   map[string]bool{subnetUUID: true},
+			vms:       []string{uuid.New().String(), uuid.New().String(), uuid.New().String()},
+			forbidden: map[string]bool{},
+		}
+		server = httptest.NewTLSServer(prism)
+		u, err := url.Parse(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		port, err := strconv.Atoi(u.Port())
+		Expect(err).ToNot(HaveOccurred())
+
+		platform = &installcfg.NutanixInstallConfigPlatform{
+			PrismCentral: installcfg.NutanixPrismCentral{
+				Endpoint: installcfg.NutanixEndpoint{Address: u.Hostname(), Port: int32(port)},
+				Username: "admin",
+				Password: "secret",
+			},
+			PrismElements: []installcfg.NutanixPrismElement{{UUID: peUUID, Name: "pe-0"}},
+			SubnetUUIDs:   []strfmt.UUID{subnetUUID},
+		}
+		cluster = &common.Cluster{Cluster: models.Cluster{Platform: &models.Platform{Type: models.PlatformTypeNutanix.Pointer()}}}
+		setOverrides()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("validates the credentials, the Prism Elements and the subnets", func() {
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeTrue())
+		Expect(result.Failures).To(BeEmpty())
+	})
+
+	It("validates the hosts on all the pages of virtual machines", func() {
+		addHost(strings.ToUpper(prism.vms[2]))
+		addHost("0123456789")
+
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(
+			fmt.Sprintf("Prism Central %s: host host-1 with serial number 0123456789 isn't a virtual machine of the Prism Central",
+				platform.PrismCentral.Endpoint.Address),
+		))
+	})
+
+	It("fails with invalid credentials", func() {
+		platform.PrismCentral.Password = "invalid"
+		setOverrides()
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(
+			fmt.Sprintf("Prism Central %s: invalid credentials for user admin", platform.PrismCentral.Endpoint.Address),
+		))
+	})
+
+	It("fails with missing permissions", func() {
+		prism.forbidden["/vms/list"] = true
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(
+			fmt.Sprintf("Prism Central %s: user admin isn't allowed to list the virtual machines", platform.PrismCentral.Endpoint.Address),
+		))
+	})
+
+	It("fails with objects that don't exist", func() {
+		platform.PrismElements[0].Name = "pe-1"
+		platform.SubnetUUIDs = []strfmt.UUID{subnetUUID, "6a1ec4a4-1fb1-4b60-9d4e-2c1b1a2f3c4d"}
+		setOverrides()
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		address := platform.PrismCentral.Endpoint.Address
+		Expect(result.Failures).To(ConsistOf(
+			fmt.Sprintf("Prism Central %s: Prism Element %s is named pe-0, not pe-1", address, peUUID),
+			fmt.Sprintf("Prism Central %s: failed to get subnet 6a1ec4a4-1fb1-4b60-9d4e-2c1b1a2f3c4d, not found", address),
+		))
+
+		platform.PrismElements[0].UUID = "9b4b0e43-2f43-4f3c-8c39-1f0b1c2d3e4f"
+		setOverrides()
+		result, err = ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ContainElement(
+			fmt.Sprintf("Prism Central %s: Prism Element 9b4b0e43-2f43-4f3c-8c39-1f0b1c2d3e4f not found", address),
+		))
+	})
+
+	It("fails when the certificate of Prism Central isn't trusted", func() {
+		result, err := ValidateCredentials(ctx, log, cluster, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(ContainSubstring("failed to list the clusters")))
+	})
+
+	It("doesn't validate the placeholders", func() {
+		platform.PrismCentral.Endpoint.Address = PhPCAddress
+		setOverrides()
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeFalse())
+
+		cluster.InstallConfigOverrides = `{"networking":{"networkType":"OVNKubernetes"}}`
+		result, err = ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeFalse())
+	})
+})
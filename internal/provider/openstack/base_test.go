@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+)
+
+var _ = Describe("base", func() {
+	var log = common.GetTestLog()
+	Context("is host supported", func() {
+		var provider provider.Provider
+		var host *models.Host
+		BeforeEach(func() {
+			provider = NewOpenstackProvider(log)
+			host = &models.Host{}
+		})
+
+		setHostInventory := func(inventory *models.Inventory, host *models.Host) {
+			data, err := json.Marshal(inventory)
+			Expect(err).To(BeNil())
+			host.Inventory = string(data)
+		}
+
+		DescribeTable("system vendor",
+			func(systemVendor *models.SystemVendor, expected bool) {
+				setHostInventory(&models.Inventory{SystemVendor: systemVendor}, host)
+				supported, err := provider.IsHostSupported(host)
+				Expect(err).To(BeNil())
+				Expect(supported).To(Equal(expected))
+			},
+			Entry("OpenStack Compute", &models.SystemVendor{Manufacturer: OpenstackManufacturer, ProductName: OpenstackProductCompute}, true),
+			Entry("OpenStack Nova", &models.SystemVendor{Manufacturer: "Red Hat", ProductName: OpenstackProductNova}, true),
+			Entry("OpenStack manufacturer", &models.SystemVendor{Manufacturer: OpenstackManufacturer}, true),
+			Entry("other", &models.SystemVendor{Manufacturer: "Red Hat", ProductName: "KVM"}, false),
+			Entry("no system vendor", nil, false),
+		)
+
+		It("no inventory", func() {
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(BeNil())
+			Expect(supported).To(BeFalse())
+		})
+
+		It("invalid inventory", func() {
+			host.Inventory = "invalid-inventory"
+			supported, err := provider.IsHostSupported(host)
+			Expect(err).To(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+	})
+
+	Context("install config", func() {
+		var (
+			cluster *common.Cluster
+			cfg     *installcfg.InstallerConfigBaremetal
+		)
+
+		BeforeEach(func() {
+			cluster = &common.Cluster{Cluster: models.Cluster{
+				OpenshiftVersion:      "4.15.0",
+				CPUArchitecture:       models.ClusterCPUArchitectureX8664,
+				Platform:              &models.Platform{Type: models.PlatformTypeOpenstack.Pointer()},
+				UserManagedNetworking: swag.Bool(false),
+				APIVips:               []*models.APIVip{{IP: "192.168.10.10"}},
+				IngressVips:           []*models.IngressVip{{IP: "192.168.10.11"}},
+			}}
+			cfg = &installcfg.InstallerConfigBaremetal{}
+		})
+
+		It("sets the VIPs and the placeholder cloud", func() {
+			Expect(NewOpenstackProvider(log).AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+			Expect(cfg.Platform.Openstack).ToNot(BeNil())
+			Expect(cfg.Platform.Openstack.Cloud).To(Equal(PhCloud))
+			Expect(cfg.Platform.Openstack.APIVIPs).To(Equal([]string{"192.168.10.10"}))
+			Expect(cfg.Platform.Openstack.IngressVIPs).To(Equal([]string{"192.168.10.11"}))
+		})
+
+		It("sets the cloud of the clouds.yaml", func() {
+			cluster.OpenstackCloudsYAML = "clouds:\n  mycloud:\n    auth:\n      auth_url: https://keystone.example.com:5000\n"
+			Expect(NewOpenstackProvider(log).AddPlatformToInstallConfig(cfg, cluster, nil)).To(Succeed())
+			Expect(cfg.Platform.Openstack.Cloud).To(Equal("mycloud"))
+		})
+
+		It("requires the VIPs without user managed networking", func() {
+			cluster.APIVips = nil
+			Expect(NewOpenstackProvider(log).AddPlatformToInstallConfig(cfg, cluster, nil)).ToNot(Succeed())
+		})
+	})
+
+	Context("manifests hooks", func() {
+		var workDir string
+
+		BeforeEach(func() {
+			var err error
+			workDir, err = os.MkdirTemp("", "openstack-manifests")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Mkdir(filepath.Join(workDir, "openshift"), 0755)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(workDir)
+		})
+
+		It("passes the clouds.yaml to the installer and removes it with the machines", func() {
+			p := NewOpenstackProvider(log)
+			cluster := &common.Cluster{OpenstackCloudsYAML: "clouds: {}"}
+			envVars := []string{}
+			Expect(p.PreCreateManifestsHook(cluster, &envVars, workDir)).To(Succeed())
+			cloudsYAMLPath := filepath.Join(workDir, CloudsYAMLFileName)
+			Expect(envVars).To(ConsistOf(CloudsYAMLEnvVar + "=" + cloudsYAMLPath))
+			data, err := os.ReadFile(cloudsYAMLPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("clouds: {}"))
+
+			machine := filepath.Join(workDir, "openshift", "99_openshift-cluster-api_master-machines-0.yaml")
+			Expect(os.WriteFile(machine, []byte{}, 0600)).To(Succeed())
+			Expect(p.PostCreateManifestsHook(cluster, &envVars, workDir)).To(Succeed())
+			Expect(machine).ToNot(BeAnExistingFile())
+			Expect(cloudsYAMLPath).ToNot(BeAnExistingFile())
+		})
+
+		It("doesn't set the clouds.yaml without credentials", func() {
+			envVars := []string{}
+			Expect(NewOpenstackProvider(log).PreCreateManifestsHook(&common.Cluster{}, &envVars, workDir)).To(Succeed())
+			Expect(envVars).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,16 @@
+package openstack
+
+const (
+	// PhCloud is the name of the cloud of the install config when the clouds.yaml of the cluster doesn't tell it
+	PhCloud = "openstack"
+
+	// CloudsYAMLFileName is the name of the clouds.yaml that is written to the working directory of the installer
+	CloudsYAMLFileName = "clouds.yaml"
+	// CloudsYAMLEnvVar tells the installer where the clouds.yaml is
+	CloudsYAMLEnvVar = "OS_CLIENT_CONFIG_FILE"
+
+	OpenstackManufacturer    string = "OpenStack Foundation"
+	OpenstackProductCompute  string = "OpenStack Compute"
+	OpenstackProductNova     string = "OpenStack Nova"
+	OpenstackDefaultAuthType string = "password"
+)
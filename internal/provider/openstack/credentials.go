@@ -0,0 +1,375 @@
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+var _ provider.CredentialsValidator = ValidateCredentials
+
+// novaPageLength is the number of servers that are listed by each request to Nova
+const novaPageLength = 500
+
+// CloudAuth is the auth section of a cloud of clouds.yaml
+type CloudAuth struct {
+	AuthURL                     string `json:"auth_url"`
+	Username                    string `json:"username,omitempty"`
+	UserID                      string `json:"user_id,omitempty"`
+	Password                    string `json:"password,omitempty"`
+	UserDomainName              string `json:"user_domain_name,omitempty"`
+	UserDomainID                string `json:"user_domain_id,omitempty"`
+	ProjectName                 string `json:"project_name,omitempty"`
+	ProjectID                   string `json:"project_id,omitempty"`
+	ProjectDomainName           string `json:"project_domain_name,omitempty"`
+	ProjectDomainID             string `json:"project_domain_id,omitempty"`
+	ApplicationCredentialID     string `json:"application_credential_id,omitempty"`
+	ApplicationCredentialSecret string `json:"application_credential_secret,omitempty"`
+}
+
+// Cloud is a cloud of clouds.yaml, only the fields that the service uses are parsed
+type Cloud struct {
+	Auth       CloudAuth `json:"auth"`
+	AuthType   string    `json:"auth_type,omitempty"`
+	RegionName string    `json:"region_name,omitempty"`
+	Interface  string    `json:"interface,omitempty"`
+	Verify     *bool     `json:"verify,omitempty"`
+}
+
+type cloudsYAML struct {
+	Clouds map[string]Cloud `json:"clouds"`
+}
+
+// ParseCloudsYAML returns the clouds of a clouds.yaml by name, it fails when the clouds.yaml has no cloud or when a
+// cloud has no Keystone URL
+func ParseCloudsYAML(data string) (map[string]Cloud, error) {
+	var config cloudsYAML
+	if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the clouds.yaml")
+	}
+	if len(config.Clouds) == 0 {
+		return nil, errors.New("the clouds.yaml has no clouds")
+	}
+	for name, cloud := range config.Clouds {
+		if cloud.Auth.AuthURL == "" {
+			return nil, errors.Errorf("cloud %s of the clouds.yaml has no auth_url", name)
+		}
+		switch cloud.AuthType {
+		case "", OpenstackDefaultAuthType, "v3password", "v3applicationcredential":
+		default:
+			return nil, errors.Errorf("cloud %s of the clouds.yaml has the unsupported auth_type %s", name, cloud.AuthType)
+		}
+	}
+	return config.Clouds, nil
+}
+
+// keystoneStatusError is returned for the responses of the OpenStack APIs that don't have a successful status
+type keystoneStatusError struct {
+	statusCode int
+}
+
+func (e *keystoneStatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d", e.statusCode)
+}
+
+type keystoneEndpoint struct {
+	Interface string `json:"interface"`
+	Region    string `json:"region"`
+	RegionID  string `json:"region_id"`
+	URL       string `json:"url"`
+}
+
+type keystoneTokenResponse struct {
+	Token struct {
+		Catalog []struct {
+			Type      string             `json:"type"`
+			Endpoints []keystoneEndpoint `json:"endpoints"`
+		} `json:"catalog"`
+	} `json:"token"`
+}
+
+type novaServer struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type novaServersResponse struct {
+	Servers      []novaServer `json:"servers"`
+	ServersLinks []struct {
+		Rel string `json:"rel"`
+	} `json:"servers_links"`
+}
+
+// hasNext tells whether Nova has more servers than the ones of the page
+func (r *novaServersResponse) hasNext() bool {
+	for _, link := range r.ServersLinks {
+		if link.Rel == "next" {
+			return len(r.Servers) > 0
+		}
+	}
+	return false
+}
+
+// openstackClient authenticates with Keystone v3 and calls the compute API of the catalog of its token
+type openstackClient struct {
+	cloud      Cloud
+	token      string
+	computeURL string
+	httpClient *http.Client
+}
+
+func newOpenstackClient(cloud Cloud, insecure bool) *openstackClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// #nosec G402 only when the service or the cloud are configured to skip the verification
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: insecure || (cloud.Verify != nil && !*cloud.Verify)}
+	return &openstackClient{
+		cloud:      cloud,
+		httpClient: &http.Client{Transport: transport},
+	}
+}
+
+func (c *openstackClient) do(ctx context.Context, method, requestURL string, body, response interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("X-Auth-Token", c.token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &keystoneStatusError{statusCode: resp.StatusCode}
+	}
+	if response == nil {
+		return resp, nil
+	}
+	return resp, json.NewDecoder(resp.Body).Decode(response)
+}
+
+// identityURL returns the Keystone v3 URL of the auth URL of the cloud, that may or may not have the version
+func (c *openstackClient) identityURL() string {
+	authURL := strings.TrimSuffix(c.cloud.Auth.AuthURL, "/")
+	if !strings.HasSuffix(authURL, "/v3") {
+		authURL += "/v3"
+	}
+	return authURL
+}
+
+func (c *openstackClient) authRequest() map[string]interface{} {
+	auth := c.cloud.Auth
+	if auth.ApplicationCredentialID != "" || c.cloud.AuthType == "v3applicationcredential" {
+		return map[string]interface{}{"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"application_credential"},
+				"application_credential": map[string]interface{}{
+					"id":     auth.ApplicationCredentialID,
+					"secret": auth.ApplicationCredentialSecret,
+				},
+			},
+		}}
+	}
+
+	user := map[string]interface{}{"password": auth.Password}
+	if auth.UserID != "" {
+		user["id"] = auth.UserID
+	} else {
+		user["name"] = auth.Username
+		user["domain"] = domain(auth.UserDomainID, auth.UserDomainName)
+	}
+	request := map[string]interface{}{
+		"identity": map[string]interface{}{
+			"methods":  []string{"password"},
+			"password": map[string]interface{}{"user": user},
+		},
+	}
+	if auth.ProjectID != "" {
+		request["scope"] = map[string]interface{}{"project": map[string]interface{}{"id": auth.ProjectID}}
+	} else if auth.ProjectName != "" {
+		request["scope"] = map[string]interface{}{"project": map[string]interface{}{
+			"name":   auth.ProjectName,
+			"domain": domain(auth.ProjectDomainID, auth.ProjectDomainName),
+		}}
+	}
+	return map[string]interface{}{"auth": request}
+}
+
+func domain(id, name string) map[string]string {
+	if id != "" {
+		return map[string]string{"id": id}
+	}
+	if name == "" {
+		name = "Default"
+	}
+	return map[string]string{"name": name}
+}
+
+// authenticate gets a token from Keystone and finds the compute endpoint in its catalog
+func (c *openstackClient) authenticate(ctx context.Context) error {
+	var response keystoneTokenResponse
+	resp, err := c.do(ctx, http.MethodPost, c.identityURL()+"/auth/tokens", c.authRequest(), &response)
+	if err != nil {
+		return err
+	}
+	c.token = resp.Header.Get("X-Subject-Token")
+	if c.token == "" {
+		return errors.New("Keystone didn't return a token")
+	}
+
+	endpointInterface := c.cloud.Interface
+	if endpointInterface == "" {
+		endpointInterface = "public"
+	}
+	for _, service := range response.Token.Catalog {
+		if service.Type != "compute" {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface != endpointInterface {
+				continue
+			}
+			if c.cloud.RegionName != "" && endpoint.Region != c.cloud.RegionName && endpoint.RegionID != c.cloud.RegionName {
+				continue
+			}
+			c.computeURL = strings.TrimSuffix(endpoint.URL, "/")
+			return nil
+		}
+	}
+	return errors.Errorf("the catalog has no %s compute endpoint", endpointInterface)
+}
+
+// listServers returns all the servers of the project, page by page, Nova links the next page while there are more
+// servers
+func (c *openstackClient) listServers(ctx context.Context) ([]novaServer, error) {
+	var servers []novaServer
+	for {
+		query := url.Values{"limit": []string{fmt.Sprint(novaPageLength)}}
+		if len(servers) > 0 {
+			query.Set("marker", servers[len(servers)-1].ID)
+		}
+		var response novaServersResponse
+		if _, err := c.do(ctx, http.MethodGet, c.computeURL+"/servers?"+query.Encode(), nil, &response); err != nil {
+			return nil, err
+		}
+		servers = append(servers, response.Servers...)
+		if !response.hasNext() {
+			return servers, nil
+		}
+	}
+}
+
+// selectCloud returns the cloud of the install config: the only cloud of the clouds.yaml, or the one named as the
+// placeholder of the install config
+func selectCloud(clouds map[string]Cloud) (string, Cloud, error) {
+	if len(clouds) == 1 {
+		for name, cloud := range clouds {
+			return name, cloud, nil
+		}
+	}
+	if cloud, ok := clouds[PhCloud]; ok {
+		return PhCloud, cloud, nil
+	}
+	return "", Cloud{}, errors.Errorf("the clouds.yaml has %d clouds and none of them is named %s", len(clouds), PhCloud)
+}
+
+// ValidateCredentials authenticates with the Keystone of the clouds.yaml of the cluster and verifies that the hosts
+// of the cluster are servers of the project of the credentials
+func ValidateCredentials(ctx context.Context, _ logrus.FieldLogger, cluster *common.Cluster, insecure bool) (*provider.CredentialsResult, error) {
+	if cluster.OpenstackCloudsYAML == "" {
+		return &provider.CredentialsResult{}, nil
+	}
+	clouds, err := ParseCloudsYAML(cluster.OpenstackCloudsYAML)
+	if err != nil {
+		return nil, err
+	}
+	name, cloud, err := selectCloud(clouds)
+	if err != nil {
+		return nil, err
+	}
+
+	client := newOpenstackClient(cloud, insecure)
+	result := &provider.CredentialsResult{Configured: true}
+	if err = client.authenticate(ctx); err != nil {
+		result.Failures = append(result.Failures, openstackFailure(name, "authenticate", err))
+		// The credentials are invalid or the cloud can't be reached, the next requests would fail the same way
+		return result, nil
+	}
+
+	servers, err := client.listServers(ctx)
+	if err != nil {
+		result.Failures = append(result.Failures, openstackFailure(name, "list the servers", err))
+		return result, nil
+	}
+	result.Failures = append(result.Failures, validateHosts(name, servers, cluster.Hosts)...)
+	return result, nil
+}
+
+// openstackFailure describes the failure of a request to the cloud
+func openstackFailure(cloud, action string, err error) string {
+	var statusErr *keystoneStatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.statusCode {
+		case http.StatusUnauthorized:
+			return fmt.Sprintf("OpenStack cloud %s: invalid credentials", cloud)
+		case http.StatusForbidden:
+			return fmt.Sprintf("OpenStack cloud %s: the credentials aren't allowed to %s", cloud, action)
+		case http.StatusNotFound:
+			return fmt.Sprintf("OpenStack cloud %s: failed to %s, not found", cloud, action)
+		}
+	}
+	return fmt.Sprintf("OpenStack cloud %s: failed to %s: %s", cloud, action, err)
+}
+
+// validateHosts verifies that the serial number of each host is the ID of a server, as Nova sets the serial number
+// of its instances to their ID
+func validateHosts(cloud string, servers []novaServer, hosts []*models.Host) []string {
+	serverIDs := make(map[string]bool, len(servers))
+	for _, server := range servers {
+		serverIDs[strings.ToLower(server.ID)] = true
+	}
+	var failures []string
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil || inventory.SystemVendor == nil {
+			continue
+		}
+		serialNumber := strings.ToLower(strings.TrimSpace(inventory.SystemVendor.SerialNumber))
+		if !serverIDs[serialNumber] {
+			failures = append(failures, fmt.Sprintf("OpenStack cloud %s: host %s with serial number %s isn't a server of the project",
+				cloud, hostutil.GetHostnameForMsg(h), inventory.SystemVendor.SerialNumber))
+		}
+	}
+	return failures
+}
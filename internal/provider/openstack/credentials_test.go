@@ -0,0 +1,253 @@
+package openstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+)
+
+const mockToken = "mock-token"
+
+// mockOpenstack serves the Keystone v3 and Nova APIs that the validation uses, the pages of servers have at most 2
+// servers to exercise the pagination
+type mockOpenstack struct {
+	url       string
+	username  string
+	password  string
+	appCredID string
+	appSecret string
+	servers   []string
+	forbidden map[string]bool
+}
+
+func (m *mockOpenstack) authenticated(r *http.Request) bool {
+	var request struct {
+		Auth struct {
+			Identity struct {
+				Methods  []string `json:"methods"`
+				Password struct {
+					User struct {
+						Name     string `json:"name"`
+						Password string `json:"password"`
+					} `json:"user"`
+				} `json:"password"`
+				ApplicationCredential struct {
+					ID     string `json:"id"`
+					Secret string `json:"secret"`
+				} `json:"application_credential"`
+			} `json:"identity"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || len(request.Auth.Identity.Methods) != 1 {
+		return false
+	}
+	identity := request.Auth.Identity
+	switch identity.Methods[0] {
+	case "password":
+		return identity.Password.User.Name == m.username && identity.Password.User.Password == m.password
+	case "application_credential":
+		return m.appCredID != "" && identity.ApplicationCredential.ID == m.appCredID && identity.ApplicationCredential.Secret == m.appSecret
+	}
+	return false
+}
+
+func (m *mockOpenstack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if m.forbidden[r.URL.Path] {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/identity/v3/auth/tokens":
+		if !m.authenticated(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("X-Subject-Token", mockToken)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"token": {"catalog": [
+			{"type": "identity", "endpoints": [{"interface": "public", "region": "RegionOne", "url": "%[1]s/identity"}]},
+			{"type": "compute", "endpoints": [
+				{"interface": "internal", "region": "RegionOne", "url": "http://compute.internal/v2.1"},
+				{"interface": "public", "region": "RegionOne", "url": "%[1]s/compute/v2.1/"}
+			]}
+		]}}`, m.url)
+	case r.Method == http.MethodGet && r.URL.Path == "/compute/v2.1/servers":
+		if r.Header.Get("X-Auth-Token") != mockToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		start := 0
+		if marker := r.URL.Query().Get("marker"); marker != "" {
+			for i, id := range m.servers {
+				if id == marker {
+					start = i + 1
+				}
+			}
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := start + 2
+		if limit > 0 && limit < 2 {
+			end = start + limit
+		}
+		if end > len(m.servers) {
+			end = len(m.servers)
+		}
+		response := novaServersResponse{Servers: []novaServer{}}
+		for _, id := range m.servers[start:end] {
+			response.Servers = append(response.Servers, novaServer{ID: id, Name: "server-" + id})
+		}
+		if end < len(m.servers) {
+			response.ServersLinks = append(response.ServersLinks, struct {
+				Rel string `json:"rel"`
+			}{Rel: "next"})
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("ValidateCredentials", func() {
+	var (
+		ctx     = context.Background()
+		log     = common.GetTestLog()
+		mock    *mockOpenstack
+		server  *httptest.Server
+		cluster *common.Cluster
+	)
+
+	passwordCloud := func(password string) string {
+		return fmt.Sprintf(`clouds:
+  mycloud:
+    auth:
+      auth_url: %s/identity
+      username: admin
+      password: %s
+      project_name: ocp
+      user_domain_name: Default
+      project_domain_name: Default
+    region_name: RegionOne
+`, server.URL, password)
+	}
+
+	addHost := func(serial string) {
+		data, err := json.Marshal(&models.Inventory{
+			Hostname:     fmt.Sprintf("host-%d", len(cluster.Hosts)),
+			SystemVendor: &models.SystemVendor{ProductName: OpenstackProductCompute, SerialNumber: serial},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		cluster.Hosts = append(cluster.Hosts, &models.Host{ID: &id, Inventory: string(data)})
+	}
+
+	BeforeEach(func() {
+		mock = &mockOpenstack{
+			username:  "admin",
+			password:  "secret",
+			appCredID: "app-cred",
+			appSecret: "app-secret",
+			servers:   []string{uuid.New().String(), uuid.New().String(), uuid.New().String()},
+			forbidden: map[string]bool{},
+		}
+		server = httptest.NewTLSServer(mock)
+		mock.url = server.URL
+		cluster = &common.Cluster{Cluster: models.Cluster{Platform: &models.Platform{Type: models.PlatformTypeOpenstack.Pointer()}}}
+		cluster.OpenstackCloudsYAML = passwordCloud("secret")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("isn't configured without a clouds.yaml", func() {
+		cluster.OpenstackCloudsYAML = ""
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeFalse())
+	})
+
+	It("validates the credentials", func() {
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeTrue())
+		Expect(result.Failures).To(BeEmpty())
+	})
+
+	It("validates application credentials", func() {
+		cluster.OpenstackCloudsYAML = fmt.Sprintf(`clouds:
+  openstack:
+    auth_type: v3applicationcredential
+    auth:
+      auth_url: %s/identity/v3
+      application_credential_id: app-cred
+      application_credential_secret: app-secret
+`, server.URL)
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(BeEmpty())
+	})
+
+	It("validates the hosts on all the pages of servers", func() {
+		addHost(strings.ToUpper(mock.servers[2]))
+		addHost("0123456789")
+
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(
+			"OpenStack cloud mycloud: host host-1 with serial number 0123456789 isn't a server of the project",
+		))
+	})
+
+	It("fails with invalid credentials", func() {
+		cluster.OpenstackCloudsYAML = passwordCloud("invalid")
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf("OpenStack cloud mycloud: invalid credentials"))
+	})
+
+	It("fails with missing permissions", func() {
+		mock.forbidden["/compute/v2.1/servers"] = true
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf("OpenStack cloud mycloud: the credentials aren't allowed to list the servers"))
+	})
+
+	It("fails without a compute endpoint in the region", func() {
+		cluster.OpenstackCloudsYAML = strings.Replace(passwordCloud("secret"), "RegionOne", "RegionTwo", 1)
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(ContainSubstring("the catalog has no public compute endpoint")))
+	})
+
+	It("fails to verify the certificate of the cloud", func() {
+		result, err := ValidateCredentials(ctx, log, cluster, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(ContainSubstring("OpenStack cloud mycloud: failed to authenticate")))
+
+		cluster.OpenstackCloudsYAML += "    verify: false\n"
+		result, err = ValidateCredentials(ctx, log, cluster, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(BeEmpty())
+	})
+
+	It("fails with an invalid clouds.yaml", func() {
+		cluster.OpenstackCloudsYAML = "clouds:\n  mycloud:\n    region_name: RegionOne\n"
+		_, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).To(MatchError(ContainSubstring("has no auth_url")))
+
+		cluster.OpenstackCloudsYAML = "clouds:\n  a:\n    auth:\n      auth_url: https://a\n  b:\n    auth:\n      auth_url: https://b\n"
+		_, err = ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).To(MatchError(ContainSubstring("none of them is named openstack")))
+	})
+})
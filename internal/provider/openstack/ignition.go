@@ -0,0 +1,63 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/openshift/assisted-service/internal/common"
+)
+
+// PreCreateManifestsHook writes the clouds.yaml of the cluster to the working directory, the installer reads the
+// cloud of the install config from it while it generates the manifests
+func (p openstackProvider) PreCreateManifestsHook(cluster *common.Cluster, envVars *[]string, workDir string) error {
+	if cluster.OpenstackCloudsYAML == "" {
+		return nil
+	}
+	cloudsYAMLPath := filepath.Join(workDir, CloudsYAMLFileName)
+	if err := os.WriteFile(cloudsYAMLPath, []byte(cluster.OpenstackCloudsYAML), 0600); err != nil {
+		return fmt.Errorf("error writing %s: %w", CloudsYAMLFileName, err)
+	}
+	*envVars = append(*envVars, fmt.Sprintf("%s=%s", CloudsYAMLEnvVar, cloudsYAMLPath))
+	return nil
+}
+
+func (p openstackProvider) PostCreateManifestsHook(_ *common.Cluster, _ *[]string, workDir string) error {
+	// The hosts are already provisioned, the machines and machine sets that the installer generates would create
+	// new instances
+	p.Log.Info("Deleting machines manifests")
+	files, _ := filepath.Glob(path.Join(workDir, "openshift", "*_openshift-cluster-api_master-machines-*.yaml"))
+	if err := p.deleteAllFiles(files); err != nil {
+		return fmt.Errorf("error deleting master machine: %w", err)
+	}
+
+	p.Log.Info("Deleting machine set manifest")
+	files, _ = filepath.Glob(path.Join(workDir, "openshift", "*_openshift-cluster-api_worker-machineset-*.yaml"))
+	if err := p.deleteAllFiles(files); err != nil {
+		return fmt.Errorf("error deleting machineset: %w", err)
+	}
+
+	p.Log.Info("Deleting control-plane machine set")
+	files, _ = filepath.Glob(path.Join(workDir, "openshift", "*_openshift-machine-api_master-control-plane-machine-set*.yaml"))
+	if err := p.deleteAllFiles(files); err != nil {
+		return fmt.Errorf("error deleting control-plane machineset: %w", err)
+	}
+
+	// The clouds.yaml has the credentials of the cloud, it isn't needed anymore
+	if err := os.Remove(filepath.Join(workDir, CloudsYAMLFileName)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error deleting %s: %w", CloudsYAMLFileName, err)
+	}
+	return nil
+}
+
+func (p openstackProvider) deleteAllFiles(files []string) error {
+	for _, f := range files {
+		p.Log.Infof("Deleting manifest %s", f)
+
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package openstack
+
+import (
+	"errors"
+
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/featuresupport"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/network"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+)
+
+func (p openstackProvider) AddPlatformToInstallConfig(
+	cfg *installcfg.InstallerConfigBaremetal, cluster *common.Cluster, infraEnvs []*common.InfraEnv) error {
+	oPlatform := &installcfg.OpenstackInstallConfigPlatform{
+		Cloud: PhCloud,
+	}
+	// The cloud is selected the same way as for the validation of the credentials, the install config overrides
+	// can still change it
+	if clouds, err := ParseCloudsYAML(cluster.OpenstackCloudsYAML); err == nil {
+		if name, _, err := selectCloud(clouds); err == nil {
+			oPlatform.Cloud = name
+		}
+	}
+
+	if !swag.BoolValue(cluster.UserManagedNetworking) {
+		if len(cluster.APIVips) == 0 {
+			return errors.New("invalid cluster parameters, APIVip must be provided")
+		}
+
+		if len(cluster.IngressVips) == 0 {
+			return errors.New("invalid cluster parameters, IngressVip must be provided")
+		}
+
+		if featuresupport.IsFeatureAvailable(models.FeatureSupportLevelIDDUALSTACKVIPS, cluster.OpenshiftVersion, swag.String(cluster.CPUArchitecture)) {
+			oPlatform.APIVIPs = network.GetApiVips(cluster)
+			oPlatform.IngressVIPs = network.GetIngressVips(cluster)
+		} else {
+			oPlatform.APIVIPs = []string{network.GetApiVips(cluster)[0]}
+			oPlatform.IngressVIPs = []string{network.GetIngressVips(cluster)[0]}
+			oPlatform.DeprecatedAPIVIP = network.GetApiVipById(cluster, 0)
+			oPlatform.DeprecatedIngressVIP = network.GetIngressVipById(cluster, 0)
+		}
+	} else {
+		cfg.Networking.MachineNetwork = provider.GetMachineNetworkForUserManagedNetworking(p.Log, cluster)
+		if cluster.NetworkType != nil {
+			cfg.Networking.NetworkType = swag.StringValue(cluster.NetworkType)
+		}
+	}
+	cfg.Platform = installcfg.Platform{
+		Openstack: oPlatform,
+	}
+	return nil
+}
@@ -318,6 +318,8 @@ func GetPlatformFeatureID(platformType models.PlatformType) models.FeatureSuppor
 		return models.FeatureSupportLevelIDVSPHEREINTEGRATION
 	case models.PlatformTypeNutanix:
 		return models.FeatureSupportLevelIDNUTANIXINTEGRATION
+	case models.PlatformTypeOpenstack:
+		return models.FeatureSupportLevelIDOPENSTACKINTEGRATION
 	default:
 		return "" // Return empty string on platform without a feature support ID
 	}
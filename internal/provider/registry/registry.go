@@ -11,6 +11,7 @@ import (
 	"github.com/openshift/assisted-service/internal/provider/external"
 	"github.com/openshift/assisted-service/internal/provider/none"
 	"github.com/openshift/assisted-service/internal/provider/nutanix"
+	"github.com/openshift/assisted-service/internal/provider/openstack"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
@@ -168,13 +169,19 @@ func (r *registry) PostCreateManifestsHook(cluster *common.Cluster, envVars *[]s
 	return currentProvider.PostCreateManifestsHook(cluster, envVars, workDir)
 }
 
-func InitProviderRegistry(log logrus.FieldLogger) ProviderRegistry {
+// InitProviderRegistry registers the providers of the service. The providers of the platform plugins are registered
+// before the generic external provider, that handles any external platform.
+func InitProviderRegistry(log logrus.FieldLogger, pluginProviders ...provider.Provider) ProviderRegistry {
 	providerRegistry := NewProviderRegistry()
 	providerRegistry.Register(vsphere.NewVsphereProvider(log))
 	providerRegistry.Register(baremetal.NewBaremetalProvider(log))
 	providerRegistry.Register(none.NewNoneProvider(log))
 	providerRegistry.Register(nutanix.NewNutanixProvider(log))
+	providerRegistry.Register(openstack.NewOpenstackProvider(log))
 	providerRegistry.Register(external.NewOciExternalProvider(log))
+	for _, pluginProvider := range pluginProviders {
+		providerRegistry.Register(pluginProvider)
+	}
 	providerRegistry.Register(external.NewExternalProvider(log))
 	return providerRegistry
 }
@@ -13,9 +13,11 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/openshift/assisted-service/internal/common"
 	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider/external"
 	"github.com/openshift/assisted-service/internal/provider/vsphere"
 	"github.com/openshift/assisted-service/internal/usage"
 	"github.com/openshift/assisted-service/models"
+	"github.com/openshift/assisted-service/pkg/platformplugin"
 	"gopkg.in/yaml.v2"
 )
 
@@ -114,6 +116,15 @@ var _ = Describe("Test GetSupportedProvidersByHosts", func() {
 		supportedPlatforms := []models.PlatformType{models.PlatformTypeBaremetal, models.PlatformTypeVsphere, models.PlatformTypeNone, models.PlatformTypeExternal}
 		Expect(platforms).Should(ContainElements(supportedPlatforms))
 	})
+	It("3 openstack hosts", func() {
+		hosts := make([]*models.Host, 0)
+		for i := 0; i < 3; i++ {
+			hosts = append(hosts, createHost(true, models.HostStatusKnown, getOpenstackInventoryStr(fmt.Sprintf("hostname%d", i), "bootMode", true, false)))
+		}
+		platforms, err := providerRegistry.GetSupportedProvidersByHosts(hosts)
+		Expect(err).To(BeNil())
+		Expect(platforms).Should(ConsistOf(models.PlatformTypeBaremetal, models.PlatformTypeOpenstack, models.PlatformTypeNone, models.PlatformTypeExternal))
+	})
 	It("2 vsphere hosts 1 generic host", func() {
 		hosts := make([]*models.Host, 0)
 		hosts = append(hosts, createHost(true, models.HostStatusKnown, bmInventory))
@@ -498,6 +509,35 @@ var _ = Describe("Test AddPlatformToInstallConfig", func() {
 	})
 })
 
+type examplePlatform struct {
+	platformplugin.BasePlatform
+}
+
+func (p *examplePlatform) Info() (*platformplugin.PlatformInfo, error) {
+	return &platformplugin.PlatformInfo{PlatformName: "example"}, nil
+}
+
+var _ = Describe("Platform plugins", func() {
+	It("are the providers of their external platforms", func() {
+		platform := &examplePlatform{}
+		info, err := platform.Info()
+		Expect(err).ToNot(HaveOccurred())
+		pluginProvider := external.NewPluginExternalProvider(common.GetTestLog(), platform, info)
+		providerRegistry = InitProviderRegistry(common.GetTestLog(), pluginProvider)
+
+		currentProvider, err := providerRegistry.Get(&models.Platform{
+			Type:     common.PlatformTypePtr(models.PlatformTypeExternal),
+			External: &models.PlatformExternal{PlatformName: swag.String("example")},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(currentProvider).To(BeIdenticalTo(pluginProvider))
+
+		currentProvider, err = providerRegistry.Get(createExternalPlatformParams())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(currentProvider).ToNot(BeIdenticalTo(pluginProvider))
+	})
+})
+
 var _ = Describe("Test SetPlatformUsages", func() {
 	var (
 		usageApi *usage.MockAPI
@@ -631,6 +671,18 @@ func getNutanixInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
 	return string(ret)
 }
 
+func getOpenstackInventoryStr(hostname, bootMode string, ipv4, ipv6 bool) string {
+	inventory := getInventory(hostname, bootMode, ipv4, ipv6)
+	inventory.SystemVendor = &models.SystemVendor{
+		Manufacturer: "OpenStack Foundation",
+		ProductName:  "OpenStack Compute",
+		SerialNumber: "5c2a6b1e-33b5-4b8e-8a59-3c3f0a5c9e1d",
+		Virtual:      true,
+	}
+	ret, _ := json.Marshal(&inventory)
+	return string(ret)
+}
+
 func createVspherePlatformParams() *models.Platform {
 	return &models.Platform{
 		Type: common.PlatformTypePtr(models.PlatformTypeVsphere),
@@ -0,0 +1,336 @@
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/host/hostutil"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/internal/provider"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+var _ provider.CredentialsValidator = ValidateCredentials
+
+// The privileges that the installed cluster requires on the objects of its failure domains, as documented by the
+// OpenShift installer for vSphere
+var (
+	computeClusterPrivileges = []string{
+		"Host.Config.Storage",
+		"Resource.AssignVMToPool",
+		"VApp.AssignResourcePool",
+		"VApp.Import",
+		"VirtualMachine.Config.AddNewDisk",
+	}
+	datastorePrivileges = []string{
+		"Datastore.AllocateSpace",
+		"Datastore.Browse",
+		"Datastore.FileManagement",
+	}
+	networkPrivileges = []string{
+		"Network.Assign",
+	}
+	folderPrivileges = []string{
+		"Resource.AssignVMToPool",
+		"VApp.Import",
+		"VirtualMachine.Config.AddExistingDisk",
+		"VirtualMachine.Config.AddNewDisk",
+		"VirtualMachine.Config.AddRemoveDevice",
+		"VirtualMachine.Config.EditDevice",
+		"VirtualMachine.Config.Settings",
+		"VirtualMachine.Interact.PowerOff",
+		"VirtualMachine.Interact.PowerOn",
+		"VirtualMachine.Inventory.Create",
+		"VirtualMachine.Inventory.Delete",
+	}
+	resourcePoolPrivileges = []string{
+		"Resource.AssignVMToPool",
+		"VApp.AssignResourcePool",
+		"VApp.Import",
+		"VirtualMachine.Config.AddNewDisk",
+	}
+)
+
+// The serial number of a vSphere virtual machine is its BIOS UUID, e.g. VMware-42 1a 2b 3c 4d 5e 6f 70-81 92 a3 b4 c5 d6 e7 f8
+var serialNumberRegex = regexp.MustCompile(`^VMware-((?:[0-9a-fA-F]{2} ?){8})-((?:[0-9a-fA-F]{2} ?){8})$`)
+
+// topologyObject is an object of a failure domain and the privileges that the user requires on it
+type topologyObject struct {
+	kind       string
+	path       string
+	find       func(context.Context, string) (object.Reference, error)
+	privileges []string
+}
+
+type installConfigOverrides struct {
+	Platform struct {
+		Vsphere *installcfg.VsphereInstallConfigPlatform `json:"vsphere"`
+	} `json:"platform"`
+}
+
+// ValidateCredentials logs in to the vCenters of the install config overrides of the cluster, verifies the datacenters
+// of the vCenters, the objects of the failure domains and the privileges of the user on them, and verifies that the
+// hosts of the cluster are virtual machines of the vCenters
+func ValidateCredentials(ctx context.Context, log logrus.FieldLogger, cluster *common.Cluster, insecure bool) (*provider.CredentialsResult, error) {
+	platform, err := vspherePlatformFromOverrides(cluster.InstallConfigOverrides)
+	if err != nil {
+		return nil, err
+	}
+	vcenters, failureDomains := configuredTopology(platform)
+	if len(vcenters) == 0 {
+		return &provider.CredentialsResult{}, nil
+	}
+
+	result := &provider.CredentialsResult{Configured: true}
+	clients := make([]*govmomi.Client, 0, len(vcenters))
+	defer func() {
+		for _, client := range clients {
+			if err := client.Logout(context.Background()); err != nil {
+				log.WithError(err).Debugf("Failed to log out of vCenter %s", client.URL().Host)
+			}
+		}
+	}()
+	for _, vcenter := range vcenters {
+		client, err := login(ctx, vcenter, insecure)
+		if err != nil {
+			result.Failures = append(result.Failures, err.Error())
+			continue
+		}
+		clients = append(clients, client)
+		result.Failures = append(result.Failures, validateVCenter(ctx, client.Client, vcenter, failureDomains)...)
+	}
+
+	// The hosts can be anywhere in the vCenters, they are searched only when the service could log in to all of them
+	if len(clients) == len(vcenters) {
+		result.Failures = append(result.Failures, validateHosts(ctx, clients, cluster.Hosts)...)
+	}
+	return result, nil
+}
+
+func vspherePlatformFromOverrides(overrides string) (*installcfg.VsphereInstallConfigPlatform, error) {
+	if overrides == "" {
+		return nil, nil
+	}
+	var config installConfigOverrides
+	if err := json.Unmarshal([]byte(overrides), &config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the install config overrides")
+	}
+	return config.Platform.Vsphere, nil
+}
+
+// configuredTopology returns the vCenters and the failure domains of the platform, the deprecated fields of the
+// platform are converted to a single vCenter and failure domain as the installer does. The vCenters that still have
+// the placeholders of the service are omitted.
+func configuredTopology(platform *installcfg.VsphereInstallConfigPlatform) ([]installcfg.VsphereVCenter, []installcfg.VsphereFailureDomain) {
+	if platform == nil {
+		return nil, nil
+	}
+	vcenters := platform.VCenters
+	failureDomains := platform.FailureDomains
+	if len(vcenters) == 0 && platform.DeprecatedVCenter != "" {
+		vcenters = []installcfg.VsphereVCenter{{
+			Server:      platform.DeprecatedVCenter,
+			Username:    platform.DeprecatedUsername,
+			Password:    platform.DeprecatedPassword,
+			Datacenters: []string{platform.DeprecatedDatacenter},
+		}}
+		failureDomain := installcfg.VsphereFailureDomain{
+			Name:   "generated-failure-domain",
+			Server: platform.DeprecatedVCenter,
+			Topology: installcfg.VsphereFailureDomainTopology{
+				Datacenter:     platform.DeprecatedDatacenter,
+				ComputeCluster: fmt.Sprintf("/%s/host/%s", platform.DeprecatedDatacenter, platform.DeprecatedCluster),
+				Datastore:      fmt.Sprintf("/%s/datastore/%s", platform.DeprecatedDatacenter, platform.DeprecatedDefaultDatastore),
+				Folder:         platform.DeprecatedFolder,
+			},
+		}
+		if platform.DeprecatedNetwork != "" {
+			failureDomain.Topology.Networks = []string{platform.DeprecatedNetwork}
+		}
+		failureDomains = []installcfg.VsphereFailureDomain{failureDomain}
+	}
+
+	configuredVCenters := make([]installcfg.VsphereVCenter, 0, len(vcenters))
+	for _, vcenter := range vcenters {
+		if vcenter.Server == "" || vcenter.Server == PhVcenter || vcenter.Username == PhUsername {
+			continue
+		}
+		configuredVCenters = append(configuredVCenters, vcenter)
+	}
+	return configuredVCenters, failureDomains
+}
+
+func login(ctx context.Context, vcenter installcfg.VsphereVCenter, insecure bool) (*govmomi.Client, error) {
+	host := vcenter.Server
+	if vcenter.Port != 0 {
+		host = net.JoinHostPort(vcenter.Server, strconv.Itoa(int(vcenter.Port)))
+	}
+	u := &url.URL{Scheme: "https", Host: host, Path: vim25.Path}
+	vimClient, err := vim25.NewClient(ctx, soap.NewClient(u, insecure))
+	if err != nil {
+		return nil, errors.Errorf("failed to connect to vCenter %s: %s", vcenter.Server, err)
+	}
+	client := &govmomi.Client{
+		Client:         vimClient,
+		SessionManager: session.NewManager(vimClient),
+	}
+	if err = client.Login(ctx, url.UserPassword(vcenter.Username, string(vcenter.Password))); err != nil {
+		return nil, errors.Errorf("failed to log in to vCenter %s as %s: %s", vcenter.Server, vcenter.Username, err)
+	}
+	return client, nil
+}
+
+func validateVCenter(ctx context.Context, client *vim25.Client, vcenter installcfg.VsphereVCenter, failureDomains []installcfg.VsphereFailureDomain) []string {
+	var failures []string
+	finder := find.NewFinder(client, false)
+	for _, datacenter := range vcenter.Datacenters {
+		if _, err := finder.Datacenter(ctx, datacenter); err != nil {
+			failures = append(failures, fmt.Sprintf("vCenter %s: datacenter %s not found", vcenter.Server, datacenter))
+		}
+	}
+
+	privileges := map[types.ManagedObjectReference][]string{}
+	names := map[types.ManagedObjectReference]string{}
+	for _, failureDomain := range failureDomains {
+		if failureDomain.Server != vcenter.Server {
+			continue
+		}
+		topology := failureDomain.Topology
+		datacenter, err := finder.Datacenter(ctx, topology.Datacenter)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("vCenter %s: datacenter %s of failure domain %s not found",
+				vcenter.Server, topology.Datacenter, failureDomain.Name))
+			continue
+		}
+		finder.SetDatacenter(datacenter)
+
+		objects := []topologyObject{
+			{"compute cluster", topology.ComputeCluster, func(ctx context.Context, p string) (object.Reference, error) {
+				return finder.ClusterComputeResource(ctx, p)
+			}, computeClusterPrivileges},
+			{"datastore", topology.Datastore, func(ctx context.Context, p string) (object.Reference, error) {
+				return finder.Datastore(ctx, p)
+			}, datastorePrivileges},
+			{"folder", topology.Folder, func(ctx context.Context, p string) (object.Reference, error) {
+				return finder.Folder(ctx, p)
+			}, folderPrivileges},
+			{"resource pool", topology.ResourcePool, func(ctx context.Context, p string) (object.Reference, error) {
+				return finder.ResourcePool(ctx, p)
+			}, resourcePoolPrivileges},
+		}
+		for _, network := range topology.Networks {
+			objects = append(objects, topologyObject{"network", network, func(ctx context.Context, p string) (object.Reference, error) {
+				return finder.Network(ctx, p)
+			}, networkPrivileges})
+		}
+
+		for _, o := range objects {
+			// The folder and the resource pool are optional
+			if o.path == "" {
+				continue
+			}
+			ref, err := o.find(ctx, o.path)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("vCenter %s: %s %s of failure domain %s not found",
+					vcenter.Server, o.kind, o.path, failureDomain.Name))
+				continue
+			}
+			privileges[ref.Reference()] = append(privileges[ref.Reference()], o.privileges...)
+			names[ref.Reference()] = fmt.Sprintf("%s %s", o.kind, path.Clean(o.path))
+		}
+	}
+	if len(privileges) == 0 {
+		return failures
+	}
+
+	entities := make([]types.ManagedObjectReference, 0, len(privileges))
+	for ref := range privileges {
+		entities = append(entities, ref)
+	}
+	userPrivileges, err := object.NewAuthorizationManager(client).FetchUserPrivilegeOnEntities(ctx, entities, vcenter.Username)
+	if err != nil {
+		return append(failures, fmt.Sprintf("vCenter %s: failed to get the privileges of user %s: %s", vcenter.Server, vcenter.Username, err))
+	}
+	for _, userPrivilege := range userPrivileges {
+		if missing := missingPrivileges(privileges[userPrivilege.Entity], userPrivilege.Privileges); len(missing) > 0 {
+			failures = append(failures, fmt.Sprintf("vCenter %s: user %s is missing privileges %s on %s",
+				vcenter.Server, vcenter.Username, strings.Join(missing, ", "), names[userPrivilege.Entity]))
+		}
+	}
+	return failures
+}
+
+// missingPrivileges returns the required privileges that aren't granted, without duplicates
+func missingPrivileges(required, granted []string) []string {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, privilege := range granted {
+		grantedSet[privilege] = true
+	}
+	var missing []string
+	for _, privilege := range required {
+		if !grantedSet[privilege] {
+			missing = append(missing, privilege)
+			grantedSet[privilege] = true
+		}
+	}
+	return missing
+}
+
+func validateHosts(ctx context.Context, clients []*govmomi.Client, hosts []*models.Host) []string {
+	var failures []string
+	for _, h := range hosts {
+		if h.Inventory == "" {
+			continue
+		}
+		inventory, err := common.UnmarshalInventory(h.Inventory)
+		if err != nil || inventory.SystemVendor == nil {
+			continue
+		}
+		uuid, ok := biosUUIDFromSerialNumber(inventory.SystemVendor.SerialNumber)
+		if !ok {
+			failures = append(failures, fmt.Sprintf("host %s isn't a vSphere virtual machine, its serial number is %q",
+				hostutil.GetHostnameForMsg(h), inventory.SystemVendor.SerialNumber))
+			continue
+		}
+		found := false
+		for _, client := range clients {
+			ref, err := object.NewSearchIndex(client.Client).FindByUuid(ctx, nil, uuid, true, nil)
+			if err == nil && ref != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			failures = append(failures, fmt.Sprintf("host %s with UUID %s isn't a virtual machine of the configured vCenters",
+				hostutil.GetHostnameForMsg(h), uuid))
+		}
+	}
+	return failures
+}
+
+// biosUUIDFromSerialNumber returns the BIOS UUID of a vSphere virtual machine from its serial number
+func biosUUIDFromSerialNumber(serialNumber string) (string, bool) {
+	matches := serialNumberRegex.FindStringSubmatch(strings.TrimSpace(serialNumber))
+	if matches == nil {
+		return "", false
+	}
+	hex := strings.ToLower(strings.ReplaceAll(matches[1]+matches[2], " ", ""))
+	return fmt.Sprintf("%s-%s-%s-%s-%s", hex[0:8], hex[8:12], hex[12:16], hex[16:20], hex[20:32]), true
+}
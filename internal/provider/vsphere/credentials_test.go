@@ -0,0 +1,217 @@
+package vsphere
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/installcfg"
+	"github.com/openshift/assisted-service/models"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+)
+
+// serialNumber returns the serial number of the virtual machine with the BIOS UUID, as seen by the host
+func serialNumber(biosUUID string) string {
+	hex := strings.ReplaceAll(biosUUID, "-", "")
+	bytes := make([]string, 0, 16)
+	for i := 0; i < len(hex); i += 2 {
+		bytes = append(bytes, hex[i:i+2])
+	}
+	return fmt.Sprintf("VMware-%s-%s", strings.Join(bytes[:8], " "), strings.Join(bytes[8:], " "))
+}
+
+var _ = Describe("ValidateCredentials", func() {
+	var (
+		ctx      = context.Background()
+		log      = common.GetTestLog()
+		model    *simulator.Model
+		server   *simulator.Server
+		platform *installcfg.VsphereInstallConfigPlatform
+		cluster  *common.Cluster
+	)
+
+	setOverrides := func() {
+		data, err := json.Marshal(map[string]interface{}{"platform": map[string]interface{}{"vsphere": platform}})
+		Expect(err).ToNot(HaveOccurred())
+		cluster.InstallConfigOverrides = string(data)
+	}
+
+	addHost := func(serial string) {
+		data, err := json.Marshal(&models.Inventory{
+			Hostname:     fmt.Sprintf("host-%d", len(cluster.Hosts)),
+			SystemVendor: &models.SystemVendor{Manufacturer: VmwareManufacturer, SerialNumber: serial},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		cluster.Hosts = append(cluster.Hosts, &models.Host{ID: &id, Inventory: string(data)})
+	}
+
+	BeforeEach(func() {
+		model = simulator.VPX()
+		Expect(model.Create()).To(Succeed())
+		model.Service.TLS = new(tls.Config)
+		model.Service.Listen = &url.URL{User: url.UserPassword("administrator@vsphere.local", "secret")}
+		server = model.Service.NewServer()
+
+		port, err := strconv.Atoi(server.URL.Port())
+		Expect(err).ToNot(HaveOccurred())
+		platform = &installcfg.VsphereInstallConfigPlatform{
+			VCenters: []installcfg.VsphereVCenter{{
+				Server:      server.URL.Hostname(),
+				Port:        int32(port),
+				Username:    "administrator@vsphere.local",
+				Password:    "secret",
+				Datacenters: []string{"DC0"},
+			}},
+			FailureDomains: []installcfg.VsphereFailureDomain{{
+				Name:   "fd-0",
+				Server: server.URL.Hostname(),
+				Topology: installcfg.VsphereFailureDomainTopology{
+					Datacenter:     "DC0",
+					ComputeCluster: "/DC0/host/DC0_C0",
+					Datastore:      "/DC0/datastore/LocalDS_0",
+					Folder:         "/DC0/vm",
+					Networks:       []string{"VM Network"},
+				},
+			}},
+		}
+		cluster = &common.Cluster{Cluster: models.Cluster{Platform: &models.Platform{Type: models.PlatformTypeVsphere.Pointer()}}}
+		setOverrides()
+	})
+
+	AfterEach(func() {
+		server.Close()
+		model.Remove()
+	})
+
+	It("validates the credentials and the topology", func() {
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeTrue())
+		Expect(result.Failures).To(BeEmpty())
+	})
+
+	It("validates the hosts", func() {
+		client, err := govmomi.NewClient(ctx, server.URL, true)
+		Expect(err).ToNot(HaveOccurred())
+		vm, err := find.NewFinder(client.Client).VirtualMachine(ctx, "/DC0/vm/DC0_C0_RP0_VM0")
+		Expect(err).ToNot(HaveOccurred())
+		addHost(serialNumber(vm.UUID(ctx)))
+		addHost(serialNumber("421a2b3c-4d5e-6f70-8192-a3b4c5d6e7f8"))
+		addHost("0123456789")
+
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(
+			"host host-1 with UUID 421a2b3c-4d5e-6f70-8192-a3b4c5d6e7f8 isn't a virtual machine of the configured vCenters",
+			`host host-2 isn't a vSphere virtual machine, its serial number is "0123456789"`,
+		))
+	})
+
+	It("fails with invalid credentials", func() {
+		platform.VCenters[0].Password = "invalid"
+		setOverrides()
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Failures).To(ConsistOf(ContainSubstring("failed to log in to vCenter %s as administrator@vsphere.local", server.URL.Hostname())))
+	})
+
+	It("fails with objects that don't exist", func() {
+		platform.VCenters[0].Datacenters = []string{"DC0", "DC1"}
+		platform.FailureDomains[0].Topology.Datastore = "/DC0/datastore/missing"
+		platform.FailureDomains[0].Topology.Networks = []string{"missing"}
+		platform.FailureDomains = append(platform.FailureDomains, installcfg.VsphereFailureDomain{
+			Name:     "fd-1",
+			Server:   server.URL.Hostname(),
+			Topology: installcfg.VsphereFailureDomainTopology{Datacenter: "DC1"},
+		})
+		setOverrides()
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		host := server.URL.Hostname()
+		Expect(result.Failures).To(ConsistOf(
+			fmt.Sprintf("vCenter %s: datacenter DC1 not found", host),
+			fmt.Sprintf("vCenter %s: datastore /DC0/datastore/missing of failure domain fd-0 not found", host),
+			fmt.Sprintf("vCenter %s: network missing of failure domain fd-0 not found", host),
+			fmt.Sprintf("vCenter %s: datacenter DC1 of failure domain fd-1 not found", host),
+		))
+	})
+
+	It("validates the deprecated fields", func() {
+		port, err := strconv.Atoi(server.URL.Port())
+		Expect(err).ToNot(HaveOccurred())
+		platform = &installcfg.VsphereInstallConfigPlatform{
+			DeprecatedVCenter:          server.URL.Hostname(),
+			DeprecatedUsername:         "administrator@vsphere.local",
+			DeprecatedPassword:         "secret",
+			DeprecatedDatacenter:       "DC0",
+			DeprecatedCluster:          "DC0_C0",
+			DeprecatedDefaultDatastore: "missing",
+			DeprecatedNetwork:          "VM Network",
+		}
+		setOverrides()
+		// The deprecated fields have no port, the vCenter of the simulator is on a random port
+		vcenters, failureDomains := configuredTopology(platform)
+		Expect(vcenters).To(HaveLen(1))
+		vcenters[0].Port = int32(port)
+		client, err := login(ctx, vcenters[0], true)
+		Expect(err).ToNot(HaveOccurred())
+		defer func() { _ = client.Logout(ctx) }()
+		Expect(validateVCenter(ctx, client.Client, vcenters[0], failureDomains)).To(ConsistOf(
+			fmt.Sprintf("vCenter %s: datastore /DC0/datastore/missing of failure domain generated-failure-domain not found", server.URL.Hostname()),
+		))
+	})
+
+	It("doesn't validate the placeholders", func() {
+		platform.VCenters[0].Server = PhVcenter
+		setOverrides()
+		result, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeFalse())
+
+		cluster.InstallConfigOverrides = ""
+		result, err = ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Configured).To(BeFalse())
+	})
+
+	It("fails with invalid install config overrides", func() {
+		cluster.InstallConfigOverrides = "{"
+		_, err := ValidateCredentials(ctx, log, cluster, true)
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the install config overrides")))
+	})
+})
+
+var _ = DescribeTable("BIOS UUID from serial number",
+	func(serial, expectedUUID string, expectedOk bool) {
+		biosUUID, ok := biosUUIDFromSerialNumber(serial)
+		Expect(ok).To(Equal(expectedOk))
+		Expect(biosUUID).To(Equal(expectedUUID))
+	},
+	Entry("virtual machine", "VMware-42 1a 2b 3c 4d 5e 6f 70-81 92 a3 b4 c5 d6 e7 f8", "421a2b3c-4d5e-6f70-8192-a3b4c5d6e7f8", true),
+	Entry("upper case", "VMware-42 1A 2B 3C 4D 5E 6F 70-81 92 A3 B4 C5 D6 E7 F8", "421a2b3c-4d5e-6f70-8192-a3b4c5d6e7f8", true),
+	Entry("not a virtual machine", "0123456789", "", false),
+	Entry("truncated", "VMware-42 1a 2b 3c-81 92 a3 b4", "", false),
+)
+
+var _ = Describe("missingPrivileges", func() {
+	It("returns the privileges that aren't granted", func() {
+		Expect(missingPrivileges(
+			[]string{"Datastore.Browse", "Network.Assign", "Datastore.Browse", "VApp.Import"},
+			[]string{"Network.Assign", "System.Read"},
+		)).To(Equal([]string{"Datastore.Browse", "VApp.Import"}))
+		Expect(missingPrivileges(networkPrivileges, []string{"Network.Assign"})).To(BeEmpty())
+	})
+})
@@ -274,6 +274,20 @@ func (mr *MockInstallerAPIMockRecorder) UpdateInfraEnv(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfraEnv", reflect.TypeOf((*MockInstallerAPI)(nil).UpdateInfraEnv), arg0, arg1)
 }
 
+// V2CancelClusterBatch mocks base method.
+func (m *MockInstallerAPI) V2CancelClusterBatch(arg0 context.Context, arg1 installer.V2CancelClusterBatchParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CancelClusterBatch", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CancelClusterBatch indicates an expected call of V2CancelClusterBatch.
+func (mr *MockInstallerAPIMockRecorder) V2CancelClusterBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CancelClusterBatch", reflect.TypeOf((*MockInstallerAPI)(nil).V2CancelClusterBatch), arg0, arg1)
+}
+
 // V2CancelInstallation mocks base method.
 func (m *MockInstallerAPI) V2CancelInstallation(arg0 context.Context, arg1 installer.V2CancelInstallationParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -302,6 +316,20 @@ func (mr *MockInstallerAPIMockRecorder) V2CompleteInstallation(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CompleteInstallation", reflect.TypeOf((*MockInstallerAPI)(nil).V2CompleteInstallation), arg0, arg1)
 }
 
+// V2CreateClusterBatch mocks base method.
+func (m *MockInstallerAPI) V2CreateClusterBatch(arg0 context.Context, arg1 installer.V2CreateClusterBatchParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2CreateClusterBatch", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2CreateClusterBatch indicates an expected call of V2CreateClusterBatch.
+func (mr *MockInstallerAPIMockRecorder) V2CreateClusterBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2CreateClusterBatch", reflect.TypeOf((*MockInstallerAPI)(nil).V2CreateClusterBatch), arg0, arg1)
+}
+
 // V2DeregisterCluster mocks base method.
 func (m *MockInstallerAPI) V2DeregisterCluster(arg0 context.Context, arg1 installer.V2DeregisterClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -372,6 +400,20 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadClusterLogs(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadClusterLogs", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadClusterLogs), arg0, arg1)
 }
 
+// V2DownloadClusterNetworkTopology mocks base method.
+func (m *MockInstallerAPI) V2DownloadClusterNetworkTopology(arg0 context.Context, arg1 installer.V2DownloadClusterNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DownloadClusterNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DownloadClusterNetworkTopology indicates an expected call of V2DownloadClusterNetworkTopology.
+func (mr *MockInstallerAPIMockRecorder) V2DownloadClusterNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadClusterNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadClusterNetworkTopology), arg0, arg1)
+}
+
 // V2DownloadHostIgnition mocks base method.
 func (m *MockInstallerAPI) V2DownloadHostIgnition(arg0 context.Context, arg1 installer.V2DownloadHostIgnitionParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -400,6 +442,34 @@ func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvFiles(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvFiles", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvFiles), arg0, arg1)
 }
 
+// V2DownloadInfraEnvNetworkTopology mocks base method.
+func (m *MockInstallerAPI) V2DownloadInfraEnvNetworkTopology(arg0 context.Context, arg1 installer.V2DownloadInfraEnvNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2DownloadInfraEnvNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2DownloadInfraEnvNetworkTopology indicates an expected call of V2DownloadInfraEnvNetworkTopology.
+func (mr *MockInstallerAPIMockRecorder) V2DownloadInfraEnvNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2DownloadInfraEnvNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).V2DownloadInfraEnvNetworkTopology), arg0, arg1)
+}
+
+// V2EraseHostDisks mocks base method.
+func (m *MockInstallerAPI) V2EraseHostDisks(arg0 context.Context, arg1 installer.V2EraseHostDisksParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2EraseHostDisks", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2EraseHostDisks indicates an expected call of V2EraseHostDisks.
+func (mr *MockInstallerAPIMockRecorder) V2EraseHostDisks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2EraseHostDisks", reflect.TypeOf((*MockInstallerAPI)(nil).V2EraseHostDisks), arg0, arg1)
+}
+
 // V2GetCluster mocks base method.
 func (m *MockInstallerAPI) V2GetCluster(arg0 context.Context, arg1 installer.V2GetClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -414,6 +484,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetCluster(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetCluster), arg0, arg1)
 }
 
+// V2GetClusterBatch mocks base method.
+func (m *MockInstallerAPI) V2GetClusterBatch(arg0 context.Context, arg1 installer.V2GetClusterBatchParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterBatch", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterBatch indicates an expected call of V2GetClusterBatch.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterBatch", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterBatch), arg0, arg1)
+}
+
 // V2GetClusterDefaultConfig mocks base method.
 func (m *MockInstallerAPI) V2GetClusterDefaultConfig(arg0 context.Context, arg1 installer.V2GetClusterDefaultConfigParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -442,6 +526,34 @@ func (mr *MockInstallerAPIMockRecorder) V2GetClusterInstallConfig(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterInstallConfig", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterInstallConfig), arg0, arg1)
 }
 
+// V2GetClusterNetworkTopology mocks base method.
+func (m *MockInstallerAPI) V2GetClusterNetworkTopology(arg0 context.Context, arg1 installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterNetworkTopology indicates an expected call of V2GetClusterNetworkTopology.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterNetworkTopology), arg0, arg1)
+}
+
+// V2GetClusterSecondaryNetworks mocks base method.
+func (m *MockInstallerAPI) V2GetClusterSecondaryNetworks(arg0 context.Context, arg1 installer.V2GetClusterSecondaryNetworksParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetClusterSecondaryNetworks", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetClusterSecondaryNetworks indicates an expected call of V2GetClusterSecondaryNetworks.
+func (mr *MockInstallerAPIMockRecorder) V2GetClusterSecondaryNetworks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetClusterSecondaryNetworks", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetClusterSecondaryNetworks), arg0, arg1)
+}
+
 // V2GetClusterUISettings mocks base method.
 func (m *MockInstallerAPI) V2GetClusterUISettings(arg0 context.Context, arg1 installer.V2GetClusterUISettingsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -512,6 +624,20 @@ func (mr *MockInstallerAPIMockRecorder) V2GetIgnoredValidations(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetIgnoredValidations", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetIgnoredValidations), arg0, arg1)
 }
 
+// V2GetInfraEnvNetworkTopology mocks base method.
+func (m *MockInstallerAPI) V2GetInfraEnvNetworkTopology(arg0 context.Context, arg1 installer.V2GetInfraEnvNetworkTopologyParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2GetInfraEnvNetworkTopology", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2GetInfraEnvNetworkTopology indicates an expected call of V2GetInfraEnvNetworkTopology.
+func (mr *MockInstallerAPIMockRecorder) V2GetInfraEnvNetworkTopology(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2GetInfraEnvNetworkTopology", reflect.TypeOf((*MockInstallerAPI)(nil).V2GetInfraEnvNetworkTopology), arg0, arg1)
+}
+
 // V2GetNextSteps mocks base method.
 func (m *MockInstallerAPI) V2GetNextSteps(arg0 context.Context, arg1 installer.V2GetNextStepsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -596,6 +722,20 @@ func (mr *MockInstallerAPIMockRecorder) V2InstallCluster(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallCluster", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallCluster), arg0, arg1)
 }
 
+// V2InstallClusterBatch mocks base method.
+func (m *MockInstallerAPI) V2InstallClusterBatch(arg0 context.Context, arg1 installer.V2InstallClusterBatchParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2InstallClusterBatch", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2InstallClusterBatch indicates an expected call of V2InstallClusterBatch.
+func (mr *MockInstallerAPIMockRecorder) V2InstallClusterBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallClusterBatch", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallClusterBatch), arg0, arg1)
+}
+
 // V2InstallHost mocks base method.
 func (m *MockInstallerAPI) V2InstallHost(arg0 context.Context, arg1 installer.V2InstallHostParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -610,6 +750,20 @@ func (mr *MockInstallerAPIMockRecorder) V2InstallHost(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2InstallHost", reflect.TypeOf((*MockInstallerAPI)(nil).V2InstallHost), arg0, arg1)
 }
 
+// V2ListClusterBatches mocks base method.
+func (m *MockInstallerAPI) V2ListClusterBatches(arg0 context.Context, arg1 installer.V2ListClusterBatchesParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListClusterBatches", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListClusterBatches indicates an expected call of V2ListClusterBatches.
+func (mr *MockInstallerAPIMockRecorder) V2ListClusterBatches(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusterBatches", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusterBatches), arg0, arg1)
+}
+
 // V2ListClusters mocks base method.
 func (m *MockInstallerAPI) V2ListClusters(arg0 context.Context, arg1 installer.V2ListClustersParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -624,6 +778,20 @@ func (mr *MockInstallerAPIMockRecorder) V2ListClusters(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListClusters", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListClusters), arg0, arg1)
 }
 
+// V2ListHostDiskErasureReports mocks base method.
+func (m *MockInstallerAPI) V2ListHostDiskErasureReports(arg0 context.Context, arg1 installer.V2ListHostDiskErasureReportsParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2ListHostDiskErasureReports", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2ListHostDiskErasureReports indicates an expected call of V2ListHostDiskErasureReports.
+func (mr *MockInstallerAPIMockRecorder) V2ListHostDiskErasureReports(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2ListHostDiskErasureReports", reflect.TypeOf((*MockInstallerAPI)(nil).V2ListHostDiskErasureReports), arg0, arg1)
+}
+
 // V2ListHosts mocks base method.
 func (m *MockInstallerAPI) V2ListHosts(arg0 context.Context, arg1 installer.V2ListHostsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -736,6 +904,20 @@ func (mr *MockInstallerAPIMockRecorder) V2SetIgnoredValidations(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2SetIgnoredValidations", reflect.TypeOf((*MockInstallerAPI)(nil).V2SetIgnoredValidations), arg0, arg1)
 }
 
+// V2SuggestNetworks mocks base method.
+func (m *MockInstallerAPI) V2SuggestNetworks(arg0 context.Context, arg1 installer.V2SuggestNetworksParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2SuggestNetworks", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2SuggestNetworks indicates an expected call of V2SuggestNetworks.
+func (mr *MockInstallerAPIMockRecorder) V2SuggestNetworks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2SuggestNetworks", reflect.TypeOf((*MockInstallerAPI)(nil).V2SuggestNetworks), arg0, arg1)
+}
+
 // V2UpdateCluster mocks base method.
 func (m *MockInstallerAPI) V2UpdateCluster(arg0 context.Context, arg1 installer.V2UpdateClusterParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -792,6 +974,20 @@ func (mr *MockInstallerAPIMockRecorder) V2UpdateClusterLogsProgress(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateClusterLogsProgress", reflect.TypeOf((*MockInstallerAPI)(nil).V2UpdateClusterLogsProgress), arg0, arg1)
 }
 
+// V2UpdateClusterSecondaryNetworks mocks base method.
+func (m *MockInstallerAPI) V2UpdateClusterSecondaryNetworks(arg0 context.Context, arg1 installer.V2UpdateClusterSecondaryNetworksParams) middleware.Responder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "V2UpdateClusterSecondaryNetworks", arg0, arg1)
+	ret0, _ := ret[0].(middleware.Responder)
+	return ret0
+}
+
+// V2UpdateClusterSecondaryNetworks indicates an expected call of V2UpdateClusterSecondaryNetworks.
+func (mr *MockInstallerAPIMockRecorder) V2UpdateClusterSecondaryNetworks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "V2UpdateClusterSecondaryNetworks", reflect.TypeOf((*MockInstallerAPI)(nil).V2UpdateClusterSecondaryNetworks), arg0, arg1)
+}
+
 // V2UpdateClusterUISettings mocks base method.
 func (m *MockInstallerAPI) V2UpdateClusterUISettings(arg0 context.Context, arg1 installer.V2UpdateClusterUISettingsParams) middleware.Responder {
 	m.ctrl.T.Helper()
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AvoidedNetwork A network that the suggested networks must not overlap.
+//
+// swagger:model avoided-network
+type AvoidedNetwork struct {
+
+	// cidr
+	Cidr Subnet `json:"cidr,omitempty" gorm:"primaryKey"`
+
+	// The cluster that uses the network, when the source is another cluster.
+	// Format: uuid
+	ClusterID strfmt.UUID `json:"cluster_id,omitempty"`
+
+	// Where the network is used, an address or a route of a host of the cluster, a reserved network of the service or a network of another cluster of the organization.
+	// Enum: [host-address host-route reserved cluster]
+	Source string `json:"source,omitempty"`
+}
+
+// Validate validates this avoided network
+func (m *AvoidedNetwork) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCidr(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AvoidedNetwork) validateCidr(formats strfmt.Registry) error {
+	if swag.IsZero(m.Cidr) { // not required
+		return nil
+	}
+
+	if err := m.Cidr.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("cidr")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("cidr")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *AvoidedNetwork) validateClusterID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_id", "body", "uuid", m.ClusterID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var avoidedNetworkTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["host-address","host-route","reserved","cluster"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		avoidedNetworkTypeSourcePropEnum = append(avoidedNetworkTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// AvoidedNetworkSourceHostAddress captures enum value "host-address"
+	AvoidedNetworkSourceHostAddress string = "host-address"
+
+	// AvoidedNetworkSourceHostRoute captures enum value "host-route"
+	AvoidedNetworkSourceHostRoute string = "host-route"
+
+	// AvoidedNetworkSourceReserved captures enum value "reserved"
+	AvoidedNetworkSourceReserved string = "reserved"
+
+	// AvoidedNetworkSourceCluster captures enum value "cluster"
+	AvoidedNetworkSourceCluster string = "cluster"
+)
+
+// prop value enum
+func (m *AvoidedNetwork) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, avoidedNetworkTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AvoidedNetwork) validateSource(formats strfmt.Registry) error {
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this avoided network based on the context it is used
+func (m *AvoidedNetwork) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCidr(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AvoidedNetwork) contextValidateCidr(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Cidr.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("cidr")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("cidr")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AvoidedNetwork) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AvoidedNetwork) UnmarshalBinary(b []byte) error {
+	var res AvoidedNetwork
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
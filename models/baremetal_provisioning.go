@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BaremetalProvisioning baremetal provisioning
+//
+// swagger:model baremetal-provisioning
+type BaremetalProvisioning struct {
+
+	// The IP address on the provisioning network of the provisioning services of the bootstrap host.
+	// Example: 172.22.0.2
+	BootstrapProvisioningIP string `json:"bootstrap_provisioning_ip,omitempty"`
+
+	// The IP address on the provisioning network of the provisioning services of the installed cluster.
+	// Example: 172.22.0.3
+	ClusterProvisioningIP string `json:"cluster_provisioning_ip,omitempty"`
+
+	// The range of the addresses that are leased by the DHCP server of the Managed provisioning network, as a comma-separated start and end addresses.
+	// Example: 172.22.0.10,172.22.0.254
+	ProvisioningDhcpRange string `json:"provisioning_dhcp_range,omitempty"`
+
+	// The mode of the provisioning network of the baremetal platform (Managed, Unmanaged or Disabled). An empty value removes the provisioning network configuration.
+	// Example: Managed
+	// Pattern: ^(Managed|Unmanaged|Disabled)?$
+	ProvisioningNetwork string `json:"provisioning_network,omitempty"`
+
+	// The CIDR of the provisioning network.
+	// Example: 172.22.0.0/24
+	ProvisioningNetworkCidr string `json:"provisioning_network_cidr,omitempty"`
+
+	// The name of the network interface of the control plane hosts that is connected to the provisioning network.
+	// Example: enp1s0
+	ProvisioningNetworkInterface string `json:"provisioning_network_interface,omitempty"`
+}
+
+// Validate validates this baremetal provisioning
+func (m *BaremetalProvisioning) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateProvisioningNetwork(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BaremetalProvisioning) validateProvisioningNetwork(formats strfmt.Registry) error {
+	if swag.IsZero(m.ProvisioningNetwork) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("provisioning_network", "body", m.ProvisioningNetwork, `^(Managed|Unmanaged|Disabled)?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this baremetal provisioning based on context it is used
+func (m *BaremetalProvisioning) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BaremetalProvisioning) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BaremetalProvisioning) UnmarshalBinary(b []byte) error {
+	var res BaremetalProvisioning
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Bundle bundle
+//
+// swagger:model bundle
+type Bundle struct {
+
+	// Longer human-readable description of the bundle.
+	Description string `json:"description,omitempty"`
+
+	// Unique identifier of the bundle.
+	// Required: true
+	// Enum: [virtualization openshift-ai]
+	ID *string `json:"id"`
+
+	// The operators of the bundle and their properties. The dependencies of the operators are installed with them.
+	// Required: true
+	Operators []*OperatorCreateParams `json:"operators"`
+
+	// Aggregated preflight hardware requirements of the operators of the bundle and of their dependencies.
+	Requirements *HostTypeHardwareRequirementsWrapper `json:"requirements,omitempty"`
+
+	// Short, human-readable name of the bundle.
+	// Required: true
+	Title *string `json:"title"`
+}
+
+// Validate validates this bundle
+func (m *Bundle) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRequirements(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var bundleTypeIDPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["virtualization","openshift-ai"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bundleTypeIDPropEnum = append(bundleTypeIDPropEnum, v)
+	}
+}
+
+const (
+
+	// BundleIDVirtualization captures enum value "virtualization"
+	BundleIDVirtualization string = "virtualization"
+
+	// BundleIDOpenshiftAi captures enum value "openshift-ai"
+	BundleIDOpenshiftAi string = "openshift-ai"
+)
+
+// prop value enum
+func (m *Bundle) validateIDEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bundleTypeIDPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Bundle) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateIDEnum("id", "body", *m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Bundle) validateOperators(formats strfmt.Registry) error {
+
+	if err := validate.Required("operators", "body", m.Operators); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Operators); i++ {
+		if swag.IsZero(m.Operators[i]) { // not required
+			continue
+		}
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Bundle) validateRequirements(formats strfmt.Registry) error {
+	if swag.IsZero(m.Requirements) { // not required
+		return nil
+	}
+
+	if m.Requirements != nil {
+		if err := m.Requirements.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("requirements")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("requirements")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Bundle) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this bundle based on the context it is used
+func (m *Bundle) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateOperators(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRequirements(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Bundle) contextValidateOperators(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Operators); i++ {
+
+		if m.Operators[i] != nil {
+			if err := m.Operators[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("operators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("operators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Bundle) contextValidateRequirements(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Requirements != nil {
+		if err := m.Requirements.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("requirements")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("requirements")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Bundle) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Bundle) UnmarshalBinary(b []byte) error {
+	var res Bundle
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -36,9 +36,16 @@ type Cluster struct {
 	// The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	APIVips []*APIVip `json:"api_vips" gorm:"foreignkey:ClusterID;references:ID"`
 
+	// The provisioning network of the baremetal platform.
+	BaremetalProvisioning *BaremetalProvisioning `json:"baremetal_provisioning,omitempty" gorm:"embedded;embeddedPrefix:baremetal_provisioning_"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
+	// The cluster batch that the cluster was created in, if any.
+	// Format: uuid
+	ClusterBatchID strfmt.UUID `json:"cluster_batch_id,omitempty"`
+
 	// IP address block from which Pod IPs are allocated. This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
@@ -76,6 +83,9 @@ type Cluster struct {
 	// Information regarding hosts' installation disks encryption.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// The DNS records that the service creates for the external load balancer of a cluster with user managed networking.
+	DNSRecordsProvisioning *DNSRecordsProvisioning `json:"dns_records_provisioning,omitempty" gorm:"embedded;embeddedPrefix:dns_records_provisioning_"`
+
 	// email domain
 	EmailDomain string `json:"email_domain,omitempty"`
 
@@ -161,6 +171,9 @@ type Cluster struct {
 	// Json formatted string containing ip collisions detected in the cluster.
 	IPCollisions string `json:"ip_collisions,omitempty" gorm:"type:text"`
 
+	// The pool of the IP address management system from which the VIPs of the cluster were reserved.
+	IpamPool string `json:"ipam_pool,omitempty"`
+
 	// Indicates the type of this object. Will be 'Cluster' if this is a complete object,
 	// 'AddHostsCluster' for cluster that add hosts to existing OCP cluster,
 	//
@@ -181,6 +194,9 @@ type Cluster struct {
 	// Machine networks that are associated with this cluster.
 	MachineNetworks []*MachineNetwork `json:"machine_networks" gorm:"foreignkey:ClusterID;references:ID"`
 
+	// Recurring time window in which the installation of the cluster is allowed to start.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty" gorm:"embedded;embeddedPrefix:maintenance_window_"`
+
 	// Operators that are associated with this cluster.
 	MonitoredOperators []*MonitoredOperator `json:"monitored_operators" gorm:"foreignkey:ClusterID;references:ID"`
 
@@ -204,6 +220,9 @@ type Cluster struct {
 	// Version of the OpenShift cluster.
 	OpenshiftVersion string `json:"openshift_version,omitempty"`
 
+	// True if the clouds.yaml with the credentials of the OpenStack cloud has been added to the cluster.
+	OpenstackCloudsYamlSet bool `json:"openstack_clouds_yaml_set,omitempty"`
+
 	// org id
 	OrgID string `json:"org_id,omitempty"`
 
@@ -230,6 +249,13 @@ type Cluster struct {
 	//
 	SchedulableMastersForcedTrue *bool `json:"schedulable_masters_forced_true,omitempty"`
 
+	// The earliest time at which the installation of the cluster will be started automatically once the cluster is ready.
+	// Format: date-time
+	ScheduledInstallTime *strfmt.DateTime `json:"scheduled_install_time,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Json formatted string containing the secondary network policies of the cluster.
+	SecondaryNetworks string `json:"secondary_networks,omitempty" gorm:"type:text"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
@@ -288,6 +314,14 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBaremetalProvisioning(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterBatchID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -320,6 +354,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDNSRecordsProvisioning(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHighAvailabilityMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -384,6 +422,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaintenanceWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMonitoredOperators(formats); err != nil {
 		res = append(res, err)
 	}
@@ -404,6 +446,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateScheduledInstallTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -472,6 +518,37 @@ func (m *Cluster) validateAPIVips(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateBaremetalProvisioning(formats strfmt.Registry) error {
+	if swag.IsZero(m.BaremetalProvisioning) { // not required
+		return nil
+	}
+
+	if m.BaremetalProvisioning != nil {
+		if err := m.BaremetalProvisioning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("baremetal_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("baremetal_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateClusterBatchID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterBatchID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("cluster_batch_id", "body", "uuid", m.ClusterBatchID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateClusterNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
 		return nil
@@ -635,6 +712,25 @@ func (m *Cluster) validateDiskEncryption(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateDNSRecordsProvisioning(formats strfmt.Registry) error {
+	if swag.IsZero(m.DNSRecordsProvisioning) { // not required
+		return nil
+	}
+
+	if m.DNSRecordsProvisioning != nil {
+		if err := m.DNSRecordsProvisioning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns_records_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dns_records_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var clusterTypeHighAvailabilityModePropEnum []interface{}
 
 func init() {
@@ -1003,6 +1099,25 @@ func (m *Cluster) validateMachineNetworks(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateMaintenanceWindow(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaintenanceWindow) { // not required
+		return nil
+	}
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance_window")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance_window")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateMonitoredOperators(formats strfmt.Registry) error {
 	if swag.IsZero(m.MonitoredOperators) { // not required
 		return nil
@@ -1121,6 +1236,18 @@ func (m *Cluster) validateProgress(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateScheduledInstallTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScheduledInstallTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scheduled_install_time", "body", "date-time", m.ScheduledInstallTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -1270,6 +1397,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateBaremetalProvisioning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateClusterNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1278,6 +1409,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDNSRecordsProvisioning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateHostNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1310,6 +1445,10 @@ func (m *Cluster) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMaintenanceWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMonitoredOperators(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1352,6 +1491,22 @@ func (m *Cluster) contextValidateAPIVips(ctx context.Context, formats strfmt.Reg
 	return nil
 }
 
+func (m *Cluster) contextValidateBaremetalProvisioning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BaremetalProvisioning != nil {
+		if err := m.BaremetalProvisioning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("baremetal_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("baremetal_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidateClusterNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.ClusterNetworks); i++ {
@@ -1388,6 +1543,22 @@ func (m *Cluster) contextValidateDiskEncryption(ctx context.Context, formats str
 	return nil
 }
 
+func (m *Cluster) contextValidateDNSRecordsProvisioning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DNSRecordsProvisioning != nil {
+		if err := m.DNSRecordsProvisioning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns_records_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dns_records_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidateHostNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.HostNetworks); i++ {
@@ -1528,6 +1699,22 @@ func (m *Cluster) contextValidateMachineNetworks(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Cluster) contextValidateMaintenanceWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance_window")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance_window")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cluster) contextValidateMonitoredOperators(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.MonitoredOperators); i++ {
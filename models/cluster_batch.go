@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterBatch cluster batch
+//
+// swagger:model cluster-batch
+type ClusterBatch struct {
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:timestamp with time zone"`
+
+	// Self link.
+	Href string `json:"href,omitempty"`
+
+	// Unique identifier of the object.
+	// Required: true
+	// Format: uuid
+	ID *strfmt.UUID `json:"id" gorm:"primaryKey"`
+
+	// The clusters of the batch.
+	Members []*ClusterBatchMember `json:"members" gorm:"-"`
+
+	// Name of the cluster batch.
+	// Required: true
+	Name *string `json:"name"`
+
+	// org id
+	OrgID string `json:"org_id,omitempty"`
+
+	// Aggregated status of the clusters of the batch. 'installing' while any cluster is being installed,
+	// 'error' or 'cancelled' once any cluster failed or was cancelled, 'installed' once all the clusters
+	// are installed, and 'pending' otherwise.
+	//
+	// Enum: [pending installing installed error cancelled]
+	Status string `json:"status,omitempty" gorm:"-"`
+
+	// Number of clusters of the batch in each cluster status.
+	StatusCounts map[string]int64 `json:"status_counts,omitempty" gorm:"-"`
+
+	// user name
+	UserName string `json:"user_name,omitempty"`
+}
+
+// Validate validates this cluster batch
+func (m *ClusterBatch) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMembers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterBatch) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterBatch) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterBatch) validateMembers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Members) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Members); i++ {
+		if swag.IsZero(m.Members[i]) { // not required
+			continue
+		}
+
+		if m.Members[i] != nil {
+			if err := m.Members[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("members" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ClusterBatch) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var clusterBatchTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","installing","installed","error","cancelled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		clusterBatchTypeStatusPropEnum = append(clusterBatchTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ClusterBatchStatusPending captures enum value "pending"
+	ClusterBatchStatusPending string = "pending"
+
+	// ClusterBatchStatusInstalling captures enum value "installing"
+	ClusterBatchStatusInstalling string = "installing"
+
+	// ClusterBatchStatusInstalled captures enum value "installed"
+	ClusterBatchStatusInstalled string = "installed"
+
+	// ClusterBatchStatusError captures enum value "error"
+	ClusterBatchStatusError string = "error"
+
+	// ClusterBatchStatusCancelled captures enum value "cancelled"
+	ClusterBatchStatusCancelled string = "cancelled"
+)
+
+// prop value enum
+func (m *ClusterBatch) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, clusterBatchTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ClusterBatch) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster batch based on the context it is used
+func (m *ClusterBatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMembers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterBatch) contextValidateMembers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Members); i++ {
+
+		if m.Members[i] != nil {
+			if err := m.Members[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("members" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterBatch) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterBatch) UnmarshalBinary(b []byte) error {
+	var res ClusterBatch
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
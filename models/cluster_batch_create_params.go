@@ -0,0 +1,245 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ClusterBatchCreateParams cluster batch create params
+//
+// swagger:model cluster-batch-create-params
+type ClusterBatchCreateParams struct {
+
+	// The properties shared by all the clusters of the batch. The name of each cluster is taken from its site.
+	//
+	// Required: true
+	ClusterTemplate *ClusterCreateParams `json:"cluster_template"`
+
+	// The properties shared by all the infra-envs of the batch. The name of each infra-env is taken from its site.
+	// When omitted, the pull secret, SSH key, proxy, OpenShift version and CPU architecture of the cluster template are used.
+	//
+	InfraEnvTemplate *InfraEnvCreateParams `json:"infra_env_template,omitempty"`
+
+	// Name of the cluster batch.
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// The sites of the batch, a cluster and an infra-env are created for each site.
+	// Required: true
+	// Min Items: 1
+	Sites []*ClusterBatchSite `json:"sites"`
+}
+
+// Validate validates this cluster batch create params
+func (m *ClusterBatchCreateParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClusterTemplate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInfraEnvTemplate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSites(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) validateClusterTemplate(formats strfmt.Registry) error {
+
+	if err := validate.Required("cluster_template", "body", m.ClusterTemplate); err != nil {
+		return err
+	}
+
+	if m.ClusterTemplate != nil {
+		if err := m.ClusterTemplate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) validateInfraEnvTemplate(formats strfmt.Registry) error {
+	if swag.IsZero(m.InfraEnvTemplate) { // not required
+		return nil
+	}
+
+	if m.InfraEnvTemplate != nil {
+		if err := m.InfraEnvTemplate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("infra_env_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("infra_env_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) validateSites(formats strfmt.Registry) error {
+
+	if err := validate.Required("sites", "body", m.Sites); err != nil {
+		return err
+	}
+
+	iSitesSize := int64(len(m.Sites))
+
+	if err := validate.MinItems("sites", "body", iSitesSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Sites); i++ {
+		if swag.IsZero(m.Sites[i]) { // not required
+			continue
+		}
+
+		if m.Sites[i] != nil {
+			if err := m.Sites[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sites" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sites" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cluster batch create params based on the context it is used
+func (m *ClusterBatchCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClusterTemplate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateInfraEnvTemplate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSites(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) contextValidateClusterTemplate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ClusterTemplate != nil {
+		if err := m.ClusterTemplate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cluster_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) contextValidateInfraEnvTemplate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.InfraEnvTemplate != nil {
+		if err := m.InfraEnvTemplate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("infra_env_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("infra_env_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ClusterBatchCreateParams) contextValidateSites(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Sites); i++ {
+
+		if m.Sites[i] != nil {
+			if err := m.Sites[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sites" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sites" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ClusterBatchCreateParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ClusterBatchCreateParams) UnmarshalBinary(b []byte) error {
+	var res ClusterBatchCreateParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -27,6 +27,9 @@ type ClusterCreateParams struct {
 	// The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	APIVips []*APIVip `json:"api_vips"`
 
+	// The provisioning network of the baremetal platform.
+	BaremetalProvisioning *BaremetalProvisioning `json:"baremetal_provisioning,omitempty" gorm:"embedded;embeddedPrefix:baremetal_provisioning_"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
@@ -52,6 +55,9 @@ type ClusterCreateParams struct {
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// The DNS records that the service creates for the external load balancer of a cluster with user managed networking.
+	DNSRecordsProvisioning *DNSRecordsProvisioning `json:"dns_records_provisioning,omitempty" gorm:"embedded;embeddedPrefix:dns_records_provisioning_"`
+
 	// Guaranteed availability of the installed cluster. 'Full' installs a Highly-Available cluster
 	// over multiple master nodes whereas 'None' installs a full cluster over one node.
 	//
@@ -78,9 +84,15 @@ type ClusterCreateParams struct {
 	// The virtual IPs used for cluster ingress traffic. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	IngressVips []*IngressVip `json:"ingress_vips"`
 
+	// The pool of the IP address management system from which the API and ingress VIPs of the cluster are reserved, when they aren't set. The VIPs are released when the cluster is deregistered.
+	IpamPool string `json:"ipam_pool,omitempty"`
+
 	// Machine networks that are associated with this cluster.
 	MachineNetworks []*MachineNetwork `json:"machine_networks"`
 
+	// Recurring time window in which the installation of the cluster is allowed to start.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty" gorm:"embedded;embeddedPrefix:maintenance_window_"`
+
 	// Name of the OpenShift cluster.
 	// Required: true
 	// Max Length: 54
@@ -97,6 +109,9 @@ type ClusterCreateParams struct {
 	// OpenShift release image URI.
 	OcpReleaseImage string `json:"ocp_release_image,omitempty"`
 
+	// List of bundles of OLM operators to be installed, the operators of the bundles are added to the OLM operators.
+	OlmBundles []string `json:"olm_bundles"`
+
 	// List of OLM operators to be installed.
 	OlmOperators []*OperatorCreateParams `json:"olm_operators"`
 
@@ -104,6 +119,9 @@ type ClusterCreateParams struct {
 	// Required: true
 	OpenshiftVersion *string `json:"openshift_version"`
 
+	// The clouds.yaml with the credentials of the OpenStack cloud of the cluster, used to validate the credentials and to generate the manifests of the openstack platform. An empty value removes the credentials.
+	OpenstackCloudsYaml *string `json:"openstack_clouds_yaml,omitempty"`
+
 	// platform
 	Platform *Platform `json:"platform,omitempty" gorm:"embedded;embeddedPrefix:platform_"`
 
@@ -114,6 +132,10 @@ type ClusterCreateParams struct {
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
+	// The earliest time at which the installation of the cluster will be started automatically once the cluster is ready.
+	// Format: date-time
+	ScheduledInstallTime *strfmt.DateTime `json:"scheduled_install_time,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
@@ -142,6 +164,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBaremetalProvisioning(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -162,6 +188,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDNSRecordsProvisioning(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHighAvailabilityMode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +212,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaintenanceWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -206,6 +240,10 @@ func (m *ClusterCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateScheduledInstallTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -246,6 +284,25 @@ func (m *ClusterCreateParams) validateAPIVips(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ClusterCreateParams) validateBaremetalProvisioning(formats strfmt.Registry) error {
+	if swag.IsZero(m.BaremetalProvisioning) { // not required
+		return nil
+	}
+
+	if m.BaremetalProvisioning != nil {
+		if err := m.BaremetalProvisioning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("baremetal_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("baremetal_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
 		return nil
@@ -373,6 +430,25 @@ func (m *ClusterCreateParams) validateDiskEncryption(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *ClusterCreateParams) validateDNSRecordsProvisioning(formats strfmt.Registry) error {
+	if swag.IsZero(m.DNSRecordsProvisioning) { // not required
+		return nil
+	}
+
+	if m.DNSRecordsProvisioning != nil {
+		if err := m.DNSRecordsProvisioning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns_records_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dns_records_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var clusterCreateParamsTypeHighAvailabilityModePropEnum []interface{}
 
 func init() {
@@ -534,6 +610,25 @@ func (m *ClusterCreateParams) validateMachineNetworks(formats strfmt.Registry) e
 	return nil
 }
 
+func (m *ClusterCreateParams) validateMaintenanceWindow(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaintenanceWindow) { // not required
+		return nil
+	}
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance_window")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance_window")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -656,6 +751,18 @@ func (m *ClusterCreateParams) validatePullSecret(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *ClusterCreateParams) validateScheduledInstallTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScheduledInstallTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scheduled_install_time", "body", "date-time", m.ScheduledInstallTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -702,6 +809,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateBaremetalProvisioning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateClusterNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -710,6 +821,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDNSRecordsProvisioning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIgnitionEndpoint(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -722,6 +837,10 @@ func (m *ClusterCreateParams) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMaintenanceWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOlmOperators(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -760,6 +879,22 @@ func (m *ClusterCreateParams) contextValidateAPIVips(ctx context.Context, format
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateBaremetalProvisioning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BaremetalProvisioning != nil {
+		if err := m.BaremetalProvisioning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("baremetal_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("baremetal_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateClusterNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.ClusterNetworks); i++ {
@@ -796,6 +931,22 @@ func (m *ClusterCreateParams) contextValidateDiskEncryption(ctx context.Context,
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateDNSRecordsProvisioning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DNSRecordsProvisioning != nil {
+		if err := m.DNSRecordsProvisioning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns_records_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dns_records_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateIgnitionEndpoint(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.IgnitionEndpoint != nil {
@@ -852,6 +1003,22 @@ func (m *ClusterCreateParams) contextValidateMachineNetworks(ctx context.Context
 	return nil
 }
 
+func (m *ClusterCreateParams) contextValidateMaintenanceWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance_window")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance_window")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ClusterCreateParams) contextValidateOlmOperators(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.OlmOperators); i++ {
@@ -122,6 +122,18 @@ const (
 
 	// ClusterValidationIDOpenshiftAiRequirementsSatisfied captures enum value "openshift-ai-requirements-satisfied"
 	ClusterValidationIDOpenshiftAiRequirementsSatisfied ClusterValidationID = "openshift-ai-requirements-satisfied"
+
+	// ClusterValidationIDCustomOperatorsRequirementsSatisfied captures enum value "custom-operators-requirements-satisfied"
+	ClusterValidationIDCustomOperatorsRequirementsSatisfied ClusterValidationID = "custom-operators-requirements-satisfied"
+
+	// ClusterValidationIDPlatformCredentialsValid captures enum value "platform-credentials-valid"
+	ClusterValidationIDPlatformCredentialsValid ClusterValidationID = "platform-credentials-valid"
+
+	// ClusterValidationIDProvisioningNetworkValid captures enum value "provisioning-network-valid"
+	ClusterValidationIDProvisioningNetworkValid ClusterValidationID = "provisioning-network-valid"
+
+	// ClusterValidationIDMtuConsistent captures enum value "mtu-consistent"
+	ClusterValidationIDMtuConsistent ClusterValidationID = "mtu-consistent"
 )
 
 // for schema
@@ -129,7 +141,7 @@ var clusterValidationIdEnum []interface{}
 
 func init() {
 	var res []ClusterValidationID
-	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","networks-same-address-families","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vips-defined","api-vips-valid","ingress-vips-defined","ingress-vips-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","cnv-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","network-type-valid","platform-requirements-satisfied","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["machine-cidr-defined","cluster-cidr-defined","service-cidr-defined","no-cidrs-overlapping","networks-same-address-families","network-prefix-valid","machine-cidr-equals-to-calculated-cidr","api-vips-defined","api-vips-valid","ingress-vips-defined","ingress-vips-valid","all-hosts-are-ready-to-install","sufficient-masters-count","dns-domain-defined","pull-secret-set","ntp-server-configured","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","cnv-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","network-type-valid","platform-requirements-satisfied","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied","custom-operators-requirements-satisfied","platform-credentials-valid","provisioning-network-valid","mtu-consistent"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -27,6 +27,9 @@ type ConnectivityCheckNic struct {
 	// Format: mac
 	Mac strfmt.MAC `json:"mac,omitempty"`
 
+	// The MTU of the NIC. The agent sends packets of this size, capped by the MTU of the outgoing NIC, with the don't fragment bit set to verify the MTU path to the NIC.
+	Mtu int64 `json:"mtu,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 }
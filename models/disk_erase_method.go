@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// DiskEraseMethod The method used to erase a disk. The agent uses the first method in this list that is
+// supported by the disk, and falls back to overwriting the disk otherwise.
+//
+// swagger:model disk_erase_method
+type DiskEraseMethod string
+
+func NewDiskEraseMethod(value DiskEraseMethod) *DiskEraseMethod {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated DiskEraseMethod.
+func (m DiskEraseMethod) Pointer() *DiskEraseMethod {
+	return &m
+}
+
+const (
+
+	// DiskEraseMethodNvmeSanitize captures enum value "nvme-sanitize"
+	DiskEraseMethodNvmeSanitize DiskEraseMethod = "nvme-sanitize"
+
+	// DiskEraseMethodNvmeFormat captures enum value "nvme-format"
+	DiskEraseMethodNvmeFormat DiskEraseMethod = "nvme-format"
+
+	// DiskEraseMethodAtaSecureErase captures enum value "ata-secure-erase"
+	DiskEraseMethodAtaSecureErase DiskEraseMethod = "ata-secure-erase"
+
+	// DiskEraseMethodBlkdiscard captures enum value "blkdiscard"
+	DiskEraseMethodBlkdiscard DiskEraseMethod = "blkdiscard"
+
+	// DiskEraseMethodOverwrite captures enum value "overwrite"
+	DiskEraseMethodOverwrite DiskEraseMethod = "overwrite"
+)
+
+// for schema
+var diskEraseMethodEnum []interface{}
+
+func init() {
+	var res []DiskEraseMethod
+	if err := json.Unmarshal([]byte(`["nvme-sanitize","nvme-format","ata-secure-erase","blkdiscard","overwrite"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		diskEraseMethodEnum = append(diskEraseMethodEnum, v)
+	}
+}
+
+func (m DiskEraseMethod) validateDiskEraseMethodEnum(path, location string, value DiskEraseMethod) error {
+	if err := validate.EnumCase(path, location, value, diskEraseMethodEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this disk erase method
+func (m DiskEraseMethod) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateDiskEraseMethodEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this disk erase method based on context it is used
+func (m DiskEraseMethod) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DiskEraseParams disk erase params
+//
+// swagger:model disk-erase-params
+type DiskEraseParams struct {
+
+	// Identifiers of the host disks to erase, as reported in the host inventory.
+	// Required: true
+	// Min Items: 1
+	DiskIds []string `json:"disk_ids"`
+}
+
+// Validate validates this disk erase params
+func (m *DiskEraseParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDiskIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DiskEraseParams) validateDiskIds(formats strfmt.Registry) error {
+
+	if err := validate.Required("disk_ids", "body", m.DiskIds); err != nil {
+		return err
+	}
+
+	iDiskIdsSize := int64(len(m.DiskIds))
+
+	if err := validate.MinItems("disk_ids", "body", iDiskIdsSize, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this disk erase params based on context it is used
+func (m *DiskEraseParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DiskEraseParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DiskEraseParams) UnmarshalBinary(b []byte) error {
+	var res DiskEraseParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
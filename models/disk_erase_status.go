@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// DiskEraseStatus disk erase status
+//
+// swagger:model disk_erase_status
+type DiskEraseStatus string
+
+func NewDiskEraseStatus(value DiskEraseStatus) *DiskEraseStatus {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated DiskEraseStatus.
+func (m DiskEraseStatus) Pointer() *DiskEraseStatus {
+	return &m
+}
+
+const (
+
+	// DiskEraseStatusSucceeded captures enum value "succeeded"
+	DiskEraseStatusSucceeded DiskEraseStatus = "succeeded"
+
+	// DiskEraseStatusFailed captures enum value "failed"
+	DiskEraseStatusFailed DiskEraseStatus = "failed"
+)
+
+// for schema
+var diskEraseStatusEnum []interface{}
+
+func init() {
+	var res []DiskEraseStatus
+	if err := json.Unmarshal([]byte(`["succeeded","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		diskEraseStatusEnum = append(diskEraseStatusEnum, v)
+	}
+}
+
+func (m DiskEraseStatus) validateDiskEraseStatusEnum(path, location string, value DiskEraseStatus) error {
+	if err := validate.EnumCase(path, location, value, diskEraseStatusEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this disk erase status
+func (m DiskEraseStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateDiskEraseStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this disk erase status based on context it is used
+func (m DiskEraseStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
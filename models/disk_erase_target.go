@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// DiskEraseTarget disk erase target
+//
+// swagger:model disk_erase_target
+type DiskEraseTarget struct {
+
+	// The identifier of the disk, as reported in the host inventory.
+	ID string `json:"id,omitempty"`
+
+	// The device path of the disk.
+	Path string `json:"path,omitempty"`
+}
+
+// Validate validates this disk erase target
+func (m *DiskEraseTarget) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this disk erase target based on context it is used
+func (m *DiskEraseTarget) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DiskEraseTarget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DiskEraseTarget) UnmarshalBinary(b []byte) error {
+	var res DiskEraseTarget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
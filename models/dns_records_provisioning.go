@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// DNSRecordsProvisioning dns records provisioning
+//
+// swagger:model dns-records-provisioning
+type DNSRecordsProvisioning struct {
+
+	// The IP address of the external load balancer of the API, which the api and api-int records point at.
+	// Example: 192.168.126.5
+	APIIP string `json:"api_ip,omitempty"`
+
+	// Create the api, api-int and *.apps DNS records of the cluster when they are missing, in the DNS provider that the service is configured with for the base domain of the cluster, and delete them when the cluster is deregistered. Only multi node clusters with user managed networking are supported.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// The IP address of the external load balancer of the ingress, which the *.apps record points at.
+	// Example: 192.168.126.6
+	IngressIP string `json:"ingress_ip,omitempty"`
+}
+
+// Validate validates this dns records provisioning
+func (m *DNSRecordsProvisioning) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this dns records provisioning based on context it is used
+func (m *DNSRecordsProvisioning) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DNSRecordsProvisioning) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DNSRecordsProvisioning) UnmarshalBinary(b []byte) error {
+	var res DNSRecordsProvisioning
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
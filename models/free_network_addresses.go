@@ -23,8 +23,11 @@ type FreeNetworkAddresses struct {
 	// free addresses
 	FreeAddresses []strfmt.IPv4 `json:"free_addresses"`
 
+	// The IPv6 addresses of the network that no host answered the NDP neighbor solicitations for.
+	FreeIPV6Addresses []strfmt.IPv6 `json:"free_ipv6_addresses"`
+
 	// network
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	Network string `json:"network,omitempty"`
 }
 
@@ -36,6 +39,10 @@ func (m *FreeNetworkAddresses) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFreeIPV6Addresses(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNetwork(formats); err != nil {
 		res = append(res, err)
 	}
@@ -62,12 +69,28 @@ func (m *FreeNetworkAddresses) validateFreeAddresses(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *FreeNetworkAddresses) validateFreeIPV6Addresses(formats strfmt.Registry) error {
+	if swag.IsZero(m.FreeIPV6Addresses) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.FreeIPV6Addresses); i++ {
+
+		if err := validate.FormatOf("free_ipv6_addresses"+"."+strconv.Itoa(i), "body", "ipv6", m.FreeIPV6Addresses[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *FreeNetworkAddresses) validateNetwork(formats strfmt.Registry) error {
 	if swag.IsZero(m.Network) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("network", "body", m.Network, `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("network", "body", m.Network, `^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$`); err != nil {
 		return err
 	}
 
@@ -26,6 +26,12 @@ type Host struct {
 	// Contains a serialized api_vip_connectivity_response
 	APIVipConnectivity string `json:"api_vip_connectivity,omitempty" gorm:"type:text"`
 
+	// The baseboard management controller of the host, added to the hosts of the baremetal platform of the install config.
+	Bmc *HostBmc `json:"bmc,omitempty" gorm:"embedded;embeddedPrefix:bmc_"`
+
+	// True if the password of the baseboard management controller of the host is set.
+	BmcPasswordSet bool `json:"bmc_password_set,omitempty"`
+
 	// bootstrap
 	Bootstrap bool `json:"bootstrap,omitempty"`
 
@@ -63,6 +69,11 @@ type Host struct {
 	// service and cannot be modified by the user.
 	DisksToBeFormatted string `json:"disks_to_be_formatted,omitempty" gorm:"type:text"`
 
+	// A comma-separated list of identifiers of host disks that are pending a
+	// secure erasure. This property is managed by the service and cannot be
+	// modified by the user.
+	DisksToErase string `json:"disks_to_erase,omitempty" gorm:"type:text"`
+
 	// The domain name resolution result.
 	DomainNameResolutions string `json:"domain_name_resolutions,omitempty" gorm:"type:text"`
 
@@ -115,6 +126,9 @@ type Host struct {
 	// Enum: [Host AddToExistingClusterHost]
 	Kind *string `json:"kind"`
 
+	// Json formatted string containing the result of probing the endpoints of the external load balancer of the cluster.
+	LoadBalancerConnectivity string `json:"load_balancer_connectivity,omitempty" gorm:"type:text"`
+
 	// logs collected at
 	// Format: date-time
 	LogsCollectedAt strfmt.DateTime `json:"logs_collected_at,omitempty" gorm:"type:timestamp with time zone"`
@@ -198,12 +212,20 @@ type Host struct {
 
 	// JSON-formatted string containing the validation results for each validation id grouped by category (network, hardware, etc.)
 	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
+
+	// True if the disks of the host are wiped when the host is reclaimed
+	// back into discovery after the cluster is decommissioned.
+	WipeDisksOnReclaim bool `json:"wipe_disks_on_reclaim,omitempty"`
 }
 
 // Validate validates this host
 func (m *Host) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBmc(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCheckedInAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -298,6 +320,25 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateBmc(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bmc) { // not required
+		return nil
+	}
+
+	if m.Bmc != nil {
+		if err := m.Bmc.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bmc")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bmc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Host) validateCheckedInAt(formats strfmt.Registry) error {
 	if swag.IsZero(m.CheckedInAt) { // not required
 		return nil
@@ -762,6 +803,10 @@ func (m *Host) validateUpdatedAt(formats strfmt.Registry) error {
 func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBmc(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateLogsInfo(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -788,6 +833,22 @@ func (m *Host) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 	return nil
 }
 
+func (m *Host) contextValidateBmc(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Bmc != nil {
+		if err := m.Bmc.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bmc")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bmc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Host) contextValidateLogsInfo(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.LogsInfo.ContextValidate(ctx, formats); err != nil {
@@ -0,0 +1,57 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HostBmc host bmc
+//
+// swagger:model host-bmc
+type HostBmc struct {
+
+	// The address of the baseboard management controller of the host, e.g. redfish-virtualmedia://192.168.111.1/redfish/v1/Systems/1. An empty value removes the BMC details of the host.
+	// Example: redfish-virtualmedia://192.168.111.1/redfish/v1/Systems/1
+	Address string `json:"address,omitempty"`
+
+	// Skip the verification of the certificate of the baseboard management controller.
+	DisableCertificateVerification bool `json:"disable_certificate_verification,omitempty"`
+
+	// The username of the baseboard management controller.
+	Username string `json:"username,omitempty"`
+}
+
+// Validate validates this host bmc
+func (m *HostBmc) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this host bmc based on context it is used
+func (m *HostBmc) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostBmc) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostBmc) UnmarshalBinary(b []byte) error {
+	var res HostBmc
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -21,6 +21,12 @@ import (
 // swagger:model host-update-params
 type HostUpdateParams struct {
 
+	// The baseboard management controller of the host.
+	Bmc *HostBmc `json:"bmc,omitempty" gorm:"embedded;embeddedPrefix:bmc_"`
+
+	// The password of the baseboard management controller of the host.
+	BmcPassword *string `json:"bmc_password,omitempty"`
+
 	// disks selected config
 	DisksSelectedConfig []*DiskConfigParams `json:"disks_selected_config"`
 
@@ -51,6 +57,10 @@ type HostUpdateParams struct {
 func (m *HostUpdateParams) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBmc(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDisksSelectedConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -77,6 +87,25 @@ func (m *HostUpdateParams) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostUpdateParams) validateBmc(formats strfmt.Registry) error {
+	if swag.IsZero(m.Bmc) { // not required
+		return nil
+	}
+
+	if m.Bmc != nil {
+		if err := m.Bmc.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bmc")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bmc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *HostUpdateParams) validateDisksSelectedConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.DisksSelectedConfig) { // not required
 		return nil
@@ -230,6 +259,10 @@ func (m *HostUpdateParams) validateNodeLabels(formats strfmt.Registry) error {
 func (m *HostUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBmc(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateDisksSelectedConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -252,6 +285,22 @@ func (m *HostUpdateParams) ContextValidate(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *HostUpdateParams) contextValidateBmc(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Bmc != nil {
+		if err := m.Bmc.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("bmc")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("bmc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *HostUpdateParams) contextValidateDisksSelectedConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.DisksSelectedConfig); i++ {
@@ -159,6 +159,15 @@ const (
 	// HostValidationIDNoIscsiNicBelongsToMachineCidr captures enum value "no-iscsi-nic-belongs-to-machine-cidr"
 	HostValidationIDNoIscsiNicBelongsToMachineCidr HostValidationID = "no-iscsi-nic-belongs-to-machine-cidr"
 
+	// HostValidationIDMtuValid captures enum value "mtu-valid"
+	HostValidationIDMtuValid HostValidationID = "mtu-valid"
+
+	// HostValidationIDSecondaryNetworksValid captures enum value "secondary-networks-valid"
+	HostValidationIDSecondaryNetworksValid HostValidationID = "secondary-networks-valid"
+
+	// HostValidationIDLoadBalancerReachable captures enum value "load-balancer-reachable"
+	HostValidationIDLoadBalancerReachable HostValidationID = "load-balancer-reachable"
+
 	// HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied captures enum value "node-feature-discovery-requirements-satisfied"
 	HostValidationIDNodeFeatureDiscoveryRequirementsSatisfied HostValidationID = "node-feature-discovery-requirements-satisfied"
 
@@ -176,6 +185,9 @@ const (
 
 	// HostValidationIDOpenshiftAiRequirementsSatisfied captures enum value "openshift-ai-requirements-satisfied"
 	HostValidationIDOpenshiftAiRequirementsSatisfied HostValidationID = "openshift-ai-requirements-satisfied"
+
+	// HostValidationIDCustomOperatorsRequirementsSatisfied captures enum value "custom-operators-requirements-satisfied"
+	HostValidationIDCustomOperatorsRequirementsSatisfied HostValidationID = "custom-operators-requirements-satisfied"
 )
 
 // for schema
@@ -183,7 +195,7 @@ var hostValidationIdEnum []interface{}
 
 func init() {
 	var res []HostValidationID
-	if err := json.Unmarshal([]byte(`["connected","media-connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","ignition-downloadable","belongs-to-majority-group","valid-platform-network-settings","ntp-synced","time-synced-between-host-and-service","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","has-default-route","api-domain-name-resolved-correctly","api-int-domain-name-resolved-correctly","apps-domain-name-resolved-correctly","release-domain-name-resolved-correctly","compatible-with-cluster-platform","dns-wildcard-not-configured","disk-encryption-requirements-satisfied","non-overlapping-subnets","vsphere-disk-uuid-enabled","compatible-agent","no-skip-installation-disk","no-skip-missing-disk","no-ip-collisions-in-network","no-iscsi-nic-belongs-to-machine-cidr","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connected","media-connected","has-inventory","has-min-cpu-cores","has-min-valid-disks","has-min-memory","machine-cidr-defined","has-cpu-cores-for-role","has-memory-for-role","hostname-unique","hostname-valid","belongs-to-machine-cidr","ignition-downloadable","belongs-to-majority-group","valid-platform-network-settings","ntp-synced","time-synced-between-host-and-service","container-images-available","lso-requirements-satisfied","ocs-requirements-satisfied","odf-requirements-satisfied","lvm-requirements-satisfied","mce-requirements-satisfied","mtv-requirements-satisfied","sufficient-installation-disk-speed","cnv-requirements-satisfied","sufficient-network-latency-requirement-for-role","sufficient-packet-loss-requirement-for-role","has-default-route","api-domain-name-resolved-correctly","api-int-domain-name-resolved-correctly","apps-domain-name-resolved-correctly","release-domain-name-resolved-correctly","compatible-with-cluster-platform","dns-wildcard-not-configured","disk-encryption-requirements-satisfied","non-overlapping-subnets","vsphere-disk-uuid-enabled","compatible-agent","no-skip-installation-disk","no-skip-missing-disk","no-ip-collisions-in-network","no-iscsi-nic-belongs-to-machine-cidr","mtu-valid","secondary-networks-valid","load-balancer-reachable","node-feature-discovery-requirements-satisfied","nvidia-gpu-requirements-satisfied","pipelines-requirements-satisfied","servicemesh-requirements-satisfied","serverless-requirements-satisfied","openshift-ai-requirements-satisfied","custom-operators-requirements-satisfied"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -68,6 +68,9 @@ type InfraEnv struct {
 	// Json formatted string containing the user overrides for the initial ignition config.
 	IgnitionConfigOverride string `json:"ignition_config_override,omitempty"`
 
+	// The pool of the IP address management system from which the static addresses of the hosts were reserved.
+	IpamPool string `json:"ipam_pool,omitempty"`
+
 	// JSON formatted string array representing the discovery image kernel arguments.
 	KernelArguments *string `json:"kernel_arguments,omitempty" gorm:"type:text"`
 
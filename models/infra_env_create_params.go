@@ -45,6 +45,9 @@ type InfraEnvCreateParams struct {
 	// image type
 	ImageType ImageType `json:"image_type,omitempty"`
 
+	// The pool of the IP address management system from which the addresses of the static network config of the hosts are reserved, for the enabled interfaces without DHCP and without addresses. The addresses are released when the infra-env is deregistered.
+	IpamPool string `json:"ipam_pool,omitempty"`
+
 	// kernel arguments
 	KernelArguments KernelArguments `json:"kernel_arguments"`
 
@@ -67,6 +70,9 @@ type InfraEnvCreateParams struct {
 
 	// static network config
 	StaticNetworkConfig []*HostStaticNetworkConfig `json:"static_network_config"`
+
+	// Template expanded into the static network configuration of the hosts. Can't be set together with static_network_config.
+	StaticNetworkConfigTemplate *StaticNetworkConfigTemplate `json:"static_network_config_template,omitempty"`
 }
 
 // Validate validates this infra env create params
@@ -109,6 +115,10 @@ func (m *InfraEnvCreateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStaticNetworkConfigTemplate(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -287,6 +297,25 @@ func (m *InfraEnvCreateParams) validateStaticNetworkConfig(formats strfmt.Regist
 	return nil
 }
 
+func (m *InfraEnvCreateParams) validateStaticNetworkConfigTemplate(formats strfmt.Registry) error {
+	if swag.IsZero(m.StaticNetworkConfigTemplate) { // not required
+		return nil
+	}
+
+	if m.StaticNetworkConfigTemplate != nil {
+		if err := m.StaticNetworkConfigTemplate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("static_network_config_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("static_network_config_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this infra env create params based on the context it is used
 func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -307,6 +336,10 @@ func (m *InfraEnvCreateParams) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStaticNetworkConfigTemplate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -377,6 +410,22 @@ func (m *InfraEnvCreateParams) contextValidateStaticNetworkConfig(ctx context.Co
 	return nil
 }
 
+func (m *InfraEnvCreateParams) contextValidateStaticNetworkConfigTemplate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StaticNetworkConfigTemplate != nil {
+		if err := m.StaticNetworkConfigTemplate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("static_network_config_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("static_network_config_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InfraEnvCreateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
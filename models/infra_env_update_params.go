@@ -50,6 +50,9 @@ type InfraEnvUpdateParams struct {
 
 	// static network config
 	StaticNetworkConfig []*HostStaticNetworkConfig `json:"static_network_config"`
+
+	// Template expanded into the static network configuration of the hosts. Can't be set together with static_network_config.
+	StaticNetworkConfigTemplate *StaticNetworkConfigTemplate `json:"static_network_config_template,omitempty"`
 }
 
 // Validate validates this infra env update params
@@ -76,6 +79,10 @@ func (m *InfraEnvUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStaticNetworkConfigTemplate(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -173,6 +180,25 @@ func (m *InfraEnvUpdateParams) validateStaticNetworkConfig(formats strfmt.Regist
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) validateStaticNetworkConfigTemplate(formats strfmt.Registry) error {
+	if swag.IsZero(m.StaticNetworkConfigTemplate) { // not required
+		return nil
+	}
+
+	if m.StaticNetworkConfigTemplate != nil {
+		if err := m.StaticNetworkConfigTemplate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("static_network_config_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("static_network_config_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this infra env update params based on the context it is used
 func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -193,6 +219,10 @@ func (m *InfraEnvUpdateParams) ContextValidate(ctx context.Context, formats strf
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStaticNetworkConfigTemplate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -263,6 +293,22 @@ func (m *InfraEnvUpdateParams) contextValidateStaticNetworkConfig(ctx context.Co
 	return nil
 }
 
+func (m *InfraEnvUpdateParams) contextValidateStaticNetworkConfigTemplate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StaticNetworkConfigTemplate != nil {
+		if err := m.StaticNetworkConfigTemplate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("static_network_config_template")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("static_network_config_template")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InfraEnvUpdateParams) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -7,7 +7,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -35,6 +37,9 @@ type Interface struct {
 	// ipv6 addresses
 	IPV6Addresses []string `json:"ipv6_addresses"`
 
+	// The neighbors of the interface discovered with LLDP, when the agent reports them.
+	LldpNeighbors []*LldpNeighbor `json:"lldp_neighbors"`
+
 	// mac address
 	MacAddress string `json:"mac_address,omitempty"`
 
@@ -59,11 +64,75 @@ type Interface struct {
 
 // Validate validates this interface
 func (m *Interface) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLldpNeighbors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Interface) validateLldpNeighbors(formats strfmt.Registry) error {
+	if swag.IsZero(m.LldpNeighbors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LldpNeighbors); i++ {
+		if swag.IsZero(m.LldpNeighbors[i]) { // not required
+			continue
+		}
+
+		if m.LldpNeighbors[i] != nil {
+			if err := m.LldpNeighbors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this interface based on context it is used
+// ContextValidate validate this interface based on the context it is used
 func (m *Interface) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLldpNeighbors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Interface) contextValidateLldpNeighbors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.LldpNeighbors); i++ {
+
+		if m.LldpNeighbors[i] != nil {
+			if err := m.LldpNeighbors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// LldpNeighbor A neighbor of the interface discovered with LLDP.
+//
+// swagger:model lldp-neighbor
+type LldpNeighbor struct {
+
+	// chassis id
+	ChassisID string `json:"chassis_id,omitempty"`
+
+	// port description
+	PortDescription string `json:"port_description,omitempty"`
+
+	// port id
+	PortID string `json:"port_id,omitempty"`
+
+	// system name
+	SystemName string `json:"system_name,omitempty"`
+}
+
+// Validate validates this lldp neighbor
+func (m *LldpNeighbor) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this lldp neighbor based on context it is used
+func (m *LldpNeighbor) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LldpNeighbor) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LldpNeighbor) UnmarshalBinary(b []byte) error {
+	var res LldpNeighbor
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
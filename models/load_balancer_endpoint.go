@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LoadBalancerEndpoint load balancer endpoint
+//
+// swagger:model load_balancer_endpoint
+type LoadBalancerEndpoint struct {
+
+	// The domain name or the IP address of the endpoint.
+	// Required: true
+	Host *string `json:"host"`
+
+	// The role of the endpoint.
+	// Required: true
+	// Enum: [api api-int ingress]
+	Name *string `json:"name"`
+
+	// The port of the endpoint.
+	// Required: true
+	// Maximum: 65535
+	// Minimum: 1
+	Port *int64 `json:"port"`
+}
+
+// Validate validates this load balancer endpoint
+func (m *LoadBalancerEndpoint) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHost(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePort(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LoadBalancerEndpoint) validateHost(formats strfmt.Registry) error {
+
+	if err := validate.Required("host", "body", m.Host); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var loadBalancerEndpointTypeNamePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["api","api-int","ingress"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		loadBalancerEndpointTypeNamePropEnum = append(loadBalancerEndpointTypeNamePropEnum, v)
+	}
+}
+
+const (
+
+	// LoadBalancerEndpointNameAPI captures enum value "api"
+	LoadBalancerEndpointNameAPI string = "api"
+
+	// LoadBalancerEndpointNameAPIInt captures enum value "api-int"
+	LoadBalancerEndpointNameAPIInt string = "api-int"
+
+	// LoadBalancerEndpointNameIngress captures enum value "ingress"
+	LoadBalancerEndpointNameIngress string = "ingress"
+)
+
+// prop value enum
+func (m *LoadBalancerEndpoint) validateNameEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, loadBalancerEndpointTypeNamePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *LoadBalancerEndpoint) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateNameEnum("name", "body", *m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LoadBalancerEndpoint) validatePort(formats strfmt.Registry) error {
+
+	if err := validate.Required("port", "body", m.Port); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("port", "body", *m.Port, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("port", "body", *m.Port, 65535, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this load balancer endpoint based on context it is used
+func (m *LoadBalancerEndpoint) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LoadBalancerEndpoint) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LoadBalancerEndpoint) UnmarshalBinary(b []byte) error {
+	var res LoadBalancerEndpoint
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,127 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LoadBalancerEndpointResult load balancer endpoint result
+//
+// swagger:model load_balancer_endpoint_result
+type LoadBalancerEndpointResult struct {
+
+	// endpoint
+	// Required: true
+	Endpoint *LoadBalancerEndpoint `json:"endpoint"`
+
+	// The reason the endpoint couldn't be reached.
+	Error string `json:"error,omitempty"`
+
+	// True if a listener accepted a connection on the endpoint.
+	// Required: true
+	Reachable *bool `json:"reachable"`
+}
+
+// Validate validates this load balancer endpoint result
+func (m *LoadBalancerEndpointResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndpoint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReachable(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LoadBalancerEndpointResult) validateEndpoint(formats strfmt.Registry) error {
+
+	if err := validate.Required("endpoint", "body", m.Endpoint); err != nil {
+		return err
+	}
+
+	if m.Endpoint != nil {
+		if err := m.Endpoint.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("endpoint")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("endpoint")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *LoadBalancerEndpointResult) validateReachable(formats strfmt.Registry) error {
+
+	if err := validate.Required("reachable", "body", m.Reachable); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this load balancer endpoint result based on the context it is used
+func (m *LoadBalancerEndpointResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEndpoint(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LoadBalancerEndpointResult) contextValidateEndpoint(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Endpoint != nil {
+		if err := m.Endpoint.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("endpoint")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("endpoint")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LoadBalancerEndpointResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LoadBalancerEndpointResult) UnmarshalBinary(b []byte) error {
+	var res LoadBalancerEndpointResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
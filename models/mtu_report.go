@@ -17,6 +17,9 @@ import (
 // swagger:model mtu-report
 type MtuReport struct {
 
+	// The size of the packets that were sent to the remote IP address.
+	Mtu int64 `json:"mtu,omitempty"`
+
 	// mtu successful
 	MtuSuccessful bool `json:"mtu_successful,omitempty"`
 
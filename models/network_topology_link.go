@@ -0,0 +1,166 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworkTopologyLink The result of a connectivity check from a NIC of a host to an address of another host.
+//
+// swagger:model network-topology-link
+type NetworkTopologyLink struct {
+
+	// average r t t ms
+	AverageRTTMs float64 `json:"average_rtt_ms,omitempty"`
+
+	// destination host id
+	// Format: uuid
+	DestinationHostID strfmt.UUID `json:"destination_host_id,omitempty"`
+
+	// The NIC of the destination host that has the remote IP address, empty when it isn't in the inventory of the destination host.
+	DestinationNic string `json:"destination_nic,omitempty"`
+
+	// packet loss percentage
+	PacketLossPercentage float64 `json:"packet_loss_percentage,omitempty"`
+
+	// remote ip address
+	RemoteIPAddress string `json:"remote_ip_address,omitempty"`
+
+	// remote mac
+	RemoteMac string `json:"remote_mac,omitempty"`
+
+	// source host id
+	// Format: uuid
+	SourceHostID strfmt.UUID `json:"source_host_id,omitempty"`
+
+	// source nic
+	SourceNic string `json:"source_nic,omitempty"`
+
+	// successful
+	Successful bool `json:"successful,omitempty"`
+
+	// type
+	// Enum: [l2 l3]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this network topology link
+func (m *NetworkTopologyLink) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDestinationHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateDestinationHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.DestinationHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("destination_host_id", "body", "uuid", m.DestinationHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateSourceHostID(formats strfmt.Registry) error {
+	if swag.IsZero(m.SourceHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("source_host_id", "body", "uuid", m.SourceHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var networkTopologyLinkTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["l2","l3"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		networkTopologyLinkTypeTypePropEnum = append(networkTopologyLinkTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// NetworkTopologyLinkTypeL2 captures enum value "l2"
+	NetworkTopologyLinkTypeL2 string = "l2"
+
+	// NetworkTopologyLinkTypeL3 captures enum value "l3"
+	NetworkTopologyLinkTypeL3 string = "l3"
+)
+
+// prop value enum
+func (m *NetworkTopologyLink) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, networkTopologyLinkTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NetworkTopologyLink) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this network topology link based on context it is used
+func (m *NetworkTopologyLink) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologyLink) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologyLink) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologyLink
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
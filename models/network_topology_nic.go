@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NetworkTopologyNic network topology nic
+//
+// swagger:model network-topology-nic
+type NetworkTopologyNic struct {
+
+	// ip addresses
+	IPAddresses []string `json:"ip_addresses"`
+
+	// lldp neighbors
+	LldpNeighbors []*LldpNeighbor `json:"lldp_neighbors"`
+
+	// mac address
+	MacAddress string `json:"mac_address,omitempty"`
+
+	// mtu
+	Mtu int64 `json:"mtu,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this network topology nic
+func (m *NetworkTopologyNic) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLldpNeighbors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkTopologyNic) validateLldpNeighbors(formats strfmt.Registry) error {
+	if swag.IsZero(m.LldpNeighbors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.LldpNeighbors); i++ {
+		if swag.IsZero(m.LldpNeighbors[i]) { // not required
+			continue
+		}
+
+		if m.LldpNeighbors[i] != nil {
+			if err := m.LldpNeighbors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this network topology nic based on the context it is used
+func (m *NetworkTopologyNic) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateLldpNeighbors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworkTopologyNic) contextValidateLldpNeighbors(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.LldpNeighbors); i++ {
+
+		if m.LldpNeighbors[i] != nil {
+			if err := m.LldpNeighbors[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("lldp_neighbors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworkTopologyNic) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworkTopologyNic) UnmarshalBinary(b []byte) error {
+	var res NetworkTopologyNic
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,342 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NetworksSuggestion Networks suggested for a cluster, they don't overlap each other nor the avoided networks.
+//
+// swagger:model networks-suggestion
+type NetworksSuggestion struct {
+
+	// The networks that the suggested networks don't overlap.
+	AvoidedNetworks []*AvoidedNetwork `json:"avoided_networks"`
+
+	// The suggested cluster networks, one per address family.
+	ClusterNetworks []*ClusterNetwork `json:"cluster_networks"`
+
+	// The machine networks of the cluster, or the networks that all the hosts of the cluster are connected to.
+	MachineNetworks []*MachineNetwork `json:"machine_networks"`
+
+	// The suggested service networks, one per address family.
+	ServiceNetworks []*ServiceNetwork `json:"service_networks"`
+
+	// The address families of the suggested networks.
+	// Enum: [ipv4 ipv6 dual-stack]
+	StackType string `json:"stack_type,omitempty"`
+}
+
+// Validate validates this networks suggestion
+func (m *NetworksSuggestion) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAvoidedNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClusterNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMachineNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceNetworks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStackType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworksSuggestion) validateAvoidedNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.AvoidedNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AvoidedNetworks); i++ {
+		if swag.IsZero(m.AvoidedNetworks[i]) { // not required
+			continue
+		}
+
+		if m.AvoidedNetworks[i] != nil {
+			if err := m.AvoidedNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("avoided_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("avoided_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworksSuggestion) validateClusterNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClusterNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+		if swag.IsZero(m.ClusterNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworksSuggestion) validateMachineNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.MachineNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+		if swag.IsZero(m.MachineNetworks[i]) { // not required
+			continue
+		}
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworksSuggestion) validateServiceNetworks(formats strfmt.Registry) error {
+	if swag.IsZero(m.ServiceNetworks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+		if swag.IsZero(m.ServiceNetworks[i]) { // not required
+			continue
+		}
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var networksSuggestionTypeStackTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ipv4","ipv6","dual-stack"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		networksSuggestionTypeStackTypePropEnum = append(networksSuggestionTypeStackTypePropEnum, v)
+	}
+}
+
+const (
+
+	// NetworksSuggestionStackTypeIPV4 captures enum value "ipv4"
+	NetworksSuggestionStackTypeIPV4 string = "ipv4"
+
+	// NetworksSuggestionStackTypeIPV6 captures enum value "ipv6"
+	NetworksSuggestionStackTypeIPV6 string = "ipv6"
+
+	// NetworksSuggestionStackTypeDualStack captures enum value "dual-stack"
+	NetworksSuggestionStackTypeDualStack string = "dual-stack"
+)
+
+// prop value enum
+func (m *NetworksSuggestion) validateStackTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, networksSuggestionTypeStackTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NetworksSuggestion) validateStackType(formats strfmt.Registry) error {
+	if swag.IsZero(m.StackType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStackTypeEnum("stack_type", "body", m.StackType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this networks suggestion based on the context it is used
+func (m *NetworksSuggestion) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAvoidedNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateClusterNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMachineNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateServiceNetworks(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NetworksSuggestion) contextValidateAvoidedNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.AvoidedNetworks); i++ {
+
+		if m.AvoidedNetworks[i] != nil {
+			if err := m.AvoidedNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("avoided_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("avoided_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworksSuggestion) contextValidateClusterNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ClusterNetworks); i++ {
+
+		if m.ClusterNetworks[i] != nil {
+			if err := m.ClusterNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("cluster_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworksSuggestion) contextValidateMachineNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.MachineNetworks); i++ {
+
+		if m.MachineNetworks[i] != nil {
+			if err := m.MachineNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("machine_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NetworksSuggestion) contextValidateServiceNetworks(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ServiceNetworks); i++ {
+
+		if m.ServiceNetworks[i] != nil {
+			if err := m.ServiceNetworks[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("service_networks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NetworksSuggestion) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NetworksSuggestion) UnmarshalBinary(b []byte) error {
+	var res NetworksSuggestion
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -21,7 +21,7 @@ import (
 type OperatorProperty struct {
 
 	// Type of the property
-	// Enum: [boolean string integer float]
+	// Enum: [boolean string integer float object array]
 	DataType string `json:"data_type,omitempty"`
 
 	// Default value for the property
@@ -58,7 +58,7 @@ var operatorPropertyTypeDataTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["boolean","string","integer","float"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["boolean","string","integer","float","object","array"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -79,6 +79,12 @@ const (
 
 	// OperatorPropertyDataTypeFloat captures enum value "float"
 	OperatorPropertyDataTypeFloat string = "float"
+
+	// OperatorPropertyDataTypeObject captures enum value "object"
+	OperatorPropertyDataTypeObject string = "object"
+
+	// OperatorPropertyDataTypeArray captures enum value "array"
+	OperatorPropertyDataTypeArray string = "array"
 )
 
 // prop value enum
@@ -44,6 +44,9 @@ const (
 
 	// PlatformTypeExternal captures enum value "external"
 	PlatformTypeExternal PlatformType = "external"
+
+	// PlatformTypeOpenstack captures enum value "openstack"
+	PlatformTypeOpenstack PlatformType = "openstack"
 )
 
 // for schema
@@ -51,7 +54,7 @@ var platformTypeEnum []interface{}
 
 func init() {
 	var res []PlatformType
-	if err := json.Unmarshal([]byte(`["baremetal","nutanix","vsphere","none","external"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["baremetal","nutanix","vsphere","none","external","openstack"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
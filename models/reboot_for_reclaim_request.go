@@ -23,6 +23,14 @@ type RebootForReclaimRequest struct {
 	// chroot into this directory in order to properly reboot.
 	// Required: true
 	HostFsMountDir *string `json:"host_fs_mount_dir"`
+
+	// Identifiers of disks that must not be wiped.
+	SkipWipeDisks []string `json:"skip_wipe_disks"`
+
+	// Wipe the partition tables and file system signatures of the host disks before
+	// rebooting. The disk that holds the /boot folder is left untouched so that the
+	// host is able to boot into discovery.
+	WipeDisks bool `json:"wipe_disks,omitempty"`
 }
 
 // Validate validates this reboot for reclaim request
@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SecondaryNetworkPolicy An NMState configuration of secondary networks, e.g. bonds, VLANs or SR-IOV VFs, applied to the selected hosts of the cluster at installation time.
+//
+// swagger:model secondary-network-policy
+type SecondaryNetworkPolicy struct {
+
+	// The NMState YAML desired state of the policy.
+	// Required: true
+	DesiredState *string `json:"desired_state"`
+
+	// The name of the policy, unique in the cluster.
+	// Required: true
+	// Max Length: 40
+	// Pattern: ^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name *string `json:"name"`
+
+	// The role of the hosts that the policy applies to.
+	// Enum: [all master worker]
+	Role *string `json:"role,omitempty"`
+}
+
+// Validate validates this secondary network policy
+func (m *SecondaryNetworkPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDesiredState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SecondaryNetworkPolicy) validateDesiredState(formats strfmt.Registry) error {
+
+	if err := validate.Required("desired_state", "body", m.DesiredState); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SecondaryNetworkPolicy) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("name", "body", *m.Name, 40); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("name", "body", *m.Name, `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var secondaryNetworkPolicyTypeRolePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["all","master","worker"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		secondaryNetworkPolicyTypeRolePropEnum = append(secondaryNetworkPolicyTypeRolePropEnum, v)
+	}
+}
+
+const (
+
+	// SecondaryNetworkPolicyRoleAll captures enum value "all"
+	SecondaryNetworkPolicyRoleAll string = "all"
+
+	// SecondaryNetworkPolicyRoleMaster captures enum value "master"
+	SecondaryNetworkPolicyRoleMaster string = "master"
+
+	// SecondaryNetworkPolicyRoleWorker captures enum value "worker"
+	SecondaryNetworkPolicyRoleWorker string = "worker"
+)
+
+// prop value enum
+func (m *SecondaryNetworkPolicy) validateRoleEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, secondaryNetworkPolicyTypeRolePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SecondaryNetworkPolicy) validateRole(formats strfmt.Registry) error {
+	if swag.IsZero(m.Role) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateRoleEnum("role", "body", *m.Role); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this secondary network policy based on context it is used
+func (m *SecondaryNetworkPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SecondaryNetworkPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SecondaryNetworkPolicy) UnmarshalBinary(b []byte) error {
+	var res SecondaryNetworkPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// StaticNetworkConfigTemplate static network config template
+//
+// swagger:model static_network_config_template
+type StaticNetworkConfigTemplate struct {
+
+	// hosts
+	// Required: true
+	// Min Items: 1
+	Hosts []*StaticNetworkConfigTemplateHost `json:"hosts"`
+
+	// Last address of the range from which addresses are picked for the hosts. Defaults to the start of the range plus the number of hosts.
+	IPRangeEnd string `json:"ip_range_end,omitempty"`
+
+	// First address of the range from which consecutive addresses are picked for the hosts, in the order of the list.
+	IPRangeStart string `json:"ip_range_start,omitempty"`
+
+	// Go template of a yaml string that can be processed by nmstate, expanded once per host. The template can
+	// reference the index of the host in the list with {{.HostIndex}}, the address picked for the host from the
+	// IP range with {{.IP}}, the mac addresses of the host by logical interface with {{index .MacAddresses "eth0"}}
+	// and the variables of the host with {{.Variables.name}}.
+	// Required: true
+	NetworkYamlTemplate *string `json:"network_yaml_template"`
+}
+
+// Validate validates this static network config template
+func (m *StaticNetworkConfigTemplate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHosts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNetworkYamlTemplate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigTemplate) validateHosts(formats strfmt.Registry) error {
+
+	if err := validate.Required("hosts", "body", m.Hosts); err != nil {
+		return err
+	}
+
+	iHostsSize := int64(len(m.Hosts))
+
+	if err := validate.MinItems("hosts", "body", iHostsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Hosts); i++ {
+		if swag.IsZero(m.Hosts[i]) { // not required
+			continue
+		}
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *StaticNetworkConfigTemplate) validateNetworkYamlTemplate(formats strfmt.Registry) error {
+
+	if err := validate.Required("network_yaml_template", "body", m.NetworkYamlTemplate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this static network config template based on the context it is used
+func (m *StaticNetworkConfigTemplate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHosts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigTemplate) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hosts); i++ {
+
+		if m.Hosts[i] != nil {
+			if err := m.Hosts[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hosts" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hosts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigTemplate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigTemplate) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigTemplate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
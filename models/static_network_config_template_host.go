@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// StaticNetworkConfigTemplateHost static network config template host
+//
+// swagger:model static_network_config_template_host
+type StaticNetworkConfigTemplateHost struct {
+
+	// mapping of host macs to logical interfaces used in the network yaml template
+	MacInterfaceMap MacInterfaceMap `json:"mac_interface_map,omitempty"`
+
+	// Values of the variables of the host referenced by the network yaml template.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// Validate validates this static network config template host
+func (m *StaticNetworkConfigTemplateHost) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMacInterfaceMap(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigTemplateHost) validateMacInterfaceMap(formats strfmt.Registry) error {
+	if swag.IsZero(m.MacInterfaceMap) { // not required
+		return nil
+	}
+
+	if err := m.MacInterfaceMap.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("mac_interface_map")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("mac_interface_map")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this static network config template host based on the context it is used
+func (m *StaticNetworkConfigTemplateHost) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMacInterfaceMap(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *StaticNetworkConfigTemplateHost) contextValidateMacInterfaceMap(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.MacInterfaceMap.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("mac_interface_map")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("mac_interface_map")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *StaticNetworkConfigTemplateHost) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *StaticNetworkConfigTemplateHost) UnmarshalBinary(b []byte) error {
+	var res StaticNetworkConfigTemplateHost
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -86,6 +86,12 @@ const (
 
 	// StepTypeVerifyVips captures enum value "verify-vips"
 	StepTypeVerifyVips StepType = "verify-vips"
+
+	// StepTypeDiskErase captures enum value "disk-erase"
+	StepTypeDiskErase StepType = "disk-erase"
+
+	// StepTypeLoadBalancerCheck captures enum value "load-balancer-check"
+	StepTypeLoadBalancerCheck StepType = "load-balancer-check"
 )
 
 // for schema
@@ -93,7 +99,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","dhcp-lease-allocate","api-vip-connectivity-check","tang-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution","stop-installation","logs-gather","next-step-runner","upgrade-agent","download-boot-artifacts","reboot-for-reclaim","verify-vips"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["connectivity-check","execute","inventory","install","free-network-addresses","dhcp-lease-allocate","api-vip-connectivity-check","tang-connectivity-check","ntp-synchronizer","installation-disk-speed-check","container-image-availability","domain-resolution","stop-installation","logs-gather","next-step-runner","upgrade-agent","download-boot-artifacts","reboot-for-reclaim","verify-vips","disk-erase","load-balancer-check"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -30,6 +30,9 @@ type V2ClusterUpdateParams struct {
 	// The virtual IPs used to reach the OpenShift cluster's API. Enter one IP address for single-stack clusters, or up to two for dual-stack clusters (at most one IP address per IP stack used). The order of stacks should be the same as order of subnets in Cluster Networks, Service Networks, and Machine Networks.
 	APIVips []*APIVip `json:"api_vips"`
 
+	// The provisioning network of the baremetal platform.
+	BaremetalProvisioning *BaremetalProvisioning `json:"baremetal_provisioning,omitempty" gorm:"embedded;embeddedPrefix:baremetal_provisioning_"`
+
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain *string `json:"base_dns_domain,omitempty"`
 
@@ -51,6 +54,9 @@ type V2ClusterUpdateParams struct {
 	// Installation disks encryption mode and host roles to be applied.
 	DiskEncryption *DiskEncryption `json:"disk_encryption,omitempty" gorm:"embedded;embeddedPrefix:disk_encryption_"`
 
+	// The DNS records that the service creates for the external load balancer of a cluster with user managed networking.
+	DNSRecordsProvisioning *DNSRecordsProvisioning `json:"dns_records_provisioning,omitempty" gorm:"embedded;embeddedPrefix:dns_records_provisioning_"`
+
 	// A proxy URL to use for creating HTTP connections outside the cluster.
 	// http://\<username\>:\<pswd\>@\<ip\>:\<port\>
 	//
@@ -78,6 +84,9 @@ type V2ClusterUpdateParams struct {
 	// Machine networks that are associated with this cluster.
 	MachineNetworks []*MachineNetwork `json:"machine_networks"`
 
+	// Recurring time window in which the installation of the cluster is allowed to start.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty" gorm:"embedded;embeddedPrefix:maintenance_window_"`
+
 	// OpenShift cluster name.
 	// Max Length: 54
 	// Min Length: 1
@@ -93,6 +102,9 @@ type V2ClusterUpdateParams struct {
 	// List of OLM operators to be installed.
 	OlmOperators []*OperatorCreateParams `json:"olm_operators"`
 
+	// The clouds.yaml with the credentials of the OpenStack cloud of the cluster, used to validate the credentials and to generate the manifests of the openstack platform. An empty value removes the credentials.
+	OpenstackCloudsYaml *string `json:"openstack_clouds_yaml,omitempty"`
+
 	// platform
 	Platform *Platform `json:"platform,omitempty" gorm:"embedded;embeddedPrefix:platform_"`
 
@@ -102,6 +114,10 @@ type V2ClusterUpdateParams struct {
 	// Schedule workloads on masters
 	SchedulableMasters *bool `json:"schedulable_masters,omitempty"`
 
+	// The earliest time at which the installation of the cluster will be started automatically once the cluster is ready.
+	// Format: date-time
+	ScheduledInstallTime *strfmt.DateTime `json:"scheduled_install_time,omitempty"`
+
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
 	// Pattern: ^(?:(?:(?:[0-9]{1,3}\.){3}[0-9]{1,3}\/(?:(?:[0-9])|(?:[1-2][0-9])|(?:3[0-2])))|(?:(?:[0-9a-fA-F]*:[0-9a-fA-F]*){2,})/(?:(?:[0-9])|(?:[1-9][0-9])|(?:1[0-1][0-9])|(?:12[0-8])))$
 	ServiceNetworkCidr *string `json:"service_network_cidr,omitempty"`
@@ -130,6 +146,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBaremetalProvisioning(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -146,6 +166,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDNSRecordsProvisioning(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHyperthreading(formats); err != nil {
 		res = append(res, err)
 	}
@@ -166,6 +190,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaintenanceWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -182,6 +210,10 @@ func (m *V2ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateScheduledInstallTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -222,6 +254,25 @@ func (m *V2ClusterUpdateParams) validateAPIVips(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateBaremetalProvisioning(formats strfmt.Registry) error {
+	if swag.IsZero(m.BaremetalProvisioning) { // not required
+		return nil
+	}
+
+	if m.BaremetalProvisioning != nil {
+		if err := m.BaremetalProvisioning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("baremetal_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("baremetal_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
 		return nil
@@ -295,6 +346,25 @@ func (m *V2ClusterUpdateParams) validateDiskEncryption(formats strfmt.Registry)
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateDNSRecordsProvisioning(formats strfmt.Registry) error {
+	if swag.IsZero(m.DNSRecordsProvisioning) { // not required
+		return nil
+	}
+
+	if m.DNSRecordsProvisioning != nil {
+		if err := m.DNSRecordsProvisioning.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns_records_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dns_records_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var v2ClusterUpdateParamsTypeHyperthreadingPropEnum []interface{}
 
 func init() {
@@ -426,6 +496,25 @@ func (m *V2ClusterUpdateParams) validateMachineNetworks(formats strfmt.Registry)
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateMaintenanceWindow(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaintenanceWindow) { // not required
+		return nil
+	}
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance_window")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance_window")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateName(formats strfmt.Registry) error {
 	if swag.IsZero(m.Name) { // not required
 		return nil
@@ -529,6 +618,18 @@ func (m *V2ClusterUpdateParams) validatePlatform(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) validateScheduledInstallTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.ScheduledInstallTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("scheduled_install_time", "body", "date-time", m.ScheduledInstallTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry) error {
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
@@ -575,6 +676,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateBaremetalProvisioning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateClusterNetworks(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -583,6 +688,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDNSRecordsProvisioning(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateIgnitionEndpoint(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -595,6 +704,10 @@ func (m *V2ClusterUpdateParams) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMaintenanceWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateOlmOperators(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -633,6 +746,22 @@ func (m *V2ClusterUpdateParams) contextValidateAPIVips(ctx context.Context, form
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateBaremetalProvisioning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.BaremetalProvisioning != nil {
+		if err := m.BaremetalProvisioning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("baremetal_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("baremetal_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateClusterNetworks(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.ClusterNetworks); i++ {
@@ -669,6 +798,22 @@ func (m *V2ClusterUpdateParams) contextValidateDiskEncryption(ctx context.Contex
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateDNSRecordsProvisioning(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DNSRecordsProvisioning != nil {
+		if err := m.DNSRecordsProvisioning.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dns_records_provisioning")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dns_records_provisioning")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateIgnitionEndpoint(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.IgnitionEndpoint != nil {
@@ -725,6 +870,22 @@ func (m *V2ClusterUpdateParams) contextValidateMachineNetworks(ctx context.Conte
 	return nil
 }
 
+func (m *V2ClusterUpdateParams) contextValidateMaintenanceWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaintenanceWindow != nil {
+		if err := m.MaintenanceWindow.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenance_window")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maintenance_window")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *V2ClusterUpdateParams) contextValidateOlmOperators(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.OlmOperators); i++ {
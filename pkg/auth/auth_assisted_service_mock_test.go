@@ -33,6 +33,26 @@ func (f fakeInventory) V2CancelInstallation(ctx context.Context, params installe
 	return installer.NewV2CancelInstallationAccepted()
 }
 
+func (f fakeInventory) V2CreateClusterBatch(ctx context.Context, params installer.V2CreateClusterBatchParams) middleware.Responder {
+	return installer.NewV2CreateClusterBatchCreated()
+}
+
+func (f fakeInventory) V2ListClusterBatches(ctx context.Context, params installer.V2ListClusterBatchesParams) middleware.Responder {
+	return installer.NewV2ListClusterBatchesOK()
+}
+
+func (f fakeInventory) V2GetClusterBatch(ctx context.Context, params installer.V2GetClusterBatchParams) middleware.Responder {
+	return installer.NewV2GetClusterBatchOK()
+}
+
+func (f fakeInventory) V2InstallClusterBatch(ctx context.Context, params installer.V2InstallClusterBatchParams) middleware.Responder {
+	return installer.NewV2InstallClusterBatchAccepted()
+}
+
+func (f fakeInventory) V2CancelClusterBatch(ctx context.Context, params installer.V2CancelClusterBatchParams) middleware.Responder {
+	return installer.NewV2CancelClusterBatchAccepted()
+}
+
 func (f fakeInventory) V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder {
 	return installer.NewV2CompleteInstallationAccepted()
 }
@@ -113,6 +133,34 @@ func (f fakeInventory) V2UploadClusterIngressCert(ctx context.Context, params in
 	return installer.NewV2UploadClusterIngressCertCreated()
 }
 
+func (f fakeInventory) V2SuggestNetworks(ctx context.Context, params installer.V2SuggestNetworksParams) middleware.Responder {
+	return installer.NewV2SuggestNetworksOK()
+}
+
+func (f fakeInventory) V2GetClusterSecondaryNetworks(ctx context.Context, params installer.V2GetClusterSecondaryNetworksParams) middleware.Responder {
+	return installer.NewV2GetClusterSecondaryNetworksOK()
+}
+
+func (f fakeInventory) V2UpdateClusterSecondaryNetworks(ctx context.Context, params installer.V2UpdateClusterSecondaryNetworksParams) middleware.Responder {
+	return installer.NewV2UpdateClusterSecondaryNetworksOK()
+}
+
+func (f fakeInventory) V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder {
+	return installer.NewV2GetClusterNetworkTopologyOK()
+}
+
+func (f fakeInventory) V2DownloadClusterNetworkTopology(ctx context.Context, params installer.V2DownloadClusterNetworkTopologyParams) middleware.Responder {
+	return filemiddleware.NewResponder(installer.NewV2DownloadClusterNetworkTopologyOK(), "network-topology.dot", 0, nil)
+}
+
+func (f fakeInventory) V2GetInfraEnvNetworkTopology(ctx context.Context, params installer.V2GetInfraEnvNetworkTopologyParams) middleware.Responder {
+	return installer.NewV2GetInfraEnvNetworkTopologyOK()
+}
+
+func (f fakeInventory) V2DownloadInfraEnvNetworkTopology(ctx context.Context, params installer.V2DownloadInfraEnvNetworkTopologyParams) middleware.Responder {
+	return filemiddleware.NewResponder(installer.NewV2DownloadInfraEnvNetworkTopologyOK(), "network-topology.dot", 0, nil)
+}
+
 func (f fakeInventory) V2UpdateClusterLogsProgress(ctx context.Context, params installer.V2UpdateClusterLogsProgressParams) middleware.Responder {
 	return installer.NewV2UpdateClusterLogsProgressNoContent()
 }
@@ -209,6 +257,14 @@ func (f fakeInventory) V2InstallHost(ctx context.Context, params installer.V2Ins
 	return installer.NewV2InstallHostAccepted()
 }
 
+func (f fakeInventory) V2EraseHostDisks(ctx context.Context, params installer.V2EraseHostDisksParams) middleware.Responder {
+	return installer.NewV2EraseHostDisksAccepted()
+}
+
+func (f fakeInventory) V2ListHostDiskErasureReports(ctx context.Context, params installer.V2ListHostDiskErasureReportsParams) middleware.Responder {
+	return installer.NewV2ListHostDiskErasureReportsOK()
+}
+
 func (f fakeInventory) V2DownloadClusterCredentials(ctx context.Context, params installer.V2DownloadClusterCredentialsParams) middleware.Responder {
 	file, err := os.CreateTemp("/tmp", "test.file")
 	if err != nil {
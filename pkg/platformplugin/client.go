@@ -0,0 +1,257 @@
+package platformplugin
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const stopTimeout = 5 * time.Second
+
+var _ Platform = &Client{}
+
+// Client starts a plugin and calls its platform over RPC. The plugin is started again by the next call when it
+// crashes or when a call times out.
+type Client struct {
+	log          logrus.FieldLogger
+	path         string
+	startTimeout time.Duration
+	callTimeout  time.Duration
+
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	rpcClient *rpc.Client
+}
+
+// NewClient creates the client of the plugin executable at the given path, the plugin is started by Start or by the
+// first call
+func NewClient(log logrus.FieldLogger, path string, startTimeout, callTimeout time.Duration) *Client {
+	return &Client{
+		log:          log.WithField("plugin", filepath.Base(path)),
+		path:         path,
+		startTimeout: startTimeout,
+		callTimeout:  callTimeout,
+	}
+}
+
+// Start starts the plugin if it isn't running
+func (c *Client) Start() error {
+	_, err := c.client()
+	return err
+}
+
+// Kill stops the plugin
+func (c *Client) Kill() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stop()
+}
+
+func (c *Client) client() (*rpc.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rpcClient == nil {
+		if err := c.start(); err != nil {
+			c.stop()
+			return nil, errors.Wrapf(err, "failed to start plugin %s", c.path)
+		}
+	}
+	return c.rpcClient, nil
+}
+
+func (c *Client) start() error {
+	c.cmd = exec.Command(c.path) // #nosec G204 the plugins are configured by the administrator of the service
+	c.cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", MagicCookieKey, MagicCookieValue))
+	var err error
+	if c.stdin, err = c.cmd.StdinPipe(); err != nil {
+		return err
+	}
+	stdout, err := c.cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := c.cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err = c.cmd.Start(); err != nil {
+		return err
+	}
+
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			c.log.Info(scanner.Text())
+		}
+	}()
+	lines := make(chan string, 1)
+	go func() {
+		reader := bufio.NewReader(stdout)
+		line, _ := reader.ReadString('\n')
+		lines <- line
+		// The output of the plugin after the handshake is logged, the plugin would block once the pipe is full
+		// otherwise
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			c.log.Info(scanner.Text())
+		}
+	}()
+	var line string
+	select {
+	case line = <-lines:
+	case <-time.After(c.startTimeout):
+		return errors.Errorf("no handshake within %s", c.startTimeout)
+	}
+
+	network, address, err := parseHandshake(line)
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialTimeout(network, address, c.startTimeout)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", address)
+	}
+	c.rpcClient = jsonrpc.NewClient(conn)
+	c.log.Infof("Started plugin %s", c.path)
+	return nil
+}
+
+func (c *Client) stop() {
+	if c.rpcClient != nil {
+		c.rpcClient.Close()
+		c.rpcClient = nil
+	}
+	if c.stdin != nil {
+		c.stdin.Close()
+		c.stdin = nil
+	}
+	if c.cmd == nil || c.cmd.Process == nil {
+		c.cmd = nil
+		return
+	}
+	cmd := c.cmd
+	c.cmd = nil
+	exited := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exited)
+	}()
+	select {
+	case <-exited:
+	case <-time.After(stopTimeout):
+		c.log.Warnf("Plugin %s didn't exit within %s, killing it", c.path, stopTimeout)
+		_ = cmd.Process.Kill()
+		<-exited
+	}
+}
+
+// reset stops the plugin of the RPC client, unless it was already started again
+func (c *Client) reset(rpcClient *rpc.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.rpcClient == rpcClient {
+		c.stop()
+	}
+}
+
+func (c *Client) call(method string, request, reply interface{}) error {
+	rpcClient, err := c.client()
+	if err != nil {
+		return err
+	}
+	call := rpcClient.Go(rpcServiceName+"."+method, request, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		if errors.Is(call.Error, rpc.ErrShutdown) || errors.Is(call.Error, io.ErrUnexpectedEOF) {
+			c.log.WithError(call.Error).Warnf("Lost the connection to plugin %s", c.path)
+			c.reset(rpcClient)
+		}
+		return call.Error
+	case <-time.After(c.callTimeout):
+		c.reset(rpcClient)
+		return errors.Errorf("call %s of plugin %s timed out after %s", method, c.path, c.callTimeout)
+	}
+}
+
+// parseHandshake returns the network and the address that the plugin serves on from its handshake line
+func parseHandshake(line string) (string, string, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return "", "", errors.Errorf("invalid handshake %q", line)
+	}
+	if parts[0] != fmt.Sprint(CoreProtocolVersion) {
+		return "", "", errors.Errorf("unsupported core protocol version %s, expected %d", parts[0], CoreProtocolVersion)
+	}
+	if parts[1] != fmt.Sprint(ProtocolVersion) {
+		return "", "", errors.Errorf("unsupported protocol version %s, expected %d", parts[1], ProtocolVersion)
+	}
+	if parts[2] != "unix" && parts[2] != "tcp" {
+		return "", "", errors.Errorf("unsupported network %s", parts[2])
+	}
+	if parts[4] != rpcProtocol {
+		return "", "", errors.Errorf("unsupported protocol %s, expected %s", parts[4], rpcProtocol)
+	}
+	return parts[2], parts[3], nil
+}
+
+func (c *Client) Info() (*PlatformInfo, error) {
+	reply := &PlatformInfo{}
+	if err := c.call("Info", &InfoRequest{}, reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (c *Client) AddPlatformToInstallConfig(cluster *models.Cluster, infraEnvs []*models.InfraEnv) (map[string]interface{}, error) {
+	reply := &InstallConfigReply{}
+	if err := c.call("AddPlatformToInstallConfig", &InstallConfigRequest{Cluster: cluster, InfraEnvs: infraEnvs}, reply); err != nil {
+		return nil, err
+	}
+	return reply.Platform, nil
+}
+
+func (c *Client) IsHostSupported(host *models.Host) (bool, error) {
+	reply := &HostReply{}
+	if err := c.call("IsHostSupported", &HostRequest{Host: host}, reply); err != nil {
+		return false, err
+	}
+	return reply.Supported, nil
+}
+
+func (c *Client) PreCreateManifestsHook(cluster *models.Cluster, envVars []string, workDir string) ([]string, error) {
+	reply := &ManifestsHookReply{}
+	if err := c.call("PreCreateManifestsHook", &ManifestsHookRequest{Cluster: cluster, EnvVars: envVars, WorkDir: workDir}, reply); err != nil {
+		return nil, err
+	}
+	return reply.EnvVars, nil
+}
+
+func (c *Client) PostCreateManifestsHook(cluster *models.Cluster, envVars []string, workDir string) ([]string, error) {
+	reply := &ManifestsHookReply{}
+	if err := c.call("PostCreateManifestsHook", &ManifestsHookRequest{Cluster: cluster, EnvVars: envVars, WorkDir: workDir}, reply); err != nil {
+		return nil, err
+	}
+	return reply.EnvVars, nil
+}
+
+func (c *Client) SetPlatformUsages(usages map[string]models.Usage) (map[string]models.Usage, error) {
+	reply := &UsagesReply{}
+	if err := c.call("SetPlatformUsages", &UsagesRequest{Usages: usages}, reply); err != nil {
+		return nil, err
+	}
+	return reply.Usages, nil
+}
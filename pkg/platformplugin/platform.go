@@ -0,0 +1,124 @@
+// Package platformplugin allows to support installing on external platforms with providers that run out of the
+// process of the service.
+//
+// A platform plugin is an executable that implements the Platform interface and calls Serve from its main function.
+// The service starts the plugins found in its plugins directory, and uses them for the clusters whose external
+// platform name matches the name of the platform of the plugin:
+//
+//	type examplePlatform struct {
+//		platformplugin.BasePlatform
+//	}
+//
+//	func (p *examplePlatform) Info() (*platformplugin.PlatformInfo, error) {
+//		return &platformplugin.PlatformInfo{PlatformName: "example", MinOpenshiftVersion: "4.14"}, nil
+//	}
+//
+//	func main() {
+//		platformplugin.Serve(&examplePlatform{})
+//	}
+package platformplugin
+
+import (
+	"github.com/openshift/assisted-service/models"
+)
+
+// Platform contains the functions that a plugin implements to support installing on an external platform. They
+// match the functions of the providers that are built into the service.
+type Platform interface {
+	// Info returns the name of the external platform and its support levels
+	Info() (*PlatformInfo, error)
+	// AddPlatformToInstallConfig returns the platform section of the install config of the cluster, for example
+	// {"external": {"platformName": "example", "cloudControllerManager": "External"}}. When nil is returned the
+	// external platform is set from the platform of the cluster.
+	AddPlatformToInstallConfig(cluster *models.Cluster, infraEnvs []*models.InfraEnv) (map[string]interface{}, error)
+	// IsHostSupported checks if the platform supports the host
+	IsHostSupported(host *models.Host) (bool, error)
+	// PreCreateManifestsHook performs the tasks required before the manifests of the cluster are created in the work
+	// directory, and returns the environment variables of the installer
+	PreCreateManifestsHook(cluster *models.Cluster, envVars []string, workDir string) ([]string, error)
+	// PostCreateManifestsHook performs the tasks required after the manifests of the cluster are created in the work
+	// directory, and returns the environment variables of the installer
+	PostCreateManifestsHook(cluster *models.Cluster, envVars []string, workDir string) ([]string, error)
+	// SetPlatformUsages returns the feature usages of the cluster updated with the usages of the platform
+	SetPlatformUsages(usages map[string]models.Usage) (map[string]models.Usage, error)
+}
+
+// PlatformInfo describes the external platform of a plugin
+type PlatformInfo struct {
+	// PlatformName is the external platform name of the clusters that the plugin handles
+	PlatformName string `json:"platform_name"`
+	// DisplayName is the user friendly name of the platform, it defaults to the platform name
+	DisplayName string `json:"display_name,omitempty"`
+	// MinOpenshiftVersion is the first OpenShift version that supports the platform
+	MinOpenshiftVersion string `json:"min_openshift_version,omitempty"`
+	// SupportLevel is the support level of the platform on the OpenShift versions that support it, it defaults to
+	// supported
+	SupportLevel models.SupportLevel `json:"support_level,omitempty"`
+	// CPUArchitectures are the CPU architectures that the platform supports, all of them when empty
+	CPUArchitectures []string `json:"cpu_architectures,omitempty"`
+	// IncompatibleFeatures are the features that can't be used on the platform
+	IncompatibleFeatures []models.FeatureSupportLevelID `json:"incompatible_features,omitempty"`
+}
+
+// BasePlatform provides a default implementation of the optional functions of Platform. Compose it and implement
+// Info to fulfill the Platform interface.
+type BasePlatform struct{}
+
+func (p *BasePlatform) AddPlatformToInstallConfig(_ *models.Cluster, _ []*models.InfraEnv) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (p *BasePlatform) IsHostSupported(_ *models.Host) (bool, error) {
+	return true, nil
+}
+
+func (p *BasePlatform) PreCreateManifestsHook(_ *models.Cluster, envVars []string, _ string) ([]string, error) {
+	return envVars, nil
+}
+
+func (p *BasePlatform) PostCreateManifestsHook(_ *models.Cluster, envVars []string, _ string) ([]string, error) {
+	return envVars, nil
+}
+
+func (p *BasePlatform) SetPlatformUsages(usages map[string]models.Usage) (map[string]models.Usage, error) {
+	return usages, nil
+}
+
+// The requests and the replies of the RPC protocol between the service and the plugins
+
+type InfoRequest struct{}
+
+type InstallConfigRequest struct {
+	Cluster   *models.Cluster    `json:"cluster"`
+	InfraEnvs []*models.InfraEnv `json:"infra_envs"`
+}
+
+type InstallConfigReply struct {
+	Platform map[string]interface{} `json:"platform"`
+}
+
+type HostRequest struct {
+	Host *models.Host `json:"host"`
+}
+
+type HostReply struct {
+	Supported bool `json:"supported"`
+}
+
+type ManifestsHookRequest struct {
+	Cluster *models.Cluster `json:"cluster"`
+	EnvVars []string        `json:"env_vars"`
+	WorkDir string          `json:"work_dir"`
+}
+
+type ManifestsHookReply struct {
+	EnvVars []string `json:"env_vars"`
+}
+
+type UsagesRequest struct {
+	Usages map[string]models.Usage `json:"usages"`
+}
+
+type UsagesReply struct {
+	Usages map[string]models.Usage `json:"usages"`
+}
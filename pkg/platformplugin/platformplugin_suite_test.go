@@ -0,0 +1,23 @@
+package platformplugin
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// TestMain serves the fake platform when the test binary is started as a plugin by the tests
+func TestMain(m *testing.M) {
+	if os.Getenv(MagicCookieKey) == MagicCookieValue {
+		Serve(&fakePlatform{})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestPlatformPlugin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Platform plugin test")
+}
@@ -0,0 +1,150 @@
+package platformplugin
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	crashingHostname = "crash"
+	verboseCluster   = "verbose"
+)
+
+type fakePlatform struct {
+	BasePlatform
+}
+
+func (p *fakePlatform) Info() (*PlatformInfo, error) {
+	return &PlatformInfo{
+		PlatformName:        "example",
+		MinOpenshiftVersion: "4.14",
+		CPUArchitectures:    []string{models.ClusterCPUArchitectureX8664},
+	}, nil
+}
+
+func (p *fakePlatform) AddPlatformToInstallConfig(cluster *models.Cluster, _ []*models.InfraEnv) (map[string]interface{}, error) {
+	return map[string]interface{}{
+		"external": map[string]interface{}{"platformName": swag.StringValue(cluster.Platform.External.PlatformName)},
+	}, nil
+}
+
+func (p *fakePlatform) IsHostSupported(host *models.Host) (bool, error) {
+	if host.RequestedHostname == crashingHostname {
+		os.Exit(1)
+	}
+	return host.RequestedHostname != "", nil
+}
+
+func (p *fakePlatform) PreCreateManifestsHook(cluster *models.Cluster, envVars []string, _ string) ([]string, error) {
+	if cluster.Name == "" {
+		return nil, errors.New("the cluster has no name")
+	}
+	if cluster.Name == verboseCluster {
+		// More than the capacity of a pipe
+		for i := 0; i < 4096; i++ {
+			fmt.Println(strings.Repeat("x", 63))
+		}
+	}
+	return append(envVars, "EXAMPLE_CLUSTER_NAME="+cluster.Name), nil
+}
+
+var _ = Describe("Platform plugin", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		log := logrus.New()
+		log.SetOutput(GinkgoWriter)
+		client = NewClient(log, os.Args[0], 30*time.Second, 30*time.Second)
+		Expect(client.Start()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		client.Kill()
+	})
+
+	It("returns the platform info", func() {
+		info, err := client.Info()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.PlatformName).To(Equal("example"))
+		Expect(info.MinOpenshiftVersion).To(Equal("4.14"))
+		Expect(info.CPUArchitectures).To(ConsistOf(models.ClusterCPUArchitectureX8664))
+	})
+
+	It("returns the install config platform", func() {
+		cluster := &models.Cluster{
+			Platform: &models.Platform{
+				Type:     models.PlatformTypeExternal.Pointer(),
+				External: &models.PlatformExternal{PlatformName: swag.String("example")},
+			},
+		}
+		platform, err := client.AddPlatformToInstallConfig(cluster, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(platform).To(Equal(map[string]interface{}{"external": map[string]interface{}{"platformName": "example"}}))
+	})
+
+	It("runs the hooks", func() {
+		envVars, err := client.PreCreateManifestsHook(&models.Cluster{Name: "my-cluster"}, []string{"A=B"}, "/tmp")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(envVars).To(Equal([]string{"A=B", "EXAMPLE_CLUSTER_NAME=my-cluster"}))
+
+		envVars, err = client.PostCreateManifestsHook(&models.Cluster{Name: "my-cluster"}, []string{"A=B"}, "/tmp")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(envVars).To(Equal([]string{"A=B"}))
+	})
+
+	It("keeps logging the output of the plugin", func() {
+		_, err := client.PreCreateManifestsHook(&models.Cluster{Name: verboseCluster}, nil, "/tmp")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.PreCreateManifestsHook(&models.Cluster{Name: verboseCluster}, nil, "/tmp")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("returns the errors of the platform", func() {
+		_, err := client.PreCreateManifestsHook(&models.Cluster{}, nil, "/tmp")
+		Expect(err).To(MatchError("the cluster has no name"))
+	})
+
+	It("starts the plugin again after it crashed", func() {
+		_, err := client.IsHostSupported(&models.Host{RequestedHostname: crashingHostname})
+		Expect(err).To(HaveOccurred())
+
+		supported, err := client.IsHostSupported(&models.Host{RequestedHostname: "master-0"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supported).To(BeTrue())
+	})
+
+	It("fails to start a plugin that doesn't complete the handshake", func() {
+		log := logrus.New()
+		log.SetOutput(GinkgoWriter)
+		invalidClient := NewClient(log, "/bin/true", time.Second, time.Second)
+		Expect(invalidClient.Start()).To(MatchError(ContainSubstring("invalid handshake")))
+	})
+})
+
+var _ = DescribeTable("Parse handshake",
+	func(line, network, address, errorSubstring string) {
+		parsedNetwork, parsedAddress, err := parseHandshake(line)
+		if errorSubstring != "" {
+			Expect(err).To(MatchError(ContainSubstring(errorSubstring)))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsedNetwork).To(Equal(network))
+		Expect(parsedAddress).To(Equal(address))
+	},
+	Entry("valid", "1|1|unix|/tmp/plugin.sock|jsonrpc\n", "unix", "/tmp/plugin.sock", ""),
+	Entry("missing fields", "1|1|unix\n", "", "", "invalid handshake"),
+	Entry("unsupported core protocol version", "2|1|unix|/tmp/plugin.sock|jsonrpc\n", "", "", "core protocol version"),
+	Entry("unsupported protocol version", "1|2|unix|/tmp/plugin.sock|jsonrpc\n", "", "", "unsupported protocol version"),
+	Entry("unsupported protocol", "1|1|unix|/tmp/plugin.sock|grpc\n", "", "", "unsupported protocol grpc"),
+)
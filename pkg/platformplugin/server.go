@@ -0,0 +1,138 @@
+package platformplugin
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MagicCookieKey and MagicCookieValue are set in the environment of the plugins by the service, they only verify
+	// that the plugin was started by the service and aren't a security measure
+	MagicCookieKey   = "ASSISTED_SERVICE_PLATFORM_PLUGIN"
+	MagicCookieValue = "0c3a1e0c-9c1f-4d52-9e1b-5b5f4f9bb1d5"
+
+	// CoreProtocolVersion is the version of the handshake, ProtocolVersion is the version of the RPC protocol
+	CoreProtocolVersion = 1
+	ProtocolVersion     = 1
+
+	rpcServiceName = "Platform"
+	rpcProtocol    = "jsonrpc"
+	socketName     = "plugin.sock"
+)
+
+// rpcServer exposes a Platform over RPC
+type rpcServer struct {
+	platform Platform
+}
+
+func (s *rpcServer) Info(_ *InfoRequest, reply *PlatformInfo) error {
+	info, err := s.platform.Info()
+	if err != nil {
+		return err
+	}
+	*reply = *info
+	return nil
+}
+
+func (s *rpcServer) AddPlatformToInstallConfig(request *InstallConfigRequest, reply *InstallConfigReply) error {
+	platform, err := s.platform.AddPlatformToInstallConfig(request.Cluster, request.InfraEnvs)
+	reply.Platform = platform
+	return err
+}
+
+func (s *rpcServer) IsHostSupported(request *HostRequest, reply *HostReply) error {
+	supported, err := s.platform.IsHostSupported(request.Host)
+	reply.Supported = supported
+	return err
+}
+
+func (s *rpcServer) PreCreateManifestsHook(request *ManifestsHookRequest, reply *ManifestsHookReply) error {
+	envVars, err := s.platform.PreCreateManifestsHook(request.Cluster, request.EnvVars, request.WorkDir)
+	reply.EnvVars = envVars
+	return err
+}
+
+func (s *rpcServer) PostCreateManifestsHook(request *ManifestsHookRequest, reply *ManifestsHookReply) error {
+	envVars, err := s.platform.PostCreateManifestsHook(request.Cluster, request.EnvVars, request.WorkDir)
+	reply.EnvVars = envVars
+	return err
+}
+
+func (s *rpcServer) SetPlatformUsages(request *UsagesRequest, reply *UsagesReply) error {
+	usages, err := s.platform.SetPlatformUsages(request.Usages)
+	reply.Usages = usages
+	return err
+}
+
+// handshakeLine is written by the plugin to its standard output once it is ready to serve, in the format
+// CORE-PROTOCOL-VERSION|PROTOCOL-VERSION|NETWORK|ADDRESS|PROTOCOL
+func handshakeLine(address string) string {
+	return fmt.Sprintf("%d|%d|unix|%s|%s\n", CoreProtocolVersion, ProtocolVersion, address, rpcProtocol)
+}
+
+// Serve serves the platform to the service that started the plugin, it returns when the service closes the standard
+// input of the plugin or the plugin is terminated. The standard output of the plugin is reserved for the handshake,
+// the plugin should log to its standard error.
+func Serve(platform Platform) {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		fmt.Fprintln(os.Stderr, "This binary is a plugin of the assisted installer service, it isn't meant to be executed directly")
+		os.Exit(1)
+	}
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	if err := serve(platform, os.Stdin, os.Stdout, stop); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to serve the platform plugin: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func serve(platform Platform, stdin io.Reader, stdout io.Writer, stop <-chan os.Signal) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(rpcServiceName, &rpcServer{platform: platform}); err != nil {
+		return errors.Wrap(err, "failed to register the platform")
+	}
+
+	dir, err := os.MkdirTemp("", "platform-plugin-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create the directory of the socket")
+	}
+	defer os.RemoveAll(dir)
+	listener, err := net.Listen("unix", filepath.Join(dir, socketName))
+	if err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+	defer listener.Close()
+
+	go func() {
+		// The service closes the standard input of the plugin when it stops using it
+		_, _ = io.Copy(io.Discard, stdin)
+		listener.Close()
+	}()
+	go func() {
+		<-stop
+		listener.Close()
+	}()
+
+	if _, err = io.WriteString(stdout, handshakeLine(listener.Addr().String())); err != nil {
+		return errors.Wrap(err, "failed to write the handshake")
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return errors.Wrap(err, "failed to accept connection")
+		}
+		go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+	}
+}
@@ -386,9 +386,8 @@ func (s *StaticNetworkConfigGenerator) ValidateStaticConfigParamsYAML(staticNetw
 	var err *multierror.Error
 	for i, hostConfig := range staticNetworkConfig {
 		err = multierror.Append(err, s.validateMacInterfaceName(i, hostConfig.MacInterfaceMap))
-		_, validateErr := s.generateConfiguration(hostConfig.NetworkYaml)
-		if validateErr != nil {
-			err = multierror.Append(err, fmt.Errorf("failed to validate network yaml for host %d, %s", i, validateErr))
+		if validateErr := s.validateNetworkYAML(i, hostConfig.NetworkYaml); validateErr != nil {
+			err = multierror.Append(err, validateErr)
 			return err.ErrorOrNil()
 		}
 		err = multierror.Append(err, s.validateInterfaceNamesExistenceYAML(hostConfig.MacInterfaceMap, hostConfig.NetworkYaml, ocpVersion, arch, installInvoker))
@@ -396,6 +395,27 @@ func (s *StaticNetworkConfigGenerator) ValidateStaticConfigParamsYAML(staticNetw
 	return err.ErrorOrNil()
 }
 
+// validateNetworkYAML validates the NMState YAML of a host. The commonly used subset of NMState is validated natively,
+// with its errors returned as a HostConfigError with an error per field, and the nmstate library validates the rest.
+// The configuration is always generated by the nmstate library.
+func (s *StaticNetworkConfigGenerator) validateNetworkYAML(hostIdx int, hostYAML string) error {
+	if hostYAML == "" {
+		return fmt.Errorf("failed to validate network yaml for host %d, cannot generate configuration with an empty host YAML", hostIdx)
+	}
+	_, fieldErrors, err := parseNMState(hostYAML)
+	if err == nil {
+		if len(fieldErrors) > 0 {
+			return &HostConfigError{HostIndex: hostIdx, Errors: fieldErrors}
+		}
+		return nil
+	}
+	s.log.WithError(err).Debugf("Falling back to nmstate to validate the network yaml of host %d", hostIdx)
+	if _, err = s.generateConfiguration(hostYAML); err != nil {
+		return fmt.Errorf("failed to validate network yaml for host %d, %s", hostIdx, err)
+	}
+	return nil
+}
+
 func (s *StaticNetworkConfigGenerator) validateMacInterfaceName(hostIdx int, macInterfaceMap models.MacInterfaceMap) error {
 	if len(macInterfaceMap) == 0 {
 		return fmt.Errorf("at least one interface for host %d must be provided", hostIdx)
@@ -0,0 +1,594 @@
+package staticnetworkconfig
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/util/json"
+	yamlconvertor "sigs.k8s.io/yaml"
+)
+
+// FieldError is a validation error of a field of the NMState YAML of a host. The field is a path such as
+// interfaces[0].ipv4.address[1].ip.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// HostConfigError holds the validation errors of the NMState YAML of a host
+type HostConfigError struct {
+	HostIndex int
+	Errors    []*FieldError
+}
+
+func (e *HostConfigError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, fieldErr := range e.Errors {
+		messages = append(messages, fieldErr.Error())
+	}
+	return fmt.Sprintf("failed to validate network yaml for host %d, %s", e.HostIndex, strings.Join(messages, "; "))
+}
+
+// errUnsupportedNMState is returned when the YAML uses NMState constructs that are not handled natively, in which
+// case the nmstate library validates it
+var errUnsupportedNMState = errors.New("unsupported nmstate configuration")
+
+const (
+	nmInterfaceTypeEthernet    = "ethernet"
+	nmInterfaceTypeBond        = "bond"
+	nmInterfaceTypeVlan        = "vlan"
+	nmInterfaceTypeLinuxBridge = "linux-bridge"
+)
+
+var nmBondModes = []string{"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb"}
+
+type nmIP struct {
+	enabled    bool
+	dhcp       bool
+	autoconf   bool
+	autoDNS    *bool
+	autoRoutes *bool
+	autoGW     *bool
+	addresses  []string
+}
+
+type nmInterface struct {
+	name          string
+	ifType        string
+	mtu           int64
+	macAddress    string
+	identifyByMac bool
+	ipv4          *nmIP
+	ipv6          *nmIP
+	bondMode      string
+	bondOptions   map[string]string
+	ports         []string
+	vlanBaseIface string
+	vlanID        int64
+	stp           *bool
+	controller    string
+	portType      string
+}
+
+type nmRoute struct {
+	destination      string
+	nextHopAddress   string
+	nextHopInterface string
+	metric           *int64
+	tableID          *int64
+}
+
+type nmState struct {
+	interfaces []*nmInterface
+	routes     []*nmRoute
+	dnsServers []string
+	dnsSearch  []string
+}
+
+// nmStateParser parses the commonly used subset of the NMState schema: ethernet, bond, vlan and linux-bridge
+// interfaces with static or dynamic IPv4/IPv6 addresses, routes and DNS. Keys outside of the subset are recorded as
+// unsupported rather than invalid, since nmstate may accept them.
+type nmStateParser struct {
+	fieldErrors []*FieldError
+	unsupported []string
+}
+
+func (p *nmStateParser) fail(field, format string, args ...interface{}) {
+	p.fieldErrors = append(p.fieldErrors, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (p *nmStateParser) checkKeys(field string, m map[string]interface{}, allowed ...string) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !lo.Contains(allowed, key) {
+			p.unsupported = append(p.unsupported, joinField(field, key))
+		}
+	}
+}
+
+func joinField(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func (p *nmStateParser) getMap(m map[string]interface{}, key, field string) (map[string]interface{}, bool) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return nil, false
+	}
+	ret, ok := value.(map[string]interface{})
+	if !ok {
+		p.fail(joinField(field, key), "must be an object")
+		return nil, false
+	}
+	return ret, true
+}
+
+func (p *nmStateParser) getList(m map[string]interface{}, key, field string) ([]interface{}, bool) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return nil, false
+	}
+	ret, ok := value.([]interface{})
+	if !ok {
+		p.fail(joinField(field, key), "must be a list")
+		return nil, false
+	}
+	return ret, true
+}
+
+func (p *nmStateParser) getString(m map[string]interface{}, key, field string) (string, bool) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return "", false
+	}
+	ret, ok := value.(string)
+	if !ok {
+		p.fail(joinField(field, key), "must be a string")
+		return "", false
+	}
+	return ret, true
+}
+
+func (p *nmStateParser) getBool(m map[string]interface{}, key, field string) (*bool, bool) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return nil, false
+	}
+	ret, ok := value.(bool)
+	if !ok {
+		p.fail(joinField(field, key), "must be a boolean")
+		return nil, false
+	}
+	return &ret, true
+}
+
+func (p *nmStateParser) getInt(m map[string]interface{}, key, field string) (*int64, bool) {
+	value, ok := m[key]
+	if !ok || value == nil {
+		return nil, false
+	}
+	ret, ok := value.(int64)
+	if !ok {
+		p.fail(joinField(field, key), "must be an integer")
+		return nil, false
+	}
+	return &ret, true
+}
+
+func (p *nmStateParser) getStringList(m map[string]interface{}, key, field string) []string {
+	list, ok := p.getList(m, key, field)
+	if !ok {
+		return nil
+	}
+	ret := make([]string, 0, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			p.fail(fmt.Sprintf("%s[%d]", joinField(field, key), i), "must be a string")
+			continue
+		}
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+// parseNMState parses and validates the NMState YAML of a host. It returns the field errors of the YAML, or
+// errUnsupportedNMState if the YAML uses constructs outside of the natively handled subset.
+func parseNMState(hostYAML string) (*nmState, []*FieldError, error) {
+	hostJSON, err := yamlconvertor.YAMLToJSON([]byte(hostYAML))
+	if err != nil {
+		return nil, []*FieldError{{Field: "", Message: fmt.Sprintf("invalid YAML: %s", err.Error())}}, nil
+	}
+	var root map[string]interface{}
+	if err = json.Unmarshal(hostJSON, &root); err != nil {
+		return nil, []*FieldError{{Field: "", Message: "must be an object"}}, nil
+	}
+
+	p := &nmStateParser{}
+	state := &nmState{}
+	p.checkKeys("", root, "interfaces", "routes", "dns-resolver")
+	if list, ok := p.getList(root, "interfaces", ""); ok {
+		for i, item := range list {
+			field := fmt.Sprintf("interfaces[%d]", i)
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				p.fail(field, "must be an object")
+				continue
+			}
+			if intf := p.parseInterface(m, field); intf != nil {
+				state.interfaces = append(state.interfaces, intf)
+			}
+		}
+	}
+	if routes, ok := p.getMap(root, "routes", ""); ok {
+		p.checkKeys("routes", routes, "config")
+		if list, ok := p.getList(routes, "config", "routes"); ok {
+			for i, item := range list {
+				field := fmt.Sprintf("routes.config[%d]", i)
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					p.fail(field, "must be an object")
+					continue
+				}
+				if route := p.parseRoute(m, field); route != nil {
+					state.routes = append(state.routes, route)
+				}
+			}
+		}
+	}
+	if dns, ok := p.getMap(root, "dns-resolver", ""); ok {
+		p.checkKeys("dns-resolver", dns, "config")
+		if config, ok := p.getMap(dns, "config", "dns-resolver"); ok {
+			p.checkKeys("dns-resolver.config", config, "server", "search")
+			state.dnsServers = p.getStringList(config, "server", "dns-resolver.config")
+			for i, server := range state.dnsServers {
+				if net.ParseIP(server) == nil {
+					p.fail(fmt.Sprintf("dns-resolver.config.server[%d]", i), "invalid IP address %q", server)
+				}
+			}
+			state.dnsSearch = p.getStringList(config, "search", "dns-resolver.config")
+		}
+	}
+
+	if len(p.unsupported) > 0 {
+		return nil, nil, errors.Wrapf(errUnsupportedNMState, "unsupported fields %s", strings.Join(p.unsupported, ", "))
+	}
+	p.validateReferences(state)
+	if len(p.fieldErrors) > 0 {
+		return nil, p.fieldErrors, nil
+	}
+	return state, nil, nil
+}
+
+func (p *nmStateParser) parseInterface(m map[string]interface{}, field string) *nmInterface {
+	p.checkKeys(field, m, "name", "type", "state", "mtu", "mac-address", "identifier", "ipv4", "ipv6",
+		"link-aggregation", "vlan", "bridge")
+	intf := &nmInterface{}
+	name, ok := p.getString(m, "name", field)
+	if !ok || name == "" {
+		p.fail(joinField(field, "name"), "is required")
+	}
+	intf.name = name
+	intf.ifType, _ = p.getString(m, "type", field)
+	switch intf.ifType {
+	case nmInterfaceTypeEthernet, nmInterfaceTypeBond, nmInterfaceTypeVlan, nmInterfaceTypeLinuxBridge:
+	case "":
+		p.fail(joinField(field, "type"), "is required")
+	default:
+		p.unsupported = append(p.unsupported, fmt.Sprintf("%s (type %s)", joinField(field, "type"), intf.ifType))
+	}
+	if state, ok := p.getString(m, "state", field); ok && state != "up" {
+		// Interfaces that are down or absent are only meaningful against the current state of the host
+		p.unsupported = append(p.unsupported, fmt.Sprintf("%s (state %s)", joinField(field, "state"), state))
+	}
+	if mtu, ok := p.getInt(m, "mtu", field); ok {
+		if *mtu < 68 || *mtu > 65535 {
+			p.fail(joinField(field, "mtu"), "must be between 68 and 65535")
+		}
+		intf.mtu = *mtu
+	}
+	if mac, ok := p.getString(m, "mac-address", field); ok {
+		if _, err := net.ParseMAC(mac); err != nil {
+			p.fail(joinField(field, "mac-address"), "invalid MAC address %q", mac)
+		}
+		intf.macAddress = mac
+	}
+	if identifier, ok := p.getString(m, "identifier", field); ok {
+		switch identifier {
+		case "name":
+		case "mac-address":
+			intf.identifyByMac = true
+			if intf.macAddress == "" {
+				p.fail(joinField(field, "mac-address"), "is required when the identifier is mac-address")
+			}
+			if intf.ifType != nmInterfaceTypeEthernet {
+				p.fail(joinField(field, "identifier"), "mac-address is only supported for ethernet interfaces")
+			}
+		default:
+			p.fail(joinField(field, "identifier"), "must be name or mac-address")
+		}
+	}
+	intf.ipv4 = p.parseIP(m, "ipv4", field, false)
+	intf.ipv6 = p.parseIP(m, "ipv6", field, true)
+
+	if bond, ok := p.getMap(m, "link-aggregation", field); ok {
+		bondField := joinField(field, "link-aggregation")
+		if intf.ifType != nmInterfaceTypeBond {
+			p.fail(bondField, "is only supported for bond interfaces")
+		}
+		p.checkKeys(bondField, bond, "mode", "port", "slaves", "options")
+		intf.bondMode, _ = p.getString(bond, "mode", bondField)
+		if !lo.Contains(nmBondModes, intf.bondMode) {
+			p.fail(joinField(bondField, "mode"), "must be one of %s", strings.Join(nmBondModes, ", "))
+		}
+		intf.ports = append(p.getStringList(bond, "port", bondField), p.getStringList(bond, "slaves", bondField)...)
+		if options, ok := p.getMap(bond, "options", bondField); ok {
+			intf.bondOptions = make(map[string]string)
+			for key, value := range options {
+				switch v := value.(type) {
+				case string, int64, float64, bool:
+					intf.bondOptions[key] = fmt.Sprint(v)
+				default:
+					p.fail(joinField(joinField(bondField, "options"), key), "must be a scalar")
+				}
+			}
+		}
+	} else if intf.ifType == nmInterfaceTypeBond {
+		p.fail(joinField(field, "link-aggregation"), "is required for bond interfaces")
+	}
+
+	if vlan, ok := p.getMap(m, "vlan", field); ok {
+		vlanField := joinField(field, "vlan")
+		if intf.ifType != nmInterfaceTypeVlan {
+			p.fail(vlanField, "is only supported for vlan interfaces")
+		}
+		p.checkKeys(vlanField, vlan, "base-iface", "id")
+		if intf.vlanBaseIface, ok = p.getString(vlan, "base-iface", vlanField); !ok || intf.vlanBaseIface == "" {
+			p.fail(joinField(vlanField, "base-iface"), "is required")
+		}
+		if id, ok := p.getInt(vlan, "id", vlanField); !ok {
+			p.fail(joinField(vlanField, "id"), "is required")
+		} else if *id < 0 || *id > 4094 {
+			p.fail(joinField(vlanField, "id"), "must be between 0 and 4094")
+		} else {
+			intf.vlanID = *id
+		}
+	} else if intf.ifType == nmInterfaceTypeVlan {
+		p.fail(joinField(field, "vlan"), "is required for vlan interfaces")
+	}
+
+	if bridge, ok := p.getMap(m, "bridge", field); ok {
+		bridgeField := joinField(field, "bridge")
+		if intf.ifType != nmInterfaceTypeLinuxBridge {
+			p.fail(bridgeField, "is only supported for linux-bridge interfaces")
+		}
+		p.checkKeys(bridgeField, bridge, "options", "port")
+		if options, ok := p.getMap(bridge, "options", bridgeField); ok {
+			optionsField := joinField(bridgeField, "options")
+			p.checkKeys(optionsField, options, "stp")
+			if stp, ok := p.getMap(options, "stp", optionsField); ok {
+				p.checkKeys(joinField(optionsField, "stp"), stp, "enabled")
+				intf.stp, _ = p.getBool(stp, "enabled", joinField(optionsField, "stp"))
+			}
+		}
+		if ports, ok := p.getList(bridge, "port", bridgeField); ok {
+			for i, item := range ports {
+				portField := fmt.Sprintf("%s.port[%d]", bridgeField, i)
+				port, ok := item.(map[string]interface{})
+				if !ok {
+					p.fail(portField, "must be an object")
+					continue
+				}
+				p.checkKeys(portField, port, "name")
+				if name, ok := p.getString(port, "name", portField); ok && name != "" {
+					intf.ports = append(intf.ports, name)
+				} else {
+					p.fail(joinField(portField, "name"), "is required")
+				}
+			}
+		}
+	}
+	return intf
+}
+
+func (p *nmStateParser) parseIP(m map[string]interface{}, key, field string, isIPv6 bool) *nmIP {
+	ipMap, ok := p.getMap(m, key, field)
+	if !ok {
+		return nil
+	}
+	ipField := joinField(field, key)
+	allowed := []string{"enabled", "dhcp", "address", "auto-dns", "auto-routes", "auto-gateway"}
+	if isIPv6 {
+		allowed = append(allowed, "autoconf")
+	}
+	p.checkKeys(ipField, ipMap, allowed...)
+	ip := &nmIP{}
+	if enabled, ok := p.getBool(ipMap, "enabled", ipField); ok {
+		ip.enabled = *enabled
+	}
+	if dhcp, ok := p.getBool(ipMap, "dhcp", ipField); ok {
+		ip.dhcp = *dhcp
+	}
+	if autoconf, ok := p.getBool(ipMap, "autoconf", ipField); ok {
+		ip.autoconf = *autoconf
+	}
+	ip.autoDNS, _ = p.getBool(ipMap, "auto-dns", ipField)
+	ip.autoRoutes, _ = p.getBool(ipMap, "auto-routes", ipField)
+	ip.autoGW, _ = p.getBool(ipMap, "auto-gateway", ipField)
+	if addresses, ok := p.getList(ipMap, "address", ipField); ok {
+		for i, item := range addresses {
+			addressField := fmt.Sprintf("%s.address[%d]", ipField, i)
+			address, ok := item.(map[string]interface{})
+			if !ok {
+				p.fail(addressField, "must be an object")
+				continue
+			}
+			p.checkKeys(addressField, address, "ip", "prefix-length")
+			ipStr, _ := p.getString(address, "ip", addressField)
+			parsed := net.ParseIP(ipStr)
+			if parsed == nil {
+				p.fail(joinField(addressField, "ip"), "invalid IP address %q", ipStr)
+				continue
+			}
+			if (parsed.To4() == nil) != isIPv6 {
+				p.fail(joinField(addressField, "ip"), "%s is not an %s address", ipStr, strings.ToUpper(key))
+				continue
+			}
+			maxPrefix := int64(32)
+			if isIPv6 {
+				maxPrefix = 128
+			}
+			prefix, ok := p.getInt(address, "prefix-length", addressField)
+			if !ok {
+				p.fail(joinField(addressField, "prefix-length"), "is required")
+				continue
+			}
+			if *prefix < 0 || *prefix > maxPrefix {
+				p.fail(joinField(addressField, "prefix-length"), "must be between 0 and %d", maxPrefix)
+				continue
+			}
+			ip.addresses = append(ip.addresses, fmt.Sprintf("%s/%d", ipStr, *prefix))
+		}
+	}
+	if len(ip.addresses) > 0 && !ip.enabled {
+		p.fail(joinField(ipField, "enabled"), "must be true when addresses are set")
+	}
+	return ip
+}
+
+func (p *nmStateParser) parseRoute(m map[string]interface{}, field string) *nmRoute {
+	p.checkKeys(field, m, "destination", "next-hop-address", "next-hop-interface", "metric", "table-id")
+	route := &nmRoute{}
+	route.destination, _ = p.getString(m, "destination", field)
+	if _, _, err := net.ParseCIDR(route.destination); err != nil {
+		p.fail(joinField(field, "destination"), "invalid CIDR %q", route.destination)
+		return nil
+	}
+	if nextHop, ok := p.getString(m, "next-hop-address", field); ok {
+		ip := net.ParseIP(nextHop)
+		if ip == nil {
+			p.fail(joinField(field, "next-hop-address"), "invalid IP address %q", nextHop)
+		} else if (ip.To4() == nil) != isIPv6Destination(route.destination) {
+			p.fail(joinField(field, "next-hop-address"), "%s is not in the IP family of the destination", nextHop)
+		}
+		route.nextHopAddress = nextHop
+	}
+	if route.nextHopInterface, _ = p.getString(m, "next-hop-interface", field); route.nextHopInterface == "" {
+		p.fail(joinField(field, "next-hop-interface"), "is required")
+	}
+	route.metric, _ = p.getInt(m, "metric", field)
+	route.tableID, _ = p.getInt(m, "table-id", field)
+	return route
+}
+
+func isIPv6Destination(destination string) bool {
+	ip, _, err := net.ParseCIDR(destination)
+	return err == nil && ip.To4() == nil
+}
+
+func (p *nmStateParser) validateReferences(state *nmState) {
+	byName := make(map[string]*nmInterface)
+	for i, intf := range state.interfaces {
+		if intf.name == "" {
+			continue
+		}
+		if _, ok := byName[intf.name]; ok {
+			p.fail(fmt.Sprintf("interfaces[%d].name", i), "interface %s is defined more than once", intf.name)
+			continue
+		}
+		byName[intf.name] = intf
+	}
+	for i, intf := range state.interfaces {
+		portsField := fmt.Sprintf("interfaces[%d].link-aggregation.port", i)
+		portType := "bond"
+		if intf.ifType == nmInterfaceTypeLinuxBridge {
+			portsField = fmt.Sprintf("interfaces[%d].bridge.port", i)
+			portType = "bridge"
+		}
+		for _, port := range intf.ports {
+			if port == intf.name {
+				p.fail(portsField, "%s can't be a port of itself", port)
+				continue
+			}
+			portIntf, ok := byName[port]
+			if !ok {
+				continue
+			}
+			if portIntf.controller != "" {
+				p.fail(portsField, "%s is already a port of %s", port, portIntf.controller)
+				continue
+			}
+			if (portIntf.ipv4 != nil && portIntf.ipv4.enabled) || (portIntf.ipv6 != nil && portIntf.ipv6.enabled) {
+				p.fail(portsField, "port %s can't have IP configuration", port)
+			}
+			portIntf.controller = intf.name
+			portIntf.portType = portType
+		}
+	}
+	for i, route := range state.routes {
+		if route.nextHopInterface == "" {
+			continue
+		}
+		intf, ok := byName[route.nextHopInterface]
+		if !ok {
+			p.fail(fmt.Sprintf("routes.config[%d].next-hop-interface", i), "interface %s is not defined", route.nextHopInterface)
+			continue
+		}
+		ip := intf.ipv4
+		if isIPv6Destination(route.destination) {
+			ip = intf.ipv6
+		}
+		if ip == nil || !ip.enabled {
+			p.fail(fmt.Sprintf("routes.config[%d].next-hop-interface", i), "interface %s has no IP configuration of the family of the route", intf.name)
+		}
+	}
+	// Checked last, since invalid addresses leave the interfaces without a static configuration
+	if len(p.fieldErrors) == 0 && len(state.dnsServers) > 0 && dnsInterface(state, false) == nil && dnsInterface(state, true) == nil {
+		p.fail("dns-resolver.config.server", "no interface has a static IP configuration to hold the DNS servers")
+	}
+}
+
+// dnsInterface returns the interface that holds the DNS servers of the IP family: the interface of the default
+// route, or else the first interface with a static configuration of the family
+func dnsInterface(state *nmState, isIPv6 bool) *nmInterface {
+	ipOf := func(intf *nmInterface) *nmIP {
+		if isIPv6 {
+			return intf.ipv6
+		}
+		return intf.ipv4
+	}
+	for _, route := range state.routes {
+		if isIPv6Destination(route.destination) != isIPv6 || !strings.HasSuffix(route.destination, "/0") {
+			continue
+		}
+		for _, intf := range state.interfaces {
+			if intf.name == route.nextHopInterface && ipOf(intf) != nil && ipOf(intf).enabled {
+				return intf
+			}
+		}
+	}
+	for _, intf := range state.interfaces {
+		if ip := ipOf(intf); ip != nil && ip.enabled && len(ip.addresses) > 0 {
+			return intf
+		}
+	}
+	return nil
+}
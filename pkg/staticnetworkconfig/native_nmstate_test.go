@@ -0,0 +1,130 @@
+package staticnetworkconfig_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/models"
+	snc "github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("native nmstate", func() {
+	var (
+		staticNetworkGenerator = snc.New(logrus.New(), snc.Config{MinVersionForNmstateService: common.MinimalVersionForNmstatectl})
+		macInterfaceMap        = models.MacInterfaceMap{
+			{LogicalNicName: "eth0", MacAddress: "f8:75:a4:a4:00:fe"},
+			{LogicalNicName: "eth1", MacAddress: "f8:75:a4:a4:00:ff"},
+		}
+	)
+
+	validate := func(hostYAML string) error {
+		return staticNetworkGenerator.ValidateStaticConfigParamsYAML([]*models.HostStaticNetworkConfig{
+			{NetworkYaml: hostYAML, MacInterfaceMap: macInterfaceMap},
+		}, "4.14", common.X86CPUArchitecture, "")
+	}
+
+	fieldErrors := func(err error) []snc.FieldError {
+		var hostErr *snc.HostConfigError
+		Expect(errors.As(err, &hostErr)).To(BeTrue(), err.Error())
+		ret := make([]snc.FieldError, 0, len(hostErr.Errors))
+		for _, fieldErr := range hostErr.Errors {
+			ret = append(ret, *fieldErr)
+		}
+		return ret
+	}
+
+	It("accepts a valid configuration", func() {
+		Expect(validate(`interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv6:
+    enabled: true
+    address:
+    - ip: 2001:db8::10
+      prefix-length: 64
+`)).To(Succeed())
+	})
+
+	It("returns an error per invalid field", func() {
+		err := validate(`interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  mtu: 10
+  ipv4:
+    enabled: true
+    address:
+    - ip: 192.0.2.300
+      prefix-length: 24
+    - ip: 2001:db8::10
+      prefix-length: 64
+- name: eth0.5000
+  type: vlan
+  state: up
+  vlan:
+    base-iface: eth0
+    id: 5000
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: round-robin
+    port:
+    - eth1
+routes:
+  config:
+  - destination: 10.0.0.0/8
+    next-hop-address: 192.0.2.1
+    next-hop-interface: eth2
+dns-resolver:
+  config:
+    server:
+    - dns.example.com
+`)
+		Expect(err).To(HaveOccurred())
+		Expect(fieldErrors(err)).To(Equal([]snc.FieldError{
+			{Field: "interfaces[0].mtu", Message: "must be between 68 and 65535"},
+			{Field: "interfaces[0].ipv4.address[0].ip", Message: `invalid IP address "192.0.2.300"`},
+			{Field: "interfaces[0].ipv4.address[1].ip", Message: "2001:db8::10 is not an IPV4 address"},
+			{Field: "interfaces[1].vlan.id", Message: "must be between 0 and 4094"},
+			{Field: "interfaces[2].link-aggregation.mode", Message: "must be one of balance-rr, active-backup, balance-xor, broadcast, 802.3ad, balance-tlb, balance-alb"},
+			{Field: "dns-resolver.config.server[0]", Message: `invalid IP address "dns.example.com"`},
+			{Field: "routes.config[0].next-hop-interface", Message: "interface eth2 is not defined"},
+		}))
+		Expect(err.Error()).To(ContainSubstring("failed to validate network yaml for host 0, interfaces[0].mtu: must be between 68 and 65535; "))
+	})
+
+	It("rejects duplicate interfaces and ports with IP configuration", func() {
+		err := validate(`interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  ipv4:
+    enabled: true
+    dhcp: true
+- name: eth0
+  type: ethernet
+  state: up
+- name: bond0
+  type: bond
+  state: up
+  link-aggregation:
+    mode: 802.3ad
+    port:
+    - eth0
+`)
+		Expect(fieldErrors(err)).To(Equal([]snc.FieldError{
+			{Field: "interfaces[1].name", Message: "interface eth0 is defined more than once"},
+			{Field: "interfaces[2].link-aggregation.port", Message: "port eth0 can't have IP configuration"},
+		}))
+	})
+
+	It("rejects invalid YAML", func() {
+		err := validate("interfaces: [")
+		Expect(fieldErrors(err)).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,178 @@
+package staticnetworkconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"text/template"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/openshift/assisted-service/internal/ipam"
+	"github.com/openshift/assisted-service/models"
+	"github.com/pkg/errors"
+)
+
+// TemplateHostData is the data that the network yaml template of a static network config template is executed with
+// for every host
+type TemplateHostData struct {
+	// HostIndex is the index of the host in the list of hosts of the template
+	HostIndex int
+	// MacAddresses maps the logical interface names of the host to their mac addresses
+	MacAddresses map[string]string
+	// Variables are the variables of the host
+	Variables map[string]string
+
+	ip           string
+	prefixLength int
+	// reserve reserves the address of the host in the IPAM pool, nil without a pool
+	reserve func() (*ipam.Address, error)
+}
+
+// IP returns the address of the host: the address picked from the IP range of the template, or else an address
+// reserved in the IPAM pool, which is only reserved when the template references it. It is empty when there is
+// neither a range nor a pool.
+func (d *TemplateHostData) IP() (string, error) {
+	if d.ip == "" && d.reserve != nil {
+		address, err := d.reserve()
+		if err != nil {
+			return "", err
+		}
+		d.ip = address.IP
+		d.prefixLength = address.PrefixLength
+		d.reserve = nil
+	}
+	return d.ip, nil
+}
+
+// PrefixLength returns the prefix length of the network of the IPAM pool of the address of the host, 0 when the
+// address is picked from the IP range of the template
+func (d *TemplateHostData) PrefixLength() (int, error) {
+	if _, err := d.IP(); err != nil {
+		return 0, err
+	}
+	return d.prefixLength, nil
+}
+
+type ipRange struct {
+	start *big.Int
+	end   *big.Int
+	size  int
+}
+
+func parseIPRange(startStr, endStr string, hosts int) (*ipRange, error) {
+	if startStr == "" {
+		if endStr != "" {
+			return nil, errors.New("the end of the IP range can't be set without its start")
+		}
+		return nil, nil
+	}
+	start := net.ParseIP(startStr)
+	if start == nil {
+		return nil, errors.Errorf("the start of the IP range %s isn't a valid IP address", startStr)
+	}
+	size := net.IPv6len
+	if v4 := start.To4(); v4 != nil {
+		start = v4
+		size = net.IPv4len
+	}
+	ret := &ipRange{start: new(big.Int).SetBytes(start), size: size}
+	if endStr == "" {
+		ret.end = new(big.Int).Add(ret.start, big.NewInt(int64(hosts-1)))
+	} else {
+		end := net.ParseIP(endStr)
+		if end == nil {
+			return nil, errors.Errorf("the end of the IP range %s isn't a valid IP address", endStr)
+		}
+		if (end.To4() != nil) != (size == net.IPv4len) {
+			return nil, errors.Errorf("the start and the end of the IP range %s-%s aren't of the same IP family", startStr, endStr)
+		}
+		if size == net.IPv4len {
+			end = end.To4()
+		}
+		ret.end = new(big.Int).SetBytes(end)
+		if ret.end.Cmp(ret.start) < 0 {
+			return nil, errors.Errorf("the end of the IP range %s-%s is before its start", startStr, endStr)
+		}
+	}
+	if ret.end.BitLen() > size*8 {
+		return nil, errors.Errorf("the IP range starting at %s overflows the address space", startStr)
+	}
+	return ret, nil
+}
+
+func (r *ipRange) get(index int) (string, error) {
+	ip := new(big.Int).Add(r.start, big.NewInt(int64(index)))
+	if ip.Cmp(r.end) > 0 {
+		return "", errors.New("the IP range doesn't have enough addresses for all the hosts")
+	}
+	return net.IP(ip.FillBytes(make([]byte, r.size))).String(), nil
+}
+
+// ExpandStaticNetworkConfigTemplate expands the network yaml template once per host of the template, and returns the
+// static network configuration of the hosts in the order of the list. When the template has no IP range and a pool is
+// given, the addresses of the hosts are reserved in the pool of the IP address management for the owner.
+func ExpandStaticNetworkConfigTemplate(ctx context.Context, tmpl *models.StaticNetworkConfigTemplate, ipamAPI ipam.API,
+	ownerID strfmt.UUID, pool string) ([]*models.HostStaticNetworkConfig, error) {
+	if tmpl == nil {
+		return nil, nil
+	}
+	if strings.TrimSpace(swag.StringValue(tmpl.NetworkYamlTemplate)) == "" {
+		return nil, errors.New("the network yaml template of the static network config template can't be empty")
+	}
+	if len(tmpl.Hosts) == 0 {
+		return nil, errors.New("the static network config template has no hosts")
+	}
+	t, err := template.New("network_yaml").Option("missingkey=error").Parse(swag.StringValue(tmpl.NetworkYamlTemplate))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the network yaml template")
+	}
+	ips, err := parseIPRange(tmpl.IPRangeStart, tmpl.IPRangeEnd, len(tmpl.Hosts))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*models.HostStaticNetworkConfig, 0, len(tmpl.Hosts))
+	for i, host := range tmpl.Hosts {
+		if host == nil {
+			return nil, errors.Errorf("failed to expand the static network config template for host %d: the host can't be null", i)
+		}
+		data := &TemplateHostData{
+			HostIndex:    i,
+			MacAddresses: make(map[string]string),
+			Variables:    host.Variables,
+		}
+		if data.Variables == nil {
+			data.Variables = make(map[string]string)
+		}
+		for _, item := range host.MacInterfaceMap {
+			data.MacAddresses[item.LogicalNicName] = item.MacAddress
+		}
+		if ips != nil {
+			if data.ip, err = ips.get(i); err != nil {
+				return nil, errors.Wrapf(err, "failed to expand the static network config template for host %d", i)
+			}
+		} else if pool != "" {
+			purpose := fmt.Sprintf("host %d", i)
+			data.reserve = func() (*ipam.Address, error) {
+				reservation, reserveErr := ipamAPI.Reserve(ctx, ownerID, pool, purpose)
+				if reserveErr != nil {
+					return nil, reserveErr
+				}
+				return &ipam.Address{IP: reservation.Address, PrefixLength: reservation.PrefixLength}, nil
+			}
+		}
+		var out bytes.Buffer
+		if err = t.Execute(&out, data); err != nil {
+			return nil, errors.Wrapf(err, "failed to expand the static network config template for host %d", i)
+		}
+		ret = append(ret, &models.HostStaticNetworkConfig{
+			NetworkYaml:     out.String(),
+			MacInterfaceMap: host.MacInterfaceMap,
+		})
+	}
+	return ret, nil
+}
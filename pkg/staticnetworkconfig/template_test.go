@@ -0,0 +1,170 @@
+package staticnetworkconfig_test
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/assisted-service/internal/common"
+	"github.com/openshift/assisted-service/internal/ipam"
+	"github.com/openshift/assisted-service/models"
+	snc "github.com/openshift/assisted-service/pkg/staticnetworkconfig"
+	"github.com/pkg/errors"
+)
+
+const networkYAMLTemplate = `interfaces:
+- name: eth0
+  type: ethernet
+  state: up
+  mac-address: {{index .MacAddresses "eth0"}}
+  ipv4:
+    enabled: true
+    address:
+    - ip: {{.IP}}
+      prefix-length: 24
+dns-resolver:
+  config:
+    server:
+    - {{.Variables.dns}}
+# host {{.HostIndex}}
+`
+
+var _ = Describe("ExpandStaticNetworkConfigTemplate", func() {
+	var tmpl *models.StaticNetworkConfigTemplate
+
+	templateHost := func(mac string, variables map[string]string) *models.StaticNetworkConfigTemplateHost {
+		return &models.StaticNetworkConfigTemplateHost{
+			MacInterfaceMap: models.MacInterfaceMap{{LogicalNicName: "eth0", MacAddress: mac}},
+			Variables:       variables,
+		}
+	}
+
+	BeforeEach(func() {
+		tmpl = &models.StaticNetworkConfigTemplate{
+			NetworkYamlTemplate: swag.String(networkYAMLTemplate),
+			IPRangeStart:        "192.168.126.10",
+			Hosts: []*models.StaticNetworkConfigTemplateHost{
+				templateHost("02:00:00:80:12:14", map[string]string{"dns": "192.168.126.1"}),
+				templateHost("02:00:00:80:12:15", map[string]string{"dns": "192.168.126.2"}),
+			},
+		}
+	})
+
+	It("expands the template for every host", func() {
+		configs, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configs).To(HaveLen(2))
+		Expect(configs[0].MacInterfaceMap).To(Equal(tmpl.Hosts[0].MacInterfaceMap))
+		Expect(configs[0].NetworkYaml).To(ContainSubstring("mac-address: 02:00:00:80:12:14"))
+		Expect(configs[0].NetworkYaml).To(ContainSubstring("ip: 192.168.126.10\n"))
+		Expect(configs[0].NetworkYaml).To(ContainSubstring("- 192.168.126.1\n"))
+		Expect(configs[0].NetworkYaml).To(ContainSubstring("# host 0"))
+		Expect(configs[1].NetworkYaml).To(ContainSubstring("mac-address: 02:00:00:80:12:15"))
+		Expect(configs[1].NetworkYaml).To(ContainSubstring("ip: 192.168.126.11\n"))
+		Expect(configs[1].NetworkYaml).To(ContainSubstring("- 192.168.126.2\n"))
+		Expect(configs[1].NetworkYaml).To(ContainSubstring("# host 1"))
+	})
+
+	It("picks IPv6 addresses from the range", func() {
+		tmpl.IPRangeStart = "2001:db8::fe"
+		configs, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configs[0].NetworkYaml).To(ContainSubstring("ip: 2001:db8::fe\n"))
+		Expect(configs[1].NetworkYaml).To(ContainSubstring("ip: 2001:db8::ff\n"))
+	})
+
+	It("fails when the range doesn't have enough addresses", func() {
+		tmpl.IPRangeEnd = "192.168.126.10"
+		_, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).To(MatchError("failed to expand the static network config template for host 1: the IP range doesn't have enough addresses for all the hosts"))
+	})
+
+	It("fails when the range is invalid", func() {
+		tmpl.IPRangeEnd = "192.168.126.9"
+		_, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).To(MatchError("the end of the IP range 192.168.126.10-192.168.126.9 is before its start"))
+
+		tmpl.IPRangeEnd = "2001:db8::1"
+		_, err = snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).To(MatchError("the start and the end of the IP range 192.168.126.10-2001:db8::1 aren't of the same IP family"))
+
+		tmpl.IPRangeStart = "255.255.255.255"
+		tmpl.IPRangeEnd = ""
+		_, err = snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).To(MatchError("the IP range starting at 255.255.255.255 overflows the address space"))
+	})
+
+	It("fails when a host lacks a variable", func() {
+		tmpl.Hosts[1].Variables = nil
+		_, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("failed to expand the static network config template for host 1"))
+	})
+
+	Context("with an IPAM pool", func() {
+		var (
+			ctrl    *gomock.Controller
+			ipamAPI *ipam.MockAPI
+			ownerID strfmt.UUID
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			ipamAPI = ipam.NewMockAPI(ctrl)
+			ownerID = strfmt.UUID(uuid.New().String())
+			tmpl.IPRangeStart = ""
+			tmpl.NetworkYamlTemplate = swag.String("address: {{.IP}}/{{.PrefixLength}}\n")
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("reserves the address of every host in the pool", func() {
+			ipamAPI.EXPECT().Reserve(gomock.Any(), ownerID, "lab", "host 0").
+				Return(&common.IPAMReservation{Address: "10.0.0.20", PrefixLength: 24}, nil).Times(1)
+			ipamAPI.EXPECT().Reserve(gomock.Any(), ownerID, "lab", "host 1").
+				Return(&common.IPAMReservation{Address: "10.0.0.21", PrefixLength: 24}, nil).Times(1)
+			configs, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, ipamAPI, ownerID, "lab")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configs[0].NetworkYaml).To(Equal("address: 10.0.0.20/24\n"))
+			Expect(configs[1].NetworkYaml).To(Equal("address: 10.0.0.21/24\n"))
+		})
+
+		It("doesn't reserve addresses when the template doesn't reference them", func() {
+			tmpl.NetworkYamlTemplate = swag.String("# host {{.HostIndex}}\n")
+			configs, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, ipamAPI, ownerID, "lab")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configs).To(HaveLen(2))
+		})
+
+		It("picks the addresses from the range when the template has one", func() {
+			tmpl.IPRangeStart = "192.168.126.10"
+			tmpl.NetworkYamlTemplate = swag.String("address: {{.IP}}\n")
+			configs, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, ipamAPI, ownerID, "lab")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configs[0].NetworkYaml).To(Equal("address: 192.168.126.10\n"))
+			Expect(configs[1].NetworkYaml).To(Equal("address: 192.168.126.11\n"))
+		})
+
+		It("fails when the pool has no free address", func() {
+			ipamAPI.EXPECT().Reserve(gomock.Any(), ownerID, "lab", "host 0").
+				Return(nil, errors.New("the pool lab has no free address")).Times(1)
+			_, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, ipamAPI, ownerID, "lab")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("failed to expand the static network config template for host 0"))
+			Expect(err.Error()).To(ContainSubstring("the pool lab has no free address"))
+		})
+	})
+
+	It("fails with an invalid template", func() {
+		tmpl.NetworkYamlTemplate = swag.String("interfaces: {{.IP")
+		_, err := snc.ExpandStaticNetworkConfigTemplate(context.Background(), tmpl, nil, "", "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("failed to parse the network yaml template"))
+	})
+})
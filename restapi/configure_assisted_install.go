@@ -130,27 +130,52 @@ type InstallerAPI interface {
 	/* V2UploadLogs Agent API to upload logs. */
 	V2UploadLogs(ctx context.Context, params installer.V2UploadLogsParams) middleware.Responder
 
+	/* V2CancelClusterBatch Cancels the ongoing installations of the clusters of the batch. */
+	V2CancelClusterBatch(ctx context.Context, params installer.V2CancelClusterBatchParams) middleware.Responder
+
 	/* V2CompleteInstallation Agent API to mark a finalizing installation as complete and progress to 100%. */
 	V2CompleteInstallation(ctx context.Context, params installer.V2CompleteInstallationParams) middleware.Responder
 
+	/* V2CreateClusterBatch Creates a batch of OpenShift cluster definitions and their infra-envs from a template and a list of per-site overrides. */
+	V2CreateClusterBatch(ctx context.Context, params installer.V2CreateClusterBatchParams) middleware.Responder
+
 	/* V2DeregisterCluster Deletes an OpenShift cluster definition. */
 	V2DeregisterCluster(ctx context.Context, params installer.V2DeregisterClusterParams) middleware.Responder
 
 	/* V2DeregisterHost Deregisters an OpenShift host. */
 	V2DeregisterHost(ctx context.Context, params installer.V2DeregisterHostParams) middleware.Responder
 
+	/* V2DownloadClusterNetworkTopology Downloads the network topology of the hosts of the cluster as a Graphviz DOT graph. */
+	V2DownloadClusterNetworkTopology(ctx context.Context, params installer.V2DownloadClusterNetworkTopologyParams) middleware.Responder
+
 	/* V2DownloadHostIgnition Downloads the customized ignition file for this bound host, produces octet stream. For unbound host - error is returned */
 	V2DownloadHostIgnition(ctx context.Context, params installer.V2DownloadHostIgnitionParams) middleware.Responder
 
 	/* V2DownloadInfraEnvFiles Downloads the customized ignition file for this host */
 	V2DownloadInfraEnvFiles(ctx context.Context, params installer.V2DownloadInfraEnvFilesParams) middleware.Responder
 
+	/* V2DownloadInfraEnvNetworkTopology Downloads the network topology of the hosts of the infra-env as a Graphviz DOT graph. */
+	V2DownloadInfraEnvNetworkTopology(ctx context.Context, params installer.V2DownloadInfraEnvNetworkTopologyParams) middleware.Responder
+
+	/* V2EraseHostDisks Securely erases the given disks of an unbound host. The result of the erasure is
+	   recorded in a signed disk erasure report. */
+	V2EraseHostDisks(ctx context.Context, params installer.V2EraseHostDisksParams) middleware.Responder
+
 	/* V2GetCluster Retrieves the details of the OpenShift cluster. */
 	V2GetCluster(ctx context.Context, params installer.V2GetClusterParams) middleware.Responder
 
+	/* V2GetClusterBatch Retrieves the details of the cluster batch, including the aggregated status of its clusters. */
+	V2GetClusterBatch(ctx context.Context, params installer.V2GetClusterBatchParams) middleware.Responder
+
 	/* V2GetClusterInstallConfig Get the cluster's install config YAML. */
 	V2GetClusterInstallConfig(ctx context.Context, params installer.V2GetClusterInstallConfigParams) middleware.Responder
 
+	/* V2GetClusterNetworkTopology Retrieves the network topology of the hosts of the cluster, built from their inventories and connectivity reports. */
+	V2GetClusterNetworkTopology(ctx context.Context, params installer.V2GetClusterNetworkTopologyParams) middleware.Responder
+
+	/* V2GetClusterSecondaryNetworks Retrieves the secondary network policies of the cluster. */
+	V2GetClusterSecondaryNetworks(ctx context.Context, params installer.V2GetClusterSecondaryNetworksParams) middleware.Responder
+
 	/* V2GetHost Retrieves the details of the OpenShift host. */
 	V2GetHost(ctx context.Context, params installer.V2GetHostParams) middleware.Responder
 
@@ -160,6 +185,9 @@ type InstallerAPI interface {
 	/* V2GetIgnoredValidations Fetch the validations which are to be ignored for this cluster. */
 	V2GetIgnoredValidations(ctx context.Context, params installer.V2GetIgnoredValidationsParams) middleware.Responder
 
+	/* V2GetInfraEnvNetworkTopology Retrieves the network topology of the hosts of the infra-env, built from their inventories and connectivity reports. */
+	V2GetInfraEnvNetworkTopology(ctx context.Context, params installer.V2GetInfraEnvNetworkTopologyParams) middleware.Responder
+
 	/* V2GetNextSteps Retrieves the next operations that the host agent needs to perform. */
 	V2GetNextSteps(ctx context.Context, params installer.V2GetNextStepsParams) middleware.Responder
 
@@ -172,12 +200,21 @@ type InstallerAPI interface {
 	/* V2InstallCluster Installs the OpenShift cluster. */
 	V2InstallCluster(ctx context.Context, params installer.V2InstallClusterParams) middleware.Responder
 
+	/* V2InstallClusterBatch Installs all the clusters of the batch. */
+	V2InstallClusterBatch(ctx context.Context, params installer.V2InstallClusterBatchParams) middleware.Responder
+
 	/* V2InstallHost install specific host for day2 cluster. */
 	V2InstallHost(ctx context.Context, params installer.V2InstallHostParams) middleware.Responder
 
+	/* V2ListClusterBatches Retrieves the list of cluster batches. */
+	V2ListClusterBatches(ctx context.Context, params installer.V2ListClusterBatchesParams) middleware.Responder
+
 	/* V2ListClusters Retrieves the list of OpenShift clusters. */
 	V2ListClusters(ctx context.Context, params installer.V2ListClustersParams) middleware.Responder
 
+	/* V2ListHostDiskErasureReports Retrieves the signed disk erasure reports of the host. */
+	V2ListHostDiskErasureReports(ctx context.Context, params installer.V2ListHostDiskErasureReportsParams) middleware.Responder
+
 	/* V2ListHosts Retrieves the list of OpenShift hosts that belong the infra-env. */
 	V2ListHosts(ctx context.Context, params installer.V2ListHostsParams) middleware.Responder
 
@@ -202,6 +239,9 @@ type InstallerAPI interface {
 	/* V2SetIgnoredValidations Register the validations which are to be ignored for this cluster. */
 	V2SetIgnoredValidations(ctx context.Context, params installer.V2SetIgnoredValidationsParams) middleware.Responder
 
+	/* V2SuggestNetworks Suggests cluster and service networks that don't overlap the networks of the hosts of the cluster, the reserved networks of the service and the networks of the other clusters of the organization. */
+	V2SuggestNetworks(ctx context.Context, params installer.V2SuggestNetworksParams) middleware.Responder
+
 	/* V2UpdateClusterFinalizingProgress Update installation finalizing progress. */
 	V2UpdateClusterFinalizingProgress(ctx context.Context, params installer.V2UpdateClusterFinalizingProgressParams) middleware.Responder
 
@@ -211,6 +251,9 @@ type InstallerAPI interface {
 	/* V2UpdateClusterLogsProgress Update log collection state and progress. */
 	V2UpdateClusterLogsProgress(ctx context.Context, params installer.V2UpdateClusterLogsProgressParams) middleware.Responder
 
+	/* V2UpdateClusterSecondaryNetworks Sets the secondary network policies of the cluster, the NMState configurations applied to the selected hosts of the cluster at installation time. */
+	V2UpdateClusterSecondaryNetworks(ctx context.Context, params installer.V2UpdateClusterSecondaryNetworksParams) middleware.Responder
+
 	/* V2UpdateHost Update an Openshift host */
 	V2UpdateHost(ctx context.Context, params installer.V2UpdateHostParams) middleware.Responder
 
@@ -262,6 +305,15 @@ type ManifestsAPI interface {
 
 /* OperatorsAPI  */
 type OperatorsAPI interface {
+	/* V2EnableClusterOperators Enables additional OLM operators on an installed cluster. The hardware of the nodes of the cluster is validated against the requirements of the operators, the manifests of the operators are applied to the cluster and the operators are added to the monitored operators of the cluster, whose status tracks the rollout. Enabling an operator that is already enabled applies its manifests again. */
+	V2EnableClusterOperators(ctx context.Context, params operators.V2EnableClusterOperatorsParams) middleware.Responder
+
+	/* V2GetBundle Retrieves a bundle of operators. */
+	V2GetBundle(ctx context.Context, params operators.V2GetBundleParams) middleware.Responder
+
+	/* V2ListBundles Lists the bundles of operators that can be installed together, such as virtualization. When an OpenShift version is given, the bundles that aren't supported on the version, CPU architecture and platform are omitted. */
+	V2ListBundles(ctx context.Context, params operators.V2ListBundlesParams) middleware.Responder
+
 	/* V2ListOfClusterOperators Lists operators to be monitored for a cluster. */
 	V2ListOfClusterOperators(ctx context.Context, params operators.V2ListOfClusterOperatorsParams) middleware.Responder
 
@@ -536,6 +588,16 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadClusterLogs(ctx, params)
 	})
+	api.OperatorsV2EnableClusterOperatorsHandler = operators.V2EnableClusterOperatorsHandlerFunc(func(params operators.V2EnableClusterOperatorsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2EnableClusterOperators(ctx, params)
+	})
+	api.OperatorsV2GetBundleHandler = operators.V2GetBundleHandlerFunc(func(params operators.V2GetBundleParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2GetBundle(ctx, params)
+	})
 	api.InstallerV2GetClusterDefaultConfigHandler = installer.V2GetClusterDefaultConfigHandlerFunc(func(params installer.V2GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -561,6 +623,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetPresignedForClusterFiles(ctx, params)
 	})
+	api.OperatorsV2ListBundlesHandler = operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.OperatorsAPI.V2ListBundles(ctx, params)
+	})
 	api.ManifestsV2ListClusterManifestsHandler = manifests.V2ListClusterManifestsHandlerFunc(func(params manifests.V2ListClusterManifestsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -606,11 +673,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UploadLogs(ctx, params)
 	})
+	api.InstallerV2CancelClusterBatchHandler = installer.V2CancelClusterBatchHandlerFunc(func(params installer.V2CancelClusterBatchParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CancelClusterBatch(ctx, params)
+	})
 	api.InstallerV2CompleteInstallationHandler = installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2CompleteInstallation(ctx, params)
 	})
+	api.InstallerV2CreateClusterBatchHandler = installer.V2CreateClusterBatchHandlerFunc(func(params installer.V2CreateClusterBatchParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2CreateClusterBatch(ctx, params)
+	})
 	api.InstallerV2DeregisterClusterHandler = installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -626,6 +703,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.ManifestsAPI.V2DownloadClusterManifest(ctx, params)
 	})
+	api.InstallerV2DownloadClusterNetworkTopologyHandler = installer.V2DownloadClusterNetworkTopologyHandlerFunc(func(params installer.V2DownloadClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DownloadClusterNetworkTopology(ctx, params)
+	})
 	api.InstallerV2DownloadHostIgnitionHandler = installer.V2DownloadHostIgnitionHandlerFunc(func(params installer.V2DownloadHostIgnitionParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -636,16 +718,41 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2DownloadInfraEnvFiles(ctx, params)
 	})
+	api.InstallerV2DownloadInfraEnvNetworkTopologyHandler = installer.V2DownloadInfraEnvNetworkTopologyHandlerFunc(func(params installer.V2DownloadInfraEnvNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2DownloadInfraEnvNetworkTopology(ctx, params)
+	})
+	api.InstallerV2EraseHostDisksHandler = installer.V2EraseHostDisksHandlerFunc(func(params installer.V2EraseHostDisksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2EraseHostDisks(ctx, params)
+	})
 	api.InstallerV2GetClusterHandler = installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetCluster(ctx, params)
 	})
+	api.InstallerV2GetClusterBatchHandler = installer.V2GetClusterBatchHandlerFunc(func(params installer.V2GetClusterBatchParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterBatch(ctx, params)
+	})
 	api.InstallerV2GetClusterInstallConfigHandler = installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetClusterInstallConfig(ctx, params)
 	})
+	api.InstallerV2GetClusterNetworkTopologyHandler = installer.V2GetClusterNetworkTopologyHandlerFunc(func(params installer.V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterNetworkTopology(ctx, params)
+	})
+	api.InstallerV2GetClusterSecondaryNetworksHandler = installer.V2GetClusterSecondaryNetworksHandlerFunc(func(params installer.V2GetClusterSecondaryNetworksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetClusterSecondaryNetworks(ctx, params)
+	})
 	api.InstallerV2GetHostHandler = installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -661,6 +768,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2GetIgnoredValidations(ctx, params)
 	})
+	api.InstallerV2GetInfraEnvNetworkTopologyHandler = installer.V2GetInfraEnvNetworkTopologyHandlerFunc(func(params installer.V2GetInfraEnvNetworkTopologyParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2GetInfraEnvNetworkTopology(ctx, params)
+	})
 	api.InstallerV2GetNextStepsHandler = installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -681,11 +793,21 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallCluster(ctx, params)
 	})
+	api.InstallerV2InstallClusterBatchHandler = installer.V2InstallClusterBatchHandlerFunc(func(params installer.V2InstallClusterBatchParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2InstallClusterBatch(ctx, params)
+	})
 	api.InstallerV2InstallHostHandler = installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2InstallHost(ctx, params)
 	})
+	api.InstallerV2ListClusterBatchesHandler = installer.V2ListClusterBatchesHandlerFunc(func(params installer.V2ListClusterBatchesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListClusterBatches(ctx, params)
+	})
 	api.InstallerV2ListClustersHandler = installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -701,6 +823,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.EventsAPI.V2ListEvents(ctx, params)
 	})
+	api.InstallerV2ListHostDiskErasureReportsHandler = installer.V2ListHostDiskErasureReportsHandlerFunc(func(params installer.V2ListHostDiskErasureReportsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2ListHostDiskErasureReports(ctx, params)
+	})
 	api.InstallerV2ListHostsHandler = installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -756,6 +883,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2SetIgnoredValidations(ctx, params)
 	})
+	api.InstallerV2SuggestNetworksHandler = installer.V2SuggestNetworksHandlerFunc(func(params installer.V2SuggestNetworksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2SuggestNetworks(ctx, params)
+	})
 	api.EventsV2TriggerEventHandler = events.V2TriggerEventHandlerFunc(func(params events.V2TriggerEventParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
@@ -776,6 +908,11 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.V2UpdateClusterLogsProgress(ctx, params)
 	})
+	api.InstallerV2UpdateClusterSecondaryNetworksHandler = installer.V2UpdateClusterSecondaryNetworksHandlerFunc(func(params installer.V2UpdateClusterSecondaryNetworksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.V2UpdateClusterSecondaryNetworks(ctx, params)
+	})
 	api.InstallerV2UpdateHostHandler = installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		ctx = storeAuth(ctx, principal)
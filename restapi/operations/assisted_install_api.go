@@ -120,6 +120,12 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2DownloadClusterLogsHandler: installer.V2DownloadClusterLogsHandlerFunc(func(params installer.V2DownloadClusterLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadClusterLogs has not yet been implemented")
 		}),
+		OperatorsV2EnableClusterOperatorsHandler: operators.V2EnableClusterOperatorsHandlerFunc(func(params operators.V2EnableClusterOperatorsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2EnableClusterOperators has not yet been implemented")
+		}),
+		OperatorsV2GetBundleHandler: operators.V2GetBundleHandlerFunc(func(params operators.V2GetBundleParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2GetBundle has not yet been implemented")
+		}),
 		InstallerV2GetClusterDefaultConfigHandler: installer.V2GetClusterDefaultConfigHandlerFunc(func(params installer.V2GetClusterDefaultConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterDefaultConfig has not yet been implemented")
 		}),
@@ -135,6 +141,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetPresignedForClusterFilesHandler: installer.V2GetPresignedForClusterFilesHandlerFunc(func(params installer.V2GetPresignedForClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetPresignedForClusterFiles has not yet been implemented")
 		}),
+		OperatorsV2ListBundlesHandler: operators.V2ListBundlesHandlerFunc(func(params operators.V2ListBundlesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation operators.V2ListBundles has not yet been implemented")
+		}),
 		ManifestsV2ListClusterManifestsHandler: manifests.V2ListClusterManifestsHandlerFunc(func(params manifests.V2ListClusterManifestsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2ListClusterManifests has not yet been implemented")
 		}),
@@ -162,9 +171,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UploadLogsHandler: installer.V2UploadLogsHandlerFunc(func(params installer.V2UploadLogsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UploadLogs has not yet been implemented")
 		}),
+		InstallerV2CancelClusterBatchHandler: installer.V2CancelClusterBatchHandlerFunc(func(params installer.V2CancelClusterBatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CancelClusterBatch has not yet been implemented")
+		}),
 		InstallerV2CompleteInstallationHandler: installer.V2CompleteInstallationHandlerFunc(func(params installer.V2CompleteInstallationParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2CompleteInstallation has not yet been implemented")
 		}),
+		InstallerV2CreateClusterBatchHandler: installer.V2CreateClusterBatchHandlerFunc(func(params installer.V2CreateClusterBatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2CreateClusterBatch has not yet been implemented")
+		}),
 		InstallerV2DeregisterClusterHandler: installer.V2DeregisterClusterHandlerFunc(func(params installer.V2DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DeregisterCluster has not yet been implemented")
 		}),
@@ -174,18 +189,36 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		ManifestsV2DownloadClusterManifestHandler: manifests.V2DownloadClusterManifestHandlerFunc(func(params manifests.V2DownloadClusterManifestParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation manifests.V2DownloadClusterManifest has not yet been implemented")
 		}),
+		InstallerV2DownloadClusterNetworkTopologyHandler: installer.V2DownloadClusterNetworkTopologyHandlerFunc(func(params installer.V2DownloadClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DownloadClusterNetworkTopology has not yet been implemented")
+		}),
 		InstallerV2DownloadHostIgnitionHandler: installer.V2DownloadHostIgnitionHandlerFunc(func(params installer.V2DownloadHostIgnitionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadHostIgnition has not yet been implemented")
 		}),
 		InstallerV2DownloadInfraEnvFilesHandler: installer.V2DownloadInfraEnvFilesHandlerFunc(func(params installer.V2DownloadInfraEnvFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvFiles has not yet been implemented")
 		}),
+		InstallerV2DownloadInfraEnvNetworkTopologyHandler: installer.V2DownloadInfraEnvNetworkTopologyHandlerFunc(func(params installer.V2DownloadInfraEnvNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2DownloadInfraEnvNetworkTopology has not yet been implemented")
+		}),
+		InstallerV2EraseHostDisksHandler: installer.V2EraseHostDisksHandlerFunc(func(params installer.V2EraseHostDisksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2EraseHostDisks has not yet been implemented")
+		}),
 		InstallerV2GetClusterHandler: installer.V2GetClusterHandlerFunc(func(params installer.V2GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetCluster has not yet been implemented")
 		}),
+		InstallerV2GetClusterBatchHandler: installer.V2GetClusterBatchHandlerFunc(func(params installer.V2GetClusterBatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterBatch has not yet been implemented")
+		}),
 		InstallerV2GetClusterInstallConfigHandler: installer.V2GetClusterInstallConfigHandlerFunc(func(params installer.V2GetClusterInstallConfigParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetClusterInstallConfig has not yet been implemented")
 		}),
+		InstallerV2GetClusterNetworkTopologyHandler: installer.V2GetClusterNetworkTopologyHandlerFunc(func(params installer.V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterNetworkTopology has not yet been implemented")
+		}),
+		InstallerV2GetClusterSecondaryNetworksHandler: installer.V2GetClusterSecondaryNetworksHandlerFunc(func(params installer.V2GetClusterSecondaryNetworksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetClusterSecondaryNetworks has not yet been implemented")
+		}),
 		InstallerV2GetHostHandler: installer.V2GetHostHandlerFunc(func(params installer.V2GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetHost has not yet been implemented")
 		}),
@@ -195,6 +228,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2GetIgnoredValidationsHandler: installer.V2GetIgnoredValidationsHandlerFunc(func(params installer.V2GetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetIgnoredValidations has not yet been implemented")
 		}),
+		InstallerV2GetInfraEnvNetworkTopologyHandler: installer.V2GetInfraEnvNetworkTopologyHandlerFunc(func(params installer.V2GetInfraEnvNetworkTopologyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2GetInfraEnvNetworkTopology has not yet been implemented")
+		}),
 		InstallerV2GetNextStepsHandler: installer.V2GetNextStepsHandlerFunc(func(params installer.V2GetNextStepsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2GetNextSteps has not yet been implemented")
 		}),
@@ -207,9 +243,15 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2InstallClusterHandler: installer.V2InstallClusterHandlerFunc(func(params installer.V2InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallCluster has not yet been implemented")
 		}),
+		InstallerV2InstallClusterBatchHandler: installer.V2InstallClusterBatchHandlerFunc(func(params installer.V2InstallClusterBatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2InstallClusterBatch has not yet been implemented")
+		}),
 		InstallerV2InstallHostHandler: installer.V2InstallHostHandlerFunc(func(params installer.V2InstallHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2InstallHost has not yet been implemented")
 		}),
+		InstallerV2ListClusterBatchesHandler: installer.V2ListClusterBatchesHandlerFunc(func(params installer.V2ListClusterBatchesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListClusterBatches has not yet been implemented")
+		}),
 		InstallerV2ListClustersHandler: installer.V2ListClustersHandlerFunc(func(params installer.V2ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListClusters has not yet been implemented")
 		}),
@@ -219,6 +261,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		EventsV2ListEventsHandler: events.V2ListEventsHandlerFunc(func(params events.V2ListEventsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2ListEvents has not yet been implemented")
 		}),
+		InstallerV2ListHostDiskErasureReportsHandler: installer.V2ListHostDiskErasureReportsHandlerFunc(func(params installer.V2ListHostDiskErasureReportsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2ListHostDiskErasureReports has not yet been implemented")
+		}),
 		InstallerV2ListHostsHandler: installer.V2ListHostsHandlerFunc(func(params installer.V2ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2ListHosts has not yet been implemented")
 		}),
@@ -252,6 +297,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2SetIgnoredValidationsHandler: installer.V2SetIgnoredValidationsHandlerFunc(func(params installer.V2SetIgnoredValidationsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2SetIgnoredValidations has not yet been implemented")
 		}),
+		InstallerV2SuggestNetworksHandler: installer.V2SuggestNetworksHandlerFunc(func(params installer.V2SuggestNetworksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2SuggestNetworks has not yet been implemented")
+		}),
 		EventsV2TriggerEventHandler: events.V2TriggerEventHandlerFunc(func(params events.V2TriggerEventParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation events.V2TriggerEvent has not yet been implemented")
 		}),
@@ -264,6 +312,9 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		InstallerV2UpdateClusterLogsProgressHandler: installer.V2UpdateClusterLogsProgressHandlerFunc(func(params installer.V2UpdateClusterLogsProgressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateClusterLogsProgress has not yet been implemented")
 		}),
+		InstallerV2UpdateClusterSecondaryNetworksHandler: installer.V2UpdateClusterSecondaryNetworksHandlerFunc(func(params installer.V2UpdateClusterSecondaryNetworksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.V2UpdateClusterSecondaryNetworks has not yet been implemented")
+		}),
 		InstallerV2UpdateHostHandler: installer.V2UpdateHostHandlerFunc(func(params installer.V2UpdateHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.V2UpdateHost has not yet been implemented")
 		}),
@@ -424,6 +475,10 @@ type AssistedInstallAPI struct {
 	InstallerV2DownloadClusterFilesHandler installer.V2DownloadClusterFilesHandler
 	// InstallerV2DownloadClusterLogsHandler sets the operation handler for the v2 download cluster logs operation
 	InstallerV2DownloadClusterLogsHandler installer.V2DownloadClusterLogsHandler
+	// OperatorsV2EnableClusterOperatorsHandler sets the operation handler for the v2 enable cluster operators operation
+	OperatorsV2EnableClusterOperatorsHandler operators.V2EnableClusterOperatorsHandler
+	// OperatorsV2GetBundleHandler sets the operation handler for the v2 get bundle operation
+	OperatorsV2GetBundleHandler operators.V2GetBundleHandler
 	// InstallerV2GetClusterDefaultConfigHandler sets the operation handler for the v2 get cluster default config operation
 	InstallerV2GetClusterDefaultConfigHandler installer.V2GetClusterDefaultConfigHandler
 	// InstallerV2GetClusterUISettingsHandler sets the operation handler for the v2 get cluster UI settings operation
@@ -434,6 +489,8 @@ type AssistedInstallAPI struct {
 	InstallerV2GetPresignedForClusterCredentialsHandler installer.V2GetPresignedForClusterCredentialsHandler
 	// InstallerV2GetPresignedForClusterFilesHandler sets the operation handler for the v2 get presigned for cluster files operation
 	InstallerV2GetPresignedForClusterFilesHandler installer.V2GetPresignedForClusterFilesHandler
+	// OperatorsV2ListBundlesHandler sets the operation handler for the v2 list bundles operation
+	OperatorsV2ListBundlesHandler operators.V2ListBundlesHandler
 	// ManifestsV2ListClusterManifestsHandler sets the operation handler for the v2 list cluster manifests operation
 	ManifestsV2ListClusterManifestsHandler manifests.V2ListClusterManifestsHandler
 	// ManagedDomainsV2ListManagedDomainsHandler sets the operation handler for the v2 list managed domains operation
@@ -452,28 +509,46 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterUISettingsHandler installer.V2UpdateClusterUISettingsHandler
 	// InstallerV2UploadLogsHandler sets the operation handler for the v2 upload logs operation
 	InstallerV2UploadLogsHandler installer.V2UploadLogsHandler
+	// InstallerV2CancelClusterBatchHandler sets the operation handler for the v2 cancel cluster batch operation
+	InstallerV2CancelClusterBatchHandler installer.V2CancelClusterBatchHandler
 	// InstallerV2CompleteInstallationHandler sets the operation handler for the v2 complete installation operation
 	InstallerV2CompleteInstallationHandler installer.V2CompleteInstallationHandler
+	// InstallerV2CreateClusterBatchHandler sets the operation handler for the v2 create cluster batch operation
+	InstallerV2CreateClusterBatchHandler installer.V2CreateClusterBatchHandler
 	// InstallerV2DeregisterClusterHandler sets the operation handler for the v2 deregister cluster operation
 	InstallerV2DeregisterClusterHandler installer.V2DeregisterClusterHandler
 	// InstallerV2DeregisterHostHandler sets the operation handler for the v2 deregister host operation
 	InstallerV2DeregisterHostHandler installer.V2DeregisterHostHandler
 	// ManifestsV2DownloadClusterManifestHandler sets the operation handler for the v2 download cluster manifest operation
 	ManifestsV2DownloadClusterManifestHandler manifests.V2DownloadClusterManifestHandler
+	// InstallerV2DownloadClusterNetworkTopologyHandler sets the operation handler for the v2 download cluster network topology operation
+	InstallerV2DownloadClusterNetworkTopologyHandler installer.V2DownloadClusterNetworkTopologyHandler
 	// InstallerV2DownloadHostIgnitionHandler sets the operation handler for the v2 download host ignition operation
 	InstallerV2DownloadHostIgnitionHandler installer.V2DownloadHostIgnitionHandler
 	// InstallerV2DownloadInfraEnvFilesHandler sets the operation handler for the v2 download infra env files operation
 	InstallerV2DownloadInfraEnvFilesHandler installer.V2DownloadInfraEnvFilesHandler
+	// InstallerV2DownloadInfraEnvNetworkTopologyHandler sets the operation handler for the v2 download infra env network topology operation
+	InstallerV2DownloadInfraEnvNetworkTopologyHandler installer.V2DownloadInfraEnvNetworkTopologyHandler
+	// InstallerV2EraseHostDisksHandler sets the operation handler for the v2 erase host disks operation
+	InstallerV2EraseHostDisksHandler installer.V2EraseHostDisksHandler
 	// InstallerV2GetClusterHandler sets the operation handler for the v2 get cluster operation
 	InstallerV2GetClusterHandler installer.V2GetClusterHandler
+	// InstallerV2GetClusterBatchHandler sets the operation handler for the v2 get cluster batch operation
+	InstallerV2GetClusterBatchHandler installer.V2GetClusterBatchHandler
 	// InstallerV2GetClusterInstallConfigHandler sets the operation handler for the v2 get cluster install config operation
 	InstallerV2GetClusterInstallConfigHandler installer.V2GetClusterInstallConfigHandler
+	// InstallerV2GetClusterNetworkTopologyHandler sets the operation handler for the v2 get cluster network topology operation
+	InstallerV2GetClusterNetworkTopologyHandler installer.V2GetClusterNetworkTopologyHandler
+	// InstallerV2GetClusterSecondaryNetworksHandler sets the operation handler for the v2 get cluster secondary networks operation
+	InstallerV2GetClusterSecondaryNetworksHandler installer.V2GetClusterSecondaryNetworksHandler
 	// InstallerV2GetHostHandler sets the operation handler for the v2 get host operation
 	InstallerV2GetHostHandler installer.V2GetHostHandler
 	// InstallerV2GetHostIgnitionHandler sets the operation handler for the v2 get host ignition operation
 	InstallerV2GetHostIgnitionHandler installer.V2GetHostIgnitionHandler
 	// InstallerV2GetIgnoredValidationsHandler sets the operation handler for the v2 get ignored validations operation
 	InstallerV2GetIgnoredValidationsHandler installer.V2GetIgnoredValidationsHandler
+	// InstallerV2GetInfraEnvNetworkTopologyHandler sets the operation handler for the v2 get infra env network topology operation
+	InstallerV2GetInfraEnvNetworkTopologyHandler installer.V2GetInfraEnvNetworkTopologyHandler
 	// InstallerV2GetNextStepsHandler sets the operation handler for the v2 get next steps operation
 	InstallerV2GetNextStepsHandler installer.V2GetNextStepsHandler
 	// InstallerV2GetPreflightRequirementsHandler sets the operation handler for the v2 get preflight requirements operation
@@ -482,14 +557,20 @@ type AssistedInstallAPI struct {
 	InstallerV2ImportClusterHandler installer.V2ImportClusterHandler
 	// InstallerV2InstallClusterHandler sets the operation handler for the v2 install cluster operation
 	InstallerV2InstallClusterHandler installer.V2InstallClusterHandler
+	// InstallerV2InstallClusterBatchHandler sets the operation handler for the v2 install cluster batch operation
+	InstallerV2InstallClusterBatchHandler installer.V2InstallClusterBatchHandler
 	// InstallerV2InstallHostHandler sets the operation handler for the v2 install host operation
 	InstallerV2InstallHostHandler installer.V2InstallHostHandler
+	// InstallerV2ListClusterBatchesHandler sets the operation handler for the v2 list cluster batches operation
+	InstallerV2ListClusterBatchesHandler installer.V2ListClusterBatchesHandler
 	// InstallerV2ListClustersHandler sets the operation handler for the v2 list clusters operation
 	InstallerV2ListClustersHandler installer.V2ListClustersHandler
 	// VersionsV2ListComponentVersionsHandler sets the operation handler for the v2 list component versions operation
 	VersionsV2ListComponentVersionsHandler versions.V2ListComponentVersionsHandler
 	// EventsV2ListEventsHandler sets the operation handler for the v2 list events operation
 	EventsV2ListEventsHandler events.V2ListEventsHandler
+	// InstallerV2ListHostDiskErasureReportsHandler sets the operation handler for the v2 list host disk erasure reports operation
+	InstallerV2ListHostDiskErasureReportsHandler installer.V2ListHostDiskErasureReportsHandler
 	// InstallerV2ListHostsHandler sets the operation handler for the v2 list hosts operation
 	InstallerV2ListHostsHandler installer.V2ListHostsHandler
 	// VersionsV2ListReleaseSourcesHandler sets the operation handler for the v2 list release sources operation
@@ -512,6 +593,8 @@ type AssistedInstallAPI struct {
 	InstallerV2ResetHostValidationHandler installer.V2ResetHostValidationHandler
 	// InstallerV2SetIgnoredValidationsHandler sets the operation handler for the v2 set ignored validations operation
 	InstallerV2SetIgnoredValidationsHandler installer.V2SetIgnoredValidationsHandler
+	// InstallerV2SuggestNetworksHandler sets the operation handler for the v2 suggest networks operation
+	InstallerV2SuggestNetworksHandler installer.V2SuggestNetworksHandler
 	// EventsV2TriggerEventHandler sets the operation handler for the v2 trigger event operation
 	EventsV2TriggerEventHandler events.V2TriggerEventHandler
 	// InstallerV2UpdateClusterFinalizingProgressHandler sets the operation handler for the v2 update cluster finalizing progress operation
@@ -520,6 +603,8 @@ type AssistedInstallAPI struct {
 	InstallerV2UpdateClusterInstallConfigHandler installer.V2UpdateClusterInstallConfigHandler
 	// InstallerV2UpdateClusterLogsProgressHandler sets the operation handler for the v2 update cluster logs progress operation
 	InstallerV2UpdateClusterLogsProgressHandler installer.V2UpdateClusterLogsProgressHandler
+	// InstallerV2UpdateClusterSecondaryNetworksHandler sets the operation handler for the v2 update cluster secondary networks operation
+	InstallerV2UpdateClusterSecondaryNetworksHandler installer.V2UpdateClusterSecondaryNetworksHandler
 	// InstallerV2UpdateHostHandler sets the operation handler for the v2 update host operation
 	InstallerV2UpdateHostHandler installer.V2UpdateHostHandler
 	// InstallerV2UpdateHostIgnitionHandler sets the operation handler for the v2 update host ignition operation
@@ -703,6 +788,12 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2DownloadClusterLogsHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadClusterLogsHandler")
 	}
+	if o.OperatorsV2EnableClusterOperatorsHandler == nil {
+		unregistered = append(unregistered, "operators.V2EnableClusterOperatorsHandler")
+	}
+	if o.OperatorsV2GetBundleHandler == nil {
+		unregistered = append(unregistered, "operators.V2GetBundleHandler")
+	}
 	if o.InstallerV2GetClusterDefaultConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterDefaultConfigHandler")
 	}
@@ -718,6 +809,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetPresignedForClusterFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetPresignedForClusterFilesHandler")
 	}
+	if o.OperatorsV2ListBundlesHandler == nil {
+		unregistered = append(unregistered, "operators.V2ListBundlesHandler")
+	}
 	if o.ManifestsV2ListClusterManifestsHandler == nil {
 		unregistered = append(unregistered, "manifests.V2ListClusterManifestsHandler")
 	}
@@ -745,9 +839,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UploadLogsHandler == nil {
 		unregistered = append(unregistered, "installer.V2UploadLogsHandler")
 	}
+	if o.InstallerV2CancelClusterBatchHandler == nil {
+		unregistered = append(unregistered, "installer.V2CancelClusterBatchHandler")
+	}
 	if o.InstallerV2CompleteInstallationHandler == nil {
 		unregistered = append(unregistered, "installer.V2CompleteInstallationHandler")
 	}
+	if o.InstallerV2CreateClusterBatchHandler == nil {
+		unregistered = append(unregistered, "installer.V2CreateClusterBatchHandler")
+	}
 	if o.InstallerV2DeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2DeregisterClusterHandler")
 	}
@@ -757,18 +857,36 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.ManifestsV2DownloadClusterManifestHandler == nil {
 		unregistered = append(unregistered, "manifests.V2DownloadClusterManifestHandler")
 	}
+	if o.InstallerV2DownloadClusterNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.V2DownloadClusterNetworkTopologyHandler")
+	}
 	if o.InstallerV2DownloadHostIgnitionHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadHostIgnitionHandler")
 	}
 	if o.InstallerV2DownloadInfraEnvFilesHandler == nil {
 		unregistered = append(unregistered, "installer.V2DownloadInfraEnvFilesHandler")
 	}
+	if o.InstallerV2DownloadInfraEnvNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.V2DownloadInfraEnvNetworkTopologyHandler")
+	}
+	if o.InstallerV2EraseHostDisksHandler == nil {
+		unregistered = append(unregistered, "installer.V2EraseHostDisksHandler")
+	}
 	if o.InstallerV2GetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterHandler")
 	}
+	if o.InstallerV2GetClusterBatchHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterBatchHandler")
+	}
 	if o.InstallerV2GetClusterInstallConfigHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetClusterInstallConfigHandler")
 	}
+	if o.InstallerV2GetClusterNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterNetworkTopologyHandler")
+	}
+	if o.InstallerV2GetClusterSecondaryNetworksHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetClusterSecondaryNetworksHandler")
+	}
 	if o.InstallerV2GetHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetHostHandler")
 	}
@@ -778,6 +896,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2GetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetIgnoredValidationsHandler")
 	}
+	if o.InstallerV2GetInfraEnvNetworkTopologyHandler == nil {
+		unregistered = append(unregistered, "installer.V2GetInfraEnvNetworkTopologyHandler")
+	}
 	if o.InstallerV2GetNextStepsHandler == nil {
 		unregistered = append(unregistered, "installer.V2GetNextStepsHandler")
 	}
@@ -790,9 +911,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2InstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallClusterHandler")
 	}
+	if o.InstallerV2InstallClusterBatchHandler == nil {
+		unregistered = append(unregistered, "installer.V2InstallClusterBatchHandler")
+	}
 	if o.InstallerV2InstallHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2InstallHostHandler")
 	}
+	if o.InstallerV2ListClusterBatchesHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListClusterBatchesHandler")
+	}
 	if o.InstallerV2ListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListClustersHandler")
 	}
@@ -802,6 +929,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.EventsV2ListEventsHandler == nil {
 		unregistered = append(unregistered, "events.V2ListEventsHandler")
 	}
+	if o.InstallerV2ListHostDiskErasureReportsHandler == nil {
+		unregistered = append(unregistered, "installer.V2ListHostDiskErasureReportsHandler")
+	}
 	if o.InstallerV2ListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.V2ListHostsHandler")
 	}
@@ -835,6 +965,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2SetIgnoredValidationsHandler == nil {
 		unregistered = append(unregistered, "installer.V2SetIgnoredValidationsHandler")
 	}
+	if o.InstallerV2SuggestNetworksHandler == nil {
+		unregistered = append(unregistered, "installer.V2SuggestNetworksHandler")
+	}
 	if o.EventsV2TriggerEventHandler == nil {
 		unregistered = append(unregistered, "events.V2TriggerEventHandler")
 	}
@@ -847,6 +980,9 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerV2UpdateClusterLogsProgressHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateClusterLogsProgressHandler")
 	}
+	if o.InstallerV2UpdateClusterSecondaryNetworksHandler == nil {
+		unregistered = append(unregistered, "installer.V2UpdateClusterSecondaryNetworksHandler")
+	}
 	if o.InstallerV2UpdateHostHandler == nil {
 		unregistered = append(unregistered, "installer.V2UpdateHostHandler")
 	}
@@ -1078,6 +1214,14 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/logs"] = installer.NewV2DownloadClusterLogs(o.context, o.InstallerV2DownloadClusterLogsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/clusters/{cluster_id}/monitored-operators"] = operators.NewV2EnableClusterOperators(o.context, o.OperatorsV2EnableClusterOperatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/operators/bundles/{bundle_id}"] = operators.NewV2GetBundle(o.context, o.OperatorsV2GetBundleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1101,6 +1245,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/operators/bundles"] = operators.NewV2ListBundles(o.context, o.OperatorsV2ListBundlesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/manifests"] = manifests.NewV2ListClusterManifests(o.context, o.ManifestsV2ListClusterManifestsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1137,7 +1285,15 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/cluster-batches/{cluster_batch_id}/actions/cancel"] = installer.NewV2CancelClusterBatch(o.context, o.InstallerV2CancelClusterBatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/clusters/{cluster_id}/actions/complete-installation"] = installer.NewV2CompleteInstallation(o.context, o.InstallerV2CompleteInstallationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/cluster-batches"] = installer.NewV2CreateClusterBatch(o.context, o.InstallerV2CreateClusterBatchHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -1153,6 +1309,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/downloads/network-topology"] = installer.NewV2DownloadClusterNetworkTopology(o.context, o.InstallerV2DownloadClusterNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-env/{infra_env_id}/hosts/{host_id}/downloads/ignition"] = installer.NewV2DownloadHostIgnition(o.context, o.InstallerV2DownloadHostIgnitionHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1161,14 +1321,34 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/downloads/network-topology"] = installer.NewV2DownloadInfraEnvNetworkTopology(o.context, o.InstallerV2DownloadInfraEnvNetworkTopologyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks"] = installer.NewV2EraseHostDisks(o.context, o.InstallerV2EraseHostDisksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}"] = installer.NewV2GetCluster(o.context, o.InstallerV2GetClusterHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/cluster-batches/{cluster_batch_id}"] = installer.NewV2GetClusterBatch(o.context, o.InstallerV2GetClusterBatchHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters/{cluster_id}/install-config"] = installer.NewV2GetClusterInstallConfig(o.context, o.InstallerV2GetClusterInstallConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/network-topology"] = installer.NewV2GetClusterNetworkTopology(o.context, o.InstallerV2GetClusterNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/secondary-networks"] = installer.NewV2GetClusterSecondaryNetworks(o.context, o.InstallerV2GetClusterSecondaryNetworksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}"] = installer.NewV2GetHost(o.context, o.InstallerV2GetHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1181,6 +1361,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/network-topology"] = installer.NewV2GetInfraEnvNetworkTopology(o.context, o.InstallerV2GetInfraEnvNetworkTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/instructions"] = installer.NewV2GetNextSteps(o.context, o.InstallerV2GetNextStepsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1197,10 +1381,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/v2/cluster-batches/{cluster_batch_id}/actions/install"] = installer.NewV2InstallClusterBatch(o.context, o.InstallerV2InstallClusterBatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/install"] = installer.NewV2InstallHost(o.context, o.InstallerV2InstallHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/cluster-batches"] = installer.NewV2ListClusterBatches(o.context, o.InstallerV2ListClusterBatchesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/clusters"] = installer.NewV2ListClusters(o.context, o.InstallerV2ListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1213,6 +1405,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports"] = installer.NewV2ListHostDiskErasureReports(o.context, o.InstallerV2ListHostDiskErasureReportsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/v2/infra-envs/{infra_env_id}/hosts"] = installer.NewV2ListHosts(o.context, o.InstallerV2ListHostsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1254,6 +1450,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/v2/clusters/{cluster_id}/ignored-validations"] = installer.NewV2SetIgnoredValidations(o.context, o.InstallerV2SetIgnoredValidationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/v2/clusters/{cluster_id}/suggest-networks"] = installer.NewV2SuggestNetworks(o.context, o.InstallerV2SuggestNetworksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1270,6 +1470,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/v2/clusters/{cluster_id}/logs-progress"] = installer.NewV2UpdateClusterLogsProgress(o.context, o.InstallerV2UpdateClusterLogsProgressHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/v2/clusters/{cluster_id}/secondary-networks"] = installer.NewV2UpdateClusterSecondaryNetworks(o.context, o.InstallerV2UpdateClusterSecondaryNetworksHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2CancelClusterBatchHandlerFunc turns a function with the right signature into a v2 cancel cluster batch handler
+type V2CancelClusterBatchHandlerFunc func(V2CancelClusterBatchParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2CancelClusterBatchHandlerFunc) Handle(params V2CancelClusterBatchParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2CancelClusterBatchHandler interface for that can handle valid v2 cancel cluster batch params
+type V2CancelClusterBatchHandler interface {
+	Handle(V2CancelClusterBatchParams, interface{}) middleware.Responder
+}
+
+// NewV2CancelClusterBatch creates a new http.Handler for the v2 cancel cluster batch operation
+func NewV2CancelClusterBatch(ctx *middleware.Context, handler V2CancelClusterBatchHandler) *V2CancelClusterBatch {
+	return &V2CancelClusterBatch{Context: ctx, Handler: handler}
+}
+
+/*
+	V2CancelClusterBatch swagger:route POST /v2/cluster-batches/{cluster_batch_id}/actions/cancel installer v2CancelClusterBatch
+
+Cancels the ongoing installations of the clusters of the batch.
+*/
+type V2CancelClusterBatch struct {
+	Context *middleware.Context
+	Handler V2CancelClusterBatchHandler
+}
+
+func (o *V2CancelClusterBatch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2CancelClusterBatchParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
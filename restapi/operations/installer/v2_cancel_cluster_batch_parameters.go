@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2CancelClusterBatchParams creates a new V2CancelClusterBatchParams object
+//
+// There are no default values defined in the spec.
+func NewV2CancelClusterBatchParams() V2CancelClusterBatchParams {
+
+	return V2CancelClusterBatchParams{}
+}
+
+// V2CancelClusterBatchParams contains all the bound params for the v2 cancel cluster batch operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2CancelClusterBatch
+type V2CancelClusterBatchParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster batch whose installations are to be canceled.
+	  Required: true
+	  In: path
+	*/
+	ClusterBatchID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2CancelClusterBatchParams() beforehand.
+func (o *V2CancelClusterBatchParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rClusterBatchID, rhkClusterBatchID, _ := route.Params.GetOK("cluster_batch_id")
+	if err := o.bindClusterBatchID(rClusterBatchID, rhkClusterBatchID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterBatchID binds and validates parameter ClusterBatchID from path.
+func (o *V2CancelClusterBatchParams) bindClusterBatchID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_batch_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterBatchID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterBatchID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterBatchID carries on validations for parameter ClusterBatchID
+func (o *V2CancelClusterBatchParams) validateClusterBatchID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_batch_id", "path", "uuid", o.ClusterBatchID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
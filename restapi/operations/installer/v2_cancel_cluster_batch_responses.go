@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CancelClusterBatchAcceptedCode is the HTTP code returned for type V2CancelClusterBatchAccepted
+const V2CancelClusterBatchAcceptedCode int = 202
+
+/*
+V2CancelClusterBatchAccepted Success.
+
+swagger:response v2CancelClusterBatchAccepted
+*/
+type V2CancelClusterBatchAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterBatch `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchAccepted creates V2CancelClusterBatchAccepted with default headers values
+func NewV2CancelClusterBatchAccepted() *V2CancelClusterBatchAccepted {
+
+	return &V2CancelClusterBatchAccepted{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch accepted response
+func (o *V2CancelClusterBatchAccepted) WithPayload(payload *models.ClusterBatch) *V2CancelClusterBatchAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch accepted response
+func (o *V2CancelClusterBatchAccepted) SetPayload(payload *models.ClusterBatch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelClusterBatchUnauthorizedCode is the HTTP code returned for type V2CancelClusterBatchUnauthorized
+const V2CancelClusterBatchUnauthorizedCode int = 401
+
+/*
+V2CancelClusterBatchUnauthorized Unauthorized.
+
+swagger:response v2CancelClusterBatchUnauthorized
+*/
+type V2CancelClusterBatchUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchUnauthorized creates V2CancelClusterBatchUnauthorized with default headers values
+func NewV2CancelClusterBatchUnauthorized() *V2CancelClusterBatchUnauthorized {
+
+	return &V2CancelClusterBatchUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch unauthorized response
+func (o *V2CancelClusterBatchUnauthorized) WithPayload(payload *models.InfraError) *V2CancelClusterBatchUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch unauthorized response
+func (o *V2CancelClusterBatchUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelClusterBatchForbiddenCode is the HTTP code returned for type V2CancelClusterBatchForbidden
+const V2CancelClusterBatchForbiddenCode int = 403
+
+/*
+V2CancelClusterBatchForbidden Forbidden.
+
+swagger:response v2CancelClusterBatchForbidden
+*/
+type V2CancelClusterBatchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchForbidden creates V2CancelClusterBatchForbidden with default headers values
+func NewV2CancelClusterBatchForbidden() *V2CancelClusterBatchForbidden {
+
+	return &V2CancelClusterBatchForbidden{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch forbidden response
+func (o *V2CancelClusterBatchForbidden) WithPayload(payload *models.InfraError) *V2CancelClusterBatchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch forbidden response
+func (o *V2CancelClusterBatchForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelClusterBatchNotFoundCode is the HTTP code returned for type V2CancelClusterBatchNotFound
+const V2CancelClusterBatchNotFoundCode int = 404
+
+/*
+V2CancelClusterBatchNotFound Error.
+
+swagger:response v2CancelClusterBatchNotFound
+*/
+type V2CancelClusterBatchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchNotFound creates V2CancelClusterBatchNotFound with default headers values
+func NewV2CancelClusterBatchNotFound() *V2CancelClusterBatchNotFound {
+
+	return &V2CancelClusterBatchNotFound{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch not found response
+func (o *V2CancelClusterBatchNotFound) WithPayload(payload *models.Error) *V2CancelClusterBatchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch not found response
+func (o *V2CancelClusterBatchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelClusterBatchMethodNotAllowedCode is the HTTP code returned for type V2CancelClusterBatchMethodNotAllowed
+const V2CancelClusterBatchMethodNotAllowedCode int = 405
+
+/*
+V2CancelClusterBatchMethodNotAllowed Method Not Allowed.
+
+swagger:response v2CancelClusterBatchMethodNotAllowed
+*/
+type V2CancelClusterBatchMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchMethodNotAllowed creates V2CancelClusterBatchMethodNotAllowed with default headers values
+func NewV2CancelClusterBatchMethodNotAllowed() *V2CancelClusterBatchMethodNotAllowed {
+
+	return &V2CancelClusterBatchMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch method not allowed response
+func (o *V2CancelClusterBatchMethodNotAllowed) WithPayload(payload *models.Error) *V2CancelClusterBatchMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch method not allowed response
+func (o *V2CancelClusterBatchMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelClusterBatchConflictCode is the HTTP code returned for type V2CancelClusterBatchConflict
+const V2CancelClusterBatchConflictCode int = 409
+
+/*
+V2CancelClusterBatchConflict Error.
+
+swagger:response v2CancelClusterBatchConflict
+*/
+type V2CancelClusterBatchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchConflict creates V2CancelClusterBatchConflict with default headers values
+func NewV2CancelClusterBatchConflict() *V2CancelClusterBatchConflict {
+
+	return &V2CancelClusterBatchConflict{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch conflict response
+func (o *V2CancelClusterBatchConflict) WithPayload(payload *models.Error) *V2CancelClusterBatchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch conflict response
+func (o *V2CancelClusterBatchConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CancelClusterBatchInternalServerErrorCode is the HTTP code returned for type V2CancelClusterBatchInternalServerError
+const V2CancelClusterBatchInternalServerErrorCode int = 500
+
+/*
+V2CancelClusterBatchInternalServerError Error.
+
+swagger:response v2CancelClusterBatchInternalServerError
+*/
+type V2CancelClusterBatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CancelClusterBatchInternalServerError creates V2CancelClusterBatchInternalServerError with default headers values
+func NewV2CancelClusterBatchInternalServerError() *V2CancelClusterBatchInternalServerError {
+
+	return &V2CancelClusterBatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 cancel cluster batch internal server error response
+func (o *V2CancelClusterBatchInternalServerError) WithPayload(payload *models.Error) *V2CancelClusterBatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 cancel cluster batch internal server error response
+func (o *V2CancelClusterBatchInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CancelClusterBatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2CreateClusterBatchHandlerFunc turns a function with the right signature into a v2 create cluster batch handler
+type V2CreateClusterBatchHandlerFunc func(V2CreateClusterBatchParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2CreateClusterBatchHandlerFunc) Handle(params V2CreateClusterBatchParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2CreateClusterBatchHandler interface for that can handle valid v2 create cluster batch params
+type V2CreateClusterBatchHandler interface {
+	Handle(V2CreateClusterBatchParams, interface{}) middleware.Responder
+}
+
+// NewV2CreateClusterBatch creates a new http.Handler for the v2 create cluster batch operation
+func NewV2CreateClusterBatch(ctx *middleware.Context, handler V2CreateClusterBatchHandler) *V2CreateClusterBatch {
+	return &V2CreateClusterBatch{Context: ctx, Handler: handler}
+}
+
+/*
+	V2CreateClusterBatch swagger:route POST /v2/cluster-batches installer v2CreateClusterBatch
+
+Creates a batch of OpenShift cluster definitions and their infra-envs from a template and a list of per-site overrides.
+*/
+type V2CreateClusterBatch struct {
+	Context *middleware.Context
+	Handler V2CreateClusterBatchHandler
+}
+
+func (o *V2CreateClusterBatch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2CreateClusterBatchParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
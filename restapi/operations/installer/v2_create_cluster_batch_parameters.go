@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// NewV2CreateClusterBatchParams creates a new V2CreateClusterBatchParams object
+//
+// There are no default values defined in the spec.
+func NewV2CreateClusterBatchParams() V2CreateClusterBatchParams {
+
+	return V2CreateClusterBatchParams{}
+}
+
+// V2CreateClusterBatchParams contains all the bound params for the v2 create cluster batch operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2CreateClusterBatch
+type V2CreateClusterBatchParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The template and the sites of the new clusters.
+	  Required: true
+	  In: body
+	*/
+	NewClusterBatchParams *models.ClusterBatchCreateParams
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2CreateClusterBatchParams() beforehand.
+func (o *V2CreateClusterBatchParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.ClusterBatchCreateParams
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("newClusterBatchParams", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("newClusterBatchParams", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.NewClusterBatchParams = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("newClusterBatchParams", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
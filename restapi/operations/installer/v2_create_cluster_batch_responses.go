@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2CreateClusterBatchCreatedCode is the HTTP code returned for type V2CreateClusterBatchCreated
+const V2CreateClusterBatchCreatedCode int = 201
+
+/*
+V2CreateClusterBatchCreated Success.
+
+swagger:response v2CreateClusterBatchCreated
+*/
+type V2CreateClusterBatchCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterBatch `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterBatchCreated creates V2CreateClusterBatchCreated with default headers values
+func NewV2CreateClusterBatchCreated() *V2CreateClusterBatchCreated {
+
+	return &V2CreateClusterBatchCreated{}
+}
+
+// WithPayload adds the payload to the v2 create cluster batch created response
+func (o *V2CreateClusterBatchCreated) WithPayload(payload *models.ClusterBatch) *V2CreateClusterBatchCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster batch created response
+func (o *V2CreateClusterBatchCreated) SetPayload(payload *models.ClusterBatch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterBatchCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterBatchBadRequestCode is the HTTP code returned for type V2CreateClusterBatchBadRequest
+const V2CreateClusterBatchBadRequestCode int = 400
+
+/*
+V2CreateClusterBatchBadRequest Error.
+
+swagger:response v2CreateClusterBatchBadRequest
+*/
+type V2CreateClusterBatchBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterBatchBadRequest creates V2CreateClusterBatchBadRequest with default headers values
+func NewV2CreateClusterBatchBadRequest() *V2CreateClusterBatchBadRequest {
+
+	return &V2CreateClusterBatchBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 create cluster batch bad request response
+func (o *V2CreateClusterBatchBadRequest) WithPayload(payload *models.Error) *V2CreateClusterBatchBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster batch bad request response
+func (o *V2CreateClusterBatchBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterBatchBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterBatchUnauthorizedCode is the HTTP code returned for type V2CreateClusterBatchUnauthorized
+const V2CreateClusterBatchUnauthorizedCode int = 401
+
+/*
+V2CreateClusterBatchUnauthorized Unauthorized.
+
+swagger:response v2CreateClusterBatchUnauthorized
+*/
+type V2CreateClusterBatchUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterBatchUnauthorized creates V2CreateClusterBatchUnauthorized with default headers values
+func NewV2CreateClusterBatchUnauthorized() *V2CreateClusterBatchUnauthorized {
+
+	return &V2CreateClusterBatchUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 create cluster batch unauthorized response
+func (o *V2CreateClusterBatchUnauthorized) WithPayload(payload *models.InfraError) *V2CreateClusterBatchUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster batch unauthorized response
+func (o *V2CreateClusterBatchUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterBatchUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterBatchForbiddenCode is the HTTP code returned for type V2CreateClusterBatchForbidden
+const V2CreateClusterBatchForbiddenCode int = 403
+
+/*
+V2CreateClusterBatchForbidden Forbidden.
+
+swagger:response v2CreateClusterBatchForbidden
+*/
+type V2CreateClusterBatchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterBatchForbidden creates V2CreateClusterBatchForbidden with default headers values
+func NewV2CreateClusterBatchForbidden() *V2CreateClusterBatchForbidden {
+
+	return &V2CreateClusterBatchForbidden{}
+}
+
+// WithPayload adds the payload to the v2 create cluster batch forbidden response
+func (o *V2CreateClusterBatchForbidden) WithPayload(payload *models.InfraError) *V2CreateClusterBatchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster batch forbidden response
+func (o *V2CreateClusterBatchForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterBatchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterBatchMethodNotAllowedCode is the HTTP code returned for type V2CreateClusterBatchMethodNotAllowed
+const V2CreateClusterBatchMethodNotAllowedCode int = 405
+
+/*
+V2CreateClusterBatchMethodNotAllowed Method Not Allowed.
+
+swagger:response v2CreateClusterBatchMethodNotAllowed
+*/
+type V2CreateClusterBatchMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterBatchMethodNotAllowed creates V2CreateClusterBatchMethodNotAllowed with default headers values
+func NewV2CreateClusterBatchMethodNotAllowed() *V2CreateClusterBatchMethodNotAllowed {
+
+	return &V2CreateClusterBatchMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 create cluster batch method not allowed response
+func (o *V2CreateClusterBatchMethodNotAllowed) WithPayload(payload *models.Error) *V2CreateClusterBatchMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster batch method not allowed response
+func (o *V2CreateClusterBatchMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterBatchMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2CreateClusterBatchInternalServerErrorCode is the HTTP code returned for type V2CreateClusterBatchInternalServerError
+const V2CreateClusterBatchInternalServerErrorCode int = 500
+
+/*
+V2CreateClusterBatchInternalServerError Error.
+
+swagger:response v2CreateClusterBatchInternalServerError
+*/
+type V2CreateClusterBatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2CreateClusterBatchInternalServerError creates V2CreateClusterBatchInternalServerError with default headers values
+func NewV2CreateClusterBatchInternalServerError() *V2CreateClusterBatchInternalServerError {
+
+	return &V2CreateClusterBatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 create cluster batch internal server error response
+func (o *V2CreateClusterBatchInternalServerError) WithPayload(payload *models.Error) *V2CreateClusterBatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 create cluster batch internal server error response
+func (o *V2CreateClusterBatchInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2CreateClusterBatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -9,17 +9,26 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
 // NewV2DeregisterClusterParams creates a new V2DeregisterClusterParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewV2DeregisterClusterParams() V2DeregisterClusterParams {
 
-	return V2DeregisterClusterParams{}
+	var (
+		// initialize parameters with default values
+
+		decommissionHostsDefault = bool(false)
+	)
+
+	return V2DeregisterClusterParams{
+		DecommissionHosts: &decommissionHostsDefault,
+	}
 }
 
 // V2DeregisterClusterParams contains all the bound params for the v2 deregister cluster operation
@@ -36,6 +45,12 @@ type V2DeregisterClusterParams struct {
 	  In: path
 	*/
 	ClusterID strfmt.UUID
+	/*Wipe the disks of the installed hosts that are returned to their infra-env before they
+	reboot into discovery. Hosts whose infra-env is bound to the cluster are deleted with it.
+	  In: query
+	  Default: false
+	*/
+	DecommissionHosts *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -47,10 +62,17 @@ func (o *V2DeregisterClusterParams) BindRequest(r *http.Request, route *middlewa
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qDecommissionHosts, qhkDecommissionHosts, _ := qs.GetOK("decommission_hosts")
+	if err := o.bindDecommissionHosts(qDecommissionHosts, qhkDecommissionHosts, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -89,3 +111,27 @@ func (o *V2DeregisterClusterParams) validateClusterID(formats strfmt.Registry) e
 	}
 	return nil
 }
+
+// bindDecommissionHosts binds and validates parameter DecommissionHosts from query.
+func (o *V2DeregisterClusterParams) bindDecommissionHosts(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2DeregisterClusterParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("decommission_hosts", "query", "bool", raw)
+	}
+	o.DecommissionHosts = &value
+
+	return nil
+}
@@ -12,12 +12,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // V2DeregisterClusterURL generates an URL for the v2 deregister cluster operation
 type V2DeregisterClusterURL struct {
 	ClusterID strfmt.UUID
 
+	DecommissionHosts *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +60,18 @@ func (o *V2DeregisterClusterURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var decommissionHostsQ string
+	if o.DecommissionHosts != nil {
+		decommissionHostsQ = swag.FormatBool(*o.DecommissionHosts)
+	}
+	if decommissionHostsQ != "" {
+		qs.Set("decommission_hosts", decommissionHostsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DownloadClusterNetworkTopologyHandlerFunc turns a function with the right signature into a v2 download cluster network topology handler
+type V2DownloadClusterNetworkTopologyHandlerFunc func(V2DownloadClusterNetworkTopologyParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DownloadClusterNetworkTopologyHandlerFunc) Handle(params V2DownloadClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DownloadClusterNetworkTopologyHandler interface for that can handle valid v2 download cluster network topology params
+type V2DownloadClusterNetworkTopologyHandler interface {
+	Handle(V2DownloadClusterNetworkTopologyParams, interface{}) middleware.Responder
+}
+
+// NewV2DownloadClusterNetworkTopology creates a new http.Handler for the v2 download cluster network topology operation
+func NewV2DownloadClusterNetworkTopology(ctx *middleware.Context, handler V2DownloadClusterNetworkTopologyHandler) *V2DownloadClusterNetworkTopology {
+	return &V2DownloadClusterNetworkTopology{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DownloadClusterNetworkTopology swagger:route GET /v2/clusters/{cluster_id}/downloads/network-topology installer v2DownloadClusterNetworkTopology
+
+Downloads the network topology of the hosts of the cluster as a Graphviz DOT graph.
+*/
+type V2DownloadClusterNetworkTopology struct {
+	Context *middleware.Context
+	Handler V2DownloadClusterNetworkTopologyHandler
+}
+
+func (o *V2DownloadClusterNetworkTopology) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DownloadClusterNetworkTopologyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
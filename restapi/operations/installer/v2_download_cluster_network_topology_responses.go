@@ -0,0 +1,283 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadClusterNetworkTopologyOKCode is the HTTP code returned for type V2DownloadClusterNetworkTopologyOK
+const V2DownloadClusterNetworkTopologyOKCode int = 200
+
+/*
+V2DownloadClusterNetworkTopologyOK Success.
+
+swagger:response v2DownloadClusterNetworkTopologyOK
+*/
+type V2DownloadClusterNetworkTopologyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2DownloadClusterNetworkTopologyOK creates V2DownloadClusterNetworkTopologyOK with default headers values
+func NewV2DownloadClusterNetworkTopologyOK() *V2DownloadClusterNetworkTopologyOK {
+
+	return &V2DownloadClusterNetworkTopologyOK{}
+}
+
+// WithPayload adds the payload to the v2 download cluster network topology o k response
+func (o *V2DownloadClusterNetworkTopologyOK) WithPayload(payload io.ReadCloser) *V2DownloadClusterNetworkTopologyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download cluster network topology o k response
+func (o *V2DownloadClusterNetworkTopologyOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadClusterNetworkTopologyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2DownloadClusterNetworkTopologyUnauthorizedCode is the HTTP code returned for type V2DownloadClusterNetworkTopologyUnauthorized
+const V2DownloadClusterNetworkTopologyUnauthorizedCode int = 401
+
+/*
+V2DownloadClusterNetworkTopologyUnauthorized Unauthorized.
+
+swagger:response v2DownloadClusterNetworkTopologyUnauthorized
+*/
+type V2DownloadClusterNetworkTopologyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadClusterNetworkTopologyUnauthorized creates V2DownloadClusterNetworkTopologyUnauthorized with default headers values
+func NewV2DownloadClusterNetworkTopologyUnauthorized() *V2DownloadClusterNetworkTopologyUnauthorized {
+
+	return &V2DownloadClusterNetworkTopologyUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 download cluster network topology unauthorized response
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) WithPayload(payload *models.InfraError) *V2DownloadClusterNetworkTopologyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download cluster network topology unauthorized response
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadClusterNetworkTopologyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadClusterNetworkTopologyForbiddenCode is the HTTP code returned for type V2DownloadClusterNetworkTopologyForbidden
+const V2DownloadClusterNetworkTopologyForbiddenCode int = 403
+
+/*
+V2DownloadClusterNetworkTopologyForbidden Forbidden.
+
+swagger:response v2DownloadClusterNetworkTopologyForbidden
+*/
+type V2DownloadClusterNetworkTopologyForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadClusterNetworkTopologyForbidden creates V2DownloadClusterNetworkTopologyForbidden with default headers values
+func NewV2DownloadClusterNetworkTopologyForbidden() *V2DownloadClusterNetworkTopologyForbidden {
+
+	return &V2DownloadClusterNetworkTopologyForbidden{}
+}
+
+// WithPayload adds the payload to the v2 download cluster network topology forbidden response
+func (o *V2DownloadClusterNetworkTopologyForbidden) WithPayload(payload *models.InfraError) *V2DownloadClusterNetworkTopologyForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download cluster network topology forbidden response
+func (o *V2DownloadClusterNetworkTopologyForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadClusterNetworkTopologyForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadClusterNetworkTopologyNotFoundCode is the HTTP code returned for type V2DownloadClusterNetworkTopologyNotFound
+const V2DownloadClusterNetworkTopologyNotFoundCode int = 404
+
+/*
+V2DownloadClusterNetworkTopologyNotFound Error.
+
+swagger:response v2DownloadClusterNetworkTopologyNotFound
+*/
+type V2DownloadClusterNetworkTopologyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadClusterNetworkTopologyNotFound creates V2DownloadClusterNetworkTopologyNotFound with default headers values
+func NewV2DownloadClusterNetworkTopologyNotFound() *V2DownloadClusterNetworkTopologyNotFound {
+
+	return &V2DownloadClusterNetworkTopologyNotFound{}
+}
+
+// WithPayload adds the payload to the v2 download cluster network topology not found response
+func (o *V2DownloadClusterNetworkTopologyNotFound) WithPayload(payload *models.Error) *V2DownloadClusterNetworkTopologyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download cluster network topology not found response
+func (o *V2DownloadClusterNetworkTopologyNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadClusterNetworkTopologyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadClusterNetworkTopologyMethodNotAllowedCode is the HTTP code returned for type V2DownloadClusterNetworkTopologyMethodNotAllowed
+const V2DownloadClusterNetworkTopologyMethodNotAllowedCode int = 405
+
+/*
+V2DownloadClusterNetworkTopologyMethodNotAllowed Method Not Allowed.
+
+swagger:response v2DownloadClusterNetworkTopologyMethodNotAllowed
+*/
+type V2DownloadClusterNetworkTopologyMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadClusterNetworkTopologyMethodNotAllowed creates V2DownloadClusterNetworkTopologyMethodNotAllowed with default headers values
+func NewV2DownloadClusterNetworkTopologyMethodNotAllowed() *V2DownloadClusterNetworkTopologyMethodNotAllowed {
+
+	return &V2DownloadClusterNetworkTopologyMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 download cluster network topology method not allowed response
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) WithPayload(payload *models.Error) *V2DownloadClusterNetworkTopologyMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download cluster network topology method not allowed response
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadClusterNetworkTopologyMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadClusterNetworkTopologyInternalServerErrorCode is the HTTP code returned for type V2DownloadClusterNetworkTopologyInternalServerError
+const V2DownloadClusterNetworkTopologyInternalServerErrorCode int = 500
+
+/*
+V2DownloadClusterNetworkTopologyInternalServerError Error.
+
+swagger:response v2DownloadClusterNetworkTopologyInternalServerError
+*/
+type V2DownloadClusterNetworkTopologyInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadClusterNetworkTopologyInternalServerError creates V2DownloadClusterNetworkTopologyInternalServerError with default headers values
+func NewV2DownloadClusterNetworkTopologyInternalServerError() *V2DownloadClusterNetworkTopologyInternalServerError {
+
+	return &V2DownloadClusterNetworkTopologyInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 download cluster network topology internal server error response
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) WithPayload(payload *models.Error) *V2DownloadClusterNetworkTopologyInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download cluster network topology internal server error response
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadClusterNetworkTopologyInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2DownloadInfraEnvNetworkTopologyHandlerFunc turns a function with the right signature into a v2 download infra env network topology handler
+type V2DownloadInfraEnvNetworkTopologyHandlerFunc func(V2DownloadInfraEnvNetworkTopologyParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2DownloadInfraEnvNetworkTopologyHandlerFunc) Handle(params V2DownloadInfraEnvNetworkTopologyParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2DownloadInfraEnvNetworkTopologyHandler interface for that can handle valid v2 download infra env network topology params
+type V2DownloadInfraEnvNetworkTopologyHandler interface {
+	Handle(V2DownloadInfraEnvNetworkTopologyParams, interface{}) middleware.Responder
+}
+
+// NewV2DownloadInfraEnvNetworkTopology creates a new http.Handler for the v2 download infra env network topology operation
+func NewV2DownloadInfraEnvNetworkTopology(ctx *middleware.Context, handler V2DownloadInfraEnvNetworkTopologyHandler) *V2DownloadInfraEnvNetworkTopology {
+	return &V2DownloadInfraEnvNetworkTopology{Context: ctx, Handler: handler}
+}
+
+/*
+	V2DownloadInfraEnvNetworkTopology swagger:route GET /v2/infra-envs/{infra_env_id}/downloads/network-topology installer v2DownloadInfraEnvNetworkTopology
+
+Downloads the network topology of the hosts of the infra-env as a Graphviz DOT graph.
+*/
+type V2DownloadInfraEnvNetworkTopology struct {
+	Context *middleware.Context
+	Handler V2DownloadInfraEnvNetworkTopologyHandler
+}
+
+func (o *V2DownloadInfraEnvNetworkTopology) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2DownloadInfraEnvNetworkTopologyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
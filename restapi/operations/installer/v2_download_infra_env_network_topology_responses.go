@@ -0,0 +1,283 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2DownloadInfraEnvNetworkTopologyOKCode is the HTTP code returned for type V2DownloadInfraEnvNetworkTopologyOK
+const V2DownloadInfraEnvNetworkTopologyOKCode int = 200
+
+/*
+V2DownloadInfraEnvNetworkTopologyOK Success.
+
+swagger:response v2DownloadInfraEnvNetworkTopologyOK
+*/
+type V2DownloadInfraEnvNetworkTopologyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyOK creates V2DownloadInfraEnvNetworkTopologyOK with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyOK() *V2DownloadInfraEnvNetworkTopologyOK {
+
+	return &V2DownloadInfraEnvNetworkTopologyOK{}
+}
+
+// WithPayload adds the payload to the v2 download infra env network topology o k response
+func (o *V2DownloadInfraEnvNetworkTopologyOK) WithPayload(payload io.ReadCloser) *V2DownloadInfraEnvNetworkTopologyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download infra env network topology o k response
+func (o *V2DownloadInfraEnvNetworkTopologyOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInfraEnvNetworkTopologyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2DownloadInfraEnvNetworkTopologyUnauthorizedCode is the HTTP code returned for type V2DownloadInfraEnvNetworkTopologyUnauthorized
+const V2DownloadInfraEnvNetworkTopologyUnauthorizedCode int = 401
+
+/*
+V2DownloadInfraEnvNetworkTopologyUnauthorized Unauthorized.
+
+swagger:response v2DownloadInfraEnvNetworkTopologyUnauthorized
+*/
+type V2DownloadInfraEnvNetworkTopologyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyUnauthorized creates V2DownloadInfraEnvNetworkTopologyUnauthorized with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyUnauthorized() *V2DownloadInfraEnvNetworkTopologyUnauthorized {
+
+	return &V2DownloadInfraEnvNetworkTopologyUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 download infra env network topology unauthorized response
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) WithPayload(payload *models.InfraError) *V2DownloadInfraEnvNetworkTopologyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download infra env network topology unauthorized response
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInfraEnvNetworkTopologyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInfraEnvNetworkTopologyForbiddenCode is the HTTP code returned for type V2DownloadInfraEnvNetworkTopologyForbidden
+const V2DownloadInfraEnvNetworkTopologyForbiddenCode int = 403
+
+/*
+V2DownloadInfraEnvNetworkTopologyForbidden Forbidden.
+
+swagger:response v2DownloadInfraEnvNetworkTopologyForbidden
+*/
+type V2DownloadInfraEnvNetworkTopologyForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyForbidden creates V2DownloadInfraEnvNetworkTopologyForbidden with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyForbidden() *V2DownloadInfraEnvNetworkTopologyForbidden {
+
+	return &V2DownloadInfraEnvNetworkTopologyForbidden{}
+}
+
+// WithPayload adds the payload to the v2 download infra env network topology forbidden response
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) WithPayload(payload *models.InfraError) *V2DownloadInfraEnvNetworkTopologyForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download infra env network topology forbidden response
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInfraEnvNetworkTopologyForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInfraEnvNetworkTopologyNotFoundCode is the HTTP code returned for type V2DownloadInfraEnvNetworkTopologyNotFound
+const V2DownloadInfraEnvNetworkTopologyNotFoundCode int = 404
+
+/*
+V2DownloadInfraEnvNetworkTopologyNotFound Error.
+
+swagger:response v2DownloadInfraEnvNetworkTopologyNotFound
+*/
+type V2DownloadInfraEnvNetworkTopologyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyNotFound creates V2DownloadInfraEnvNetworkTopologyNotFound with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyNotFound() *V2DownloadInfraEnvNetworkTopologyNotFound {
+
+	return &V2DownloadInfraEnvNetworkTopologyNotFound{}
+}
+
+// WithPayload adds the payload to the v2 download infra env network topology not found response
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) WithPayload(payload *models.Error) *V2DownloadInfraEnvNetworkTopologyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download infra env network topology not found response
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInfraEnvNetworkTopologyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInfraEnvNetworkTopologyMethodNotAllowedCode is the HTTP code returned for type V2DownloadInfraEnvNetworkTopologyMethodNotAllowed
+const V2DownloadInfraEnvNetworkTopologyMethodNotAllowedCode int = 405
+
+/*
+V2DownloadInfraEnvNetworkTopologyMethodNotAllowed Method Not Allowed.
+
+swagger:response v2DownloadInfraEnvNetworkTopologyMethodNotAllowed
+*/
+type V2DownloadInfraEnvNetworkTopologyMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyMethodNotAllowed creates V2DownloadInfraEnvNetworkTopologyMethodNotAllowed with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyMethodNotAllowed() *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed {
+
+	return &V2DownloadInfraEnvNetworkTopologyMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 download infra env network topology method not allowed response
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) WithPayload(payload *models.Error) *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download infra env network topology method not allowed response
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInfraEnvNetworkTopologyMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2DownloadInfraEnvNetworkTopologyInternalServerErrorCode is the HTTP code returned for type V2DownloadInfraEnvNetworkTopologyInternalServerError
+const V2DownloadInfraEnvNetworkTopologyInternalServerErrorCode int = 500
+
+/*
+V2DownloadInfraEnvNetworkTopologyInternalServerError Error.
+
+swagger:response v2DownloadInfraEnvNetworkTopologyInternalServerError
+*/
+type V2DownloadInfraEnvNetworkTopologyInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2DownloadInfraEnvNetworkTopologyInternalServerError creates V2DownloadInfraEnvNetworkTopologyInternalServerError with default headers values
+func NewV2DownloadInfraEnvNetworkTopologyInternalServerError() *V2DownloadInfraEnvNetworkTopologyInternalServerError {
+
+	return &V2DownloadInfraEnvNetworkTopologyInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 download infra env network topology internal server error response
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) WithPayload(payload *models.Error) *V2DownloadInfraEnvNetworkTopologyInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 download infra env network topology internal server error response
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2DownloadInfraEnvNetworkTopologyInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
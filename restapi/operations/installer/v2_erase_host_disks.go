@@ -0,0 +1,70 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2EraseHostDisksHandlerFunc turns a function with the right signature into a v2 erase host disks handler
+type V2EraseHostDisksHandlerFunc func(V2EraseHostDisksParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2EraseHostDisksHandlerFunc) Handle(params V2EraseHostDisksParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2EraseHostDisksHandler interface for that can handle valid v2 erase host disks params
+type V2EraseHostDisksHandler interface {
+	Handle(V2EraseHostDisksParams, interface{}) middleware.Responder
+}
+
+// NewV2EraseHostDisks creates a new http.Handler for the v2 erase host disks operation
+func NewV2EraseHostDisks(ctx *middleware.Context, handler V2EraseHostDisksHandler) *V2EraseHostDisks {
+	return &V2EraseHostDisks{Context: ctx, Handler: handler}
+}
+
+/*
+	V2EraseHostDisks swagger:route POST /v2/infra-envs/{infra_env_id}/hosts/{host_id}/actions/erase-disks installer v2EraseHostDisks
+
+Securely erases the given disks of an unbound host. The result of the erasure is
+recorded in a signed disk erasure report.
+*/
+type V2EraseHostDisks struct {
+	Context *middleware.Context
+	Handler V2EraseHostDisksHandler
+}
+
+func (o *V2EraseHostDisks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2EraseHostDisksParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2EraseHostDisksAcceptedCode is the HTTP code returned for type V2EraseHostDisksAccepted
+const V2EraseHostDisksAcceptedCode int = 202
+
+/*
+V2EraseHostDisksAccepted Success.
+
+swagger:response v2EraseHostDisksAccepted
+*/
+type V2EraseHostDisksAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Host `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksAccepted creates V2EraseHostDisksAccepted with default headers values
+func NewV2EraseHostDisksAccepted() *V2EraseHostDisksAccepted {
+
+	return &V2EraseHostDisksAccepted{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks accepted response
+func (o *V2EraseHostDisksAccepted) WithPayload(payload *models.Host) *V2EraseHostDisksAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks accepted response
+func (o *V2EraseHostDisksAccepted) SetPayload(payload *models.Host) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EraseHostDisksBadRequestCode is the HTTP code returned for type V2EraseHostDisksBadRequest
+const V2EraseHostDisksBadRequestCode int = 400
+
+/*
+V2EraseHostDisksBadRequest Error.
+
+swagger:response v2EraseHostDisksBadRequest
+*/
+type V2EraseHostDisksBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksBadRequest creates V2EraseHostDisksBadRequest with default headers values
+func NewV2EraseHostDisksBadRequest() *V2EraseHostDisksBadRequest {
+
+	return &V2EraseHostDisksBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks bad request response
+func (o *V2EraseHostDisksBadRequest) WithPayload(payload *models.Error) *V2EraseHostDisksBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks bad request response
+func (o *V2EraseHostDisksBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EraseHostDisksUnauthorizedCode is the HTTP code returned for type V2EraseHostDisksUnauthorized
+const V2EraseHostDisksUnauthorizedCode int = 401
+
+/*
+V2EraseHostDisksUnauthorized Unauthorized.
+
+swagger:response v2EraseHostDisksUnauthorized
+*/
+type V2EraseHostDisksUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksUnauthorized creates V2EraseHostDisksUnauthorized with default headers values
+func NewV2EraseHostDisksUnauthorized() *V2EraseHostDisksUnauthorized {
+
+	return &V2EraseHostDisksUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks unauthorized response
+func (o *V2EraseHostDisksUnauthorized) WithPayload(payload *models.InfraError) *V2EraseHostDisksUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks unauthorized response
+func (o *V2EraseHostDisksUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EraseHostDisksForbiddenCode is the HTTP code returned for type V2EraseHostDisksForbidden
+const V2EraseHostDisksForbiddenCode int = 403
+
+/*
+V2EraseHostDisksForbidden Forbidden.
+
+swagger:response v2EraseHostDisksForbidden
+*/
+type V2EraseHostDisksForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksForbidden creates V2EraseHostDisksForbidden with default headers values
+func NewV2EraseHostDisksForbidden() *V2EraseHostDisksForbidden {
+
+	return &V2EraseHostDisksForbidden{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks forbidden response
+func (o *V2EraseHostDisksForbidden) WithPayload(payload *models.InfraError) *V2EraseHostDisksForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks forbidden response
+func (o *V2EraseHostDisksForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EraseHostDisksNotFoundCode is the HTTP code returned for type V2EraseHostDisksNotFound
+const V2EraseHostDisksNotFoundCode int = 404
+
+/*
+V2EraseHostDisksNotFound Error.
+
+swagger:response v2EraseHostDisksNotFound
+*/
+type V2EraseHostDisksNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksNotFound creates V2EraseHostDisksNotFound with default headers values
+func NewV2EraseHostDisksNotFound() *V2EraseHostDisksNotFound {
+
+	return &V2EraseHostDisksNotFound{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks not found response
+func (o *V2EraseHostDisksNotFound) WithPayload(payload *models.Error) *V2EraseHostDisksNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks not found response
+func (o *V2EraseHostDisksNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EraseHostDisksConflictCode is the HTTP code returned for type V2EraseHostDisksConflict
+const V2EraseHostDisksConflictCode int = 409
+
+/*
+V2EraseHostDisksConflict Error.
+
+swagger:response v2EraseHostDisksConflict
+*/
+type V2EraseHostDisksConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksConflict creates V2EraseHostDisksConflict with default headers values
+func NewV2EraseHostDisksConflict() *V2EraseHostDisksConflict {
+
+	return &V2EraseHostDisksConflict{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks conflict response
+func (o *V2EraseHostDisksConflict) WithPayload(payload *models.Error) *V2EraseHostDisksConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks conflict response
+func (o *V2EraseHostDisksConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EraseHostDisksInternalServerErrorCode is the HTTP code returned for type V2EraseHostDisksInternalServerError
+const V2EraseHostDisksInternalServerErrorCode int = 500
+
+/*
+V2EraseHostDisksInternalServerError Error.
+
+swagger:response v2EraseHostDisksInternalServerError
+*/
+type V2EraseHostDisksInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EraseHostDisksInternalServerError creates V2EraseHostDisksInternalServerError with default headers values
+func NewV2EraseHostDisksInternalServerError() *V2EraseHostDisksInternalServerError {
+
+	return &V2EraseHostDisksInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 erase host disks internal server error response
+func (o *V2EraseHostDisksInternalServerError) WithPayload(payload *models.Error) *V2EraseHostDisksInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 erase host disks internal server error response
+func (o *V2EraseHostDisksInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EraseHostDisksInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterBatchHandlerFunc turns a function with the right signature into a v2 get cluster batch handler
+type V2GetClusterBatchHandlerFunc func(V2GetClusterBatchParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterBatchHandlerFunc) Handle(params V2GetClusterBatchParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterBatchHandler interface for that can handle valid v2 get cluster batch params
+type V2GetClusterBatchHandler interface {
+	Handle(V2GetClusterBatchParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterBatch creates a new http.Handler for the v2 get cluster batch operation
+func NewV2GetClusterBatch(ctx *middleware.Context, handler V2GetClusterBatchHandler) *V2GetClusterBatch {
+	return &V2GetClusterBatch{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetClusterBatch swagger:route GET /v2/cluster-batches/{cluster_batch_id} installer v2GetClusterBatch
+
+Retrieves the details of the cluster batch, including the aggregated status of its clusters.
+*/
+type V2GetClusterBatch struct {
+	Context *middleware.Context
+	Handler V2GetClusterBatchHandler
+}
+
+func (o *V2GetClusterBatch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterBatchParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterBatchOKCode is the HTTP code returned for type V2GetClusterBatchOK
+const V2GetClusterBatchOKCode int = 200
+
+/*
+V2GetClusterBatchOK Success.
+
+swagger:response v2GetClusterBatchOK
+*/
+type V2GetClusterBatchOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterBatch `json:"body,omitempty"`
+}
+
+// NewV2GetClusterBatchOK creates V2GetClusterBatchOK with default headers values
+func NewV2GetClusterBatchOK() *V2GetClusterBatchOK {
+
+	return &V2GetClusterBatchOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster batch o k response
+func (o *V2GetClusterBatchOK) WithPayload(payload *models.ClusterBatch) *V2GetClusterBatchOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster batch o k response
+func (o *V2GetClusterBatchOK) SetPayload(payload *models.ClusterBatch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterBatchOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterBatchUnauthorizedCode is the HTTP code returned for type V2GetClusterBatchUnauthorized
+const V2GetClusterBatchUnauthorizedCode int = 401
+
+/*
+V2GetClusterBatchUnauthorized Unauthorized.
+
+swagger:response v2GetClusterBatchUnauthorized
+*/
+type V2GetClusterBatchUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterBatchUnauthorized creates V2GetClusterBatchUnauthorized with default headers values
+func NewV2GetClusterBatchUnauthorized() *V2GetClusterBatchUnauthorized {
+
+	return &V2GetClusterBatchUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster batch unauthorized response
+func (o *V2GetClusterBatchUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterBatchUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster batch unauthorized response
+func (o *V2GetClusterBatchUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterBatchUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterBatchForbiddenCode is the HTTP code returned for type V2GetClusterBatchForbidden
+const V2GetClusterBatchForbiddenCode int = 403
+
+/*
+V2GetClusterBatchForbidden Forbidden.
+
+swagger:response v2GetClusterBatchForbidden
+*/
+type V2GetClusterBatchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterBatchForbidden creates V2GetClusterBatchForbidden with default headers values
+func NewV2GetClusterBatchForbidden() *V2GetClusterBatchForbidden {
+
+	return &V2GetClusterBatchForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster batch forbidden response
+func (o *V2GetClusterBatchForbidden) WithPayload(payload *models.InfraError) *V2GetClusterBatchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster batch forbidden response
+func (o *V2GetClusterBatchForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterBatchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterBatchNotFoundCode is the HTTP code returned for type V2GetClusterBatchNotFound
+const V2GetClusterBatchNotFoundCode int = 404
+
+/*
+V2GetClusterBatchNotFound Error.
+
+swagger:response v2GetClusterBatchNotFound
+*/
+type V2GetClusterBatchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterBatchNotFound creates V2GetClusterBatchNotFound with default headers values
+func NewV2GetClusterBatchNotFound() *V2GetClusterBatchNotFound {
+
+	return &V2GetClusterBatchNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster batch not found response
+func (o *V2GetClusterBatchNotFound) WithPayload(payload *models.Error) *V2GetClusterBatchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster batch not found response
+func (o *V2GetClusterBatchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterBatchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterBatchMethodNotAllowedCode is the HTTP code returned for type V2GetClusterBatchMethodNotAllowed
+const V2GetClusterBatchMethodNotAllowedCode int = 405
+
+/*
+V2GetClusterBatchMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetClusterBatchMethodNotAllowed
+*/
+type V2GetClusterBatchMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterBatchMethodNotAllowed creates V2GetClusterBatchMethodNotAllowed with default headers values
+func NewV2GetClusterBatchMethodNotAllowed() *V2GetClusterBatchMethodNotAllowed {
+
+	return &V2GetClusterBatchMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get cluster batch method not allowed response
+func (o *V2GetClusterBatchMethodNotAllowed) WithPayload(payload *models.Error) *V2GetClusterBatchMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster batch method not allowed response
+func (o *V2GetClusterBatchMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterBatchMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterBatchInternalServerErrorCode is the HTTP code returned for type V2GetClusterBatchInternalServerError
+const V2GetClusterBatchInternalServerErrorCode int = 500
+
+/*
+V2GetClusterBatchInternalServerError Error.
+
+swagger:response v2GetClusterBatchInternalServerError
+*/
+type V2GetClusterBatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterBatchInternalServerError creates V2GetClusterBatchInternalServerError with default headers values
+func NewV2GetClusterBatchInternalServerError() *V2GetClusterBatchInternalServerError {
+
+	return &V2GetClusterBatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster batch internal server error response
+func (o *V2GetClusterBatchInternalServerError) WithPayload(payload *models.Error) *V2GetClusterBatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster batch internal server error response
+func (o *V2GetClusterBatchInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterBatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterNetworkTopologyHandlerFunc turns a function with the right signature into a v2 get cluster network topology handler
+type V2GetClusterNetworkTopologyHandlerFunc func(V2GetClusterNetworkTopologyParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterNetworkTopologyHandlerFunc) Handle(params V2GetClusterNetworkTopologyParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterNetworkTopologyHandler interface for that can handle valid v2 get cluster network topology params
+type V2GetClusterNetworkTopologyHandler interface {
+	Handle(V2GetClusterNetworkTopologyParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterNetworkTopology creates a new http.Handler for the v2 get cluster network topology operation
+func NewV2GetClusterNetworkTopology(ctx *middleware.Context, handler V2GetClusterNetworkTopologyHandler) *V2GetClusterNetworkTopology {
+	return &V2GetClusterNetworkTopology{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetClusterNetworkTopology swagger:route GET /v2/clusters/{cluster_id}/network-topology installer v2GetClusterNetworkTopology
+
+Retrieves the network topology of the hosts of the cluster, built from their inventories and connectivity reports.
+*/
+type V2GetClusterNetworkTopology struct {
+	Context *middleware.Context
+	Handler V2GetClusterNetworkTopologyHandler
+}
+
+func (o *V2GetClusterNetworkTopology) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterNetworkTopologyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
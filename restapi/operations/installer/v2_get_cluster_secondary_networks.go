@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetClusterSecondaryNetworksHandlerFunc turns a function with the right signature into a v2 get cluster secondary networks handler
+type V2GetClusterSecondaryNetworksHandlerFunc func(V2GetClusterSecondaryNetworksParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetClusterSecondaryNetworksHandlerFunc) Handle(params V2GetClusterSecondaryNetworksParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetClusterSecondaryNetworksHandler interface for that can handle valid v2 get cluster secondary networks params
+type V2GetClusterSecondaryNetworksHandler interface {
+	Handle(V2GetClusterSecondaryNetworksParams, interface{}) middleware.Responder
+}
+
+// NewV2GetClusterSecondaryNetworks creates a new http.Handler for the v2 get cluster secondary networks operation
+func NewV2GetClusterSecondaryNetworks(ctx *middleware.Context, handler V2GetClusterSecondaryNetworksHandler) *V2GetClusterSecondaryNetworks {
+	return &V2GetClusterSecondaryNetworks{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetClusterSecondaryNetworks swagger:route GET /v2/clusters/{cluster_id}/secondary-networks installer v2GetClusterSecondaryNetworks
+
+Retrieves the secondary network policies of the cluster.
+*/
+type V2GetClusterSecondaryNetworks struct {
+	Context *middleware.Context
+	Handler V2GetClusterSecondaryNetworksHandler
+}
+
+func (o *V2GetClusterSecondaryNetworks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetClusterSecondaryNetworksParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,287 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetClusterSecondaryNetworksOKCode is the HTTP code returned for type V2GetClusterSecondaryNetworksOK
+const V2GetClusterSecondaryNetworksOKCode int = 200
+
+/*
+V2GetClusterSecondaryNetworksOK Success.
+
+swagger:response v2GetClusterSecondaryNetworksOK
+*/
+type V2GetClusterSecondaryNetworksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.SecondaryNetworkPolicies `json:"body,omitempty"`
+}
+
+// NewV2GetClusterSecondaryNetworksOK creates V2GetClusterSecondaryNetworksOK with default headers values
+func NewV2GetClusterSecondaryNetworksOK() *V2GetClusterSecondaryNetworksOK {
+
+	return &V2GetClusterSecondaryNetworksOK{}
+}
+
+// WithPayload adds the payload to the v2 get cluster secondary networks o k response
+func (o *V2GetClusterSecondaryNetworksOK) WithPayload(payload models.SecondaryNetworkPolicies) *V2GetClusterSecondaryNetworksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster secondary networks o k response
+func (o *V2GetClusterSecondaryNetworksOK) SetPayload(payload models.SecondaryNetworkPolicies) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterSecondaryNetworksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.SecondaryNetworkPolicies{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2GetClusterSecondaryNetworksUnauthorizedCode is the HTTP code returned for type V2GetClusterSecondaryNetworksUnauthorized
+const V2GetClusterSecondaryNetworksUnauthorizedCode int = 401
+
+/*
+V2GetClusterSecondaryNetworksUnauthorized Unauthorized.
+
+swagger:response v2GetClusterSecondaryNetworksUnauthorized
+*/
+type V2GetClusterSecondaryNetworksUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterSecondaryNetworksUnauthorized creates V2GetClusterSecondaryNetworksUnauthorized with default headers values
+func NewV2GetClusterSecondaryNetworksUnauthorized() *V2GetClusterSecondaryNetworksUnauthorized {
+
+	return &V2GetClusterSecondaryNetworksUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get cluster secondary networks unauthorized response
+func (o *V2GetClusterSecondaryNetworksUnauthorized) WithPayload(payload *models.InfraError) *V2GetClusterSecondaryNetworksUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster secondary networks unauthorized response
+func (o *V2GetClusterSecondaryNetworksUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterSecondaryNetworksUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterSecondaryNetworksForbiddenCode is the HTTP code returned for type V2GetClusterSecondaryNetworksForbidden
+const V2GetClusterSecondaryNetworksForbiddenCode int = 403
+
+/*
+V2GetClusterSecondaryNetworksForbidden Forbidden.
+
+swagger:response v2GetClusterSecondaryNetworksForbidden
+*/
+type V2GetClusterSecondaryNetworksForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetClusterSecondaryNetworksForbidden creates V2GetClusterSecondaryNetworksForbidden with default headers values
+func NewV2GetClusterSecondaryNetworksForbidden() *V2GetClusterSecondaryNetworksForbidden {
+
+	return &V2GetClusterSecondaryNetworksForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get cluster secondary networks forbidden response
+func (o *V2GetClusterSecondaryNetworksForbidden) WithPayload(payload *models.InfraError) *V2GetClusterSecondaryNetworksForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster secondary networks forbidden response
+func (o *V2GetClusterSecondaryNetworksForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterSecondaryNetworksForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterSecondaryNetworksNotFoundCode is the HTTP code returned for type V2GetClusterSecondaryNetworksNotFound
+const V2GetClusterSecondaryNetworksNotFoundCode int = 404
+
+/*
+V2GetClusterSecondaryNetworksNotFound Error.
+
+swagger:response v2GetClusterSecondaryNetworksNotFound
+*/
+type V2GetClusterSecondaryNetworksNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterSecondaryNetworksNotFound creates V2GetClusterSecondaryNetworksNotFound with default headers values
+func NewV2GetClusterSecondaryNetworksNotFound() *V2GetClusterSecondaryNetworksNotFound {
+
+	return &V2GetClusterSecondaryNetworksNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get cluster secondary networks not found response
+func (o *V2GetClusterSecondaryNetworksNotFound) WithPayload(payload *models.Error) *V2GetClusterSecondaryNetworksNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster secondary networks not found response
+func (o *V2GetClusterSecondaryNetworksNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterSecondaryNetworksNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterSecondaryNetworksMethodNotAllowedCode is the HTTP code returned for type V2GetClusterSecondaryNetworksMethodNotAllowed
+const V2GetClusterSecondaryNetworksMethodNotAllowedCode int = 405
+
+/*
+V2GetClusterSecondaryNetworksMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetClusterSecondaryNetworksMethodNotAllowed
+*/
+type V2GetClusterSecondaryNetworksMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterSecondaryNetworksMethodNotAllowed creates V2GetClusterSecondaryNetworksMethodNotAllowed with default headers values
+func NewV2GetClusterSecondaryNetworksMethodNotAllowed() *V2GetClusterSecondaryNetworksMethodNotAllowed {
+
+	return &V2GetClusterSecondaryNetworksMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get cluster secondary networks method not allowed response
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) WithPayload(payload *models.Error) *V2GetClusterSecondaryNetworksMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster secondary networks method not allowed response
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterSecondaryNetworksMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetClusterSecondaryNetworksInternalServerErrorCode is the HTTP code returned for type V2GetClusterSecondaryNetworksInternalServerError
+const V2GetClusterSecondaryNetworksInternalServerErrorCode int = 500
+
+/*
+V2GetClusterSecondaryNetworksInternalServerError Error.
+
+swagger:response v2GetClusterSecondaryNetworksInternalServerError
+*/
+type V2GetClusterSecondaryNetworksInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetClusterSecondaryNetworksInternalServerError creates V2GetClusterSecondaryNetworksInternalServerError with default headers values
+func NewV2GetClusterSecondaryNetworksInternalServerError() *V2GetClusterSecondaryNetworksInternalServerError {
+
+	return &V2GetClusterSecondaryNetworksInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get cluster secondary networks internal server error response
+func (o *V2GetClusterSecondaryNetworksInternalServerError) WithPayload(payload *models.Error) *V2GetClusterSecondaryNetworksInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get cluster secondary networks internal server error response
+func (o *V2GetClusterSecondaryNetworksInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetClusterSecondaryNetworksInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
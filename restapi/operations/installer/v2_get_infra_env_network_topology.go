@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetInfraEnvNetworkTopologyHandlerFunc turns a function with the right signature into a v2 get infra env network topology handler
+type V2GetInfraEnvNetworkTopologyHandlerFunc func(V2GetInfraEnvNetworkTopologyParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetInfraEnvNetworkTopologyHandlerFunc) Handle(params V2GetInfraEnvNetworkTopologyParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetInfraEnvNetworkTopologyHandler interface for that can handle valid v2 get infra env network topology params
+type V2GetInfraEnvNetworkTopologyHandler interface {
+	Handle(V2GetInfraEnvNetworkTopologyParams, interface{}) middleware.Responder
+}
+
+// NewV2GetInfraEnvNetworkTopology creates a new http.Handler for the v2 get infra env network topology operation
+func NewV2GetInfraEnvNetworkTopology(ctx *middleware.Context, handler V2GetInfraEnvNetworkTopologyHandler) *V2GetInfraEnvNetworkTopology {
+	return &V2GetInfraEnvNetworkTopology{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetInfraEnvNetworkTopology swagger:route GET /v2/infra-envs/{infra_env_id}/network-topology installer v2GetInfraEnvNetworkTopology
+
+Retrieves the network topology of the hosts of the infra-env, built from their inventories and connectivity reports.
+*/
+type V2GetInfraEnvNetworkTopology struct {
+	Context *middleware.Context
+	Handler V2GetInfraEnvNetworkTopologyHandler
+}
+
+func (o *V2GetInfraEnvNetworkTopology) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetInfraEnvNetworkTopologyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
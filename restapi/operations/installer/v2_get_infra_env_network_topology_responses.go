@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetInfraEnvNetworkTopologyOKCode is the HTTP code returned for type V2GetInfraEnvNetworkTopologyOK
+const V2GetInfraEnvNetworkTopologyOKCode int = 200
+
+/*
+V2GetInfraEnvNetworkTopologyOK Success.
+
+swagger:response v2GetInfraEnvNetworkTopologyOK
+*/
+type V2GetInfraEnvNetworkTopologyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NetworkTopology `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvNetworkTopologyOK creates V2GetInfraEnvNetworkTopologyOK with default headers values
+func NewV2GetInfraEnvNetworkTopologyOK() *V2GetInfraEnvNetworkTopologyOK {
+
+	return &V2GetInfraEnvNetworkTopologyOK{}
+}
+
+// WithPayload adds the payload to the v2 get infra env network topology o k response
+func (o *V2GetInfraEnvNetworkTopologyOK) WithPayload(payload *models.NetworkTopology) *V2GetInfraEnvNetworkTopologyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env network topology o k response
+func (o *V2GetInfraEnvNetworkTopologyOK) SetPayload(payload *models.NetworkTopology) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvNetworkTopologyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvNetworkTopologyUnauthorizedCode is the HTTP code returned for type V2GetInfraEnvNetworkTopologyUnauthorized
+const V2GetInfraEnvNetworkTopologyUnauthorizedCode int = 401
+
+/*
+V2GetInfraEnvNetworkTopologyUnauthorized Unauthorized.
+
+swagger:response v2GetInfraEnvNetworkTopologyUnauthorized
+*/
+type V2GetInfraEnvNetworkTopologyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvNetworkTopologyUnauthorized creates V2GetInfraEnvNetworkTopologyUnauthorized with default headers values
+func NewV2GetInfraEnvNetworkTopologyUnauthorized() *V2GetInfraEnvNetworkTopologyUnauthorized {
+
+	return &V2GetInfraEnvNetworkTopologyUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get infra env network topology unauthorized response
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) WithPayload(payload *models.InfraError) *V2GetInfraEnvNetworkTopologyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env network topology unauthorized response
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvNetworkTopologyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvNetworkTopologyForbiddenCode is the HTTP code returned for type V2GetInfraEnvNetworkTopologyForbidden
+const V2GetInfraEnvNetworkTopologyForbiddenCode int = 403
+
+/*
+V2GetInfraEnvNetworkTopologyForbidden Forbidden.
+
+swagger:response v2GetInfraEnvNetworkTopologyForbidden
+*/
+type V2GetInfraEnvNetworkTopologyForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvNetworkTopologyForbidden creates V2GetInfraEnvNetworkTopologyForbidden with default headers values
+func NewV2GetInfraEnvNetworkTopologyForbidden() *V2GetInfraEnvNetworkTopologyForbidden {
+
+	return &V2GetInfraEnvNetworkTopologyForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get infra env network topology forbidden response
+func (o *V2GetInfraEnvNetworkTopologyForbidden) WithPayload(payload *models.InfraError) *V2GetInfraEnvNetworkTopologyForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env network topology forbidden response
+func (o *V2GetInfraEnvNetworkTopologyForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvNetworkTopologyForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvNetworkTopologyNotFoundCode is the HTTP code returned for type V2GetInfraEnvNetworkTopologyNotFound
+const V2GetInfraEnvNetworkTopologyNotFoundCode int = 404
+
+/*
+V2GetInfraEnvNetworkTopologyNotFound Error.
+
+swagger:response v2GetInfraEnvNetworkTopologyNotFound
+*/
+type V2GetInfraEnvNetworkTopologyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvNetworkTopologyNotFound creates V2GetInfraEnvNetworkTopologyNotFound with default headers values
+func NewV2GetInfraEnvNetworkTopologyNotFound() *V2GetInfraEnvNetworkTopologyNotFound {
+
+	return &V2GetInfraEnvNetworkTopologyNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get infra env network topology not found response
+func (o *V2GetInfraEnvNetworkTopologyNotFound) WithPayload(payload *models.Error) *V2GetInfraEnvNetworkTopologyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env network topology not found response
+func (o *V2GetInfraEnvNetworkTopologyNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvNetworkTopologyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvNetworkTopologyMethodNotAllowedCode is the HTTP code returned for type V2GetInfraEnvNetworkTopologyMethodNotAllowed
+const V2GetInfraEnvNetworkTopologyMethodNotAllowedCode int = 405
+
+/*
+V2GetInfraEnvNetworkTopologyMethodNotAllowed Method Not Allowed.
+
+swagger:response v2GetInfraEnvNetworkTopologyMethodNotAllowed
+*/
+type V2GetInfraEnvNetworkTopologyMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvNetworkTopologyMethodNotAllowed creates V2GetInfraEnvNetworkTopologyMethodNotAllowed with default headers values
+func NewV2GetInfraEnvNetworkTopologyMethodNotAllowed() *V2GetInfraEnvNetworkTopologyMethodNotAllowed {
+
+	return &V2GetInfraEnvNetworkTopologyMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 get infra env network topology method not allowed response
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) WithPayload(payload *models.Error) *V2GetInfraEnvNetworkTopologyMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env network topology method not allowed response
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvNetworkTopologyMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetInfraEnvNetworkTopologyInternalServerErrorCode is the HTTP code returned for type V2GetInfraEnvNetworkTopologyInternalServerError
+const V2GetInfraEnvNetworkTopologyInternalServerErrorCode int = 500
+
+/*
+V2GetInfraEnvNetworkTopologyInternalServerError Error.
+
+swagger:response v2GetInfraEnvNetworkTopologyInternalServerError
+*/
+type V2GetInfraEnvNetworkTopologyInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetInfraEnvNetworkTopologyInternalServerError creates V2GetInfraEnvNetworkTopologyInternalServerError with default headers values
+func NewV2GetInfraEnvNetworkTopologyInternalServerError() *V2GetInfraEnvNetworkTopologyInternalServerError {
+
+	return &V2GetInfraEnvNetworkTopologyInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get infra env network topology internal server error response
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) WithPayload(payload *models.Error) *V2GetInfraEnvNetworkTopologyInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get infra env network topology internal server error response
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetInfraEnvNetworkTopologyInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
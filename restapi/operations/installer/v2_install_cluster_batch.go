@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2InstallClusterBatchHandlerFunc turns a function with the right signature into a v2 install cluster batch handler
+type V2InstallClusterBatchHandlerFunc func(V2InstallClusterBatchParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2InstallClusterBatchHandlerFunc) Handle(params V2InstallClusterBatchParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2InstallClusterBatchHandler interface for that can handle valid v2 install cluster batch params
+type V2InstallClusterBatchHandler interface {
+	Handle(V2InstallClusterBatchParams, interface{}) middleware.Responder
+}
+
+// NewV2InstallClusterBatch creates a new http.Handler for the v2 install cluster batch operation
+func NewV2InstallClusterBatch(ctx *middleware.Context, handler V2InstallClusterBatchHandler) *V2InstallClusterBatch {
+	return &V2InstallClusterBatch{Context: ctx, Handler: handler}
+}
+
+/*
+	V2InstallClusterBatch swagger:route POST /v2/cluster-batches/{cluster_batch_id}/actions/install installer v2InstallClusterBatch
+
+Installs all the clusters of the batch.
+*/
+type V2InstallClusterBatch struct {
+	Context *middleware.Context
+	Handler V2InstallClusterBatchHandler
+}
+
+func (o *V2InstallClusterBatch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2InstallClusterBatchParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2InstallClusterBatchAcceptedCode is the HTTP code returned for type V2InstallClusterBatchAccepted
+const V2InstallClusterBatchAcceptedCode int = 202
+
+/*
+V2InstallClusterBatchAccepted Success.
+
+swagger:response v2InstallClusterBatchAccepted
+*/
+type V2InstallClusterBatchAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterBatch `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchAccepted creates V2InstallClusterBatchAccepted with default headers values
+func NewV2InstallClusterBatchAccepted() *V2InstallClusterBatchAccepted {
+
+	return &V2InstallClusterBatchAccepted{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch accepted response
+func (o *V2InstallClusterBatchAccepted) WithPayload(payload *models.ClusterBatch) *V2InstallClusterBatchAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch accepted response
+func (o *V2InstallClusterBatchAccepted) SetPayload(payload *models.ClusterBatch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstallClusterBatchUnauthorizedCode is the HTTP code returned for type V2InstallClusterBatchUnauthorized
+const V2InstallClusterBatchUnauthorizedCode int = 401
+
+/*
+V2InstallClusterBatchUnauthorized Unauthorized.
+
+swagger:response v2InstallClusterBatchUnauthorized
+*/
+type V2InstallClusterBatchUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchUnauthorized creates V2InstallClusterBatchUnauthorized with default headers values
+func NewV2InstallClusterBatchUnauthorized() *V2InstallClusterBatchUnauthorized {
+
+	return &V2InstallClusterBatchUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch unauthorized response
+func (o *V2InstallClusterBatchUnauthorized) WithPayload(payload *models.InfraError) *V2InstallClusterBatchUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch unauthorized response
+func (o *V2InstallClusterBatchUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstallClusterBatchForbiddenCode is the HTTP code returned for type V2InstallClusterBatchForbidden
+const V2InstallClusterBatchForbiddenCode int = 403
+
+/*
+V2InstallClusterBatchForbidden Forbidden.
+
+swagger:response v2InstallClusterBatchForbidden
+*/
+type V2InstallClusterBatchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchForbidden creates V2InstallClusterBatchForbidden with default headers values
+func NewV2InstallClusterBatchForbidden() *V2InstallClusterBatchForbidden {
+
+	return &V2InstallClusterBatchForbidden{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch forbidden response
+func (o *V2InstallClusterBatchForbidden) WithPayload(payload *models.InfraError) *V2InstallClusterBatchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch forbidden response
+func (o *V2InstallClusterBatchForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstallClusterBatchNotFoundCode is the HTTP code returned for type V2InstallClusterBatchNotFound
+const V2InstallClusterBatchNotFoundCode int = 404
+
+/*
+V2InstallClusterBatchNotFound Error.
+
+swagger:response v2InstallClusterBatchNotFound
+*/
+type V2InstallClusterBatchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchNotFound creates V2InstallClusterBatchNotFound with default headers values
+func NewV2InstallClusterBatchNotFound() *V2InstallClusterBatchNotFound {
+
+	return &V2InstallClusterBatchNotFound{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch not found response
+func (o *V2InstallClusterBatchNotFound) WithPayload(payload *models.Error) *V2InstallClusterBatchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch not found response
+func (o *V2InstallClusterBatchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstallClusterBatchMethodNotAllowedCode is the HTTP code returned for type V2InstallClusterBatchMethodNotAllowed
+const V2InstallClusterBatchMethodNotAllowedCode int = 405
+
+/*
+V2InstallClusterBatchMethodNotAllowed Method Not Allowed.
+
+swagger:response v2InstallClusterBatchMethodNotAllowed
+*/
+type V2InstallClusterBatchMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchMethodNotAllowed creates V2InstallClusterBatchMethodNotAllowed with default headers values
+func NewV2InstallClusterBatchMethodNotAllowed() *V2InstallClusterBatchMethodNotAllowed {
+
+	return &V2InstallClusterBatchMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch method not allowed response
+func (o *V2InstallClusterBatchMethodNotAllowed) WithPayload(payload *models.Error) *V2InstallClusterBatchMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch method not allowed response
+func (o *V2InstallClusterBatchMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstallClusterBatchConflictCode is the HTTP code returned for type V2InstallClusterBatchConflict
+const V2InstallClusterBatchConflictCode int = 409
+
+/*
+V2InstallClusterBatchConflict Error.
+
+swagger:response v2InstallClusterBatchConflict
+*/
+type V2InstallClusterBatchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchConflict creates V2InstallClusterBatchConflict with default headers values
+func NewV2InstallClusterBatchConflict() *V2InstallClusterBatchConflict {
+
+	return &V2InstallClusterBatchConflict{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch conflict response
+func (o *V2InstallClusterBatchConflict) WithPayload(payload *models.Error) *V2InstallClusterBatchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch conflict response
+func (o *V2InstallClusterBatchConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2InstallClusterBatchInternalServerErrorCode is the HTTP code returned for type V2InstallClusterBatchInternalServerError
+const V2InstallClusterBatchInternalServerErrorCode int = 500
+
+/*
+V2InstallClusterBatchInternalServerError Error.
+
+swagger:response v2InstallClusterBatchInternalServerError
+*/
+type V2InstallClusterBatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2InstallClusterBatchInternalServerError creates V2InstallClusterBatchInternalServerError with default headers values
+func NewV2InstallClusterBatchInternalServerError() *V2InstallClusterBatchInternalServerError {
+
+	return &V2InstallClusterBatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 install cluster batch internal server error response
+func (o *V2InstallClusterBatchInternalServerError) WithPayload(payload *models.Error) *V2InstallClusterBatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 install cluster batch internal server error response
+func (o *V2InstallClusterBatchInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2InstallClusterBatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListClusterBatchesHandlerFunc turns a function with the right signature into a v2 list cluster batches handler
+type V2ListClusterBatchesHandlerFunc func(V2ListClusterBatchesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListClusterBatchesHandlerFunc) Handle(params V2ListClusterBatchesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListClusterBatchesHandler interface for that can handle valid v2 list cluster batches params
+type V2ListClusterBatchesHandler interface {
+	Handle(V2ListClusterBatchesParams, interface{}) middleware.Responder
+}
+
+// NewV2ListClusterBatches creates a new http.Handler for the v2 list cluster batches operation
+func NewV2ListClusterBatches(ctx *middleware.Context, handler V2ListClusterBatchesHandler) *V2ListClusterBatches {
+	return &V2ListClusterBatches{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListClusterBatches swagger:route GET /v2/cluster-batches installer v2ListClusterBatches
+
+Retrieves the list of cluster batches.
+*/
+type V2ListClusterBatches struct {
+	Context *middleware.Context
+	Handler V2ListClusterBatchesHandler
+}
+
+func (o *V2ListClusterBatches) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListClusterBatchesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListClusterBatchesOKCode is the HTTP code returned for type V2ListClusterBatchesOK
+const V2ListClusterBatchesOKCode int = 200
+
+/*
+V2ListClusterBatchesOK Success.
+
+swagger:response v2ListClusterBatchesOK
+*/
+type V2ListClusterBatchesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.ClusterBatchList `json:"body,omitempty"`
+}
+
+// NewV2ListClusterBatchesOK creates V2ListClusterBatchesOK with default headers values
+func NewV2ListClusterBatchesOK() *V2ListClusterBatchesOK {
+
+	return &V2ListClusterBatchesOK{}
+}
+
+// WithPayload adds the payload to the v2 list cluster batches o k response
+func (o *V2ListClusterBatchesOK) WithPayload(payload models.ClusterBatchList) *V2ListClusterBatchesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster batches o k response
+func (o *V2ListClusterBatchesOK) SetPayload(payload models.ClusterBatchList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterBatchesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.ClusterBatchList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListClusterBatchesUnauthorizedCode is the HTTP code returned for type V2ListClusterBatchesUnauthorized
+const V2ListClusterBatchesUnauthorizedCode int = 401
+
+/*
+V2ListClusterBatchesUnauthorized Unauthorized.
+
+swagger:response v2ListClusterBatchesUnauthorized
+*/
+type V2ListClusterBatchesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterBatchesUnauthorized creates V2ListClusterBatchesUnauthorized with default headers values
+func NewV2ListClusterBatchesUnauthorized() *V2ListClusterBatchesUnauthorized {
+
+	return &V2ListClusterBatchesUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list cluster batches unauthorized response
+func (o *V2ListClusterBatchesUnauthorized) WithPayload(payload *models.InfraError) *V2ListClusterBatchesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster batches unauthorized response
+func (o *V2ListClusterBatchesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterBatchesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterBatchesForbiddenCode is the HTTP code returned for type V2ListClusterBatchesForbidden
+const V2ListClusterBatchesForbiddenCode int = 403
+
+/*
+V2ListClusterBatchesForbidden Forbidden.
+
+swagger:response v2ListClusterBatchesForbidden
+*/
+type V2ListClusterBatchesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListClusterBatchesForbidden creates V2ListClusterBatchesForbidden with default headers values
+func NewV2ListClusterBatchesForbidden() *V2ListClusterBatchesForbidden {
+
+	return &V2ListClusterBatchesForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list cluster batches forbidden response
+func (o *V2ListClusterBatchesForbidden) WithPayload(payload *models.InfraError) *V2ListClusterBatchesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster batches forbidden response
+func (o *V2ListClusterBatchesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterBatchesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterBatchesMethodNotAllowedCode is the HTTP code returned for type V2ListClusterBatchesMethodNotAllowed
+const V2ListClusterBatchesMethodNotAllowedCode int = 405
+
+/*
+V2ListClusterBatchesMethodNotAllowed Method Not Allowed.
+
+swagger:response v2ListClusterBatchesMethodNotAllowed
+*/
+type V2ListClusterBatchesMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterBatchesMethodNotAllowed creates V2ListClusterBatchesMethodNotAllowed with default headers values
+func NewV2ListClusterBatchesMethodNotAllowed() *V2ListClusterBatchesMethodNotAllowed {
+
+	return &V2ListClusterBatchesMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 list cluster batches method not allowed response
+func (o *V2ListClusterBatchesMethodNotAllowed) WithPayload(payload *models.Error) *V2ListClusterBatchesMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster batches method not allowed response
+func (o *V2ListClusterBatchesMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterBatchesMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListClusterBatchesInternalServerErrorCode is the HTTP code returned for type V2ListClusterBatchesInternalServerError
+const V2ListClusterBatchesInternalServerErrorCode int = 500
+
+/*
+V2ListClusterBatchesInternalServerError Error.
+
+swagger:response v2ListClusterBatchesInternalServerError
+*/
+type V2ListClusterBatchesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListClusterBatchesInternalServerError creates V2ListClusterBatchesInternalServerError with default headers values
+func NewV2ListClusterBatchesInternalServerError() *V2ListClusterBatchesInternalServerError {
+
+	return &V2ListClusterBatchesInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list cluster batches internal server error response
+func (o *V2ListClusterBatchesInternalServerError) WithPayload(payload *models.Error) *V2ListClusterBatchesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list cluster batches internal server error response
+func (o *V2ListClusterBatchesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListClusterBatchesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListHostDiskErasureReportsHandlerFunc turns a function with the right signature into a v2 list host disk erasure reports handler
+type V2ListHostDiskErasureReportsHandlerFunc func(V2ListHostDiskErasureReportsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListHostDiskErasureReportsHandlerFunc) Handle(params V2ListHostDiskErasureReportsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListHostDiskErasureReportsHandler interface for that can handle valid v2 list host disk erasure reports params
+type V2ListHostDiskErasureReportsHandler interface {
+	Handle(V2ListHostDiskErasureReportsParams, interface{}) middleware.Responder
+}
+
+// NewV2ListHostDiskErasureReports creates a new http.Handler for the v2 list host disk erasure reports operation
+func NewV2ListHostDiskErasureReports(ctx *middleware.Context, handler V2ListHostDiskErasureReportsHandler) *V2ListHostDiskErasureReports {
+	return &V2ListHostDiskErasureReports{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListHostDiskErasureReports swagger:route GET /v2/infra-envs/{infra_env_id}/hosts/{host_id}/disk-erasure-reports installer v2ListHostDiskErasureReports
+
+Retrieves the signed disk erasure reports of the host.
+*/
+type V2ListHostDiskErasureReports struct {
+	Context *middleware.Context
+	Handler V2ListHostDiskErasureReportsHandler
+}
+
+func (o *V2ListHostDiskErasureReports) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListHostDiskErasureReportsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,242 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListHostDiskErasureReportsOKCode is the HTTP code returned for type V2ListHostDiskErasureReportsOK
+const V2ListHostDiskErasureReportsOKCode int = 200
+
+/*
+V2ListHostDiskErasureReportsOK Success.
+
+swagger:response v2ListHostDiskErasureReportsOK
+*/
+type V2ListHostDiskErasureReportsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.DiskErasureReportList `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiskErasureReportsOK creates V2ListHostDiskErasureReportsOK with default headers values
+func NewV2ListHostDiskErasureReportsOK() *V2ListHostDiskErasureReportsOK {
+
+	return &V2ListHostDiskErasureReportsOK{}
+}
+
+// WithPayload adds the payload to the v2 list host disk erasure reports o k response
+func (o *V2ListHostDiskErasureReportsOK) WithPayload(payload models.DiskErasureReportList) *V2ListHostDiskErasureReportsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host disk erasure reports o k response
+func (o *V2ListHostDiskErasureReportsOK) SetPayload(payload models.DiskErasureReportList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiskErasureReportsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.DiskErasureReportList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListHostDiskErasureReportsUnauthorizedCode is the HTTP code returned for type V2ListHostDiskErasureReportsUnauthorized
+const V2ListHostDiskErasureReportsUnauthorizedCode int = 401
+
+/*
+V2ListHostDiskErasureReportsUnauthorized Unauthorized.
+
+swagger:response v2ListHostDiskErasureReportsUnauthorized
+*/
+type V2ListHostDiskErasureReportsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiskErasureReportsUnauthorized creates V2ListHostDiskErasureReportsUnauthorized with default headers values
+func NewV2ListHostDiskErasureReportsUnauthorized() *V2ListHostDiskErasureReportsUnauthorized {
+
+	return &V2ListHostDiskErasureReportsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list host disk erasure reports unauthorized response
+func (o *V2ListHostDiskErasureReportsUnauthorized) WithPayload(payload *models.InfraError) *V2ListHostDiskErasureReportsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host disk erasure reports unauthorized response
+func (o *V2ListHostDiskErasureReportsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiskErasureReportsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostDiskErasureReportsForbiddenCode is the HTTP code returned for type V2ListHostDiskErasureReportsForbidden
+const V2ListHostDiskErasureReportsForbiddenCode int = 403
+
+/*
+V2ListHostDiskErasureReportsForbidden Forbidden.
+
+swagger:response v2ListHostDiskErasureReportsForbidden
+*/
+type V2ListHostDiskErasureReportsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiskErasureReportsForbidden creates V2ListHostDiskErasureReportsForbidden with default headers values
+func NewV2ListHostDiskErasureReportsForbidden() *V2ListHostDiskErasureReportsForbidden {
+
+	return &V2ListHostDiskErasureReportsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list host disk erasure reports forbidden response
+func (o *V2ListHostDiskErasureReportsForbidden) WithPayload(payload *models.InfraError) *V2ListHostDiskErasureReportsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host disk erasure reports forbidden response
+func (o *V2ListHostDiskErasureReportsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiskErasureReportsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostDiskErasureReportsNotFoundCode is the HTTP code returned for type V2ListHostDiskErasureReportsNotFound
+const V2ListHostDiskErasureReportsNotFoundCode int = 404
+
+/*
+V2ListHostDiskErasureReportsNotFound Error.
+
+swagger:response v2ListHostDiskErasureReportsNotFound
+*/
+type V2ListHostDiskErasureReportsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiskErasureReportsNotFound creates V2ListHostDiskErasureReportsNotFound with default headers values
+func NewV2ListHostDiskErasureReportsNotFound() *V2ListHostDiskErasureReportsNotFound {
+
+	return &V2ListHostDiskErasureReportsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 list host disk erasure reports not found response
+func (o *V2ListHostDiskErasureReportsNotFound) WithPayload(payload *models.Error) *V2ListHostDiskErasureReportsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host disk erasure reports not found response
+func (o *V2ListHostDiskErasureReportsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiskErasureReportsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListHostDiskErasureReportsInternalServerErrorCode is the HTTP code returned for type V2ListHostDiskErasureReportsInternalServerError
+const V2ListHostDiskErasureReportsInternalServerErrorCode int = 500
+
+/*
+V2ListHostDiskErasureReportsInternalServerError Error.
+
+swagger:response v2ListHostDiskErasureReportsInternalServerError
+*/
+type V2ListHostDiskErasureReportsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListHostDiskErasureReportsInternalServerError creates V2ListHostDiskErasureReportsInternalServerError with default headers values
+func NewV2ListHostDiskErasureReportsInternalServerError() *V2ListHostDiskErasureReportsInternalServerError {
+
+	return &V2ListHostDiskErasureReportsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list host disk erasure reports internal server error response
+func (o *V2ListHostDiskErasureReportsInternalServerError) WithPayload(payload *models.Error) *V2ListHostDiskErasureReportsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list host disk erasure reports internal server error response
+func (o *V2ListHostDiskErasureReportsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListHostDiskErasureReportsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2SuggestNetworksHandlerFunc turns a function with the right signature into a v2 suggest networks handler
+type V2SuggestNetworksHandlerFunc func(V2SuggestNetworksParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2SuggestNetworksHandlerFunc) Handle(params V2SuggestNetworksParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2SuggestNetworksHandler interface for that can handle valid v2 suggest networks params
+type V2SuggestNetworksHandler interface {
+	Handle(V2SuggestNetworksParams, interface{}) middleware.Responder
+}
+
+// NewV2SuggestNetworks creates a new http.Handler for the v2 suggest networks operation
+func NewV2SuggestNetworks(ctx *middleware.Context, handler V2SuggestNetworksHandler) *V2SuggestNetworks {
+	return &V2SuggestNetworks{Context: ctx, Handler: handler}
+}
+
+/*
+	V2SuggestNetworks swagger:route GET /v2/clusters/{cluster_id}/suggest-networks installer v2SuggestNetworks
+
+Suggests cluster and service networks that don't overlap the networks of the hosts of the cluster, the reserved networks of the service and the networks of the other clusters of the organization.
+*/
+type V2SuggestNetworks struct {
+	Context *middleware.Context
+	Handler V2SuggestNetworksHandler
+}
+
+func (o *V2SuggestNetworks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2SuggestNetworksParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
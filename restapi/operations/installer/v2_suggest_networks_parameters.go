@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2SuggestNetworksParams creates a new V2SuggestNetworksParams object
+//
+// There are no default values defined in the spec.
+func NewV2SuggestNetworksParams() V2SuggestNetworksParams {
+
+	return V2SuggestNetworksParams{}
+}
+
+// V2SuggestNetworksParams contains all the bound params for the v2 suggest networks operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters v2SuggestNetworks
+type V2SuggestNetworksParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cluster to suggest networks for.
+	  Required: true
+	  In: path
+	*/
+	ClusterID strfmt.UUID
+	/*The address families of the suggested networks. By default, the address families of the hosts of the cluster.
+	  In: query
+	*/
+	StackType *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2SuggestNetworksParams() beforehand.
+func (o *V2SuggestNetworksParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
+	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStackType, qhkStackType, _ := qs.GetOK("stack_type")
+	if err := o.bindStackType(qStackType, qhkStackType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClusterID binds and validates parameter ClusterID from path.
+func (o *V2SuggestNetworksParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("cluster_id", "path", "strfmt.UUID", raw)
+	}
+	o.ClusterID = *(value.(*strfmt.UUID))
+
+	if err := o.validateClusterID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateClusterID carries on validations for parameter ClusterID
+func (o *V2SuggestNetworksParams) validateClusterID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("cluster_id", "path", "uuid", o.ClusterID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindStackType binds and validates parameter StackType from query.
+func (o *V2SuggestNetworksParams) bindStackType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.StackType = &raw
+
+	if err := o.validateStackType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStackType carries on validations for parameter StackType
+func (o *V2SuggestNetworksParams) validateStackType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("stack_type", "query", *o.StackType, []interface{}{"ipv4", "ipv6", "dual-stack"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2SuggestNetworksOKCode is the HTTP code returned for type V2SuggestNetworksOK
+const V2SuggestNetworksOKCode int = 200
+
+/*
+V2SuggestNetworksOK Success.
+
+swagger:response v2SuggestNetworksOK
+*/
+type V2SuggestNetworksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NetworksSuggestion `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksOK creates V2SuggestNetworksOK with default headers values
+func NewV2SuggestNetworksOK() *V2SuggestNetworksOK {
+
+	return &V2SuggestNetworksOK{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks o k response
+func (o *V2SuggestNetworksOK) WithPayload(payload *models.NetworksSuggestion) *V2SuggestNetworksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks o k response
+func (o *V2SuggestNetworksOK) SetPayload(payload *models.NetworksSuggestion) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SuggestNetworksBadRequestCode is the HTTP code returned for type V2SuggestNetworksBadRequest
+const V2SuggestNetworksBadRequestCode int = 400
+
+/*
+V2SuggestNetworksBadRequest Error.
+
+swagger:response v2SuggestNetworksBadRequest
+*/
+type V2SuggestNetworksBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksBadRequest creates V2SuggestNetworksBadRequest with default headers values
+func NewV2SuggestNetworksBadRequest() *V2SuggestNetworksBadRequest {
+
+	return &V2SuggestNetworksBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks bad request response
+func (o *V2SuggestNetworksBadRequest) WithPayload(payload *models.Error) *V2SuggestNetworksBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks bad request response
+func (o *V2SuggestNetworksBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SuggestNetworksUnauthorizedCode is the HTTP code returned for type V2SuggestNetworksUnauthorized
+const V2SuggestNetworksUnauthorizedCode int = 401
+
+/*
+V2SuggestNetworksUnauthorized Unauthorized.
+
+swagger:response v2SuggestNetworksUnauthorized
+*/
+type V2SuggestNetworksUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksUnauthorized creates V2SuggestNetworksUnauthorized with default headers values
+func NewV2SuggestNetworksUnauthorized() *V2SuggestNetworksUnauthorized {
+
+	return &V2SuggestNetworksUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks unauthorized response
+func (o *V2SuggestNetworksUnauthorized) WithPayload(payload *models.InfraError) *V2SuggestNetworksUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks unauthorized response
+func (o *V2SuggestNetworksUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SuggestNetworksForbiddenCode is the HTTP code returned for type V2SuggestNetworksForbidden
+const V2SuggestNetworksForbiddenCode int = 403
+
+/*
+V2SuggestNetworksForbidden Forbidden.
+
+swagger:response v2SuggestNetworksForbidden
+*/
+type V2SuggestNetworksForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksForbidden creates V2SuggestNetworksForbidden with default headers values
+func NewV2SuggestNetworksForbidden() *V2SuggestNetworksForbidden {
+
+	return &V2SuggestNetworksForbidden{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks forbidden response
+func (o *V2SuggestNetworksForbidden) WithPayload(payload *models.InfraError) *V2SuggestNetworksForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks forbidden response
+func (o *V2SuggestNetworksForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SuggestNetworksNotFoundCode is the HTTP code returned for type V2SuggestNetworksNotFound
+const V2SuggestNetworksNotFoundCode int = 404
+
+/*
+V2SuggestNetworksNotFound Error.
+
+swagger:response v2SuggestNetworksNotFound
+*/
+type V2SuggestNetworksNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksNotFound creates V2SuggestNetworksNotFound with default headers values
+func NewV2SuggestNetworksNotFound() *V2SuggestNetworksNotFound {
+
+	return &V2SuggestNetworksNotFound{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks not found response
+func (o *V2SuggestNetworksNotFound) WithPayload(payload *models.Error) *V2SuggestNetworksNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks not found response
+func (o *V2SuggestNetworksNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SuggestNetworksMethodNotAllowedCode is the HTTP code returned for type V2SuggestNetworksMethodNotAllowed
+const V2SuggestNetworksMethodNotAllowedCode int = 405
+
+/*
+V2SuggestNetworksMethodNotAllowed Method Not Allowed.
+
+swagger:response v2SuggestNetworksMethodNotAllowed
+*/
+type V2SuggestNetworksMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksMethodNotAllowed creates V2SuggestNetworksMethodNotAllowed with default headers values
+func NewV2SuggestNetworksMethodNotAllowed() *V2SuggestNetworksMethodNotAllowed {
+
+	return &V2SuggestNetworksMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks method not allowed response
+func (o *V2SuggestNetworksMethodNotAllowed) WithPayload(payload *models.Error) *V2SuggestNetworksMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks method not allowed response
+func (o *V2SuggestNetworksMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2SuggestNetworksInternalServerErrorCode is the HTTP code returned for type V2SuggestNetworksInternalServerError
+const V2SuggestNetworksInternalServerErrorCode int = 500
+
+/*
+V2SuggestNetworksInternalServerError Error.
+
+swagger:response v2SuggestNetworksInternalServerError
+*/
+type V2SuggestNetworksInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2SuggestNetworksInternalServerError creates V2SuggestNetworksInternalServerError with default headers values
+func NewV2SuggestNetworksInternalServerError() *V2SuggestNetworksInternalServerError {
+
+	return &V2SuggestNetworksInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 suggest networks internal server error response
+func (o *V2SuggestNetworksInternalServerError) WithPayload(payload *models.Error) *V2SuggestNetworksInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 suggest networks internal server error response
+func (o *V2SuggestNetworksInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2SuggestNetworksInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
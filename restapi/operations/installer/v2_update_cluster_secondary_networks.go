@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2UpdateClusterSecondaryNetworksHandlerFunc turns a function with the right signature into a v2 update cluster secondary networks handler
+type V2UpdateClusterSecondaryNetworksHandlerFunc func(V2UpdateClusterSecondaryNetworksParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2UpdateClusterSecondaryNetworksHandlerFunc) Handle(params V2UpdateClusterSecondaryNetworksParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2UpdateClusterSecondaryNetworksHandler interface for that can handle valid v2 update cluster secondary networks params
+type V2UpdateClusterSecondaryNetworksHandler interface {
+	Handle(V2UpdateClusterSecondaryNetworksParams, interface{}) middleware.Responder
+}
+
+// NewV2UpdateClusterSecondaryNetworks creates a new http.Handler for the v2 update cluster secondary networks operation
+func NewV2UpdateClusterSecondaryNetworks(ctx *middleware.Context, handler V2UpdateClusterSecondaryNetworksHandler) *V2UpdateClusterSecondaryNetworks {
+	return &V2UpdateClusterSecondaryNetworks{Context: ctx, Handler: handler}
+}
+
+/*
+	V2UpdateClusterSecondaryNetworks swagger:route PUT /v2/clusters/{cluster_id}/secondary-networks installer v2UpdateClusterSecondaryNetworks
+
+Sets the secondary network policies of the cluster, the NMState configurations applied to the selected hosts of the cluster at installation time.
+*/
+type V2UpdateClusterSecondaryNetworks struct {
+	Context *middleware.Context
+	Handler V2UpdateClusterSecondaryNetworksHandler
+}
+
+func (o *V2UpdateClusterSecondaryNetworks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2UpdateClusterSecondaryNetworksParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
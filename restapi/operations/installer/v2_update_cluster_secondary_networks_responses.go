@@ -0,0 +1,377 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package installer
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2UpdateClusterSecondaryNetworksOKCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksOK
+const V2UpdateClusterSecondaryNetworksOKCode int = 200
+
+/*
+V2UpdateClusterSecondaryNetworksOK Success.
+
+swagger:response v2UpdateClusterSecondaryNetworksOK
+*/
+type V2UpdateClusterSecondaryNetworksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.SecondaryNetworkPolicies `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksOK creates V2UpdateClusterSecondaryNetworksOK with default headers values
+func NewV2UpdateClusterSecondaryNetworksOK() *V2UpdateClusterSecondaryNetworksOK {
+
+	return &V2UpdateClusterSecondaryNetworksOK{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks o k response
+func (o *V2UpdateClusterSecondaryNetworksOK) WithPayload(payload models.SecondaryNetworkPolicies) *V2UpdateClusterSecondaryNetworksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks o k response
+func (o *V2UpdateClusterSecondaryNetworksOK) SetPayload(payload models.SecondaryNetworkPolicies) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.SecondaryNetworkPolicies{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksBadRequestCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksBadRequest
+const V2UpdateClusterSecondaryNetworksBadRequestCode int = 400
+
+/*
+V2UpdateClusterSecondaryNetworksBadRequest Error.
+
+swagger:response v2UpdateClusterSecondaryNetworksBadRequest
+*/
+type V2UpdateClusterSecondaryNetworksBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksBadRequest creates V2UpdateClusterSecondaryNetworksBadRequest with default headers values
+func NewV2UpdateClusterSecondaryNetworksBadRequest() *V2UpdateClusterSecondaryNetworksBadRequest {
+
+	return &V2UpdateClusterSecondaryNetworksBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks bad request response
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) WithPayload(payload *models.Error) *V2UpdateClusterSecondaryNetworksBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks bad request response
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksUnauthorizedCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksUnauthorized
+const V2UpdateClusterSecondaryNetworksUnauthorizedCode int = 401
+
+/*
+V2UpdateClusterSecondaryNetworksUnauthorized Unauthorized.
+
+swagger:response v2UpdateClusterSecondaryNetworksUnauthorized
+*/
+type V2UpdateClusterSecondaryNetworksUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksUnauthorized creates V2UpdateClusterSecondaryNetworksUnauthorized with default headers values
+func NewV2UpdateClusterSecondaryNetworksUnauthorized() *V2UpdateClusterSecondaryNetworksUnauthorized {
+
+	return &V2UpdateClusterSecondaryNetworksUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks unauthorized response
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) WithPayload(payload *models.InfraError) *V2UpdateClusterSecondaryNetworksUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks unauthorized response
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksForbiddenCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksForbidden
+const V2UpdateClusterSecondaryNetworksForbiddenCode int = 403
+
+/*
+V2UpdateClusterSecondaryNetworksForbidden Forbidden.
+
+swagger:response v2UpdateClusterSecondaryNetworksForbidden
+*/
+type V2UpdateClusterSecondaryNetworksForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksForbidden creates V2UpdateClusterSecondaryNetworksForbidden with default headers values
+func NewV2UpdateClusterSecondaryNetworksForbidden() *V2UpdateClusterSecondaryNetworksForbidden {
+
+	return &V2UpdateClusterSecondaryNetworksForbidden{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks forbidden response
+func (o *V2UpdateClusterSecondaryNetworksForbidden) WithPayload(payload *models.InfraError) *V2UpdateClusterSecondaryNetworksForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks forbidden response
+func (o *V2UpdateClusterSecondaryNetworksForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksNotFoundCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksNotFound
+const V2UpdateClusterSecondaryNetworksNotFoundCode int = 404
+
+/*
+V2UpdateClusterSecondaryNetworksNotFound Error.
+
+swagger:response v2UpdateClusterSecondaryNetworksNotFound
+*/
+type V2UpdateClusterSecondaryNetworksNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksNotFound creates V2UpdateClusterSecondaryNetworksNotFound with default headers values
+func NewV2UpdateClusterSecondaryNetworksNotFound() *V2UpdateClusterSecondaryNetworksNotFound {
+
+	return &V2UpdateClusterSecondaryNetworksNotFound{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks not found response
+func (o *V2UpdateClusterSecondaryNetworksNotFound) WithPayload(payload *models.Error) *V2UpdateClusterSecondaryNetworksNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks not found response
+func (o *V2UpdateClusterSecondaryNetworksNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksMethodNotAllowedCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksMethodNotAllowed
+const V2UpdateClusterSecondaryNetworksMethodNotAllowedCode int = 405
+
+/*
+V2UpdateClusterSecondaryNetworksMethodNotAllowed Method Not Allowed.
+
+swagger:response v2UpdateClusterSecondaryNetworksMethodNotAllowed
+*/
+type V2UpdateClusterSecondaryNetworksMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksMethodNotAllowed creates V2UpdateClusterSecondaryNetworksMethodNotAllowed with default headers values
+func NewV2UpdateClusterSecondaryNetworksMethodNotAllowed() *V2UpdateClusterSecondaryNetworksMethodNotAllowed {
+
+	return &V2UpdateClusterSecondaryNetworksMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks method not allowed response
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) WithPayload(payload *models.Error) *V2UpdateClusterSecondaryNetworksMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks method not allowed response
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksConflictCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksConflict
+const V2UpdateClusterSecondaryNetworksConflictCode int = 409
+
+/*
+V2UpdateClusterSecondaryNetworksConflict Error.
+
+swagger:response v2UpdateClusterSecondaryNetworksConflict
+*/
+type V2UpdateClusterSecondaryNetworksConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksConflict creates V2UpdateClusterSecondaryNetworksConflict with default headers values
+func NewV2UpdateClusterSecondaryNetworksConflict() *V2UpdateClusterSecondaryNetworksConflict {
+
+	return &V2UpdateClusterSecondaryNetworksConflict{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks conflict response
+func (o *V2UpdateClusterSecondaryNetworksConflict) WithPayload(payload *models.Error) *V2UpdateClusterSecondaryNetworksConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks conflict response
+func (o *V2UpdateClusterSecondaryNetworksConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2UpdateClusterSecondaryNetworksInternalServerErrorCode is the HTTP code returned for type V2UpdateClusterSecondaryNetworksInternalServerError
+const V2UpdateClusterSecondaryNetworksInternalServerErrorCode int = 500
+
+/*
+V2UpdateClusterSecondaryNetworksInternalServerError Error.
+
+swagger:response v2UpdateClusterSecondaryNetworksInternalServerError
+*/
+type V2UpdateClusterSecondaryNetworksInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2UpdateClusterSecondaryNetworksInternalServerError creates V2UpdateClusterSecondaryNetworksInternalServerError with default headers values
+func NewV2UpdateClusterSecondaryNetworksInternalServerError() *V2UpdateClusterSecondaryNetworksInternalServerError {
+
+	return &V2UpdateClusterSecondaryNetworksInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 update cluster secondary networks internal server error response
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) WithPayload(payload *models.Error) *V2UpdateClusterSecondaryNetworksInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 update cluster secondary networks internal server error response
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2UpdateClusterSecondaryNetworksInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2EnableClusterOperatorsHandlerFunc turns a function with the right signature into a v2 enable cluster operators handler
+type V2EnableClusterOperatorsHandlerFunc func(V2EnableClusterOperatorsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2EnableClusterOperatorsHandlerFunc) Handle(params V2EnableClusterOperatorsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2EnableClusterOperatorsHandler interface for that can handle valid v2 enable cluster operators params
+type V2EnableClusterOperatorsHandler interface {
+	Handle(V2EnableClusterOperatorsParams, interface{}) middleware.Responder
+}
+
+// NewV2EnableClusterOperators creates a new http.Handler for the v2 enable cluster operators operation
+func NewV2EnableClusterOperators(ctx *middleware.Context, handler V2EnableClusterOperatorsHandler) *V2EnableClusterOperators {
+	return &V2EnableClusterOperators{Context: ctx, Handler: handler}
+}
+
+/*
+	V2EnableClusterOperators swagger:route POST /v2/clusters/{cluster_id}/monitored-operators operators v2EnableClusterOperators
+
+Enables additional OLM operators on an installed cluster. The hardware of the nodes of the cluster is validated against the requirements of the operators, the manifests of the operators are applied to the cluster and the operators are added to the monitored operators of the cluster, whose status tracks the rollout. Enabling an operator that is already enabled applies its manifests again.
+*/
+type V2EnableClusterOperators struct {
+	Context *middleware.Context
+	Handler V2EnableClusterOperatorsHandler
+}
+
+func (o *V2EnableClusterOperators) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2EnableClusterOperatorsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,332 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2EnableClusterOperatorsCreatedCode is the HTTP code returned for type V2EnableClusterOperatorsCreated
+const V2EnableClusterOperatorsCreatedCode int = 201
+
+/*
+V2EnableClusterOperatorsCreated Success.
+
+swagger:response v2EnableClusterOperatorsCreated
+*/
+type V2EnableClusterOperatorsCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.MonitoredOperatorsList `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsCreated creates V2EnableClusterOperatorsCreated with default headers values
+func NewV2EnableClusterOperatorsCreated() *V2EnableClusterOperatorsCreated {
+
+	return &V2EnableClusterOperatorsCreated{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators created response
+func (o *V2EnableClusterOperatorsCreated) WithPayload(payload models.MonitoredOperatorsList) *V2EnableClusterOperatorsCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators created response
+func (o *V2EnableClusterOperatorsCreated) SetPayload(payload models.MonitoredOperatorsList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.MonitoredOperatorsList{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2EnableClusterOperatorsBadRequestCode is the HTTP code returned for type V2EnableClusterOperatorsBadRequest
+const V2EnableClusterOperatorsBadRequestCode int = 400
+
+/*
+V2EnableClusterOperatorsBadRequest Error.
+
+swagger:response v2EnableClusterOperatorsBadRequest
+*/
+type V2EnableClusterOperatorsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsBadRequest creates V2EnableClusterOperatorsBadRequest with default headers values
+func NewV2EnableClusterOperatorsBadRequest() *V2EnableClusterOperatorsBadRequest {
+
+	return &V2EnableClusterOperatorsBadRequest{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators bad request response
+func (o *V2EnableClusterOperatorsBadRequest) WithPayload(payload *models.Error) *V2EnableClusterOperatorsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators bad request response
+func (o *V2EnableClusterOperatorsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EnableClusterOperatorsUnauthorizedCode is the HTTP code returned for type V2EnableClusterOperatorsUnauthorized
+const V2EnableClusterOperatorsUnauthorizedCode int = 401
+
+/*
+V2EnableClusterOperatorsUnauthorized Unauthorized.
+
+swagger:response v2EnableClusterOperatorsUnauthorized
+*/
+type V2EnableClusterOperatorsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsUnauthorized creates V2EnableClusterOperatorsUnauthorized with default headers values
+func NewV2EnableClusterOperatorsUnauthorized() *V2EnableClusterOperatorsUnauthorized {
+
+	return &V2EnableClusterOperatorsUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators unauthorized response
+func (o *V2EnableClusterOperatorsUnauthorized) WithPayload(payload *models.InfraError) *V2EnableClusterOperatorsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators unauthorized response
+func (o *V2EnableClusterOperatorsUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EnableClusterOperatorsForbiddenCode is the HTTP code returned for type V2EnableClusterOperatorsForbidden
+const V2EnableClusterOperatorsForbiddenCode int = 403
+
+/*
+V2EnableClusterOperatorsForbidden Forbidden.
+
+swagger:response v2EnableClusterOperatorsForbidden
+*/
+type V2EnableClusterOperatorsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsForbidden creates V2EnableClusterOperatorsForbidden with default headers values
+func NewV2EnableClusterOperatorsForbidden() *V2EnableClusterOperatorsForbidden {
+
+	return &V2EnableClusterOperatorsForbidden{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators forbidden response
+func (o *V2EnableClusterOperatorsForbidden) WithPayload(payload *models.InfraError) *V2EnableClusterOperatorsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators forbidden response
+func (o *V2EnableClusterOperatorsForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EnableClusterOperatorsNotFoundCode is the HTTP code returned for type V2EnableClusterOperatorsNotFound
+const V2EnableClusterOperatorsNotFoundCode int = 404
+
+/*
+V2EnableClusterOperatorsNotFound Error.
+
+swagger:response v2EnableClusterOperatorsNotFound
+*/
+type V2EnableClusterOperatorsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsNotFound creates V2EnableClusterOperatorsNotFound with default headers values
+func NewV2EnableClusterOperatorsNotFound() *V2EnableClusterOperatorsNotFound {
+
+	return &V2EnableClusterOperatorsNotFound{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators not found response
+func (o *V2EnableClusterOperatorsNotFound) WithPayload(payload *models.Error) *V2EnableClusterOperatorsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators not found response
+func (o *V2EnableClusterOperatorsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EnableClusterOperatorsConflictCode is the HTTP code returned for type V2EnableClusterOperatorsConflict
+const V2EnableClusterOperatorsConflictCode int = 409
+
+/*
+V2EnableClusterOperatorsConflict Error.
+
+swagger:response v2EnableClusterOperatorsConflict
+*/
+type V2EnableClusterOperatorsConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsConflict creates V2EnableClusterOperatorsConflict with default headers values
+func NewV2EnableClusterOperatorsConflict() *V2EnableClusterOperatorsConflict {
+
+	return &V2EnableClusterOperatorsConflict{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators conflict response
+func (o *V2EnableClusterOperatorsConflict) WithPayload(payload *models.Error) *V2EnableClusterOperatorsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators conflict response
+func (o *V2EnableClusterOperatorsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2EnableClusterOperatorsInternalServerErrorCode is the HTTP code returned for type V2EnableClusterOperatorsInternalServerError
+const V2EnableClusterOperatorsInternalServerErrorCode int = 500
+
+/*
+V2EnableClusterOperatorsInternalServerError Error.
+
+swagger:response v2EnableClusterOperatorsInternalServerError
+*/
+type V2EnableClusterOperatorsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2EnableClusterOperatorsInternalServerError creates V2EnableClusterOperatorsInternalServerError with default headers values
+func NewV2EnableClusterOperatorsInternalServerError() *V2EnableClusterOperatorsInternalServerError {
+
+	return &V2EnableClusterOperatorsInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 enable cluster operators internal server error response
+func (o *V2EnableClusterOperatorsInternalServerError) WithPayload(payload *models.Error) *V2EnableClusterOperatorsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 enable cluster operators internal server error response
+func (o *V2EnableClusterOperatorsInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2EnableClusterOperatorsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
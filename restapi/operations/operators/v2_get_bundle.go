@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2GetBundleHandlerFunc turns a function with the right signature into a v2 get bundle handler
+type V2GetBundleHandlerFunc func(V2GetBundleParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2GetBundleHandlerFunc) Handle(params V2GetBundleParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2GetBundleHandler interface for that can handle valid v2 get bundle params
+type V2GetBundleHandler interface {
+	Handle(V2GetBundleParams, interface{}) middleware.Responder
+}
+
+// NewV2GetBundle creates a new http.Handler for the v2 get bundle operation
+func NewV2GetBundle(ctx *middleware.Context, handler V2GetBundleHandler) *V2GetBundle {
+	return &V2GetBundle{Context: ctx, Handler: handler}
+}
+
+/*
+	V2GetBundle swagger:route GET /v2/operators/bundles/{bundle_id} operators v2GetBundle
+
+Retrieves a bundle of operators.
+*/
+type V2GetBundle struct {
+	Context *middleware.Context
+	Handler V2GetBundleHandler
+}
+
+func (o *V2GetBundle) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2GetBundleParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
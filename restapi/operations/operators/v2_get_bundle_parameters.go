@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewV2GetBundleParams creates a new V2GetBundleParams object
+//
+// There are no default values defined in the spec.
+func NewV2GetBundleParams() V2GetBundleParams {
+
+	return V2GetBundleParams{}
+}
+
+// V2GetBundleParams contains all the bound params for the v2 get bundle operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters V2GetBundle
+type V2GetBundleParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Identifier of the bundle, for example "virtualization".
+	  Required: true
+	  In: path
+	*/
+	BundleID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2GetBundleParams() beforehand.
+func (o *V2GetBundleParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rBundleID, rhkBundleID, _ := route.Params.GetOK("bundle_id")
+	if err := o.bindBundleID(rBundleID, rhkBundleID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBundleID binds and validates parameter BundleID from path.
+func (o *V2GetBundleParams) bindBundleID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.BundleID = raw
+
+	return nil
+}
@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2GetBundleOKCode is the HTTP code returned for type V2GetBundleOK
+const V2GetBundleOKCode int = 200
+
+/*
+V2GetBundleOK Success.
+
+swagger:response v2GetBundleOK
+*/
+type V2GetBundleOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Bundle `json:"body,omitempty"`
+}
+
+// NewV2GetBundleOK creates V2GetBundleOK with default headers values
+func NewV2GetBundleOK() *V2GetBundleOK {
+
+	return &V2GetBundleOK{}
+}
+
+// WithPayload adds the payload to the v2 get bundle o k response
+func (o *V2GetBundleOK) WithPayload(payload *models.Bundle) *V2GetBundleOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bundle o k response
+func (o *V2GetBundleOK) SetPayload(payload *models.Bundle) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBundleOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBundleUnauthorizedCode is the HTTP code returned for type V2GetBundleUnauthorized
+const V2GetBundleUnauthorizedCode int = 401
+
+/*
+V2GetBundleUnauthorized Unauthorized.
+
+swagger:response v2GetBundleUnauthorized
+*/
+type V2GetBundleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetBundleUnauthorized creates V2GetBundleUnauthorized with default headers values
+func NewV2GetBundleUnauthorized() *V2GetBundleUnauthorized {
+
+	return &V2GetBundleUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 get bundle unauthorized response
+func (o *V2GetBundleUnauthorized) WithPayload(payload *models.InfraError) *V2GetBundleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bundle unauthorized response
+func (o *V2GetBundleUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBundleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBundleForbiddenCode is the HTTP code returned for type V2GetBundleForbidden
+const V2GetBundleForbiddenCode int = 403
+
+/*
+V2GetBundleForbidden Forbidden.
+
+swagger:response v2GetBundleForbidden
+*/
+type V2GetBundleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2GetBundleForbidden creates V2GetBundleForbidden with default headers values
+func NewV2GetBundleForbidden() *V2GetBundleForbidden {
+
+	return &V2GetBundleForbidden{}
+}
+
+// WithPayload adds the payload to the v2 get bundle forbidden response
+func (o *V2GetBundleForbidden) WithPayload(payload *models.InfraError) *V2GetBundleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bundle forbidden response
+func (o *V2GetBundleForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBundleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBundleNotFoundCode is the HTTP code returned for type V2GetBundleNotFound
+const V2GetBundleNotFoundCode int = 404
+
+/*
+V2GetBundleNotFound Error.
+
+swagger:response v2GetBundleNotFound
+*/
+type V2GetBundleNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetBundleNotFound creates V2GetBundleNotFound with default headers values
+func NewV2GetBundleNotFound() *V2GetBundleNotFound {
+
+	return &V2GetBundleNotFound{}
+}
+
+// WithPayload adds the payload to the v2 get bundle not found response
+func (o *V2GetBundleNotFound) WithPayload(payload *models.Error) *V2GetBundleNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bundle not found response
+func (o *V2GetBundleNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBundleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2GetBundleInternalServerErrorCode is the HTTP code returned for type V2GetBundleInternalServerError
+const V2GetBundleInternalServerErrorCode int = 500
+
+/*
+V2GetBundleInternalServerError Error.
+
+swagger:response v2GetBundleInternalServerError
+*/
+type V2GetBundleInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2GetBundleInternalServerError creates V2GetBundleInternalServerError with default headers values
+func NewV2GetBundleInternalServerError() *V2GetBundleInternalServerError {
+
+	return &V2GetBundleInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 get bundle internal server error response
+func (o *V2GetBundleInternalServerError) WithPayload(payload *models.Error) *V2GetBundleInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 get bundle internal server error response
+func (o *V2GetBundleInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2GetBundleInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// V2ListBundlesHandlerFunc turns a function with the right signature into a v2 list bundles handler
+type V2ListBundlesHandlerFunc func(V2ListBundlesParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn V2ListBundlesHandlerFunc) Handle(params V2ListBundlesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// V2ListBundlesHandler interface for that can handle valid v2 list bundles params
+type V2ListBundlesHandler interface {
+	Handle(V2ListBundlesParams, interface{}) middleware.Responder
+}
+
+// NewV2ListBundles creates a new http.Handler for the v2 list bundles operation
+func NewV2ListBundles(ctx *middleware.Context, handler V2ListBundlesHandler) *V2ListBundles {
+	return &V2ListBundles{Context: ctx, Handler: handler}
+}
+
+/*
+	V2ListBundles swagger:route GET /v2/operators/bundles operators v2ListBundles
+
+Lists the bundles of operators that can be installed together, such as virtualization. When an OpenShift version is given, the bundles that aren't supported on the version, CPU architecture and platform are omitted.
+*/
+type V2ListBundles struct {
+	Context *middleware.Context
+	Handler V2ListBundlesHandler
+}
+
+func (o *V2ListBundles) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewV2ListBundlesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,155 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewV2ListBundlesParams creates a new V2ListBundlesParams object
+// with the default values initialized.
+func NewV2ListBundlesParams() V2ListBundlesParams {
+
+	var (
+		// initialize parameters with default values
+
+		cPUArchitectureDefault = string("x86_64")
+	)
+
+	return V2ListBundlesParams{
+		CPUArchitecture: &cPUArchitectureDefault,
+	}
+}
+
+// V2ListBundlesParams contains all the bound params for the v2 list bundles operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters V2ListBundles
+type V2ListBundlesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The CPU architecture of the cluster.
+	  In: query
+	  Default: "x86_64"
+	*/
+	CPUArchitecture *string
+	/*Version of the OpenShift cluster.
+	  In: query
+	*/
+	OpenshiftVersion *string
+	/*The platform of the cluster.
+	  In: query
+	*/
+	PlatformType *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewV2ListBundlesParams() beforehand.
+func (o *V2ListBundlesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qCPUArchitecture, qhkCPUArchitecture, _ := qs.GetOK("cpu_architecture")
+	if err := o.bindCPUArchitecture(qCPUArchitecture, qhkCPUArchitecture, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOpenshiftVersion, qhkOpenshiftVersion, _ := qs.GetOK("openshift_version")
+	if err := o.bindOpenshiftVersion(qOpenshiftVersion, qhkOpenshiftVersion, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPlatformType, qhkPlatformType, _ := qs.GetOK("platform_type")
+	if err := o.bindPlatformType(qPlatformType, qhkPlatformType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCPUArchitecture binds and validates parameter CPUArchitecture from query.
+func (o *V2ListBundlesParams) bindCPUArchitecture(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewV2ListBundlesParams()
+		return nil
+	}
+	o.CPUArchitecture = &raw
+
+	if err := o.validateCPUArchitecture(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCPUArchitecture carries on validations for parameter CPUArchitecture
+func (o *V2ListBundlesParams) validateCPUArchitecture(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("cpu_architecture", "query", *o.CPUArchitecture, []interface{}{"x86_64", "aarch64", "arm64", "ppc64le", "s390x", "multi"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOpenshiftVersion binds and validates parameter OpenshiftVersion from query.
+func (o *V2ListBundlesParams) bindOpenshiftVersion(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.OpenshiftVersion = &raw
+
+	return nil
+}
+
+// bindPlatformType binds and validates parameter PlatformType from query.
+func (o *V2ListBundlesParams) bindPlatformType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PlatformType = &raw
+
+	return nil
+}
@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operators
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openshift/assisted-service/models"
+)
+
+// V2ListBundlesOKCode is the HTTP code returned for type V2ListBundlesOK
+const V2ListBundlesOKCode int = 200
+
+/*
+V2ListBundlesOK Success.
+
+swagger:response v2ListBundlesOK
+*/
+type V2ListBundlesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Bundle `json:"body,omitempty"`
+}
+
+// NewV2ListBundlesOK creates V2ListBundlesOK with default headers values
+func NewV2ListBundlesOK() *V2ListBundlesOK {
+
+	return &V2ListBundlesOK{}
+}
+
+// WithPayload adds the payload to the v2 list bundles o k response
+func (o *V2ListBundlesOK) WithPayload(payload []*models.Bundle) *V2ListBundlesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bundles o k response
+func (o *V2ListBundlesOK) SetPayload(payload []*models.Bundle) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBundlesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Bundle, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// V2ListBundlesUnauthorizedCode is the HTTP code returned for type V2ListBundlesUnauthorized
+const V2ListBundlesUnauthorizedCode int = 401
+
+/*
+V2ListBundlesUnauthorized Unauthorized.
+
+swagger:response v2ListBundlesUnauthorized
+*/
+type V2ListBundlesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListBundlesUnauthorized creates V2ListBundlesUnauthorized with default headers values
+func NewV2ListBundlesUnauthorized() *V2ListBundlesUnauthorized {
+
+	return &V2ListBundlesUnauthorized{}
+}
+
+// WithPayload adds the payload to the v2 list bundles unauthorized response
+func (o *V2ListBundlesUnauthorized) WithPayload(payload *models.InfraError) *V2ListBundlesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bundles unauthorized response
+func (o *V2ListBundlesUnauthorized) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBundlesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListBundlesForbiddenCode is the HTTP code returned for type V2ListBundlesForbidden
+const V2ListBundlesForbiddenCode int = 403
+
+/*
+V2ListBundlesForbidden Forbidden.
+
+swagger:response v2ListBundlesForbidden
+*/
+type V2ListBundlesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.InfraError `json:"body,omitempty"`
+}
+
+// NewV2ListBundlesForbidden creates V2ListBundlesForbidden with default headers values
+func NewV2ListBundlesForbidden() *V2ListBundlesForbidden {
+
+	return &V2ListBundlesForbidden{}
+}
+
+// WithPayload adds the payload to the v2 list bundles forbidden response
+func (o *V2ListBundlesForbidden) WithPayload(payload *models.InfraError) *V2ListBundlesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bundles forbidden response
+func (o *V2ListBundlesForbidden) SetPayload(payload *models.InfraError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBundlesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// V2ListBundlesInternalServerErrorCode is the HTTP code returned for type V2ListBundlesInternalServerError
+const V2ListBundlesInternalServerErrorCode int = 500
+
+/*
+V2ListBundlesInternalServerError Error.
+
+swagger:response v2ListBundlesInternalServerError
+*/
+type V2ListBundlesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewV2ListBundlesInternalServerError creates V2ListBundlesInternalServerError with default headers values
+func NewV2ListBundlesInternalServerError() *V2ListBundlesInternalServerError {
+
+	return &V2ListBundlesInternalServerError{}
+}
+
+// WithPayload adds the payload to the v2 list bundles internal server error response
+func (o *V2ListBundlesInternalServerError) WithPayload(payload *models.Error) *V2ListBundlesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the v2 list bundles internal server error response
+func (o *V2ListBundlesInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *V2ListBundlesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
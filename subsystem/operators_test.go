@@ -64,7 +64,64 @@ var _ = Describe("Operators endpoint tests", func() {
 			reply, err := userBMClient.Operators.V2ListOperatorProperties(context.TODO(), params)
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(reply.Payload).To(BeEquivalentTo(models.OperatorProperties{}))
+			Expect(reply.Payload).To(ContainElement(HaveField("Name", "channel")))
+		})
+	})
+
+	Context("bundles", func() {
+		It("should return all the bundles", func() {
+			reply, err := userBMClient.Operators.V2ListBundles(context.TODO(), opclient.NewV2ListBundlesParams())
+
+			Expect(err).ToNot(HaveOccurred())
+			ids := []string{}
+			for _, bundle := range reply.GetPayload() {
+				ids = append(ids, swag.StringValue(bundle.ID))
+			}
+			Expect(ids).To(ConsistOf(models.BundleIDVirtualization, models.BundleIDOpenshiftAi))
+		})
+
+		It("should provide a bundle with its requirements", func() {
+			params := opclient.NewV2GetBundleParams().WithBundleID(models.BundleIDVirtualization)
+			reply, err := userBMClient.Operators.V2GetBundle(context.TODO(), params)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reply.Payload.Operators).To(ContainElement(&models.OperatorCreateParams{Name: cnv.Operator.Name}))
+			Expect(reply.Payload.Requirements.Master.Quantitative.CPUCores).To(BeNumerically(">", 0))
+		})
+
+		It("should create a cluster with the operators of a bundle", func() {
+			reply, err := userBMClient.Installer.V2RegisterCluster(context.TODO(), &installer.V2RegisterClusterParams{
+				NewClusterParams: &models.ClusterCreateParams{
+					Name:             swag.String("test-cluster"),
+					OpenshiftVersion: swag.String(openshiftVersion),
+					PullSecret:       swag.String(pullSecret),
+					OlmBundles:       []string{models.BundleIDVirtualization},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			c := &common.Cluster{Cluster: *reply.GetPayload()}
+			for _, operatorName := range []string{cnv.Operator.Name, mtv.Operator.Name, lso.Operator.Name} {
+				Expect(operatorscommon.HasOperator(c.MonitoredOperators, operatorName)).Should(BeTrue())
+			}
+		})
+	})
+
+	Context("enable operators on installed clusters", func() {
+		It("should reject a cluster that isn't installed", func() {
+			reply, err := userBMClient.Installer.V2RegisterCluster(context.TODO(), &installer.V2RegisterClusterParams{
+				NewClusterParams: &models.ClusterCreateParams{
+					Name:             swag.String("test-cluster"),
+					OpenshiftVersion: swag.String(openshiftVersion),
+					PullSecret:       swag.String(pullSecret),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = userBMClient.Operators.V2EnableClusterOperators(context.TODO(), opclient.NewV2EnableClusterOperatorsParams().
+				WithClusterID(*reply.GetPayload().ID).
+				WithOperators([]*models.OperatorCreateParams{{Name: lvm.Operator.Name}}))
+			Expect(err).To(BeAssignableToTypeOf(opclient.NewV2EnableClusterOperatorsConflict()))
 		})
 	})
 
@@ -524,6 +524,7 @@ func generateEssentialHostStepsWithInventory(ctx context.Context, h *models.Host
 	generateFAPostStepReply(ctx, h, validFreeAddresses)
 	generateNTPPostStepReply(ctx, h, []*models.NtpSource{common.TestNTPSourceSynced})
 	generateDomainNameResolutionReply(ctx, h, *common.TestDomainNameResolutionsSuccess)
+	generateLoadBalancerCheckReply(ctx, h)
 }
 
 func generateDomainResolution(ctx context.Context, h *models.Host, name string, baseDomain string) {
@@ -671,6 +672,34 @@ func generateDomainNameResolutionReply(ctx context.Context, h *models.Host, doma
 	Expect(err).To(BeNil())
 }
 
+func generateLoadBalancerCheckReply(ctx context.Context, h *models.Host) {
+	response := models.LoadBalancerCheckResponse{
+		Endpoints: []*models.LoadBalancerEndpointResult{
+			{
+				Endpoint: &models.LoadBalancerEndpoint{
+					Name: swag.String(models.LoadBalancerEndpointNameAPI),
+					Host: swag.String("api.test-cluster.example.com"),
+					Port: swag.Int64(6443),
+				},
+				Reachable: swag.Bool(true),
+			},
+		},
+	}
+	b, err := json.Marshal(&response)
+	Expect(err).NotTo(HaveOccurred())
+	_, err = agentBMClient.Installer.V2PostStepReply(ctx, &installer.V2PostStepReplyParams{
+		InfraEnvID: h.InfraEnvID,
+		HostID:     *h.ID,
+		Reply: &models.StepReply{
+			ExitCode: 0,
+			Output:   string(b),
+			StepID:   string(models.StepTypeLoadBalancerCheck),
+			StepType: models.StepTypeLoadBalancerCheck,
+		},
+	})
+	Expect(err).To(BeNil())
+}
+
 func updateVipParams(ctx context.Context, clusterID strfmt.UUID) {
 	apiVip := "1.2.3.5"
 	ingressVip := "1.2.3.6"